@@ -25,9 +25,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 const (
+	getGenesisPath          = "/eth/v1/beacon/genesis"
 	getSignedBlockPath      = "/eth/v2/beacon/blocks"
 	getBlockRootPath        = "/eth/v1/beacon/blocks/{{.Id}}/root"
 	getForkForStatePath     = "/eth/v1/beacon/states/{{.Id}}/fork"
@@ -35,6 +37,8 @@ const (
 	getForkSchedulePath     = "/eth/v1/config/fork_schedule"
 	getStatePath            = "/eth/v2/debug/beacon/states"
 	getNodeVersionPath      = "/eth/v1/node/version"
+	getForkChoiceGraphPath  = "/eth/v1alpha1/debug/forkchoice_graph"
+	postVoluntaryExitPath   = "/eth/v1/beacon/pool/voluntary_exits"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -171,6 +175,32 @@ func (c *Client) get(ctx context.Context, path string, opts ...reqOption) ([]byt
 	return b, nil
 }
 
+// post is a generic, opinionated POST function sending a JSON body, to reduce boilerplate amongst the
+// submitters in this package.
+func (c *Client) post(ctx context.Context, path string, body []byte) ([]byte, error) {
+	u := c.baseURL.ResolveReference(&url.URL{Path: path})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r, err := c.hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = r.Body.Close()
+	}()
+	if r.StatusCode != http.StatusOK {
+		return nil, non200Err(r)
+	}
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading http response body from %s", path)
+	}
+	return b, nil
+}
+
 func renderGetBlockPath(id StateOrBlockId) string {
 	return path.Join(getSignedBlockPath, string(id))
 }
@@ -343,6 +373,45 @@ func (c *Client) GetWeakSubjectivity(ctx context.Context) (*WeakSubjectivityData
 	}, nil
 }
 
+// GetForkChoiceGraph retrieves the forkchoice block tree of the beacon node as a graph, from its
+// debug API, with every block of the tree along with its weight and the checkpoints of the store.
+func (c *Client) GetForkChoiceGraph(ctx context.Context) (*ethpb.ForkChoiceGraph, error) {
+	b, err := c.get(ctx, getForkChoiceGraphPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting forkchoice graph")
+	}
+	g := &ethpb.ForkChoiceGraph{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, g); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling response body: %s", string(b))
+	}
+	return g, nil
+}
+
+// GetGenesis retrieves the genesis time, genesis validators root and genesis fork version of the chain.
+func (c *Client) GetGenesis(ctx context.Context) (*apimiddleware.GenesisResponse_GenesisJson, error) {
+	b, err := c.get(ctx, getGenesisPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting genesis")
+	}
+	gr := &apimiddleware.GenesisResponseJson{}
+	if err := json.Unmarshal(b, gr); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling response body: %s", string(b))
+	}
+	if gr.Data == nil {
+		return nil, errors.New("empty genesis response")
+	}
+	return gr.Data, nil
+}
+
+// SubmitVoluntaryExit submits a signed voluntary exit, encoded as the JSON body expected by the beacon node API,
+// to the pool of the beacon node.
+func (c *Client) SubmitVoluntaryExit(ctx context.Context, signedExit []byte) error {
+	if _, err := c.post(ctx, postVoluntaryExitPath, signedExit); err != nil {
+		return errors.Wrap(err, "error submitting voluntary exit")
+	}
+	return nil
+}
+
 func non200Err(response *http.Response) error {
 	bodyBytes, err := io.ReadAll(response.Body)
 	var body string
@@ -1,6 +1,11 @@
 package beacon
 
 import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
@@ -136,3 +141,61 @@ func TestValidHostname(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitVoluntaryExit(t *testing.T) {
+	body := []byte(`{"message":{"epoch":"1","validator_index":"2"},"signature":"0x00"}`)
+	var received []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, postVoluntaryExitPath, r.URL.Path)
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = b
+		if bytes.Contains(b, []byte(`"validator_index":"3"`)) {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	require.NoError(t, c.SubmitVoluntaryExit(context.Background(), body))
+	require.DeepEqual(t, body, received)
+	err = c.SubmitVoluntaryExit(context.Background(), []byte(`{"message":{"epoch":"1","validator_index":"3"}}`))
+	require.ErrorIs(t, err, ErrNotOK)
+}
+
+func TestGetForkChoiceGraph(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, getForkChoiceGraphPath, r.URL.Path)
+		_, err := w.Write([]byte(`{"headRoot":"Ag==","nodes":[{"slot":"1","root":"AQ=="},{"slot":"2","root":"Ag==","parentRoot":"AQ==","head":true}],"dot":"digraph"}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	g, err := c.GetForkChoiceGraph(context.Background())
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{2}, g.HeadRoot)
+	require.Equal(t, 2, len(g.Nodes))
+	require.DeepEqual(t, []byte{1}, g.Nodes[1].ParentRoot)
+	require.Equal(t, true, g.Nodes[1].Head)
+	require.Equal(t, "digraph", g.Dot)
+}
+
+func TestGetGenesis(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, getGenesisPath, r.URL.Path)
+		_, err := w.Write([]byte(`{"data":{"genesis_time":"1606824023","genesis_validators_root":"0x4b36","genesis_fork_version":"0x00000000"}}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	genesis, err := c.GetGenesis(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "1606824023", genesis.GenesisTime)
+	require.Equal(t, "0x4b36", genesis.GenesisValidatorsRoot)
+}
@@ -0,0 +1,135 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+)
+
+// Number of signatures accumulated across the blocks of a batch before they are
+// handed over to a verification worker.
+const batchSigVerifyChunkSize = 512
+
+var errBatchSigVerificationFailed = errors.New("batch block signature verification failed")
+
+// batchSigVerifier verifies the signatures of a block batch while its state transition
+// is still running. The signature sets of consecutive blocks are joined into chunks,
+// in which signatures over a common message are aggregated, and the chunks are verified
+// concurrently, in any order, by a bounded number of workers. The workers stop as soon
+// as a chunk fails or the verifier is cancelled.
+type batchSigVerifier struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	chunkSize int
+	pending   *bls.SignatureBatch
+	workers   chan struct{}
+	wg        sync.WaitGroup
+	lock      sync.Mutex
+	err       error
+}
+
+// newBatchSigVerifier returns a verifier running up to the given number of workers until
+// the given context is done.
+func newBatchSigVerifier(ctx context.Context, chunkSize, workers int) *batchSigVerifier {
+	if workers < 1 {
+		workers = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &batchSigVerifier{
+		ctx:       ctx,
+		cancel:    cancel,
+		chunkSize: chunkSize,
+		pending:   bls.NewSet(),
+		workers:   make(chan struct{}, workers),
+	}
+}
+
+// add queues the signature set of a block, dispatching the pending sets for verification
+// once they reach the chunk size.
+func (v *batchSigVerifier) add(set *bls.SignatureBatch) {
+	v.pending.Join(set)
+	if len(v.pending.Signatures) >= v.chunkSize {
+		v.dispatch()
+	}
+}
+
+// wait verifies the remaining sets and blocks until all the chunks are verified,
+// returning the first failure.
+func (v *batchSigVerifier) wait() error {
+	v.dispatch()
+	v.wg.Wait()
+	v.cancel()
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.err
+}
+
+func (v *batchSigVerifier) dispatch() {
+	if len(v.pending.Signatures) == 0 {
+		return
+	}
+	chunk := v.pending
+	v.pending = bls.NewSet()
+	v.wg.Add(1)
+	go func() {
+		defer v.wg.Done()
+		select {
+		case v.workers <- struct{}{}:
+		case <-v.ctx.Done():
+			// The batch is known to be invalid or is not processed anymore.
+			v.fail(v.ctx.Err())
+			return
+		}
+		defer func() { <-v.workers }()
+		if v.ctx.Err() != nil {
+			v.fail(v.ctx.Err())
+			return
+		}
+		start := time.Now()
+		err := verifySignatureChunk(chunk)
+		batchSigVerificationTime.Observe(float64(time.Since(start).Milliseconds()))
+		if err != nil {
+			v.fail(err)
+		}
+	}()
+}
+
+// stop cancels the chunks which are not verified yet, e.g. when the state transition
+// of the batch fails.
+func (v *batchSigVerifier) stop() {
+	v.cancel()
+}
+
+// fail records the first failure and stops the remaining workers.
+func (v *batchSigVerifier) fail(err error) {
+	v.lock.Lock()
+	if v.err == nil {
+		v.err = err
+	}
+	v.lock.Unlock()
+	v.cancel()
+}
+
+// verifySignatureChunk aggregates the signatures over common messages, such as the
+// attestations of a committee included in several blocks, before verifying the chunk.
+func verifySignatureChunk(set *bls.SignatureBatch) error {
+	_, set, err := set.RemoveDuplicates()
+	if err != nil {
+		return err
+	}
+	set, err = set.AggregateBatch()
+	if err != nil {
+		return err
+	}
+	verified, err := set.Verify()
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return errBatchSigVerificationFailed
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func testSignatureSet(t *testing.T, msg [32]byte, keys int) *bls.SignatureBatch {
+	set := bls.NewSet()
+	for i := 0; i < keys; i++ {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		set.Signatures = append(set.Signatures, key.Sign(msg[:]).Marshal())
+		set.PublicKeys = append(set.PublicKeys, key.PublicKey())
+		set.Messages = append(set.Messages, msg)
+	}
+	return set
+}
+
+func TestBatchSigVerifier(t *testing.T) {
+	v := newBatchSigVerifier(context.Background(), 4, 2)
+	for i := 0; i < 10; i++ {
+		v.add(testSignatureSet(t, [32]byte{byte(i % 3)}, 3))
+	}
+	require.NoError(t, v.wait())
+
+	// A bad signature in any chunk fails the whole batch.
+	v = newBatchSigVerifier(context.Background(), 4, 2)
+	for i := 0; i < 10; i++ {
+		set := testSignatureSet(t, [32]byte{byte(i)}, 2)
+		if i == 7 {
+			set.Messages[1] = [32]byte{'b', 'a', 'd'}
+		}
+		v.add(set)
+	}
+	require.ErrorIs(t, v.wait(), errBatchSigVerificationFailed)
+}
+
+func TestBatchSigVerifier_Empty(t *testing.T) {
+	require.NoError(t, newBatchSigVerifier(context.Background(), batchSigVerifyChunkSize, 0).wait())
+}
+
+func TestBatchSigVerifier_Stop(t *testing.T) {
+	v := newBatchSigVerifier(context.Background(), 1, 1)
+	v.stop()
+	v.add(testSignatureSet(t, [32]byte{'a'}, 2))
+	require.ErrorIs(t, v.wait(), context.Canceled)
+}
@@ -0,0 +1,17 @@
+package blockchain
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+)
+
+// recordBlockPhase records the time spent by a block in a phase of its import since start,
+// if block timings are enabled.
+func (s *Service) recordBlockPhase(root [32]byte, slot types.Slot, phase cache.BlockPhase, start time.Time) {
+	if s.cfg.BlockTimings == nil {
+		return
+	}
+	s.cfg.BlockTimings.RecordBlockPhase(root, slot, phase, time.Since(start))
+}
@@ -0,0 +1,137 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/transition"
+	testDB "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
+	consensusblocks "github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func TestOnBlock_RecordsBlockTimings(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	fcs := doublylinkedtree.New()
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	timings := cache.NewBlockTimingsCache(cache.BlockTimingsSize)
+	opts := []Option{
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB, fcs)),
+		WithForkChoiceStore(fcs),
+		WithDepositCache(depositCache),
+		WithStateNotifier(&mock.MockStateNotifier{}),
+		WithAttestationPool(attestations.NewPool()),
+		WithBlockTimingsCache(timings),
+	}
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+
+	gs, keys := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, service.saveGenesisData(ctx, gs))
+
+	blk, err := util.GenerateFullBlock(gs, keys, util.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	r, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	wsb, err := consensusblocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	require.NoError(t, service.onBlock(ctx, wsb, r))
+
+	bt, ok := timings.BlockTiming(r)
+	require.Equal(t, true, ok)
+	for _, p := range []cache.BlockPhase{
+		cache.StateTransitionPhase,
+		cache.StateRootPhase,
+		cache.SignatureVerificationPhase,
+		cache.ExecutionPayloadPhase,
+		cache.DatabaseSavePhase,
+		cache.ForkchoiceInsertPhase,
+		cache.HeadUpdatePhase,
+		cache.TotalProcessingPhase,
+	} {
+		_, ok := bt.Durations[p]
+		assert.Equal(t, true, ok, "missing %s timing", p)
+	}
+	_, ok = bt.Durations[cache.ArrivalPhase]
+	assert.Equal(t, false, ok, "unexpected arrival timing")
+
+	// A block with an invalid signature is still rejected.
+	blk, err = util.GenerateFullBlock(gs, keys, util.DefaultBlockGenConfig(), 2)
+	require.NoError(t, err)
+	blk.Signature = []byte{'a'}
+	r, err = blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	wsb, err = consensusblocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	require.Equal(t, true, IsInvalidBlock(service.onBlock(ctx, wsb, r)))
+}
+
+func TestOnBlockBatch_RecordsBlockTimings(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	fcs := doublylinkedtree.New()
+	timings := cache.NewBlockTimingsCache(cache.BlockTimingsSize)
+	opts := []Option{
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB, fcs)),
+		WithForkChoiceStore(fcs),
+		WithBlockTimingsCache(timings),
+	}
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+
+	gs, keys := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, service.saveGenesisData(ctx, gs))
+	st := gs.Copy()
+	var blks []interfaces.SignedBeaconBlock
+	var roots [][32]byte
+	for i := 1; i <= 3; i++ {
+		blk, err := util.GenerateFullBlock(st, keys, util.DefaultBlockGenConfig(), types.Slot(i))
+		require.NoError(t, err)
+		wsb, err := consensusblocks.NewSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, wsb)
+		require.NoError(t, err)
+		r, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		blks = append(blks, wsb)
+		roots = append(roots, r)
+	}
+	require.NoError(t, service.onBlockBatch(ctx, blks, roots))
+
+	for i, r := range roots {
+		bt, ok := timings.BlockTiming(r)
+		require.Equal(t, true, ok)
+		for _, p := range []cache.BlockPhase{
+			cache.StateTransitionPhase,
+			cache.StateRootPhase,
+			cache.ExecutionPayloadPhase,
+			cache.DatabaseSavePhase,
+		} {
+			_, ok := bt.Durations[p]
+			assert.Equal(t, true, ok, "missing %s timing", p)
+		}
+		// The batch wide phases are recorded on the last block.
+		for _, p := range []cache.BlockPhase{
+			cache.SignatureVerificationPhase,
+			cache.ForkchoiceInsertPhase,
+			cache.HeadUpdatePhase,
+		} {
+			_, ok := bt.Durations[p]
+			assert.Equal(t, i == len(roots)-1, ok, "unexpected %s timing", p)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
@@ -36,6 +37,14 @@ type HeadUpdater interface {
 	UpdateHead(context.Context) error
 }
 
+// OptimisticBlocksManager defines a common interface for methods in blockchain service
+// which allow to revalidate and invalidate the payloads of optimistic blocks.
+type OptimisticBlocksManager interface {
+	OptimisticBlocks() []*forkchoicetypes.OptimisticBlock
+	RevalidateOptimisticBlocks(ctx context.Context, startSlot, endSlot types.Slot) ([]*RevalidationResult, error)
+	InvalidatePayload(ctx context.Context, payloadHash [32]byte) ([][32]byte, error)
+}
+
 // TimeFetcher retrieves the Ethereum consensus data that's related to time.
 type TimeFetcher interface {
 	GenesisTime() time.Time
@@ -178,6 +178,27 @@ var (
 		Name: "state_transition_processing_milliseconds",
 		Help: "Total time to call a state transition in onBlock()",
 	})
+	batchStateTransitionTime = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "batch_state_transition_milliseconds",
+			Help:    "Captures latency for the state transition of an initial sync block batch, without signature checks, in milliseconds",
+			Buckets: []float64{10, 50, 100, 250, 500, 1000, 2500, 5000, 10000},
+		},
+	)
+	batchSigVerificationTime = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "batch_signature_verification_milliseconds",
+			Help:    "Captures latency for verifying a chunk of the signatures of an initial sync block batch in milliseconds",
+			Buckets: []float64{5, 20, 50, 100, 250, 500, 1000, 2500},
+		},
+	)
+	batchSigVerificationWaitTime = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "batch_signature_verification_wait_milliseconds",
+			Help:    "Captures the time spent waiting for the signatures of an initial sync block batch to be verified after its state transition in milliseconds",
+			Buckets: []float64{1, 5, 20, 100, 250, 500, 1000, 2500},
+		},
+	)
 	processAttsElapsedTime = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "process_attestations_milliseconds",
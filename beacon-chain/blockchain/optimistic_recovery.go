@@ -0,0 +1,201 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/execution"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/types"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// Outcomes of revalidating the payload of an optimistic block.
+const (
+	RevalidationValid   = "VALID"
+	RevalidationSyncing = "SYNCING"
+	RevalidationInvalid = "INVALID"
+	// The block was removed from forkchoice along with an invalid ancestor.
+	RevalidationPruned = "PRUNED"
+)
+
+// ErrUnknownOptimisticPayload is returned when no optimistic block carries the payload to invalidate.
+var ErrUnknownOptimisticPayload = errors.New("no optimistic block with the given payload hash")
+
+// RevalidationResult is the outcome of revalidating the payload of an optimistic block.
+type RevalidationResult struct {
+	Block  *forkchoicetypes.OptimisticBlock
+	Status string
+}
+
+// OptimisticBlocks returns the blocks whose payloads have not been validated by the
+// execution client yet, sorted by slot.
+func (s *Service) OptimisticBlocks() []*forkchoicetypes.OptimisticBlock {
+	return s.cfg.ForkChoiceStore.OptimisticBlocks()
+}
+
+// RevalidateOptimisticBlocks sends the payloads of the optimistic blocks within the given
+// slot range to the execution client again. Blocks found valid are marked as such in
+// forkchoice while invalid ones are pruned along with their descendants. The execution
+// client is then notified of the resulting head.
+func (s *Service) RevalidateOptimisticBlocks(ctx context.Context, startSlot, endSlot types.Slot) ([]*RevalidationResult, error) {
+	if startSlot > endSlot {
+		return nil, fmt.Errorf("start slot %d is after end slot %d", startSlot, endSlot)
+	}
+	results := make([]*RevalidationResult, 0)
+	for _, b := range s.cfg.ForkChoiceStore.OptimisticBlocks() {
+		if b.Slot < startSlot || b.Slot > endSlot {
+			continue
+		}
+		status, err := s.revalidateOptimisticBlock(ctx, b)
+		if err != nil {
+			return results, errors.Wrapf(err, "could not revalidate block %#x", b.Root)
+		}
+		results = append(results, &RevalidationResult{Block: b, Status: status})
+	}
+	s.processAttestationsLock.Lock()
+	defer s.processAttestationsLock.Unlock()
+	if err := s.recomputeHead(ctx); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+func (s *Service) revalidateOptimisticBlock(ctx context.Context, b *forkchoicetypes.OptimisticBlock) (string, error) {
+	// Blocks may have been pruned or validated since they were listed.
+	if !s.cfg.ForkChoiceStore.HasNode(b.Root) {
+		return RevalidationPruned, nil
+	}
+	optimistic, err := s.cfg.ForkChoiceStore.IsOptimistic(b.Root)
+	if err != nil {
+		return "", err
+	}
+	if !optimistic {
+		return RevalidationValid, nil
+	}
+	blk, err := s.getBlock(ctx, b.Root)
+	if err != nil {
+		return "", err
+	}
+	payload, err := blk.Block().Body().Execution()
+	if err != nil {
+		return "", errors.Wrap(err, "could not get execution payload")
+	}
+	lastValidHash, err := s.cfg.ExecutionEngineCaller.NewPayload(ctx, payload)
+	// Only the forkchoice update is serialized with the head updates, not the execution client call.
+	s.processAttestationsLock.Lock()
+	defer s.processAttestationsLock.Unlock()
+	switch err {
+	case nil:
+		newPayloadValidNodeCount.Inc()
+		// The block may have been pruned while the payload was validated.
+		if !s.cfg.ForkChoiceStore.HasNode(b.Root) {
+			return RevalidationPruned, nil
+		}
+		if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, b.Root); err != nil {
+			return "", errors.Wrap(err, "could not set optimistic block to valid")
+		}
+		return RevalidationValid, nil
+	case execution.ErrAcceptedSyncingPayloadStatus:
+		newPayloadOptimisticNodeCount.Inc()
+		return RevalidationSyncing, nil
+	case execution.ErrInvalidPayloadStatus:
+		newPayloadInvalidNodeCount.Inc()
+		if !s.cfg.ForkChoiceStore.HasNode(b.Root) {
+			return RevalidationPruned, nil
+		}
+		if err := s.pruneInvalidBlock(ctx, b, bytesutil.ToBytes32(lastValidHash)); err != nil {
+			return "", err
+		}
+		return RevalidationInvalid, nil
+	default:
+		return "", errors.Wrap(err, "could not validate execution payload")
+	}
+}
+
+// InvalidatePayload marks the optimistic block with the given payload hash invalid,
+// regardless of the execution client's view. The block and its descendants are pruned
+// and the head is recomputed. The roots of the pruned blocks are returned.
+func (s *Service) InvalidatePayload(ctx context.Context, payloadHash [32]byte) ([][32]byte, error) {
+	// Head updates from attestations are held off while forkchoice is updated.
+	s.processAttestationsLock.Lock()
+	defer s.processAttestationsLock.Unlock()
+	var target *forkchoicetypes.OptimisticBlock
+	for _, b := range s.cfg.ForkChoiceStore.OptimisticBlocks() {
+		if b.PayloadHash == payloadHash {
+			target = b
+			break
+		}
+	}
+	if target == nil {
+		return nil, ErrUnknownOptimisticPayload
+	}
+	// Using the parent's payload as the last valid one invalidates the block itself only.
+	parentHash, err := s.getPayloadHash(ctx, target.ParentRoot[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get parent payload hash")
+	}
+	invalidRoots, err := s.cfg.ForkChoiceStore.SetOptimisticToInvalid(ctx, target.Root, target.ParentRoot, parentHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not set block to invalid")
+	}
+	if err := s.removeInvalidBlockAndState(ctx, invalidRoots); err != nil {
+		return invalidRoots, errors.Wrap(err, "could not remove invalid block and state")
+	}
+	log.WithFields(logrus.Fields{
+		"slot":                 target.Slot,
+		"blockRoot":            fmt.Sprintf("%#x", target.Root),
+		"payloadBlockHash":     fmt.Sprintf("%#x", payloadHash),
+		"invalidChildrenCount": len(invalidRoots),
+	}).Warn("Manually invalidated optimistic block")
+	return invalidRoots, s.recomputeHead(ctx)
+}
+
+// pruneInvalidBlock removes the block found invalid, along with its optimistic ancestors
+// up to the last valid payload hash and all of their descendants.
+func (s *Service) pruneInvalidBlock(ctx context.Context, b *forkchoicetypes.OptimisticBlock, lastValidHash [32]byte) error {
+	invalidRoots, err := s.cfg.ForkChoiceStore.SetOptimisticToInvalid(ctx, b.Root, b.ParentRoot, lastValidHash)
+	if err != nil {
+		return errors.Wrap(err, "could not set block to invalid")
+	}
+	if err := s.removeInvalidBlockAndState(ctx, invalidRoots); err != nil {
+		return errors.Wrap(err, "could not remove invalid block and state")
+	}
+	log.WithFields(logrus.Fields{
+		"slot":                 b.Slot,
+		"blockRoot":            fmt.Sprintf("%#x", b.Root),
+		"invalidChildrenCount": len(invalidRoots),
+	}).Warn("Pruned invalid blocks")
+	return nil
+}
+
+// recomputeHead runs head selection and notifies the execution client of the resulting head.
+func (s *Service) recomputeHead(ctx context.Context) error {
+	headRoot, err := s.cfg.ForkChoiceStore.Head(ctx, s.justifiedBalances.balances)
+	if err != nil {
+		return errors.Wrap(err, "could not update head")
+	}
+	headBlock, err := s.getBlock(ctx, headRoot)
+	if err != nil {
+		return err
+	}
+	headState, err := s.cfg.StateGen.StateByRoot(ctx, headRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head state in DB")
+	}
+	_, err = s.notifyForkchoiceUpdate(ctx, &notifyForkchoiceUpdateArg{
+		headState: headState,
+		headRoot:  headRoot,
+		headBlock: headBlock.Block(),
+	})
+	if IsInvalidBlock(err) {
+		// The head was found invalid, pruned and replaced while notifying the execution client.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.saveHead(ctx, headRoot, headBlock, headState)
+}
@@ -0,0 +1,141 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
+	testDB "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/execution"
+	mockExecution "github.com/prysmaticlabs/prysm/v3/beacon-chain/execution/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+// setupOptimisticChain returns a service with the optimistic chain A <- B <- C in forkchoice,
+// along with the block roots.
+func setupOptimisticChain(t *testing.T) (*Service, *doublylinkedtree.ForkChoice, [][32]byte) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+	fcs := doublylinkedtree.New()
+	service.cfg.ForkChoiceStore = fcs
+	service.cfg.ProposerSlotIndexCache = cache.NewProposerPayloadIDsCache()
+	service.justifiedBalances.balances = []uint64{50, 100, 200}
+
+	st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+	ojc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	ofc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	roots := make([][32]byte, 0, 3)
+	parentRoot := [32]byte{}
+	for i, hash := range [][32]byte{{'A'}, {'B'}, {'C'}} {
+		b := util.NewBeaconBlockBellatrix()
+		b.Block.Slot = types.Slot(i + 1)
+		b.Block.ParentRoot = parentRoot[:]
+		b.Block.Body.ExecutionPayload.BlockHash = hash[:]
+		b.Block.Body.ExecutionPayload.BlockNumber = uint64(i + 1)
+		wb := util.SaveBlock(t, ctx, beaconDB, b)
+		root, err := wb.Block().HashTreeRoot()
+		require.NoError(t, err)
+		fcState, blkRoot, err := prepareForkchoiceState(ctx, b.Block.Slot, root, parentRoot, hash, ojc, ofc)
+		require.NoError(t, err)
+		require.NoError(t, fcs.InsertNode(ctx, fcState, blkRoot))
+		if i == 0 {
+			require.NoError(t, beaconDB.SaveState(ctx, st, root))
+			require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, root))
+			service.head = &head{state: st, block: wb, root: root}
+		}
+		roots = append(roots, root)
+		parentRoot = root
+	}
+	return service, fcs, roots
+}
+
+func TestService_RevalidateOptimisticBlocks(t *testing.T) {
+	ctx := context.Background()
+	service, fcs, roots := setupOptimisticChain(t)
+	lastValidHash := [32]byte{'A'}
+	service.cfg.ExecutionEngineCaller = &mockExecution.EngineClient{
+		ErrNewPayload:  execution.ErrInvalidPayloadStatus,
+		NewPayloadResp: lastValidHash[:],
+	}
+
+	results, err := service.RevalidateOptimisticBlocks(ctx, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(results))
+	assert.Equal(t, roots[1], results[0].Block.Root)
+	assert.Equal(t, RevalidationInvalid, results[0].Status)
+	assert.Equal(t, roots[2], results[1].Block.Root)
+	assert.Equal(t, RevalidationPruned, results[1].Status)
+
+	assert.Equal(t, true, fcs.HasNode(roots[0]))
+	assert.Equal(t, false, fcs.HasNode(roots[1]))
+	assert.Equal(t, false, fcs.HasNode(roots[2]))
+	assert.Equal(t, false, service.cfg.BeaconDB.HasBlock(ctx, roots[1]))
+	assert.Equal(t, roots[0], service.headRoot())
+	// The head was validated by the forkchoice update.
+	optimistic, err := fcs.IsOptimistic(roots[0])
+	require.NoError(t, err)
+	assert.Equal(t, false, optimistic)
+
+	_, err = service.RevalidateOptimisticBlocks(ctx, 3, 2)
+	assert.ErrorContains(t, "start slot 3 is after end slot 2", err)
+}
+
+func TestService_RevalidateOptimisticBlocks_Valid(t *testing.T) {
+	ctx := context.Background()
+	service, fcs, roots := setupOptimisticChain(t)
+	service.cfg.ExecutionEngineCaller = &mockExecution.EngineClient{ErrForkchoiceUpdated: execution.ErrAcceptedSyncingPayloadStatus}
+	// C remains the head.
+	require.NoError(t, service.cfg.BeaconDB.SaveState(ctx, service.head.state, roots[2]))
+
+	results, err := service.RevalidateOptimisticBlocks(ctx, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(results))
+	for _, res := range results {
+		assert.Equal(t, RevalidationValid, res.Status)
+	}
+	blocks := fcs.OptimisticBlocks()
+	require.Equal(t, 1, len(blocks))
+	assert.Equal(t, roots[2], blocks[0].Root)
+}
+
+func TestService_InvalidatePayload(t *testing.T) {
+	ctx := context.Background()
+	service, fcs, roots := setupOptimisticChain(t)
+	service.cfg.ExecutionEngineCaller = &mockExecution.EngineClient{}
+
+	_, err := service.InvalidatePayload(ctx, [32]byte{'D'})
+	require.ErrorIs(t, err, ErrUnknownOptimisticPayload)
+
+	invalidRoots, err := service.InvalidatePayload(ctx, [32]byte{'B'})
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, [][32]byte{roots[2], roots[1]}, invalidRoots)
+	assert.Equal(t, true, fcs.HasNode(roots[0]))
+	assert.Equal(t, false, fcs.HasNode(roots[1]))
+	assert.Equal(t, roots[0], service.headRoot())
+}
+
+func TestService_InvalidatePayload_WaitsForHeadUpdate(t *testing.T) {
+	service, fcs, roots := setupOptimisticChain(t)
+	service.cfg.ExecutionEngineCaller = &mockExecution.EngineClient{}
+
+	service.processAttestationsLock.Lock()
+	done := make(chan error)
+	go func() {
+		_, err := service.InvalidatePayload(context.Background(), [32]byte{'B'})
+		done <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+	// Forkchoice is left alone while the head is updated from attestations.
+	assert.Equal(t, true, fcs.HasNode(roots[1]))
+	service.processAttestationsLock.Unlock()
+	require.NoError(t, <-done)
+	assert.Equal(t, false, fcs.HasNode(roots[1]))
+}
@@ -75,6 +75,14 @@ func WithProposerIdsCache(c *cache.ProposerPayloadIDsCache) Option {
 	}
 }
 
+// WithBlockTimingsCache for recording the time spent in each phase of block imports.
+func WithBlockTimingsCache(c *cache.BlockTimingsCache) Option {
+	return func(s *Service) error {
+		s.cfg.BlockTimings = c
+		return nil
+	}
+}
+
 // WithAttestationPool for attestation lifecycle after chain inclusion.
 func WithAttestationPool(p attestations.Pool) Option {
 	return func(s *Service) error {
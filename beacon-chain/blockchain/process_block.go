@@ -3,10 +3,12 @@ package blockchain
 import (
 	"context"
 	"fmt"
+	"runtime"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/async/event"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
@@ -115,33 +117,56 @@ func (s *Service) onBlock(ctx context.Context, signed interfaces.SignedBeaconBlo
 	if err != nil {
 		return err
 	}
+	// Same as transition.ExecuteStateTransition, timing separately the processing of the block,
+	// the check of its state root and the verification of its signatures.
 	stateTransitionStartTime := time.Now()
-	postState, err := transition.ExecuteStateTransition(ctx, preState, signed)
+	set, postState, err := transition.ProcessSlotsAndBlockNoVerifyAnySig(ctx, preState, signed)
 	if err != nil {
-		return invalidBlock{error: err}
+		return invalidBlock{error: errors.Wrap(err, "could not execute state transition")}
+	}
+	s.recordBlockPhase(blockRoot, b.Slot(), cache.StateTransitionPhase, stateTransitionStartTime)
+	stateRootStartTime := time.Now()
+	if err := transition.VerifyStateRoot(ctx, postState, signed); err != nil {
+		return invalidBlock{error: errors.Wrap(err, "could not execute state transition")}
+	}
+	s.recordBlockPhase(blockRoot, b.Slot(), cache.StateRootPhase, stateRootStartTime)
+	verifyStartTime := time.Now()
+	valid, err := set.Verify()
+	if err != nil {
+		return invalidBlock{error: errors.Wrap(err, "could not batch verify signature")}
+	}
+	if !valid {
+		return invalidBlock{error: errors.New("signature in block failed to verify")}
 	}
+	s.recordBlockPhase(blockRoot, b.Slot(), cache.SignatureVerificationPhase, verifyStartTime)
 	stateTransitionProcessingTime.Observe(float64(time.Since(stateTransitionStartTime).Milliseconds()))
 
 	postStateVersion, postStateHeader, err := getStateVersionAndPayload(postState)
 	if err != nil {
 		return err
 	}
+	payloadStartTime := time.Now()
 	isValidPayload, err := s.notifyNewPayload(ctx, postStateVersion, postStateHeader, signed)
 	if err != nil {
 		return errors.Wrap(err, "could not validate new payload")
 	}
+	s.recordBlockPhase(blockRoot, b.Slot(), cache.ExecutionPayloadPhase, payloadStartTime)
 	if isValidPayload {
 		if err := s.validateMergeTransitionBlock(ctx, preStateVersion, preStateHeader, signed); err != nil {
 			return err
 		}
 	}
+	saveStartTime := time.Now()
 	if err := s.savePostStateInfo(ctx, blockRoot, signed, postState); err != nil {
 		return err
 	}
+	s.recordBlockPhase(blockRoot, b.Slot(), cache.DatabaseSavePhase, saveStartTime)
 
+	forkchoiceStartTime := time.Now()
 	if err := s.insertBlockToForkchoiceStore(ctx, signed.Block(), blockRoot, postState); err != nil {
 		return errors.Wrapf(err, "could not insert block %d to fork choice store", signed.Block().Slot())
 	}
+	s.recordBlockPhase(blockRoot, b.Slot(), cache.ForkchoiceInsertPhase, forkchoiceStartTime)
 	if err := s.handleBlockAttestations(ctx, signed.Block(), postState); err != nil {
 		return errors.Wrap(err, "could not handle block's attestations")
 	}
@@ -196,6 +221,7 @@ func (s *Service) onBlock(ctx context.Context, signed interfaces.SignedBeaconBlo
 	if err := s.notifyEngineIfChangedHead(ctx, headRoot); err != nil {
 		return err
 	}
+	s.recordBlockPhase(blockRoot, b.Slot(), cache.HeadUpdatePhase, start)
 
 	if err := s.pruneCanonicalAttsFromPool(ctx, blockRoot, signed); err != nil {
 		return err
@@ -274,6 +300,7 @@ func (s *Service) onBlock(ctx context.Context, signed interfaces.SignedBeaconBlo
 		return err
 	}
 	onBlockProcessingTime.Observe(float64(time.Since(startTime).Milliseconds()))
+	s.recordBlockPhase(blockRoot, b.Slot(), cache.TotalProcessingPhase, startTime)
 	return nil
 }
 
@@ -332,11 +359,9 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.SignedBeac
 
 	jCheckpoints := make([]*ethpb.Checkpoint, len(blks))
 	fCheckpoints := make([]*ethpb.Checkpoint, len(blks))
-	sigSet := &bls.SignatureBatch{
-		Signatures: [][]byte{},
-		PublicKeys: []bls.PublicKey{},
-		Messages:   [][32]byte{},
-	}
+	// Signatures are verified in the background while the state transition runs.
+	sigVerifier := newBatchSigVerifier(ctx, batchSigVerifyChunkSize, runtime.GOMAXPROCS(0))
+	defer sigVerifier.stop()
 	type versionAndHeader struct {
 		version int
 		header  interfaces.ExecutionData
@@ -345,6 +370,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.SignedBeac
 	postVersionAndHeaders := make([]*versionAndHeader, len(blks))
 	var set *bls.SignatureBatch
 	boundaries := make(map[[32]byte]state.BeaconState)
+	transitionStart := time.Now()
 	for i, b := range blks {
 		v, h, err := getStateVersionAndPayload(preState)
 		if err != nil {
@@ -355,10 +381,17 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.SignedBeac
 			header:  h,
 		}
 
-		set, preState, err = transition.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
+		blockTransitionStart := time.Now()
+		set, preState, err = transition.ProcessSlotsAndBlockNoVerifyAnySig(ctx, preState, b)
 		if err != nil {
 			return invalidBlock{error: err}
 		}
+		s.recordBlockPhase(blockRoots[i], b.Block().Slot(), cache.StateTransitionPhase, blockTransitionStart)
+		stateRootStart := time.Now()
+		if err := transition.VerifyStateRoot(ctx, preState, b); err != nil {
+			return invalidBlock{error: err}
+		}
+		s.recordBlockPhase(blockRoots[i], b.Block().Slot(), cache.StateRootPhase, stateRootStart)
 		// Save potential boundary states.
 		if slots.IsEpochStart(preState.Slot()) {
 			boundaries[blockRoots[i]] = preState.Copy()
@@ -374,26 +407,34 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.SignedBeac
 			version: v,
 			header:  h,
 		}
-		sigSet.Join(set)
+		sigVerifier.add(set)
+	}
+	batchStateTransitionTime.Observe(float64(time.Since(transitionStart).Milliseconds()))
+	verifyStart := time.Now()
+	err = sigVerifier.wait()
+	batchSigVerificationWaitTime.Observe(float64(time.Since(verifyStart).Milliseconds()))
+	if errors.Is(err, errBatchSigVerificationFailed) || ctx.Err() != nil {
+		return err
 	}
-	verify, err := sigSet.Verify()
 	if err != nil {
 		return invalidBlock{error: err}
 	}
-	if !verify {
-		return errors.New("batch block signature verification failed")
-	}
+	// The signatures of the blocks are verified together, the time spent on the whole batch is
+	// recorded on its last block.
+	s.recordBlockPhase(blockRoots[len(blks)-1], blks[len(blks)-1].Block().Slot(), cache.SignatureVerificationPhase, verifyStart)
 
 	// blocks have been verified, save them and call the engine
 	pendingNodes := make([]*forkchoicetypes.BlockAndCheckpoints, len(blks))
 	var isValidPayload bool
 	for i, b := range blks {
+		payloadStartTime := time.Now()
 		isValidPayload, err = s.notifyNewPayload(ctx,
 			postVersionAndHeaders[i].version,
 			postVersionAndHeaders[i].header, b)
 		if err != nil {
 			return err
 		}
+		s.recordBlockPhase(blockRoots[i], b.Block().Slot(), cache.ExecutionPayloadPhase, payloadStartTime)
 		if isValidPayload {
 			if err := s.validateMergeTransitionBlock(ctx, preVersionAndHeaders[i].version,
 				preVersionAndHeaders[i].header, b); err != nil {
@@ -404,6 +445,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.SignedBeac
 			JustifiedCheckpoint: jCheckpoints[i],
 			FinalizedCheckpoint: fCheckpoints[i]}
 		pendingNodes[len(blks)-i-1] = args
+		saveStartTime := time.Now()
 		if err := s.saveInitSyncBlock(ctx, blockRoots[i], b); err != nil {
 			tracing.AnnotateError(span, err)
 			return err
@@ -415,6 +457,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.SignedBeac
 			tracing.AnnotateError(span, err)
 			return err
 		}
+		s.recordBlockPhase(blockRoots[i], b.Block().Slot(), cache.DatabaseSavePhase, saveStartTime)
 		if i > 0 && jCheckpoints[i].Epoch > jCheckpoints[i-1].Epoch {
 			if err := s.cfg.BeaconDB.SaveJustifiedCheckpoint(ctx, jCheckpoints[i]); err != nil {
 				tracing.AnnotateError(span, err)
@@ -429,6 +472,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.SignedBeac
 		}
 	}
 	// Insert all nodes but the last one to forkchoice
+	forkchoiceStartTime := time.Now()
 	if err := s.cfg.ForkChoiceStore.InsertOptimisticChain(ctx, pendingNodes); err != nil {
 		return errors.Wrap(err, "could not insert batch to forkchoice")
 	}
@@ -437,6 +481,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.SignedBeac
 	if err := s.cfg.ForkChoiceStore.InsertNode(ctx, preState, lastBR); err != nil {
 		return errors.Wrap(err, "could not insert last block in batch to forkchoice")
 	}
+	s.recordBlockPhase(lastBR, blks[len(blks)-1].Block().Slot(), cache.ForkchoiceInsertPhase, forkchoiceStartTime)
 	// Set their optimistic status
 	if isValidPayload {
 		if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, lastBR); err != nil {
@@ -459,9 +504,11 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []interfaces.SignedBeac
 		headRoot:  lastBR,
 		headBlock: lastB.Block(),
 	}
+	headStartTime := time.Now()
 	if _, err := s.notifyForkchoiceUpdate(ctx, arg); err != nil {
 		return err
 	}
+	s.recordBlockPhase(lastBR, lastB.Block().Slot(), cache.HeadUpdatePhase, headStartTime)
 	return s.saveHeadNoDB(ctx, lastB, lastBR, preState)
 }
 
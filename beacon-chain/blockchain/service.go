@@ -81,6 +81,7 @@ type config struct {
 	BlockFetcher            execution.POWBlockFetcher
 	FinalizedStateAtStartUp state.BeaconState
 	ExecutionEngineCaller   execution.EngineCaller
+	BlockTimings            *cache.BlockTimingsCache
 }
 
 // NewService instantiates a new block service instance that will
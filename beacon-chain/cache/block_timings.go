@@ -0,0 +1,254 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+)
+
+// BlockTimingsSize is the number of most recent blocks whose timings are kept.
+const BlockTimingsSize = 256
+
+// blockPhaseBuckets are the upper bounds, in milliseconds, of the block phase histograms.
+var blockPhaseBuckets = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2000, 4000, 8000, 12000}
+
+var blockPhaseTime = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "block_import_phase_milliseconds",
+		Help:    "Time spent by a block in each phase of its import, in milliseconds.",
+		Buckets: blockPhaseBuckets,
+	}, []string{"phase"},
+)
+
+// BlockPhase is a step in the import of a block.
+type BlockPhase int
+
+const (
+	// ArrivalPhase is the delay between the start of the block's slot and its arrival over gossip.
+	ArrivalPhase BlockPhase = iota
+	// GossipValidationPhase is the time spent validating the block before accepting it over gossip.
+	GossipValidationPhase
+	// StateTransitionPhase is the time spent processing the slots and the block on top of its pre state.
+	StateTransitionPhase
+	// StateRootPhase is the time spent computing and checking the post state root.
+	StateRootPhase
+	// SignatureVerificationPhase is the time spent verifying the signatures of the block. The signatures
+	// of the blocks imported in a batch are verified together: the phase is then only recorded on the
+	// last block of the batch, and is the time spent waiting for the verification of the whole batch
+	// once the state transitions of its blocks are done.
+	SignatureVerificationPhase
+	// ExecutionPayloadPhase is the time spent verifying the execution payload with the execution client.
+	ExecutionPayloadPhase
+	// DatabaseSavePhase is the time spent saving the block and its post state.
+	DatabaseSavePhase
+	// ForkchoiceInsertPhase is the time spent inserting the block in forkchoice.
+	ForkchoiceInsertPhase
+	// HeadUpdatePhase is the time spent computing the new head and notifying the execution client.
+	HeadUpdatePhase
+	// TotalProcessingPhase is the time spent importing the block, from its pre state lookup to the end
+	// of its processing.
+	TotalProcessingPhase
+	numBlockPhases
+)
+
+var blockPhaseNames = [numBlockPhases]string{
+	"arrival",
+	"gossip_validation",
+	"state_transition",
+	"state_root",
+	"signature_verification",
+	"execution_payload",
+	"db_save",
+	"forkchoice_insert",
+	"head_update",
+	"total_processing",
+}
+
+// String returns the name of the block phase.
+func (p BlockPhase) String() string {
+	if p < 0 || p >= numBlockPhases {
+		return "unknown"
+	}
+	return blockPhaseNames[p]
+}
+
+// BlockPhases returns all the block phases in import order.
+func BlockPhases() []BlockPhase {
+	phases := make([]BlockPhase, numBlockPhases)
+	for i := range phases {
+		phases[i] = BlockPhase(i)
+	}
+	return phases
+}
+
+// BlockTiming is the time spent by a block in each of the phases of its import
+// which have been recorded so far.
+type BlockTiming struct {
+	Slot      types.Slot
+	BlockRoot [32]byte
+	Durations map[BlockPhase]time.Duration
+	UpdatedAt time.Time
+}
+
+func (t *BlockTiming) copy() *BlockTiming {
+	durations := make(map[BlockPhase]time.Duration, len(t.Durations))
+	for p, d := range t.Durations {
+		durations[p] = d
+	}
+	return &BlockTiming{
+		Slot:      t.Slot,
+		BlockRoot: t.BlockRoot,
+		Durations: durations,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// BlockPhaseSummary summarizes the time spent in a block phase by the blocks in the cache.
+// Buckets holds the number of blocks per histogram bucket, the last one counting the blocks
+// above the largest bound in BucketBounds.
+type BlockPhaseSummary struct {
+	Phase        BlockPhase
+	Count        int
+	Mean         time.Duration
+	P50          time.Duration
+	P90          time.Duration
+	P99          time.Duration
+	Max          time.Duration
+	BucketBounds []time.Duration
+	Buckets      []int
+}
+
+// BlockTimingsCache is a ring buffer of the import timing breakdowns of the most recent blocks.
+type BlockTimingsCache struct {
+	lock    sync.RWMutex
+	timings map[[32]byte]*BlockTiming
+	roots   [][32]byte
+	next    int
+}
+
+// NewBlockTimingsCache creates a block timings cache holding the given number of blocks.
+func NewBlockTimingsCache(size int) *BlockTimingsCache {
+	if size <= 0 {
+		size = BlockTimingsSize
+	}
+	return &BlockTimingsCache{
+		timings: make(map[[32]byte]*BlockTiming, size),
+		roots:   make([][32]byte, 0, size),
+	}
+}
+
+// RecordBlockPhase records the time spent by the block with the given root in a phase of its import.
+// When the cache is full, recording a new block evicts the oldest one.
+func (c *BlockTimingsCache) RecordBlockPhase(root [32]byte, slot types.Slot, phase BlockPhase, d time.Duration) {
+	if phase < 0 || phase >= numBlockPhases {
+		return
+	}
+	blockPhaseTime.WithLabelValues(phase.String()).Observe(float64(d.Milliseconds()))
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	t, ok := c.timings[root]
+	if !ok {
+		if len(c.roots) < cap(c.roots) {
+			c.roots = append(c.roots, root)
+		} else {
+			delete(c.timings, c.roots[c.next])
+			c.roots[c.next] = root
+			c.next = (c.next + 1) % len(c.roots)
+		}
+		t = &BlockTiming{
+			Slot:      slot,
+			BlockRoot: root,
+			Durations: make(map[BlockPhase]time.Duration),
+		}
+		c.timings[root] = t
+	}
+	t.Durations[phase] = d
+	t.UpdatedAt = time.Now()
+}
+
+// BlockTiming returns the timing breakdown of the block with the given root.
+func (c *BlockTimingsCache) BlockTiming(root [32]byte) (*BlockTiming, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	t, ok := c.timings[root]
+	if !ok {
+		return nil, false
+	}
+	return t.copy(), true
+}
+
+// RecentBlockTimings returns the timing breakdowns of at most limit blocks, most recently
+// recorded first. A limit of zero returns all of them.
+func (c *BlockTimingsCache) RecentBlockTimings(limit int) []*BlockTiming {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	n := len(c.roots)
+	if limit > 0 && limit < n {
+		n = limit
+	}
+	timings := make([]*BlockTiming, 0, n)
+	for i := 1; i <= len(c.roots) && len(timings) < n; i++ {
+		idx := (c.next - i + len(c.roots)) % len(c.roots)
+		timings = append(timings, c.timings[c.roots[idx]].copy())
+	}
+	return timings
+}
+
+// Summarize returns, for each block phase, a summary of the time spent in it by the blocks
+// in the cache.
+func (c *BlockTimingsCache) Summarize() []*BlockPhaseSummary {
+	durations := make([][]time.Duration, numBlockPhases)
+	c.lock.RLock()
+	for _, t := range c.timings {
+		for p, d := range t.Durations {
+			durations[p] = append(durations[p], d)
+		}
+	}
+	c.lock.RUnlock()
+
+	bounds := make([]time.Duration, len(blockPhaseBuckets))
+	for i, b := range blockPhaseBuckets {
+		bounds[i] = time.Duration(b) * time.Millisecond
+	}
+	summaries := make([]*BlockPhaseSummary, 0, numBlockPhases)
+	for _, p := range BlockPhases() {
+		ds := durations[p]
+		sort.Slice(ds, func(i, j int) bool {
+			return ds[i] < ds[j]
+		})
+		s := &BlockPhaseSummary{
+			Phase:        p,
+			Count:        len(ds),
+			BucketBounds: bounds,
+			Buckets:      make([]int, len(bounds)+1),
+		}
+		if len(ds) > 0 {
+			var total time.Duration
+			for _, d := range ds {
+				total += d
+				s.Buckets[sort.Search(len(bounds), func(i int) bool { return d <= bounds[i] })]++
+			}
+			s.Mean = total / time.Duration(len(ds))
+			s.P50 = percentile(ds, 50)
+			s.P90 = percentile(ds, 90)
+			s.P99 = percentile(ds, 99)
+			s.Max = ds[len(ds)-1]
+		}
+		summaries = append(summaries, s)
+	}
+	return summaries
+}
+
+// percentile returns the nearest rank percentile of the sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
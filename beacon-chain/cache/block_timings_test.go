@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestBlockTimingsCache_RecordBlockPhase(t *testing.T) {
+	c := NewBlockTimingsCache(2)
+	c.RecordBlockPhase([32]byte{'a'}, 1, ArrivalPhase, time.Second)
+	c.RecordBlockPhase([32]byte{'a'}, 1, StateTransitionPhase, 20*time.Millisecond)
+	c.RecordBlockPhase([32]byte{'b'}, 2, ArrivalPhase, 2*time.Second)
+
+	bt, ok := c.BlockTiming([32]byte{'a'})
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Slot(1), bt.Slot)
+	assert.Equal(t, time.Second, bt.Durations[ArrivalPhase])
+	assert.Equal(t, 20*time.Millisecond, bt.Durations[StateTransitionPhase])
+
+	// The cache is full, recording a new block evicts the oldest one.
+	c.RecordBlockPhase([32]byte{'c'}, 3, ArrivalPhase, 3*time.Second)
+	_, ok = c.BlockTiming([32]byte{'a'})
+	assert.Equal(t, false, ok)
+	recent := c.RecentBlockTimings(0)
+	require.Equal(t, 2, len(recent))
+	assert.Equal(t, [32]byte{'c'}, recent[0].BlockRoot)
+	assert.Equal(t, [32]byte{'b'}, recent[1].BlockRoot)
+
+	recent = c.RecentBlockTimings(1)
+	require.Equal(t, 1, len(recent))
+	assert.Equal(t, [32]byte{'c'}, recent[0].BlockRoot)
+}
+
+func TestBlockTimingsCache_Summarize(t *testing.T) {
+	c := NewBlockTimingsCache(BlockTimingsSize)
+	for i := 1; i <= 100; i++ {
+		c.RecordBlockPhase([32]byte{byte(i)}, types.Slot(i), StateTransitionPhase, time.Duration(i)*time.Millisecond)
+	}
+	summaries := c.Summarize()
+	require.Equal(t, len(BlockPhases()), len(summaries))
+
+	s := summaries[StateTransitionPhase]
+	assert.Equal(t, StateTransitionPhase, s.Phase)
+	assert.Equal(t, 100, s.Count)
+	assert.Equal(t, 50500*time.Microsecond, s.Mean)
+	assert.Equal(t, 50*time.Millisecond, s.P50)
+	assert.Equal(t, 90*time.Millisecond, s.P90)
+	assert.Equal(t, 99*time.Millisecond, s.P99)
+	assert.Equal(t, 100*time.Millisecond, s.Max)
+	// 1-5, 6-10, 11-25, 26-50 and 51-100 milliseconds.
+	assert.DeepEqual(t, []int{5, 5, 15, 25, 50, 0, 0, 0, 0, 0, 0, 0, 0}, s.Buckets)
+
+	assert.Equal(t, 0, summaries[ArrivalPhase].Count)
+	assert.Equal(t, time.Duration(0), summaries[ArrivalPhase].Max)
+}
+
+func TestBlockPhase_String(t *testing.T) {
+	assert.Equal(t, "arrival", ArrivalPhase.String())
+	assert.Equal(t, "total_processing", TotalProcessingPhase.String())
+	assert.Equal(t, "unknown", BlockPhase(100).String())
+}
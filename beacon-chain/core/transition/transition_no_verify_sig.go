@@ -54,6 +54,38 @@ func ExecuteStateTransitionNoVerifyAnySig(
 
 	ctx, span := trace.StartSpan(ctx, "core.state.ExecuteStateTransitionNoVerifyAttSigs")
 	defer span.End()
+
+	set, st, err := ProcessSlotsAndBlockNoVerifyAnySig(ctx, st, signed)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := VerifyStateRoot(ctx, st, signed); err != nil {
+		return nil, nil, err
+	}
+	return set, st, nil
+}
+
+// ProcessSlotsAndBlockNoVerifyAnySig processes the slots up to the block's slot and the block on top of
+// the passed in state, without validating any BLS signatures nor the post state root. It returns the
+// signature set of all the signatures not verified and the post state, whose root is to be checked
+// with VerifyStateRoot.
+//
+// WARNING: This method does not validate any signatures nor the state root. This method also modifies the
+// passed in state.
+func ProcessSlotsAndBlockNoVerifyAnySig(
+	ctx context.Context,
+	st state.BeaconState,
+	signed interfaces.SignedBeaconBlock,
+) (*bls.SignatureBatch, state.BeaconState, error) {
+	if ctx.Err() != nil {
+		return nil, nil, ctx.Err()
+	}
+	if signed == nil || signed.IsNil() || signed.Block().IsNil() {
+		return nil, nil, errors.New("nil block")
+	}
+
+	ctx, span := trace.StartSpan(ctx, "core.state.ProcessSlotsAndBlockNoVerifyAnySig")
+	defer span.End()
 	var err error
 
 	interop.WriteBlockToDisk(signed, false /* Has the block failed */)
@@ -70,19 +102,31 @@ func ExecuteStateTransitionNoVerifyAnySig(
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "could not process block")
 	}
+	return set, st, nil
+}
 
-	// State root validation.
-	postStateRoot, err := st.HashTreeRoot(ctx)
+// VerifyStateRoot checks that the state root of the block is the root of its post state.
+//
+// Spec pseudocode definition:
+//  def state_transition(state: BeaconState, signed_block: SignedBeaconBlock, validate_result: bool=True) -> None:
+//    ...
+//    # Verify state root
+//    if validate_result:
+//        assert block.state_root == hash_tree_root(state)
+func VerifyStateRoot(ctx context.Context, postState state.BeaconState, signed interfaces.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "core.state.VerifyStateRoot")
+	defer span.End()
+
+	postStateRoot, err := postState.HashTreeRoot(ctx)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
 	stateRoot := signed.Block().StateRoot()
 	if !bytes.Equal(postStateRoot[:], stateRoot[:]) {
-		return nil, nil, fmt.Errorf("could not validate state root, wanted: %#x, received: %#x",
+		return fmt.Errorf("could not validate state root, wanted: %#x, received: %#x",
 			postStateRoot[:], signed.Block().StateRoot())
 	}
-
-	return set, st, nil
+	return nil
 }
 
 // CalculateStateRoot defines the procedure for a state transition function.
@@ -154,6 +154,18 @@ type SlasherDatabase interface {
 		ctx context.Context,
 		indices []types.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	ChunkParameters(ctx context.Context) (*slashertypes.ChunkParameters, error)
+	SaveChunkParameters(ctx context.Context, params *slashertypes.ChunkParameters) error
+	ResetChunkMigration(ctx context.Context) error
+	SaveMigratedSlasherChunks(
+		ctx context.Context, kind slashertypes.ChunkKind, chunkKeys [][]byte, chunks [][]uint16,
+	) error
+	CommitChunkMigration(ctx context.Context, params *slashertypes.ChunkParameters) error
+	SaveSlashingEvidence(ctx context.Context, evidence []*slashertypes.SlashingEvidence) error
+	UpdateSlashingEvidenceStatus(ctx context.Context, root [32]byte, status slashertypes.EvidenceStatus) error
+	SlashingEvidence(
+		ctx context.Context, filter *slashertypes.EvidenceFilter,
+	) ([]*slashertypes.SlashingEvidence, error)
 	DatabasePath() string
 	ClearDB() error
 }
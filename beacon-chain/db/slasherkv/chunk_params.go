@@ -0,0 +1,131 @@
+package slasherkv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Chunk size, validator chunk size and history length as little-endian uint64s.
+const chunkParametersSize = 24 // Bytes.
+
+// ChunkParameters returns the parameters the slasher chunks in the database were written
+// with, or nil if none were stored.
+func (s *Store) ChunkParameters(ctx context.Context) (*slashertypes.ChunkParameters, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ChunkParameters")
+	defer span.End()
+	var params *slashertypes.ChunkParameters
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slasherParamsBucket).Get(chunkParametersKey)
+		if enc == nil {
+			return nil
+		}
+		var err error
+		params, err = decodeChunkParameters(enc)
+		return err
+	})
+	return params, err
+}
+
+// SaveChunkParameters stores the parameters the slasher chunks are written with.
+func (s *Store) SaveChunkParameters(ctx context.Context, params *slashertypes.ChunkParameters) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveChunkParameters")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(slasherParamsBucket).Put(chunkParametersKey, encodeChunkParameters(params))
+	})
+}
+
+// ResetChunkMigration discards the chunks saved by a previous migration which
+// was not committed.
+func (s *Store) ResetChunkMigration(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ResetChunkMigration")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return recreateBucket(tx, migratedSlasherChunksBucket)
+	})
+}
+
+// SaveMigratedSlasherChunks saves chunks laid out with new chunk parameters. They are
+// kept apart from the chunks used for slashing detection until the migration is committed.
+func (s *Store) SaveMigratedSlasherChunks(
+	ctx context.Context, kind slashertypes.ChunkKind, chunkKeys [][]byte, chunks [][]uint16,
+) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveMigratedSlasherChunks")
+	defer span.End()
+	encodedKeys := make([][]byte, len(chunkKeys))
+	encodedChunks := make([][]byte, len(chunkKeys))
+	for i := 0; i < len(chunkKeys); i++ {
+		encodedKeys[i] = append(ssz.MarshalUint8(make([]byte, 0), uint8(kind)), chunkKeys[i]...)
+		encodedChunk, err := encodeSlasherChunk(chunks[i])
+		if err != nil {
+			return err
+		}
+		encodedChunks[i] = encodedChunk
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(migratedSlasherChunksBucket)
+		for i := 0; i < len(chunkKeys); i++ {
+			if err := bkt.Put(encodedKeys[i], encodedChunks[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CommitChunkMigration atomically replaces the slasher chunks with the migrated ones
+// and stores the parameters they were written with.
+func (s *Store) CommitChunkMigration(ctx context.Context, params *slashertypes.ChunkParameters) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.CommitChunkMigration")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := recreateBucket(tx, slasherChunksBucket); err != nil {
+			return err
+		}
+		bkt := tx.Bucket(slasherChunksBucket)
+		if err := tx.Bucket(migratedSlasherChunksBucket).ForEach(func(k, v []byte) error {
+			// Values are only valid for the lifetime of the transaction, copy them over.
+			return bkt.Put(append([]byte{}, k...), append([]byte{}, v...))
+		}); err != nil {
+			return err
+		}
+		if err := recreateBucket(tx, migratedSlasherChunksBucket); err != nil {
+			return err
+		}
+		return tx.Bucket(slasherParamsBucket).Put(chunkParametersKey, encodeChunkParameters(params))
+	})
+}
+
+func recreateBucket(tx *bolt.Tx, bucket []byte) error {
+	if err := tx.DeleteBucket(bucket); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+		return err
+	}
+	_, err := tx.CreateBucket(bucket)
+	return err
+}
+
+func encodeChunkParameters(params *slashertypes.ChunkParameters) []byte {
+	enc := make([]byte, chunkParametersSize)
+	binary.LittleEndian.PutUint64(enc[0:8], params.ChunkSize)
+	binary.LittleEndian.PutUint64(enc[8:16], params.ValidatorChunkSize)
+	binary.LittleEndian.PutUint64(enc[16:24], uint64(params.HistoryLength))
+	return enc
+}
+
+func decodeChunkParameters(enc []byte) (*slashertypes.ChunkParameters, error) {
+	if len(enc) != chunkParametersSize {
+		return nil, errors.Errorf("wrong chunk parameters size, expected %d, got %d", chunkParametersSize, len(enc))
+	}
+	return &slashertypes.ChunkParameters{
+		ChunkSize:          binary.LittleEndian.Uint64(enc[0:8]),
+		ValidatorChunkSize: binary.LittleEndian.Uint64(enc[8:16]),
+		HistoryLength:      types.Epoch(binary.LittleEndian.Uint64(enc[16:24])),
+	}, nil
+}
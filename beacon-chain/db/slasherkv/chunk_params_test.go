@@ -0,0 +1,54 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestStore_ChunkParameters(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	params, err := beaconDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.Equal(t, (*slashertypes.ChunkParameters)(nil), params)
+
+	want := &slashertypes.ChunkParameters{ChunkSize: 16, ValidatorChunkSize: 256, HistoryLength: 4096}
+	require.NoError(t, beaconDB.SaveChunkParameters(ctx, want))
+	params, err = beaconDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, params)
+}
+
+func TestStore_CommitChunkMigration(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	oldKeys := [][]byte{{1}, {2}}
+	require.NoError(t, beaconDB.SaveSlasherChunks(ctx, slashertypes.MinSpan, oldKeys, [][]uint16{{1, 2}, {3, 4}}))
+
+	// Leftovers of an interrupted migration are discarded.
+	require.NoError(t, beaconDB.SaveMigratedSlasherChunks(ctx, slashertypes.MinSpan, [][]byte{{9}}, [][]uint16{{9}}))
+	require.NoError(t, beaconDB.ResetChunkMigration(ctx))
+
+	newKeys := [][]byte{{1}, {3}}
+	require.NoError(t, beaconDB.SaveMigratedSlasherChunks(ctx, slashertypes.MinSpan, newKeys, [][]uint16{{5}, {6}}))
+	// Migrated chunks are not visible before the migration is committed.
+	chunks, exists, err := beaconDB.LoadSlasherChunks(ctx, slashertypes.MinSpan, oldKeys)
+	require.NoError(t, err)
+	require.DeepEqual(t, []bool{true, true}, exists)
+	require.DeepEqual(t, []uint16{1, 2}, chunks[0])
+
+	params := &slashertypes.ChunkParameters{ChunkSize: 1, ValidatorChunkSize: 1, HistoryLength: 2}
+	require.NoError(t, beaconDB.CommitChunkMigration(ctx, params))
+	chunks, exists, err = beaconDB.LoadSlasherChunks(ctx, slashertypes.MinSpan, [][]byte{{1}, {2}, {3}, {9}})
+	require.NoError(t, err)
+	require.DeepEqual(t, []bool{true, false, true, false}, exists)
+	require.DeepEqual(t, []uint16{5}, chunks[0])
+	require.DeepEqual(t, []uint16{6}, chunks[2])
+	stored, err := beaconDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, params, stored)
+}
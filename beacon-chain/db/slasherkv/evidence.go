@@ -0,0 +1,161 @@
+package slasherkv
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Offense, status, detection time in unix nanoseconds and source length, followed by the
+// source and the compressed slashing.
+const evidencePrefixSize = 11 // Bytes.
+
+// SaveSlashingEvidence archives the evidence of detected slashings by slashing root. Evidence
+// which was already archived is left untouched, keeping the time it was first detected at.
+func (s *Store) SaveSlashingEvidence(ctx context.Context, evidence []*slashertypes.SlashingEvidence) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveSlashingEvidence")
+	defer span.End()
+	keys := make([][]byte, len(evidence))
+	encoded := make([][]byte, len(evidence))
+	for i, e := range evidence {
+		root, err := e.Root()
+		if err != nil {
+			return err
+		}
+		keys[i] = root[:]
+		encoded[i], err = encodeSlashingEvidence(e)
+		if err != nil {
+			return err
+		}
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(slashingEvidenceBucket)
+		for i, key := range keys {
+			if bkt.Get(key) != nil {
+				continue
+			}
+			if err := bkt.Put(key, encoded[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateSlashingEvidenceStatus sets the status of the archived evidence with the slashing root.
+func (s *Store) UpdateSlashingEvidenceStatus(
+	ctx context.Context, root [32]byte, status slashertypes.EvidenceStatus,
+) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.UpdateSlashingEvidenceStatus")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(slashingEvidenceBucket)
+		enc := bkt.Get(root[:])
+		if enc == nil {
+			return errors.Errorf("no slashing evidence for root %#x", root)
+		}
+		updated := make([]byte, len(enc))
+		copy(updated, enc)
+		updated[1] = byte(status)
+		return bkt.Put(root[:], updated)
+	})
+}
+
+// SlashingEvidence returns the archived evidence selected by the filter, ordered by
+// detection time.
+func (s *Store) SlashingEvidence(
+	ctx context.Context, filter *slashertypes.EvidenceFilter,
+) ([]*slashertypes.SlashingEvidence, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SlashingEvidence")
+	defer span.End()
+	evidence := make([]*slashertypes.SlashingEvidence, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(slashingEvidenceBucket).ForEach(func(_, enc []byte) error {
+			e, err := decodeSlashingEvidence(enc)
+			if err != nil {
+				return err
+			}
+			if filter.Matches(e) {
+				evidence = append(evidence, e)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(evidence, func(i, j int) bool {
+		return evidence[i].DetectedAt.Before(evidence[j].DetectedAt)
+	})
+	return evidence, nil
+}
+
+func encodeSlashingEvidence(e *slashertypes.SlashingEvidence) ([]byte, error) {
+	var encodedSlashing []byte
+	var err error
+	switch {
+	case e.ProposerSlashing != nil:
+		encodedSlashing, err = e.ProposerSlashing.MarshalSSZ()
+	case e.AttesterSlashing != nil:
+		encodedSlashing, err = e.AttesterSlashing.MarshalSSZ()
+	default:
+		return nil, errors.New("nil slashing evidence")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(e.Source) > math.MaxUint8 {
+		return nil, errors.Errorf("slashing evidence source longer than %d bytes", math.MaxUint8)
+	}
+	enc := make([]byte, evidencePrefixSize, evidencePrefixSize+len(e.Source))
+	enc[0] = byte(e.Offense)
+	enc[1] = byte(e.Status)
+	binary.LittleEndian.PutUint64(enc[2:], uint64(e.DetectedAt.UnixNano()))
+	enc[10] = byte(len(e.Source))
+	enc = append(enc, e.Source...)
+	return append(enc, snappy.Encode(nil, encodedSlashing)...), nil
+}
+
+func decodeSlashingEvidence(enc []byte) (*slashertypes.SlashingEvidence, error) {
+	if len(enc) < evidencePrefixSize {
+		return nil, errors.Errorf(
+			"wrong length for encoded slashing evidence, want at least %d, got %d", evidencePrefixSize, len(enc),
+		)
+	}
+	sourceEnd := evidencePrefixSize + int(enc[10])
+	if len(enc) < sourceEnd {
+		return nil, errors.Errorf(
+			"wrong length for encoded slashing evidence, want at least %d, got %d", sourceEnd, len(enc),
+		)
+	}
+	e := &slashertypes.SlashingEvidence{
+		Offense:    slashertypes.SlashableOffense(enc[0]),
+		Status:     slashertypes.EvidenceStatus(enc[1]),
+		DetectedAt: time.Unix(0, int64(binary.LittleEndian.Uint64(enc[2:10]))),
+		Source:     string(enc[evidencePrefixSize:sourceEnd]),
+	}
+	encodedSlashing, err := snappy.Decode(nil, enc[sourceEnd:])
+	if err != nil {
+		return nil, err
+	}
+	if e.Offense == slashertypes.DoubleProposal {
+		e.ProposerSlashing = &ethpb.ProposerSlashing{}
+		err = e.ProposerSlashing.UnmarshalSSZ(encodedSlashing)
+	} else {
+		e.AttesterSlashing = &ethpb.AttesterSlashing{}
+		err = e.AttesterSlashing.UnmarshalSSZ(encodedSlashing)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
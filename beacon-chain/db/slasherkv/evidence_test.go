@@ -0,0 +1,88 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestStore_SlashingEvidence(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	detectedAt := time.Unix(1000, 0)
+
+	doubleVote := slashertypes.NewAttesterSlashingEvidence(&ethpb.AttesterSlashing{
+		Attestation_1: createAttestationWrapper(1, 2, []uint64{1, 2}, []byte{1}).IndexedAttestation,
+		Attestation_2: createAttestationWrapper(0, 2, []uint64{2, 3}, []byte{2}).IndexedAttestation,
+	}, detectedAt.Add(time.Second))
+	surroundVote := slashertypes.NewAttesterSlashingEvidence(&ethpb.AttesterSlashing{
+		Attestation_1: createAttestationWrapper(1, 6, []uint64{4}, []byte{1}).IndexedAttestation,
+		Attestation_2: createAttestationWrapper(2, 5, []uint64{4}, []byte{2}).IndexedAttestation,
+	}, detectedAt.Add(2*time.Second))
+	surroundVote.Status = slashertypes.EvidenceRejected
+	surroundVote.Source = "127.0.0.1:4000"
+	doubleProposal := slashertypes.NewProposerSlashingEvidence(&ethpb.ProposerSlashing{
+		Header_1: createProposalWrapper(t, params.BeaconConfig().SlotsPerEpoch*3, 5, []byte{1}).SignedBeaconBlockHeader,
+		Header_2: createProposalWrapper(t, params.BeaconConfig().SlotsPerEpoch*3, 5, []byte{2}).SignedBeaconBlockHeader,
+	}, detectedAt)
+	assert.Equal(t, slashertypes.DoubleVote, doubleVote.Offense)
+	assert.Equal(t, slashertypes.SurroundVote, surroundVote.Offense)
+	assert.Equal(t, slashertypes.DoubleProposal, doubleProposal.Offense)
+	require.NoError(t, beaconDB.SaveSlashingEvidence(ctx, []*slashertypes.SlashingEvidence{
+		doubleVote, surroundVote, doubleProposal,
+	}))
+
+	allEpochs := &slashertypes.EvidenceFilter{EndEpoch: params.BeaconConfig().FarFutureEpoch}
+	evidence, err := beaconDB.SlashingEvidence(ctx, allEpochs)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(evidence))
+	// Evidence is returned by detection time.
+	for i, want := range []*slashertypes.SlashingEvidence{doubleProposal, doubleVote, surroundVote} {
+		assert.Equal(t, want.Offense, evidence[i].Offense)
+		assert.Equal(t, want.Status, evidence[i].Status)
+		assert.Equal(t, want.DetectedAt.UnixNano(), evidence[i].DetectedAt.UnixNano())
+		assert.Equal(t, want.Source, evidence[i].Source)
+		assert.DeepEqual(t, want.AttesterSlashing, evidence[i].AttesterSlashing)
+		assert.DeepEqual(t, want.ProposerSlashing, evidence[i].ProposerSlashing)
+	}
+
+	// Only validator 2 was in both attestations of the double vote.
+	evidence, err = beaconDB.SlashingEvidence(ctx, &slashertypes.EvidenceFilter{
+		ValidatorIndices: []types.ValidatorIndex{1, 5},
+		EndEpoch:         params.BeaconConfig().FarFutureEpoch,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(evidence))
+	assert.Equal(t, slashertypes.DoubleProposal, evidence[0].Offense)
+
+	// Surround votes are selected by the target epoch of either attestation.
+	evidence, err = beaconDB.SlashingEvidence(ctx, &slashertypes.EvidenceFilter{StartEpoch: 4, EndEpoch: 5})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(evidence))
+	assert.Equal(t, slashertypes.SurroundVote, evidence[0].Offense)
+
+	// Archiving the evidence again keeps its detection time, and its status is updated in place.
+	root, err := doubleProposal.Root()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveSlashingEvidence(ctx, []*slashertypes.SlashingEvidence{
+		slashertypes.NewProposerSlashingEvidence(doubleProposal.ProposerSlashing, detectedAt.Add(time.Hour)),
+	}))
+	require.NoError(t, beaconDB.UpdateSlashingEvidenceStatus(ctx, root, slashertypes.EvidenceIncluded))
+	evidence, err = beaconDB.SlashingEvidence(ctx, &slashertypes.EvidenceFilter{
+		ValidatorIndices: []types.ValidatorIndex{5},
+		EndEpoch:         params.BeaconConfig().FarFutureEpoch,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(evidence))
+	assert.Equal(t, slashertypes.EvidenceIncluded, evidence[0].Status)
+	assert.Equal(t, detectedAt.UnixNano(), evidence[0].DetectedAt.UnixNano())
+
+	require.ErrorContains(t, "no slashing evidence", beaconDB.UpdateSlashingEvidenceStatus(ctx, [32]byte{1}, slashertypes.EvidenceIncluded))
+}
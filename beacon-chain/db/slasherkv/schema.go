@@ -13,4 +13,12 @@ var (
 	attestationDataRootsBucket = []byte("attestation-data-roots")
 	proposalRecordsBucket      = []byte("proposal-records")
 	slasherChunksBucket        = []byte("slasher-chunks")
+	slasherParamsBucket        = []byte("slasher-params")
+	slashingEvidenceBucket     = []byte("slashing-evidence")
+
+	// Chunks written by a migration to new chunk parameters, before they replace
+	// the ones in slasherChunksBucket.
+	migratedSlasherChunksBucket = []byte("migrated-slasher-chunks")
+
+	chunkParametersKey = []byte("chunk-parameters")
 )
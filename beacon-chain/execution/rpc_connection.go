@@ -46,6 +46,10 @@ func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpo
 		}
 		return errors.Wrap(err, errStr)
 	}
+	// The execution client may have been upgraded while disconnected.
+	s.clientVersionLock.Lock()
+	s.clientVersion = ""
+	s.clientVersionLock.Unlock()
 	s.updateConnectedETH1(true)
 	s.runError = nil
 	return nil
@@ -80,6 +80,7 @@ type ChainInfoFetcher interface {
 	ExecutionClientConnected() bool
 	ExecutionClientEndpoint() string
 	ExecutionClientConnectionErr() error
+	ExecutionClientVersion(ctx context.Context) (string, error)
 }
 
 // POWBlockFetcher defines a struct that can retrieve mainchain blocks.
@@ -156,6 +157,8 @@ type Service struct {
 	httpLogger              bind.ContractFilterer
 	eth1DataFetcher         RPCDataFetcher
 	rpcClient               RPCClient
+	clientVersion           string // web3_clientVersion of the execution client, reset on reconnection.
+	clientVersionLock       sync.RWMutex
 	headerCache             *headerCache // cache to store block hash/block height.
 	latestEth1Data          *ethpb.LatestETH1Data
 	depositContractCaller   *contracts.DepositContractCaller
@@ -312,6 +315,27 @@ func (s *Service) ExecutionClientConnectionErr() error {
 	return s.runError
 }
 
+// ExecutionClientVersion returns the version of the current execution client, as reported by web3_clientVersion.
+// The version is cached until the execution client is reconnected.
+func (s *Service) ExecutionClientVersion(ctx context.Context) (string, error) {
+	s.clientVersionLock.RLock()
+	v := s.clientVersion
+	s.clientVersionLock.RUnlock()
+	if v != "" {
+		return v, nil
+	}
+	if s.rpcClient == nil {
+		return "", errors.New("not connected to an execution client")
+	}
+	if err := s.rpcClient.CallContext(ctx, &v, "web3_clientVersion"); err != nil {
+		return "", errors.Wrap(err, "could not get execution client version")
+	}
+	s.clientVersionLock.Lock()
+	s.clientVersion = v
+	s.clientVersionLock.Unlock()
+	return v, nil
+}
+
 func (s *Service) updateBeaconNodeStats() {
 	bs := clientstats.BeaconNodeStats{}
 	if s.ExecutionClientConnected() {
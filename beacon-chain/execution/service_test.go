@@ -847,3 +847,29 @@ func (s *slowRPCClient) BatchCall(b []rpc.BatchElem) error {
 func (s *slowRPCClient) CallContext(_ context.Context, _ interface{}, _ string, _ ...interface{}) error {
 	panic("implement me")
 }
+
+type versionRPCClient struct {
+	RPCClientEmpty
+	version    string
+	numOfCalls int
+}
+
+func (c *versionRPCClient) CallContext(_ context.Context, result interface{}, method string, _ ...interface{}) error {
+	if method != "web3_clientVersion" {
+		return errors.New("unexpected method " + method)
+	}
+	c.numOfCalls++
+	*result.(*string) = c.version
+	return nil
+}
+
+func TestService_ExecutionClientVersion_Cached(t *testing.T) {
+	client := &versionRPCClient{version: "Geth/v1.10.26-stable/linux-amd64/go1.19.3"}
+	s := &Service{rpcClient: client}
+	for i := 0; i < 3; i++ {
+		v, err := s.ExecutionClientVersion(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, client.version, v)
+	}
+	assert.Equal(t, 1, client.numOfCalls)
+}
@@ -36,6 +36,7 @@ type Chain struct {
 	CurrError         error
 	Endpoints         []string
 	Errors            []error
+	ClientVersion     string
 }
 
 // GenesisTime represents a static past date - JAN 01 2000.
@@ -130,6 +131,10 @@ func (m *Chain) ExecutionClientConnectionErr() error {
 	return m.CurrError
 }
 
+func (m *Chain) ExecutionClientVersion(_ context.Context) (string, error) {
+	return m.ClientVersion, nil
+}
+
 func (m *Chain) ETH1Endpoints() []string {
 	return m.Endpoints
 }
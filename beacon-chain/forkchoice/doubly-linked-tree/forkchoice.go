@@ -618,6 +618,9 @@ func (f *ForkChoice) JustifiedPayloadBlockHash() [32]byte {
 
 // ForkChoiceDump returns a full dump of forkhoice.
 func (f *ForkChoice) ForkChoiceDump(ctx context.Context) (*v1.ForkChoiceResponse, error) {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	f.store.checkpointsLock.RLock()
 	jc := &v1.Checkpoint{
 		Epoch: f.store.justifiedCheckpoint.Epoch,
 		Root:  f.store.justifiedCheckpoint.Root[:],
@@ -638,7 +641,13 @@ func (f *ForkChoice) ForkChoiceDump(ctx context.Context) (*v1.ForkChoiceResponse
 		Epoch: f.store.unrealizedFinalizedCheckpoint.Epoch,
 		Root:  f.store.unrealizedFinalizedCheckpoint.Root[:],
 	}
-	nodes := make([]*v1.ForkChoiceNode, 0, f.NodeCount())
+	f.store.checkpointsLock.RUnlock()
+	f.store.proposerBoostLock.RLock()
+	proposerBoostRoot := f.store.proposerBoostRoot
+	previousProposerBoostRoot := f.store.previousProposerBoostRoot
+	f.store.proposerBoostLock.RUnlock()
+
+	nodes := make([]*v1.ForkChoiceNode, 0, len(f.store.nodeByRoot))
 	var err error
 	if f.store.treeRootNode != nil {
 		nodes, err = f.store.treeRootNode.nodeTreeDump(ctx, nodes)
@@ -656,8 +665,8 @@ func (f *ForkChoice) ForkChoiceDump(ctx context.Context) (*v1.ForkChoiceResponse
 		UnrealizedJustifiedCheckpoint: ujc,
 		FinalizedCheckpoint:           fc,
 		UnrealizedFinalizedCheckpoint: ufc,
-		ProposerBoostRoot:             f.store.proposerBoostRoot[:],
-		PreviousProposerBoostRoot:     f.store.previousProposerBoostRoot[:],
+		ProposerBoostRoot:             proposerBoostRoot[:],
+		PreviousProposerBoostRoot:     previousProposerBoostRoot[:],
 		HeadRoot:                      headRoot[:],
 		ForkchoiceNodes:               nodes,
 	}
@@ -5,10 +5,12 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v3/config/features"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	v1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
 )
 
 // applyWeightChanges recomputes the weight of the node passed as an argument and all of its descendants,
@@ -179,3 +181,86 @@ func (f *ForkChoice) VotedFraction(root [32]byte) (uint64, error) {
 	}
 	return node.balance * 100 / f.store.committeeBalance, nil
 }
+
+// Weight returns the weight of the node with the given root: the balance of the validators
+// voting for it or any of its descendants, including the proposer boost.
+func (f *ForkChoice) Weight(root [32]byte) (uint64, error) {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	node, ok := f.store.nodeByRoot[root]
+	if !ok || node == nil {
+		return 0, ErrNilNode
+	}
+	return node.weight, nil
+}
+
+// IsTimely returns true if the node with the given root was received during its
+// slot, before the attestation deadline.
+func (f *ForkChoice) IsTimely(root [32]byte) (bool, error) {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	node, ok := f.store.nodeByRoot[root]
+	if !ok || node == nil {
+		return false, ErrNilNode
+	}
+	return node.timely, nil
+}
+
+// ParentRoot returns the root of the parent of the node with the given root. It returns
+// zeros for the tree root node.
+func (f *ForkChoice) ParentRoot(root [32]byte) ([32]byte, error) {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	node, ok := f.store.nodeByRoot[root]
+	if !ok || node == nil {
+		return [32]byte{}, ErrNilNode
+	}
+	if node.parent == nil {
+		return [32]byte{}, nil
+	}
+	return node.parent.root, nil
+}
+
+// Slot returns the slot of the node with the given root.
+func (f *ForkChoice) Slot(root [32]byte) (types.Slot, error) {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	node, ok := f.store.nodeByRoot[root]
+	if !ok || node == nil {
+		return 0, ErrNilNode
+	}
+	return node.slot, nil
+}
+
+// UnrealizedJustifiedCheckpoint returns the checkpoint that would be justified if the node with
+// the given root was advanced to the next epoch. Its root is the ancestor of the node at the start
+// slot of the checkpoint epoch.
+func (f *ForkChoice) UnrealizedJustifiedCheckpoint(root [32]byte) (*forkchoicetypes.Checkpoint, error) {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	node, ok := f.store.nodeByRoot[root]
+	if !ok || node == nil {
+		return nil, ErrNilNode
+	}
+	epochStart, err := slots.EpochStart(node.unrealizedJustifiedEpoch)
+	if err != nil {
+		return nil, err
+	}
+	n := node
+	for n.parent != nil && n.slot > epochStart {
+		n = n.parent
+	}
+	return &forkchoicetypes.Checkpoint{Epoch: node.unrealizedJustifiedEpoch, Root: n.root}, nil
+}
+
+// CommitteeWeight returns the total active balance divided by the number of slots per epoch.
+func (f *ForkChoice) CommitteeWeight() uint64 {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return f.store.committeeBalance
+}
@@ -3,7 +3,9 @@ package doublylinkedtree
 import (
 	"context"
 	"testing"
+	"time"
 
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	v1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
@@ -303,3 +305,63 @@ func TestStore_VotedFraction(t *testing.T) {
 	_, err = f.VotedFraction([32]byte{'c'})
 	require.ErrorIs(t, err, ErrNilNode)
 }
+
+func TestStore_ReorgGetters(t *testing.T) {
+	f := setup(1, 1)
+	ctx := context.Background()
+	// The current slot is 101.
+	f.SetGenesisTime(uint64(time.Now().Unix()) - 101*params.BeaconConfig().SecondsPerSlot)
+	state, blkRoot, err := prepareForkchoiceState(ctx, 100, [32]byte{'a'}, params.BeaconConfig().ZeroHash, [32]byte{'A'}, 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, state, blkRoot))
+	state, blkRoot, err = prepareForkchoiceState(ctx, 101, [32]byte{'b'}, [32]byte{'a'}, [32]byte{'B'}, 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, state, blkRoot))
+
+	timely, err := f.IsTimely([32]byte{'a'})
+	require.NoError(t, err)
+	assert.Equal(t, false, timely)
+	timely, err = f.IsTimely([32]byte{'b'})
+	require.NoError(t, err)
+	assert.Equal(t, true, timely)
+
+	parent, err := f.ParentRoot([32]byte{'b'})
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{'a'}, parent)
+	parent, err = f.ParentRoot(params.BeaconConfig().ZeroHash)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, parent)
+	slot, err := f.Slot([32]byte{'b'})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(101), slot)
+	// The checkpoint root is the ancestor of the node at the start of the justified epoch.
+	uj, err := f.UnrealizedJustifiedCheckpoint([32]byte{'b'})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &forkchoicetypes.Checkpoint{Epoch: 1, Root: params.BeaconConfig().ZeroHash}, uj)
+
+	// Weights are only updated when computing the head.
+	balances := []uint64{params.BeaconConfig().MaxEffectiveBalance, params.BeaconConfig().MaxEffectiveBalance, 0}
+	f.ProcessAttestation(ctx, []uint64{0}, [32]byte{'a'}, 2)
+	f.ProcessAttestation(ctx, []uint64{1}, [32]byte{'b'}, 2)
+	require.NoError(t, f.ResetBoostedProposerRoot(ctx))
+	_, err = f.Head(ctx, balances)
+	require.NoError(t, err)
+	weight, err := f.Weight([32]byte{'b'})
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, weight)
+	weight, err = f.Weight([32]byte{'a'})
+	require.NoError(t, err)
+	assert.Equal(t, 2*params.BeaconConfig().MaxEffectiveBalance, weight)
+	f.store.committeeBalance = 100
+	assert.Equal(t, uint64(100), f.CommitteeWeight())
+
+	// Check for non-existent root
+	_, err = f.Weight([32]byte{'c'})
+	require.ErrorIs(t, err, ErrNilNode)
+	_, err = f.IsTimely([32]byte{'c'})
+	require.ErrorIs(t, err, ErrNilNode)
+	_, err = f.ParentRoot([32]byte{'c'})
+	require.ErrorIs(t, err, ErrNilNode)
+	_, err = f.UnrealizedJustifiedCheckpoint([32]byte{'c'})
+	require.ErrorIs(t, err, ErrNilNode)
+}
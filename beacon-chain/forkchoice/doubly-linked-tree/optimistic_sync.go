@@ -1,9 +1,12 @@
 package doublylinkedtree
 
 import (
+	"bytes"
 	"context"
+	"sort"
 
 	"github.com/pkg/errors"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 )
 
@@ -116,3 +119,32 @@ func (f *ForkChoice) AllTipsAreInvalid() bool {
 	defer f.store.nodesLock.RUnlock()
 	return f.store.allTipsAreInvalid
 }
+
+// OptimisticBlocks returns the blocks in the store whose payloads have not been
+// validated yet, sorted by slot.
+func (f *ForkChoice) OptimisticBlocks() []*forkchoicetypes.OptimisticBlock {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	blocks := make([]*forkchoicetypes.OptimisticBlock, 0)
+	for _, node := range f.store.nodeByRoot {
+		if node == nil || !node.optimistic {
+			continue
+		}
+		b := &forkchoicetypes.OptimisticBlock{
+			Slot:        node.slot,
+			Root:        node.root,
+			PayloadHash: node.payloadHash,
+		}
+		if node.parent != nil {
+			b.ParentRoot = node.parent.root
+		}
+		blocks = append(blocks, b)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		if blocks[i].Slot == blocks[j].Slot {
+			return bytes.Compare(blocks[i].Root[:], blocks[j].Root[:]) < 0
+		}
+		return blocks[i].Slot < blocks[j].Slot
+	})
+	return blocks
+}
@@ -400,3 +400,28 @@ func TestSetOptimisticToValid(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, false, op)
 }
+
+func TestOptimisticBlocks(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+
+	state, blkRoot, err := prepareForkchoiceState(ctx, 101, [32]byte{'b'}, params.BeaconConfig().ZeroHash, [32]byte{'B'}, 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, state, blkRoot))
+	state, blkRoot, err = prepareForkchoiceState(ctx, 100, [32]byte{'a'}, params.BeaconConfig().ZeroHash, [32]byte{'A'}, 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, state, blkRoot))
+	state, blkRoot, err = prepareForkchoiceState(ctx, 102, [32]byte{'c'}, [32]byte{'b'}, [32]byte{'C'}, 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, state, blkRoot))
+	require.NoError(t, f.SetOptimisticToValid(ctx, params.BeaconConfig().ZeroHash))
+	require.NoError(t, f.SetOptimisticToValid(ctx, [32]byte{'a'}))
+
+	blocks := f.OptimisticBlocks()
+	require.Equal(t, 2, len(blocks))
+	require.Equal(t, [32]byte{'b'}, blocks[0].Root)
+	require.Equal(t, [32]byte{'B'}, blocks[0].PayloadHash)
+	require.Equal(t, [32]byte{'c'}, blocks[1].Root)
+	require.Equal(t, [32]byte{'b'}, blocks[1].ParentRoot)
+	require.Equal(t, [32]byte{'C'}, blocks[1].PayloadHash)
+}
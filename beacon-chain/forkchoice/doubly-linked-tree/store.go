@@ -121,6 +121,15 @@ func (s *Store) insert(ctx context.Context,
 
 	parent := s.nodeByRoot[parentRoot]
 
+	timeNow := uint64(time.Now().Unix())
+	currentSlot := slots.CurrentSlot(s.genesisTime)
+	boostThreshold := params.BeaconConfig().SecondsPerSlot / params.BeaconConfig().IntervalsPerSlot
+	isTimely := false
+	if timeNow >= s.genesisTime {
+		secondsIntoSlot := (timeNow - s.genesisTime) % params.BeaconConfig().SecondsPerSlot
+		isTimely = currentSlot == slot && secondsIntoSlot < boostThreshold
+	}
+
 	n := &Node{
 		slot:                     slot,
 		root:                     root,
@@ -131,7 +140,8 @@ func (s *Store) insert(ctx context.Context,
 		unrealizedFinalizedEpoch: finalizedEpoch,
 		optimistic:               true,
 		payloadHash:              payloadHash,
-		timestamp:                uint64(time.Now().Unix()),
+		timestamp:                timeNow,
+		timely:                   isTimely,
 	}
 
 	s.nodeByPayload[payloadHash] = n
@@ -147,14 +157,10 @@ func (s *Store) insert(ctx context.Context,
 	} else {
 		parent.children = append(parent.children, n)
 		// Apply proposer boost
-		timeNow := uint64(time.Now().Unix())
 		if timeNow < s.genesisTime {
 			return n, nil
 		}
-		secondsIntoSlot := (timeNow - s.genesisTime) % params.BeaconConfig().SecondsPerSlot
-		currentSlot := slots.CurrentSlot(s.genesisTime)
-		boostThreshold := params.BeaconConfig().SecondsPerSlot / params.BeaconConfig().IntervalsPerSlot
-		if currentSlot == slot && secondsIntoSlot < boostThreshold {
+		if isTimely {
 			s.proposerBoostLock.Lock()
 			s.proposerBoostRoot = root
 			s.proposerBoostLock.Unlock()
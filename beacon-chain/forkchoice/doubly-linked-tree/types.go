@@ -62,6 +62,7 @@ type Node struct {
 	bestDescendant           *Node                        // bestDescendant node of this node.
 	optimistic               bool                         // whether the block has been fully validated or not
 	timestamp                uint64                       // The timestamp when the node was inserted.
+	timely                   bool                         // whether the block was received during its slot, before the attestation deadline.
 }
 
 // Vote defines an individual validator's vote.
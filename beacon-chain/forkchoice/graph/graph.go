@@ -0,0 +1,116 @@
+// Package graph represents the forkchoice block tree as a graph which can be
+// served by the debug API or rendered with Graphviz.
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/dot"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+)
+
+// New builds the graph of the forkchoice tree from a forkchoice dump, with its nodes ordered
+// from the tree root down. Each node carries its weight, the balance voting for it, its
+// optimistic status and whether it is the head, a justified or finalized checkpoint or has
+// the proposer boost.
+func New(dump *v1.ForkChoiceResponse) *ethpb.ForkChoiceGraph {
+	g := &ethpb.ForkChoiceGraph{
+		HeadRoot:            bytesutil.SafeCopyBytes(dump.HeadRoot),
+		ProposerBoostRoot:   bytesutil.SafeCopyBytes(dump.ProposerBoostRoot),
+		JustifiedCheckpoint: newCheckpoint(dump.JustifiedCheckpoint),
+		FinalizedCheckpoint: newCheckpoint(dump.FinalizedCheckpoint),
+		Nodes:               make([]*ethpb.ForkChoiceGraphNode, len(dump.ForkchoiceNodes)),
+	}
+	for i, n := range dump.ForkchoiceNodes {
+		g.Nodes[i] = &ethpb.ForkChoiceGraphNode{
+			Slot:                     n.Slot,
+			Root:                     bytesutil.SafeCopyBytes(n.Root),
+			ParentRoot:               bytesutil.SafeCopyBytes(n.ParentRoot),
+			Weight:                   n.Weight,
+			Balance:                  n.Balance,
+			JustifiedEpoch:           n.JustifiedEpoch,
+			FinalizedEpoch:           n.FinalizedEpoch,
+			UnrealizedJustifiedEpoch: n.UnrealizedJustifiedEpoch,
+			UnrealizedFinalizedEpoch: n.UnrealizedFinalizedEpoch,
+			ExecutionBlockHash:       bytesutil.SafeCopyBytes(n.ExecutionPayload),
+			ExecutionOptimistic:      n.ExecutionOptimistic,
+			Head:                     bytes.Equal(n.Root, g.HeadRoot),
+			Justified:                bytes.Equal(n.Root, g.JustifiedCheckpoint.Root),
+			Finalized:                bytes.Equal(n.Root, g.FinalizedCheckpoint.Root),
+			ProposerBoost:            bytes.Equal(n.Root, g.ProposerBoostRoot),
+		}
+	}
+	return g
+}
+
+// DOT returns the graph in the Graphviz DOT language. Every block points to its parent,
+// the head is drawn in bold, the justified and finalized checkpoints are colored and
+// optimistic blocks are dashed.
+func DOT(g *ethpb.ForkChoiceGraph) string {
+	graph := dot.NewGraph(dot.Directed)
+	graph.Attr("rankdir", "RL")
+	graph.Attr("labeljust", "l")
+
+	nodes := make(map[string]dot.Node, len(g.Nodes))
+	for _, n := range g.Nodes {
+		root := rootString(n.Root)
+		label := fmt.Sprintf("slot: %d\nroot: %s\nweight: %d\nvotes: %d", n.Slot, root[:10], n.Weight, n.Balance)
+		var markers []string
+		if n.Head {
+			markers = append(markers, "head")
+		}
+		if n.Justified {
+			markers = append(markers, "justified")
+		}
+		if n.Finalized {
+			markers = append(markers, "finalized")
+		}
+		if n.ProposerBoost {
+			markers = append(markers, "boosted")
+		}
+		if n.ExecutionOptimistic {
+			markers = append(markers, "optimistic")
+		}
+		if len(markers) > 0 {
+			label += "\n" + strings.Join(markers, ", ")
+		}
+		dn := graph.Node(root).Box().Label(label)
+		switch {
+		case n.Finalized:
+			dn.Attr("color", "darkgreen")
+		case n.Justified:
+			dn.Attr("color", "blue")
+		case n.ProposerBoost:
+			dn.Attr("color", "orange")
+		}
+		if n.Head {
+			dn.Attr("penwidth", "3")
+		}
+		if n.ExecutionOptimistic {
+			dn.Attr("style", "dashed")
+		}
+		nodes[root] = dn
+	}
+	// Construct an edge only if the block's parent is in the tree.
+	for _, n := range g.Nodes {
+		if parent, ok := nodes[rootString(n.ParentRoot)]; ok {
+			graph.Edge(nodes[rootString(n.Root)], parent)
+		}
+	}
+	return graph.String()
+}
+
+func newCheckpoint(cp *v1.Checkpoint) *ethpb.Checkpoint {
+	if cp == nil {
+		return &ethpb.Checkpoint{Root: make([]byte, 32)}
+	}
+	return &ethpb.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.SafeCopyBytes(cp.Root)}
+}
+
+func rootString(root []byte) string {
+	return fmt.Sprintf("%#x", bytesutil.ToBytes32(root))
+}
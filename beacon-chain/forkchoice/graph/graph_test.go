@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/prysmaticlabs/prysm/v3/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func testDump() *v1.ForkChoiceResponse {
+	a, b, c := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}
+	return &v1.ForkChoiceResponse{
+		JustifiedCheckpoint: &v1.Checkpoint{Epoch: 1, Root: a[:]},
+		FinalizedCheckpoint: &v1.Checkpoint{Epoch: 1, Root: a[:]},
+		ProposerBoostRoot:   c[:],
+		HeadRoot:            b[:],
+		ForkchoiceNodes: []*v1.ForkChoiceNode{
+			{Slot: 32, Root: a[:], ParentRoot: make([]byte, 32), Weight: 96, Balance: 0},
+			{Slot: 33, Root: b[:], ParentRoot: a[:], Weight: 64, Balance: 64},
+			{Slot: 34, Root: c[:], ParentRoot: a[:], Weight: 32, Balance: 32, ExecutionOptimistic: true},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	g := New(testDump())
+	b := [32]byte{'b'}
+	assert.DeepEqual(t, b[:], g.HeadRoot)
+	require.Equal(t, 3, len(g.Nodes))
+
+	root := g.Nodes[0]
+	assert.Equal(t, true, root.Justified)
+	assert.Equal(t, true, root.Finalized)
+	assert.Equal(t, false, root.Head)
+	assert.Equal(t, uint64(96), root.Weight)
+
+	head := g.Nodes[1]
+	assert.Equal(t, true, head.Head)
+	assert.Equal(t, false, head.ProposerBoost)
+	assert.DeepEqual(t, root.Root, head.ParentRoot)
+	assert.Equal(t, uint64(64), head.Balance)
+
+	boosted := g.Nodes[2]
+	assert.Equal(t, true, boosted.ProposerBoost)
+	assert.Equal(t, true, boosted.ExecutionOptimistic)
+}
+
+func TestDOT(t *testing.T) {
+	out := DOT(New(testDump()))
+	assert.Equal(t, true, strings.HasPrefix(out, "digraph"))
+	// Every block but the tree root has an edge to its parent.
+	assert.Equal(t, 2, strings.Count(out, "->"))
+	assert.Equal(t, true, strings.Contains(out, "slot: 33"))
+	assert.Equal(t, true, strings.Contains(out, "justified, finalized"))
+	assert.Equal(t, true, strings.Contains(out, "boosted, optimistic"))
+	assert.Equal(t, true, strings.Contains(out, "penwidth=\"3\""))
+	assert.Equal(t, true, strings.Contains(out, "style=\"dashed\""))
+}
@@ -70,6 +70,13 @@ type Getter interface {
 	ReceivedBlocksLastEpoch() (uint64, error)
 	ForkChoiceDump(context.Context) (*v1.ForkChoiceResponse, error)
 	VotedFraction(root [32]byte) (uint64, error)
+	Weight(root [32]byte) (uint64, error)
+	IsTimely(root [32]byte) (bool, error)
+	ParentRoot(root [32]byte) ([32]byte, error)
+	Slot(root [32]byte) (types.Slot, error)
+	UnrealizedJustifiedCheckpoint(root [32]byte) (*forkchoicetypes.Checkpoint, error)
+	CommitteeWeight() uint64
+	OptimisticBlocks() []*forkchoicetypes.OptimisticBlock
 }
 
 // Setter allows to set forkchoice information
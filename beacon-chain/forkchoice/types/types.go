@@ -29,3 +29,12 @@ type BlockAndCheckpoints struct {
 	JustifiedCheckpoint *ethpb.Checkpoint
 	FinalizedCheckpoint *ethpb.Checkpoint
 }
+
+// OptimisticBlock is a block in forkchoice whose execution payload has not been
+// validated by the execution client yet.
+type OptimisticBlock struct {
+	Slot        types.Slot
+	Root        [fieldparams.RootLength]byte
+	ParentRoot  [fieldparams.RootLength]byte
+	PayloadHash [fieldparams.RootLength]byte
+}
@@ -96,6 +96,7 @@ type BeaconNode struct {
 	syncCommitteePool       synccommittee.Pool
 	depositCache            *depositcache.DepositCache
 	proposerIdsCache        *cache.ProposerPayloadIDsCache
+	blockTimings            *cache.BlockTimingsCache
 	stateFeed               *event.Feed
 	blockFeed               *event.Feed
 	opFeed                  *event.Feed
@@ -172,6 +173,7 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
 		proposerIdsCache:        cache.NewProposerPayloadIDsCache(),
+		blockTimings:            cache.NewBlockTimingsCache(cache.BlockTimingsSize),
 	}
 
 	for _, opt := range opts {
@@ -535,6 +537,8 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
 		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
 		DenyListCIDR:      slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		UploadLimit:       cliCtx.Uint64(cmd.P2PUploadLimit.Name),
+		PeerUploadLimit:   cliCtx.Uint64(cmd.P2PPeerUploadLimit.Name),
 		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
 		StateNotifier:     b,
 		DB:                b.db,
@@ -600,6 +604,7 @@ func (b *BeaconNode) registerBlockchainService(fc forkchoice.ForkChoicer) error
 		blockchain.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		blockchain.WithFinalizedStateAtStartUp(b.finalizedStateAtStartUp),
 		blockchain.WithProposerIdsCache(b.proposerIdsCache),
+		blockchain.WithBlockTimingsCache(b.blockTimings),
 	)
 
 	blockchainService, err := blockchain.NewService(b.ctx, opts...)
@@ -657,6 +662,10 @@ func (b *BeaconNode) registerSyncService() error {
 		return err
 	}
 
+	slasherAttsQueueSize := 0
+	if b.cliCtx.Bool(flags.SlasherUnaggregatedAttestations.Name) {
+		slasherAttsQueueSize = b.cliCtx.Int(flags.SlasherUnaggregatedAttestationsQueueSize.Name)
+	}
 	rs := regularsync.NewService(
 		b.ctx,
 		regularsync.WithDatabase(b.db),
@@ -674,7 +683,9 @@ func (b *BeaconNode) registerSyncService() error {
 		regularsync.WithStateGen(b.stateGen),
 		regularsync.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		regularsync.WithSlasherBlockHeadersFeed(b.slasherBlockHeadersFeed),
+		regularsync.WithSlasherAttestationsQueueSize(slasherAttsQueueSize),
 		regularsync.WithExecutionPayloadReconstructor(web3Service),
+		regularsync.WithBlockTimingsCache(b.blockTimings),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -708,6 +719,14 @@ func (b *BeaconNode) registerSlasherService() error {
 		return err
 	}
 
+	slasherParams, err := slasher.NewParams(
+		b.cliCtx.Uint64(flags.SlasherChunkSize.Name),
+		b.cliCtx.Uint64(flags.SlasherValidatorChunkSize.Name),
+		types.Epoch(b.cliCtx.Uint64(flags.SlasherHistoryLength.Name)),
+	)
+	if err != nil {
+		return errors.Wrap(err, "invalid slasher parameters")
+	}
 	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
 		IndexedAttestationsFeed: b.slasherAttestationsFeed,
 		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
@@ -718,6 +737,7 @@ func (b *BeaconNode) registerSlasherService() error {
 		SlashingPoolInserter:    b.slashingsPool,
 		SyncChecker:             syncService,
 		HeadStateFetcher:        chainService,
+		Params:                  slasherParams,
 	})
 	if err != nil {
 		return err
@@ -741,11 +761,13 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
-	var slasherService *slasher.Service
+	var slashingChecker slasher.SlashingChecker
 	if features.Get().EnableSlasher {
+		var slasherService *slasher.Service
 		if err := b.services.FetchService(&slasherService); err != nil {
 			return err
 		}
+		slashingChecker = slasherService
 	}
 
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
@@ -779,6 +801,7 @@ func (b *BeaconNode) registerRPCService() error {
 	}
 
 	p2pService := b.fetchP2P()
+	enrIndexProvider, _ := p2pService.(p2p.ENRIndexProvider)
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		ExecutionEngineCaller:         web3Service,
 		ExecutionPayloadReconstructor: web3Service,
@@ -792,9 +815,12 @@ func (b *BeaconNode) registerRPCService() error {
 		Broadcaster:                   p2pService,
 		PeersFetcher:                  p2pService,
 		PeerManager:                   p2pService,
+		BandwidthProvider:             p2pService,
+		ENRIndexProvider:              enrIndexProvider,
 		MetadataProvider:              p2pService,
 		ChainInfoFetcher:              chainService,
 		HeadUpdater:                   chainService,
+		OptimisticBlocksManager:       chainService,
 		HeadFetcher:                   chainService,
 		CanonicalFetcher:              chainService,
 		ForkFetcher:                   chainService,
@@ -807,7 +833,7 @@ func (b *BeaconNode) registerRPCService() error {
 		AttestationsPool:              b.attestationPool,
 		ExitPool:                      b.exitPool,
 		SlashingsPool:                 b.slashingsPool,
-		SlashingChecker:               slasherService,
+		SlashingChecker:               slashingChecker,
 		SyncCommitteeObjectPool:       b.syncCommitteePool,
 		ExecutionChainService:         web3Service,
 		ExecutionChainInfoFetcher:     web3Service,
@@ -824,6 +850,7 @@ func (b *BeaconNode) registerRPCService() error {
 		MaxMsgSize:                    maxMsgSize,
 		ProposerIdsCache:              b.proposerIdsCache,
 		BlockBuilder:                  b.fetchBuilderService(),
+		BlockTimings:                  b.blockTimings,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -836,6 +863,7 @@ func (b *BeaconNode) registerPrometheusService(_ *cli.Context) error {
 		panic(err)
 	}
 	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/p2p", Handler: p.InfoHandler})
+	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/p2p/enr-index", Handler: p.ENRIndexHandler})
 
 	var c *blockchain.Service
 	if err := b.services.FetchService(&c); err != nil {
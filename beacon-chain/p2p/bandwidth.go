@@ -0,0 +1,238 @@
+package p2p
+
+import (
+	"context"
+	"math"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+)
+
+const (
+	// Peers which have not exchanged any traffic for this long have their bandwidth
+	// meters dropped.
+	bandwidthIdleExpiry = 1 * time.Hour
+	// Upper bound on the time a throttled upload waits before checking the upload limits
+	// again.
+	uploadThrottleInterval = 50 * time.Millisecond
+	// Key of the bucket tracking the uploads to all peers.
+	globalUploadKey = "global"
+)
+
+// BandwidthForPeer returns the total traffic exchanged with the peer, along with the
+// current rates, in bytes.
+func (s *Service) BandwidthForPeer(pid peer.ID) metrics.Stats {
+	return s.bandwidth.GetBandwidthForPeer(pid)
+}
+
+// BandwidthByProtocol returns the total traffic exchanged, along with the current rates,
+// per libp2p protocol in bytes. Req/resp protocols are listed individually while all
+// gossip traffic is reported under the gossipsub protocol.
+func (s *Service) BandwidthByProtocol() map[protocol.ID]metrics.Stats {
+	return s.bandwidth.GetBandwidthByProtocol()
+}
+
+// topicBandwidthTracer accounts the gossip traffic per topic. Only the payload of the
+// published messages is accounted, leaving out the gossipsub control messages.
+type topicBandwidthTracer struct{}
+
+var _ = pubsub.RawTracer(topicBandwidthTracer{})
+
+func (topicBandwidthTracer) RecvRPC(rpc *pubsub.RPC) {
+	for _, msg := range rpc.Publish {
+		topicBandwidth.WithLabelValues(msg.GetTopic(), "in").Add(float64(msg.Size()))
+	}
+}
+
+func (topicBandwidthTracer) SendRPC(rpc *pubsub.RPC, _ peer.ID) {
+	for _, msg := range rpc.Publish {
+		topicBandwidth.WithLabelValues(msg.GetTopic(), "out").Add(float64(msg.Size()))
+	}
+}
+
+func (topicBandwidthTracer) AddPeer(peer.ID, protocol.ID)          {}
+func (topicBandwidthTracer) RemovePeer(peer.ID)                    {}
+func (topicBandwidthTracer) Join(string)                           {}
+func (topicBandwidthTracer) Leave(string)                          {}
+func (topicBandwidthTracer) Graft(peer.ID, string)                 {}
+func (topicBandwidthTracer) Prune(peer.ID, string)                 {}
+func (topicBandwidthTracer) ValidateMessage(*pubsub.Message)       {}
+func (topicBandwidthTracer) DeliverMessage(*pubsub.Message)        {}
+func (topicBandwidthTracer) RejectMessage(*pubsub.Message, string) {}
+func (topicBandwidthTracer) DuplicateMessage(*pubsub.Message)      {}
+func (topicBandwidthTracer) ThrottlePeer(peer.ID)                  {}
+func (topicBandwidthTracer) DropRPC(*pubsub.RPC, peer.ID)          {}
+func (topicBandwidthTracer) UndeliverableMessage(*pubsub.Message)  {}
+
+// Req/resp protocols which are never throttled. They carry small control messages which
+// must get through in time for peers to keep the connection up.
+var unthrottledTopics = []string{
+	RPCStatusTopicV1,
+	RPCGoodByeTopicV1,
+	RPCPingTopicV1,
+	RPCMetaDataTopicV1,
+	RPCMetaDataTopicV2,
+}
+
+// isThrottledTopic returns whether the responses to the given req/resp topic are subject
+// to the upload limits.
+func isThrottledTopic(topic string) bool {
+	for _, t := range unthrottledTopics {
+		if strings.HasPrefix(topic, t) {
+			return false
+		}
+	}
+	return true
+}
+
+// uploadLimiter caps the rate at which req/resp responses are written, both to all
+// peers and to every single peer.
+type uploadLimiter struct {
+	// Guards the reservations made across both collectors.
+	lock    sync.Mutex
+	global  *leakybucket.Collector
+	perPeer *leakybucket.Collector
+}
+
+// newUploadLimiter returns a limiter for the given limits in bytes per second, where 0
+// means no limit. Returns nil when uploads are not limited at all.
+func newUploadLimiter(globalLimit, peerLimit uint64) *uploadLimiter {
+	if globalLimit == 0 && peerLimit == 0 {
+		return nil
+	}
+	l := &uploadLimiter{}
+	// Buckets hold one second worth of uploads, allowing for short bursts.
+	if globalLimit > 0 {
+		l.global = leakybucket.NewCollector(float64(globalLimit), int64(globalLimit), false /* deleteEmptyBuckets */)
+	}
+	if peerLimit > 0 {
+		l.perPeer = leakybucket.NewCollector(float64(peerLimit), int64(peerLimit), true /* deleteEmptyBuckets */)
+	}
+	return l
+}
+
+// reserve takes up to n bytes from the upload allowance for the peer, returning the
+// amount which may be written right away. When nothing may be written, the time to wait
+// before trying again is returned instead.
+func (l *uploadLimiter) reserve(pid peer.ID, n int) (int, time.Duration) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	allowed := int64(n)
+	var wait time.Duration
+	limit := func(c *leakybucket.Collector, key string) {
+		if c == nil {
+			return
+		}
+		if remaining := c.Remaining(key); remaining < allowed {
+			allowed = remaining
+		}
+		if allowed <= 0 {
+			// Wait for the bucket to drain by one throttle interval worth of uploads,
+			// or by the whole write if it is smaller.
+			want := math.Min(float64(n), c.Rate()*uploadThrottleInterval.Seconds())
+			drain := time.Duration(want / c.Rate() * float64(time.Second))
+			if drain > wait {
+				wait = drain
+			}
+		}
+	}
+	limit(l.global, globalUploadKey)
+	limit(l.perPeer, pid.String())
+	if allowed <= 0 {
+		return 0, wait
+	}
+	if l.global != nil {
+		l.global.Add(globalUploadKey, allowed)
+	}
+	if l.perPeer != nil {
+		l.perPeer.Add(pid.String(), allowed)
+	}
+	return int(allowed), 0
+}
+
+// wrapHandler throttles the writes made by the handler to the streams it serves. Throttled
+// writes are abandoned once the context is done.
+func (l *uploadLimiter) wrapHandler(ctx context.Context, handler network.StreamHandler) network.StreamHandler {
+	return func(stream network.Stream) {
+		handler(&throttledStream{Stream: stream, ctx: ctx, limiter: l, pid: stream.Conn().RemotePeer()})
+	}
+}
+
+// throttledStream is a stream whose writes are held back by the upload limits.
+type throttledStream struct {
+	network.Stream
+	ctx     context.Context
+	limiter *uploadLimiter
+	pid     peer.ID
+
+	deadlineLock  sync.Mutex
+	writeDeadline time.Time
+}
+
+// SetDeadline sets the read and write deadlines of the stream.
+func (s *throttledStream) SetDeadline(t time.Time) error {
+	s.setWriteDeadline(t)
+	return s.Stream.SetDeadline(t)
+}
+
+// SetWriteDeadline sets the write deadline of the stream, which also bounds the time
+// spent waiting for the upload limits.
+func (s *throttledStream) SetWriteDeadline(t time.Time) error {
+	s.setWriteDeadline(t)
+	return s.Stream.SetWriteDeadline(t)
+}
+
+func (s *throttledStream) setWriteDeadline(t time.Time) {
+	s.deadlineLock.Lock()
+	defer s.deadlineLock.Unlock()
+	s.writeDeadline = t
+}
+
+// Write blocks until the whole buffer has been written within the upload limits, the
+// write deadline of the stream passes or the context is done.
+func (s *throttledStream) Write(b []byte) (int, error) {
+	written := 0
+	for written < len(b) {
+		allowed, wait := s.limiter.reserve(s.pid, len(b)-written)
+		if allowed == 0 {
+			uploadThrottleWaits.Inc()
+			if err := s.wait(wait); err != nil {
+				return written, err
+			}
+			continue
+		}
+		n, err := s.Stream.Write(b[written : written+allowed])
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// wait sleeps for the given duration, returning early with an error when the write
+// deadline passes or the context is done in the meantime.
+func (s *throttledStream) wait(d time.Duration) error {
+	s.deadlineLock.Lock()
+	deadline := s.writeDeadline
+	s.deadlineLock.Unlock()
+	if !deadline.IsZero() && time.Until(deadline) < d {
+		return os.ErrDeadlineExceeded
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
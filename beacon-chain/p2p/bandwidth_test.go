@@ -0,0 +1,165 @@
+package p2p
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestUploadLimiter_Reserve(t *testing.T) {
+	assert.Equal(t, true, newUploadLimiter(0, 0) == nil)
+
+	ids := []peer.ID{"a", "b"}
+	l := newUploadLimiter(150, 100)
+	// The per peer limit applies first.
+	n, _ := l.reserve(ids[0], 120)
+	assert.Equal(t, 100, n)
+	n, wait := l.reserve(ids[0], 10)
+	if n == 0 {
+		assert.Equal(t, true, wait > 0 && wait <= uploadThrottleInterval)
+	}
+	assert.Equal(t, true, n < 10)
+	// The global limit is shared by all peers.
+	n, _ = l.reserve(ids[1], 100)
+	assert.Equal(t, 50, n)
+	n, _ = l.reserve(ids[1], 10)
+	assert.Equal(t, true, n < 10)
+
+	// Only the per peer limit applies without a global one.
+	l = newUploadLimiter(0, 100)
+	n, _ = l.reserve(ids[0], 100)
+	assert.Equal(t, 100, n)
+	n, _ = l.reserve(ids[1], 100)
+	assert.Equal(t, 100, n)
+}
+
+func TestUploadLimiter_Reserve_Concurrent(t *testing.T) {
+	l := newUploadLimiter(1000, 0)
+	var wg sync.WaitGroup
+	var total int64
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(pid peer.ID) {
+			defer wg.Done()
+			n, _ := l.reserve(pid, 200)
+			atomic.AddInt64(&total, int64(n))
+		}(peer.ID(rune('a' + i)))
+	}
+	wg.Wait()
+	// Concurrent reservations never exceed the global allowance, along with what drained
+	// in the meantime.
+	drained := int64(time.Since(start).Seconds()*1000) + 1
+	assert.Equal(t, true, total <= 1000+drained, "reserved %d bytes", total)
+}
+
+func TestIsThrottledTopic(t *testing.T) {
+	suffix := encoder.SszNetworkEncoder{}.ProtocolSuffix()
+	assert.Equal(t, false, isThrottledTopic(RPCStatusTopicV1+suffix))
+	assert.Equal(t, false, isThrottledTopic(RPCPingTopicV1+suffix))
+	assert.Equal(t, false, isThrottledTopic(RPCGoodByeTopicV1+suffix))
+	assert.Equal(t, false, isThrottledTopic(RPCMetaDataTopicV2+suffix))
+	assert.Equal(t, true, isThrottledTopic(RPCBlocksByRangeTopicV2+suffix))
+}
+
+func TestThrottledStream_Write(t *testing.T) {
+	l := newUploadLimiter(0, 1000)
+	stream := &recordingStream{}
+	ts := &throttledStream{Stream: stream, ctx: context.Background(), limiter: l, pid: peer.ID("a")}
+
+	start := time.Now()
+	n, err := ts.Write(make([]byte, 1500))
+	require.NoError(t, err)
+	assert.Equal(t, 1500, n)
+	assert.Equal(t, 1500, stream.written)
+	// The first second worth of data is written right away, the rest once the bucket drains.
+	assert.Equal(t, true, time.Since(start) >= 400*time.Millisecond)
+	assert.Equal(t, true, len(stream.writes) > 1)
+}
+
+func TestThrottledStream_Write_Deadline(t *testing.T) {
+	l := newUploadLimiter(0, 1000)
+	stream := &recordingStream{}
+	ts := &throttledStream{Stream: stream, ctx: context.Background(), limiter: l, pid: peer.ID("a")}
+
+	require.NoError(t, ts.SetWriteDeadline(time.Now().Add(100*time.Millisecond)))
+	n, err := ts.Write(make([]byte, 5000))
+	require.ErrorIs(t, err, os.ErrDeadlineExceeded)
+	assert.Equal(t, true, n < 5000)
+}
+
+func TestThrottledStream_Write_Canceled(t *testing.T) {
+	l := newUploadLimiter(0, 1000)
+	ctx, cancel := context.WithCancel(context.Background())
+	ts := &throttledStream{Stream: &recordingStream{}, ctx: ctx, limiter: l, pid: peer.ID("a")}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+	_, err := ts.Write(make([]byte, 5000))
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestService_BandwidthForPeer(t *testing.T) {
+	hosts := make([]*Service, 2)
+	for i := range hosts {
+		s, err := NewService(context.Background(), &Config{StateNotifier: &mock.MockStateNotifier{}, UploadLimit: 1 << 20})
+		require.NoError(t, err)
+		hosts[i] = s
+	}
+	const proto = "/testing/bandwidth"
+	payload := make([]byte, 4096)
+	hosts[1].SetStreamHandler(proto, func(stream network.Stream) {
+		_, err := stream.Write(payload)
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Close())
+	})
+	require.NoError(t, hosts[0].Connect(peer.AddrInfo{ID: hosts[1].PeerID(), Addrs: hosts[1].Host().Addrs()}))
+	stream, err := hosts[0].Host().NewStream(context.Background(), hosts[1].PeerID(), protocol.ID(proto))
+	require.NoError(t, err)
+	received, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	assert.Equal(t, len(payload), len(received))
+
+	// Meters are updated in the background.
+	sent := func() bool {
+		return hosts[1].BandwidthForPeer(hosts[0].PeerID()).TotalOut >= int64(len(payload))
+	}
+	for i := 0; i < 30 && !sent(); i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.Equal(t, true, sent())
+	assert.Equal(t, true, hosts[0].BandwidthForPeer(hosts[1].PeerID()).TotalIn >= int64(len(payload)))
+	_, ok := hosts[1].BandwidthByProtocol()[protocol.ID(proto)]
+	assert.Equal(t, true, ok)
+}
+
+// recordingStream is a stream recording the writes made to it.
+type recordingStream struct {
+	network.Stream
+	writes  []int
+	written int
+}
+
+func (*recordingStream) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+func (s *recordingStream) Write(b []byte) (int, error) {
+	s.writes = append(s.writes, len(b))
+	s.written += len(b)
+	return len(b), nil
+}
@@ -25,6 +25,8 @@ type Config struct {
 	MaxPeers            uint
 	AllowListCIDR       string
 	DenyListCIDR        []string
+	UploadLimit         uint64
+	PeerUploadLimit     uint64
 	StateNotifier       statefeed.Notifier
 	DB                  db.ReadOnlyDatabase
 }
@@ -0,0 +1,417 @@
+package p2p
+
+import (
+	"container/list"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// File name of the persisted ENR index, stored in the node's data directory.
+	enrIndexFileName = "enr-index.json"
+	// Maximum number of records kept in the ENR index. Once reached, the records
+	// seen least recently are evicted first.
+	maxIndexedNodes = 10000
+	// Records which have not been seen on the network for this long are dropped.
+	enrIndexExpiry = 24 * time.Hour
+	// Interval at which the index is refreshed from a discovery walk, pruned and
+	// persisted.
+	enrIndexRefreshInterval = 5 * time.Minute
+	// Maximum number of nodes visited by a single refresh walk.
+	enrIndexRefreshWalk = 500
+	// Time allowed for a single refresh walk.
+	enrIndexRefreshTimeout = 1 * time.Minute
+)
+
+// subnetIndexKey identifies a single attestation or sync committee subnet on a given fork.
+type subnetIndexKey struct {
+	forkDigest [4]byte
+	sync       bool
+	subnet     uint64
+}
+
+// indexedNode is a record seen through discovery, along with the fork and subnet
+// information extracted from it.
+type indexedNode struct {
+	node       *enode.Node
+	forkDigest [4]byte
+	attnets    []uint64
+	syncnets   []uint64
+	lastSeen   time.Time
+	// elem is the position of the node in the index order of last sighting.
+	elem *list.Element
+}
+
+// enrIndex keeps the ENRs seen through discovery, keyed by fork digest and the subnets
+// advertised in the record, so that subnet peers can be looked up without walking
+// the DHT. Nodes are also kept in order of last sighting, most recent first, so that
+// the nodes seen least recently can be evicted and pruned without scanning the index.
+type enrIndex struct {
+	lock     sync.RWMutex
+	nodes    map[enode.ID]*indexedNode
+	bySubnet map[subnetIndexKey]map[enode.ID]bool
+	order    *list.List
+	capacity int
+}
+
+func newENRIndex(capacity int) *enrIndex {
+	return &enrIndex{
+		nodes:    make(map[enode.ID]*indexedNode),
+		bySubnet: make(map[subnetIndexKey]map[enode.ID]bool),
+		order:    list.New(),
+		capacity: capacity,
+	}
+}
+
+// add indexes the node, replacing any older record of the same node. Records without
+// an eth2 entry are ignored. Returns whether the node was indexed.
+func (idx *enrIndex) add(node *enode.Node, seen time.Time) bool {
+	if node == nil {
+		return false
+	}
+	entry, err := forkEntry(node.Record())
+	if err != nil {
+		return false
+	}
+	in := &indexedNode{
+		node:       node,
+		forkDigest: bytesutil.ToBytes4(entry.CurrentForkDigest),
+		lastSeen:   seen,
+	}
+	// Missing subnet entries simply mean the node is not subscribed to any.
+	if attnets, err := attSubnets(node.Record()); err == nil {
+		in.attnets = attnets
+	}
+	if syncnets, err := syncSubnets(node.Record()); err == nil {
+		in.syncnets = syncnets
+	}
+
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+	if existing, ok := idx.nodes[node.ID()]; ok {
+		if existing.node.Seq() > node.Seq() {
+			if seen.After(existing.lastSeen) {
+				existing.lastSeen = seen
+				idx.order.Remove(existing.elem)
+				existing.elem = idx.insertNoLock(existing)
+			}
+			return true
+		}
+		idx.removeNoLock(node.ID())
+	}
+	if idx.capacity > 0 && len(idx.nodes) >= idx.capacity {
+		idx.evictOldestNoLock()
+	}
+	idx.nodes[node.ID()] = in
+	in.elem = idx.insertNoLock(in)
+	for _, subnet := range in.attnets {
+		idx.addToSubnetNoLock(subnetIndexKey{forkDigest: in.forkDigest, subnet: subnet}, node.ID())
+	}
+	for _, subnet := range in.syncnets {
+		idx.addToSubnetNoLock(subnetIndexKey{forkDigest: in.forkDigest, sync: true, subnet: subnet}, node.ID())
+	}
+	return true
+}
+
+// nodesWithSubnet returns the indexed nodes on the given fork that advertise the
+// subnet, most recently seen first.
+func (idx *enrIndex) nodesWithSubnet(forkDigest [4]byte, sync bool, subnet uint64) []*enode.Node {
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+	ids := idx.bySubnet[subnetIndexKey{forkDigest: forkDigest, sync: sync, subnet: subnet}]
+	found := make([]*indexedNode, 0, len(ids))
+	for id := range ids {
+		found = append(found, idx.nodes[id])
+	}
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].lastSeen.After(found[j].lastSeen)
+	})
+	nodes := make([]*enode.Node, len(found))
+	for i, in := range found {
+		nodes[i] = in.node
+	}
+	return nodes
+}
+
+// prune drops every record last seen before the cutoff.
+func (idx *enrIndex) prune(cutoff time.Time) int {
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+	pruned := 0
+	for e := idx.order.Back(); e != nil; e = idx.order.Back() {
+		in, ok := e.Value.(*indexedNode)
+		if !ok || !in.lastSeen.Before(cutoff) {
+			break
+		}
+		idx.removeNoLock(in.node.ID())
+		pruned++
+	}
+	return pruned
+}
+
+func (idx *enrIndex) len() int {
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+	return len(idx.nodes)
+}
+
+func (idx *enrIndex) addToSubnetNoLock(key subnetIndexKey, id enode.ID) {
+	ids, ok := idx.bySubnet[key]
+	if !ok {
+		ids = make(map[enode.ID]bool)
+		idx.bySubnet[key] = ids
+	}
+	ids[id] = true
+}
+
+func (idx *enrIndex) removeNoLock(id enode.ID) {
+	in, ok := idx.nodes[id]
+	if !ok {
+		return
+	}
+	remove := func(key subnetIndexKey) {
+		delete(idx.bySubnet[key], id)
+		if len(idx.bySubnet[key]) == 0 {
+			delete(idx.bySubnet, key)
+		}
+	}
+	for _, subnet := range in.attnets {
+		remove(subnetIndexKey{forkDigest: in.forkDigest, subnet: subnet})
+	}
+	for _, subnet := range in.syncnets {
+		remove(subnetIndexKey{forkDigest: in.forkDigest, sync: true, subnet: subnet})
+	}
+	idx.order.Remove(in.elem)
+	delete(idx.nodes, id)
+}
+
+// insertNoLock places the node in the order of last sighting. Nodes are almost always
+// seen after every indexed node, so the position is searched for from the front.
+func (idx *enrIndex) insertNoLock(in *indexedNode) *list.Element {
+	for e := idx.order.Front(); e != nil; e = e.Next() {
+		if other, ok := e.Value.(*indexedNode); ok && !other.lastSeen.After(in.lastSeen) {
+			return idx.order.InsertBefore(in, e)
+		}
+	}
+	return idx.order.PushBack(in)
+}
+
+func (idx *enrIndex) evictOldestNoLock() {
+	if e := idx.order.Back(); e != nil {
+		if oldest, ok := e.Value.(*indexedNode); ok {
+			idx.removeNoLock(oldest.node.ID())
+		}
+	}
+}
+
+// persistedENR is the on-disk representation of an index entry.
+type persistedENR struct {
+	ENR      string    `json:"enr"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// save writes all the indexed records to the given path.
+func (idx *enrIndex) save(path string) error {
+	idx.lock.RLock()
+	records := make([]*persistedENR, 0, len(idx.nodes))
+	for _, in := range idx.nodes {
+		records = append(records, &persistedENR{ENR: in.node.String(), LastSeen: in.lastSeen})
+	}
+	idx.lock.RUnlock()
+	enc, err := json.Marshal(records)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal ENR index")
+	}
+	return file.WriteFile(path, enc)
+}
+
+// load indexes the records previously saved to the given path. A missing file is
+// not an error, as the index is then simply rebuilt from discovery.
+func (idx *enrIndex) load(path string) (int, error) {
+	if !file.FileExists(path) {
+		return 0, nil
+	}
+	enc, err := os.ReadFile(path) // #nosec G304 -- path is built from the configured data directory.
+	if err != nil {
+		return 0, errors.Wrap(err, "could not read ENR index")
+	}
+	var records []*persistedENR
+	if err := json.Unmarshal(enc, &records); err != nil {
+		return 0, errors.Wrap(err, "could not unmarshal ENR index")
+	}
+	// Index the records in the order they were seen, so that each is inserted at the front.
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].LastSeen.Before(records[j].LastSeen)
+	})
+	loaded := 0
+	for _, r := range records {
+		node, err := enode.Parse(enode.ValidSchemes, r.ENR)
+		if err != nil {
+			log.WithError(err).Debug("Could not parse persisted ENR")
+			continue
+		}
+		if idx.add(node, r.LastSeen) {
+			loaded++
+		}
+	}
+	return loaded, nil
+}
+
+// ENRIndexEntry describes a single record of the ENR index.
+type ENRIndexEntry struct {
+	ENR        string    `json:"enr"`
+	NodeID     string    `json:"node_id"`
+	ForkDigest string    `json:"fork_digest"`
+	Attnets    []uint64  `json:"attnets"`
+	Syncnets   []uint64  `json:"syncnets"`
+	LastSeen   time.Time `json:"last_seen"`
+}
+
+// ENRIndexDigestSummary counts the indexed records of a single fork digest, per subnet.
+type ENRIndexDigestSummary struct {
+	Nodes    int            `json:"nodes"`
+	Attnets  map[uint64]int `json:"attnets"`
+	Syncnets map[uint64]int `json:"syncnets"`
+}
+
+// ENRIndexSummary is a view of the ENR index for debugging.
+type ENRIndexSummary struct {
+	Total   int                               `json:"total"`
+	Digests map[string]*ENRIndexDigestSummary `json:"digests"`
+	Entries []*ENRIndexEntry                  `json:"entries,omitempty"`
+}
+
+// summary returns the number of indexed records per fork digest and subnet, along
+// with the records themselves if requested.
+func (idx *enrIndex) summary(withEntries bool) *ENRIndexSummary {
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+	s := &ENRIndexSummary{
+		Total:   len(idx.nodes),
+		Digests: make(map[string]*ENRIndexDigestSummary),
+	}
+	for key, ids := range idx.bySubnet {
+		digest := hex.EncodeToString(key.forkDigest[:])
+		ds := s.digestSummary(digest)
+		if key.sync {
+			ds.Syncnets[key.subnet] = len(ids)
+		} else {
+			ds.Attnets[key.subnet] = len(ids)
+		}
+	}
+	for _, in := range idx.nodes {
+		digest := hex.EncodeToString(in.forkDigest[:])
+		s.digestSummary(digest).Nodes++
+		if withEntries {
+			s.Entries = append(s.Entries, &ENRIndexEntry{
+				ENR:        in.node.String(),
+				NodeID:     in.node.ID().String(),
+				ForkDigest: digest,
+				Attnets:    in.attnets,
+				Syncnets:   in.syncnets,
+				LastSeen:   in.lastSeen,
+			})
+		}
+	}
+	if withEntries {
+		sort.Slice(s.Entries, func(i, j int) bool {
+			return s.Entries[i].LastSeen.After(s.Entries[j].LastSeen)
+		})
+	}
+	return s
+}
+
+func (s *ENRIndexSummary) digestSummary(digest string) *ENRIndexDigestSummary {
+	ds, ok := s.Digests[digest]
+	if !ok {
+		ds = &ENRIndexDigestSummary{
+			Attnets:  make(map[uint64]int),
+			Syncnets: make(map[uint64]int),
+		}
+		s.Digests[digest] = ds
+	}
+	return ds
+}
+
+// loadENRIndex restores the index persisted in the data directory, if any.
+func (s *Service) loadENRIndex() {
+	if s.cfg.DataDir == "" {
+		return
+	}
+	loaded, err := s.enrIndex.load(filepath.Join(s.cfg.DataDir, enrIndexFileName))
+	if err != nil {
+		log.WithError(err).Error("Could not load ENR index")
+		return
+	}
+	s.enrIndex.prune(time.Now().Add(-enrIndexExpiry))
+	enrIndexSize.Set(float64(s.enrIndex.len()))
+	log.WithField("records", loaded).Debug("Loaded ENR index")
+}
+
+// saveENRIndex persists the index in the data directory.
+func (s *Service) saveENRIndex() {
+	if s.cfg.DataDir == "" {
+		return
+	}
+	if err := s.enrIndex.save(filepath.Join(s.cfg.DataDir, enrIndexFileName)); err != nil {
+		log.WithError(err).Error("Could not save ENR index")
+	}
+}
+
+// refreshENRIndex walks a bounded number of random nodes of the DHT, indexing every
+// record seen, then drops the expired records and persists the index.
+func (s *Service) refreshENRIndex() {
+	ctx, cancel := context.WithTimeout(s.ctx, enrIndexRefreshTimeout)
+	defer cancel()
+	iterator := s.dv5Listener.RandomNodes()
+	// Closing the iterator unblocks Next once the walk times out.
+	go func() {
+		<-ctx.Done()
+		iterator.Close()
+	}()
+	for i := 0; i < enrIndexRefreshWalk && iterator.Next(); i++ {
+		s.enrIndex.add(iterator.Node(), time.Now())
+	}
+	pruned := s.enrIndex.prune(time.Now().Add(-enrIndexExpiry))
+	enrIndexSize.Set(float64(s.enrIndex.len()))
+	log.WithFields(logrus.Fields{
+		"records": s.enrIndex.len(),
+		"pruned":  pruned,
+	}).Debug("Refreshed ENR index")
+	s.saveENRIndex()
+}
+
+// ENRIndex returns the number of indexed records per fork digest and subnet, along
+// with the records themselves if requested.
+func (s *Service) ENRIndex(withEntries bool) *ENRIndexSummary {
+	return s.enrIndex.summary(withEntries)
+}
+
+// ENRIndexHandler writes the number of indexed records per fork digest and subnet
+// as JSON. The records themselves are included when the entries query parameter is set.
+func (s *Service) ENRIndexHandler(w http.ResponseWriter, r *http.Request) {
+	withEntries := r.URL.Query().Get("entries") == "true"
+	enc, err := json.Marshal(s.ENRIndex(withEntries))
+	if err != nil {
+		log.WithError(err).Error("Could not marshal ENR index")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(enc); err != nil {
+		log.WithError(err).Error("Failed to write ENR index")
+	}
+}
@@ -0,0 +1,171 @@
+package p2p
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/prysmaticlabs/go-bitfield"
+	ecdsaprysm "github.com/prysmaticlabs/prysm/v3/crypto/ecdsa"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func indexTestNode(t *testing.T, digest [4]byte, attnets, syncnets []uint64) *enode.Node {
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	convertedKey, err := ecdsaprysm.ConvertFromInterfacePrivKey(priv)
+	require.NoError(t, err)
+	localNode := enode.NewLocalNode(db, convertedKey)
+
+	enc, err := (&pb.ENRForkID{
+		CurrentForkDigest: digest[:],
+		NextForkVersion:   []byte{0, 0, 0, 0},
+	}).MarshalSSZ()
+	require.NoError(t, err)
+	localNode.Set(enr.WithEntry(eth2ENRKey, enc))
+	attBits := bitfield.NewBitvector64()
+	for _, i := range attnets {
+		attBits.SetBitAt(i, true)
+	}
+	localNode.Set(enr.WithEntry(attSubnetEnrKey, attBits.Bytes()))
+	syncBits := bitfield.Bitvector4{byte(0x00)}
+	for _, i := range syncnets {
+		syncBits.SetBitAt(i, true)
+	}
+	localNode.Set(enr.WithEntry(syncCommsSubnetEnrKey, syncBits.Bytes()))
+	return localNode.Node()
+}
+
+func TestENRIndex_NodesWithSubnet(t *testing.T) {
+	digest := [4]byte{'A', 'B', 'C', 'D'}
+	otherDigest := [4]byte{'E', 'F', 'G', 'H'}
+	now := time.Now()
+	idx := newENRIndex(10)
+
+	older := indexTestNode(t, digest, []uint64{1, 2}, []uint64{0})
+	newer := indexTestNode(t, digest, []uint64{2}, nil)
+	otherFork := indexTestNode(t, otherDigest, []uint64{2}, nil)
+	assert.Equal(t, true, idx.add(older, now.Add(-time.Minute)))
+	assert.Equal(t, true, idx.add(newer, now))
+	assert.Equal(t, true, idx.add(otherFork, now))
+
+	nodes := idx.nodesWithSubnet(digest, false, 2)
+	require.Equal(t, 2, len(nodes))
+	assert.Equal(t, newer.ID(), nodes[0].ID())
+	assert.Equal(t, older.ID(), nodes[1].ID())
+	assert.Equal(t, 1, len(idx.nodesWithSubnet(digest, false, 1)))
+	assert.Equal(t, 1, len(idx.nodesWithSubnet(digest, true, 0)))
+	assert.Equal(t, 0, len(idx.nodesWithSubnet(digest, true, 2)))
+	assert.Equal(t, 1, len(idx.nodesWithSubnet(otherDigest, false, 2)))
+
+	// Records without an eth2 entry are not indexed.
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	convertedKey, err := ecdsaprysm.ConvertFromInterfacePrivKey(priv)
+	require.NoError(t, err)
+	assert.Equal(t, false, idx.add(enode.NewLocalNode(db, convertedKey).Node(), now))
+	assert.Equal(t, 3, idx.len())
+}
+
+func TestENRIndex_EvictsAndPrunes(t *testing.T) {
+	digest := [4]byte{'A', 'B', 'C', 'D'}
+	now := time.Now()
+	idx := newENRIndex(2)
+
+	oldest := indexTestNode(t, digest, []uint64{1}, nil)
+	middle := indexTestNode(t, digest, []uint64{1}, nil)
+	newest := indexTestNode(t, digest, []uint64{1}, nil)
+	idx.add(oldest, now.Add(-2*time.Hour))
+	idx.add(middle, now.Add(-time.Hour))
+	idx.add(newest, now)
+	assert.Equal(t, 2, idx.len())
+	for _, n := range idx.nodesWithSubnet(digest, false, 1) {
+		assert.NotEqual(t, oldest.ID(), n.ID())
+	}
+
+	assert.Equal(t, 1, idx.prune(now.Add(-30*time.Minute)))
+	nodes := idx.nodesWithSubnet(digest, false, 1)
+	require.Equal(t, 1, len(nodes))
+	assert.Equal(t, newest.ID(), nodes[0].ID())
+}
+
+func TestENRIndex_EvictsLeastRecentlySeen(t *testing.T) {
+	digest := [4]byte{'A', 'B', 'C', 'D'}
+	now := time.Now()
+	idx := newENRIndex(3)
+
+	first := indexTestNode(t, digest, []uint64{1}, nil)
+	second := indexTestNode(t, digest, []uint64{1}, nil)
+	third := indexTestNode(t, digest, []uint64{1}, nil)
+	// Nodes are kept in order of last sighting, whatever the order they are added in.
+	idx.add(second, now.Add(-time.Hour))
+	idx.add(first, now.Add(-2*time.Hour))
+	idx.add(third, now.Add(-time.Minute))
+	// Seeing the oldest node again makes it the most recent one.
+	idx.add(first, now)
+
+	idx.add(indexTestNode(t, digest, []uint64{1}, nil), now)
+	assert.Equal(t, 3, idx.len())
+	for _, n := range idx.nodesWithSubnet(digest, false, 1) {
+		assert.NotEqual(t, second.ID(), n.ID())
+	}
+	assert.Equal(t, 1, idx.prune(now.Add(-30*time.Second)))
+	assert.Equal(t, 2, idx.order.Len())
+}
+
+func TestENRIndex_SaveLoad(t *testing.T) {
+	digest := [4]byte{'A', 'B', 'C', 'D'}
+	idx := newENRIndex(10)
+	idx.add(indexTestNode(t, digest, []uint64{3}, []uint64{1}), time.Now())
+	idx.add(indexTestNode(t, digest, []uint64{3, 4}, nil), time.Now())
+
+	path := filepath.Join(t.TempDir(), enrIndexFileName)
+	require.NoError(t, idx.save(path))
+
+	loaded := newENRIndex(10)
+	n, err := loaded.load(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 2, len(loaded.nodesWithSubnet(digest, false, 3)))
+	assert.Equal(t, 1, len(loaded.nodesWithSubnet(digest, true, 1)))
+
+	// A missing file leaves the index empty.
+	n, err = newENRIndex(10).load(filepath.Join(t.TempDir(), enrIndexFileName))
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestENRIndexHandler(t *testing.T) {
+	digest := [4]byte{'A', 'B', 'C', 'D'}
+	s := &Service{enrIndex: newENRIndex(10)}
+	s.enrIndex.add(indexTestNode(t, digest, []uint64{3}, []uint64{1}), time.Now())
+
+	summary := s.enrIndex.summary(false)
+	assert.Equal(t, 1, summary.Total)
+	assert.Equal(t, 1, summary.Digests["41424344"].Nodes)
+	assert.Equal(t, 1, summary.Digests["41424344"].Attnets[3])
+	assert.Equal(t, 1, summary.Digests["41424344"].Syncnets[1])
+	assert.Equal(t, 0, len(summary.Entries))
+
+	rr := httptest.NewRecorder()
+	s.ENRIndexHandler(rr, httptest.NewRequest(http.MethodGet, "/p2p/enr-index?entries=true", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	decoded := &ENRIndexSummary{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), decoded))
+	require.Equal(t, 1, len(decoded.Entries))
+	assert.DeepEqual(t, []uint64{3}, decoded.Entries[0].Attnets)
+	assert.Equal(t, "41424344", decoded.Entries[0].ForkDigest)
+}
@@ -7,6 +7,7 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/connmgr"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/metrics"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
@@ -28,6 +29,7 @@ type P2P interface {
 	ConnectionHandler
 	PeersProvider
 	MetadataProvider
+	BandwidthProvider
 }
 
 // Broadcaster broadcasts messages to peers over the p2p pubsub protocol.
@@ -101,3 +103,13 @@ type MetadataProvider interface {
 	Metadata() metadata.Metadata
 	MetadataSeq() uint64
 }
+
+// BandwidthProvider returns the traffic exchanged with the peers of the node.
+type BandwidthProvider interface {
+	BandwidthForPeer(pid peer.ID) metrics.Stats
+}
+
+// ENRIndexProvider returns a view of the index of node records seen through discovery.
+type ENRIndexProvider interface {
+	ENRIndex(withEntries bool) *ENRIndexSummary
+}
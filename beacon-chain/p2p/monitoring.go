@@ -63,6 +63,34 @@ var (
 		Name: "p2p_sync_committee_subnet_attempted_broadcasts",
 		Help: "The number of sync committee that were attempted to be broadcast.",
 	})
+	enrIndexSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "p2p_enr_index_size",
+		Help: "The number of discovered node records held in the ENR index.",
+	})
+	enrIndexSubnetDials = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_enr_index_subnet_dials_total",
+		Help: "The number of subnet peers dialed from the ENR index instead of a discovery walk.",
+	})
+	protocolBandwidth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_protocol_bandwidth_bytes",
+		Help: "The total number of bytes exchanged per libp2p protocol and direction.",
+	}, []string{"protocol", "direction"})
+	protocolBandwidthRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_protocol_bandwidth_rate_bytes",
+		Help: "The current rate in bytes per second exchanged per libp2p protocol and direction.",
+	}, []string{"protocol", "direction"})
+	topicBandwidth = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_topic_bandwidth_bytes_total",
+		Help: "The number of gossip message bytes received and sent per topic.",
+	}, []string{"topic", "direction"})
+	peerBandwidthByClient = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_peer_bandwidth_rate_bytes",
+		Help: "The current rate in bytes per second exchanged with the connected peers, by agent string and direction.",
+	}, []string{"agent", "direction"})
+	uploadThrottleWaits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_upload_throttle_waits_total",
+		Help: "The number of times writing a req/resp response was held back by the upload limits.",
+	})
 )
 
 func (s *Service) updateMetrics() {
@@ -76,6 +104,8 @@ func (s *Service) updateMetrics() {
 	store := s.Host().Peerstore()
 	numConnectedPeersByClient := make(map[string]float64)
 	peerScoresByClient := make(map[string][]float64)
+	rateInByClient := make(map[string]float64)
+	rateOutByClient := make(map[string]float64)
 	for i := 0; i < len(connectedPeers); i++ {
 		p := connectedPeers[i]
 		pid, err := peer.Decode(p.String())
@@ -103,6 +133,11 @@ func (s *Service) updateMetrics() {
 		// Get peer scoring data.
 		overallScore := s.peers.Scorers().Score(pid)
 		peerScoresByClient[foundName] = append(peerScoresByClient[foundName], overallScore)
+
+		// Get bandwidth data.
+		bw := s.bandwidth.GetBandwidthForPeer(pid)
+		rateInByClient[foundName] += bw.RateIn
+		rateOutByClient[foundName] += bw.RateOut
 	}
 	for agent, total := range numConnectedPeersByClient {
 		connectedPeersCount.WithLabelValues(agent).Set(total)
@@ -111,6 +146,16 @@ func (s *Service) updateMetrics() {
 		avgScore := average(scoringData)
 		avgScoreConnectedClients.WithLabelValues(agent).Set(avgScore)
 	}
+	for agent, rate := range rateInByClient {
+		peerBandwidthByClient.WithLabelValues(agent, "in").Set(rate)
+		peerBandwidthByClient.WithLabelValues(agent, "out").Set(rateOutByClient[agent])
+	}
+	for proto, stats := range s.bandwidth.GetBandwidthByProtocol() {
+		protocolBandwidth.WithLabelValues(string(proto), "in").Set(float64(stats.TotalIn))
+		protocolBandwidth.WithLabelValues(string(proto), "out").Set(float64(stats.TotalOut))
+		protocolBandwidthRate.WithLabelValues(string(proto), "in").Set(stats.RateIn)
+		protocolBandwidthRate.WithLabelValues(string(proto), "out").Set(stats.RateOut)
+	}
 }
 
 func average(xs []float64) float64 {
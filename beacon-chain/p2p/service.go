@@ -15,6 +15,7 @@ import (
 	"github.com/libp2p/go-libp2p"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/metrics"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
@@ -77,6 +78,9 @@ type Service struct {
 	subnetsLockLock       sync.Mutex // Lock access to subnetsLock
 	initializationLock    sync.Mutex
 	dv5Listener           Listener
+	enrIndex              *enrIndex
+	bandwidth             *metrics.BandwidthCounter
+	uploadLimiter         *uploadLimiter
 	startupErr            error
 	stateNotifier         statefeed.Notifier
 	ctx                   context.Context
@@ -101,6 +105,9 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		isPreGenesis:  true,
 		joinedTopics:  make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
 		subnetsLock:   make(map[uint64]*sync.RWMutex),
+		enrIndex:      newENRIndex(maxIndexedNodes),
+		bandwidth:     metrics.NewBandwidthCounter(),
+		uploadLimiter: newUploadLimiter(cfg.UploadLimit, cfg.PeerUploadLimit),
 	}
 
 	dv5Nodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
@@ -206,7 +213,9 @@ func (s *Service) Start() {
 			return
 		}
 		s.dv5Listener = listener
+		s.loadENRIndex()
 		go s.listenForNewNodes()
+		async.RunEvery(s.ctx, enrIndexRefreshInterval, s.refreshENRIndex)
 	}
 
 	s.started = true
@@ -231,6 +240,9 @@ func (s *Service) Start() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
 	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	async.RunEvery(s.ctx, 30*time.Minute, func() {
+		s.bandwidth.TrimIdle(time.Now().Add(-bandwidthIdleExpiry))
+	})
 	async.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, s.RefreshENR)
 	async.RunEvery(s.ctx, 1*time.Minute, func() {
@@ -264,6 +276,7 @@ func (s *Service) Stop() error {
 	defer s.cancel()
 	s.started = false
 	if s.dv5Listener != nil {
+		s.saveENRIndex()
 		s.dv5Listener.Close()
 	}
 	return nil
@@ -310,7 +323,12 @@ func (s *Service) Host() host.Host {
 
 // SetStreamHandler sets the protocol handler on the p2p host multiplexer.
 // This method is a pass through to libp2pcore.Host.SetStreamHandler.
+// Responses written by the handler are throttled when upload limits are configured,
+// except for the status, goodbye, ping and metadata protocols.
 func (s *Service) SetStreamHandler(topic string, handler network.StreamHandler) {
+	if s.uploadLimiter != nil && isThrottledTopic(topic) {
+		handler = s.uploadLimiter.wrapHandler(s.ctx, handler)
+	}
 	s.host.SetStreamHandler(protocol.ID(topic), handler)
 }
 
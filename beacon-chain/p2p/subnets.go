@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
@@ -48,18 +49,29 @@ func (s *Service) FindPeersWithSubnet(ctx context.Context, topic string,
 	}
 
 	topic += s.Encoding().ProtocolSuffix()
-	iterator := s.dv5Listener.RandomNodes()
+	var isSync bool
+	var filter func(node *enode.Node) bool
 	switch {
 	case strings.Contains(topic, GossipAttestationMessage):
-		iterator = filterNodes(ctx, iterator, s.filterPeerForAttSubnet(index))
+		filter = s.filterPeerForAttSubnet(index)
 	case strings.Contains(topic, GossipSyncCommitteeMessage):
-		iterator = filterNodes(ctx, iterator, s.filterPeerForSyncSubnet(index))
+		isSync = true
+		filter = s.filterPeerForSyncSubnet(index)
 	default:
 		return false, errors.New("no subnet exists for provided topic")
 	}
 
 	currNum := len(s.pubsub.ListPeers(topic))
 	wg := new(sync.WaitGroup)
+	// Try the nodes already known to be on the subnet before walking the DHT.
+	if currNum < threshold {
+		s.connectWithIndexedSubnetPeers(ctx, topic, isSync, index, threshold, filter)
+		currNum = len(s.pubsub.ListPeers(topic))
+	}
+	iterator := filterNodes(ctx, s.dv5Listener.RandomNodes(), func(node *enode.Node) bool {
+		s.enrIndex.add(node, time.Now())
+		return filter(node)
+	})
 	for {
 		if err := ctx.Err(); err != nil {
 			return false, errors.Errorf("unable to find requisite number of peers for topic %s - "+
@@ -89,6 +101,50 @@ func (s *Service) FindPeersWithSubnet(ctx context.Context, topic string,
 	return true, nil
 }
 
+// connectWithIndexedSubnetPeers dials nodes from the ENR index which advertise the subnet
+// on the current fork digest, until either the number of wanted peers is reached or the
+// index has no more candidates.
+func (s *Service) connectWithIndexedSubnetPeers(
+	ctx context.Context, topic string, isSync bool, index uint64, threshold int, filter func(node *enode.Node) bool,
+) {
+	digest, err := s.currentForkDigest()
+	if err != nil {
+		log.WithError(err).Debug("Could not compute fork digest")
+		return
+	}
+	candidates := s.enrIndex.nodesWithSubnet(digest, isSync, index)
+	if len(candidates) == 0 {
+		return
+	}
+	wg := new(sync.WaitGroup)
+	for i := 0; i < len(candidates); {
+		wanted := threshold - len(s.pubsub.ListPeers(topic))
+		if ctx.Err() != nil || wanted <= 0 {
+			return
+		}
+		dialed := 0
+		for ; i < len(candidates) && dialed < wanted; i++ {
+			if !filter(candidates[i]) {
+				continue
+			}
+			info, _, err := convertToAddrInfo(candidates[i])
+			if err != nil {
+				continue
+			}
+			dialed++
+			wg.Add(1)
+			go func() {
+				if err := s.connectWithPeer(ctx, *info); err != nil {
+					log.WithError(err).Tracef("Could not connect with peer %s", info.String())
+				}
+				wg.Done()
+			}()
+		}
+		wg.Wait()
+		enrIndexSubnetDials.Add(float64(dialed))
+	}
+}
+
 // returns a method with filters peers specifically for a particular attestation subnet.
 func (s *Service) filterPeerForAttSubnet(index uint64) func(node *enode.Node) bool {
 	return func(node *enode.Node) bool {
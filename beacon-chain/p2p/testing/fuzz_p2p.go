@@ -7,6 +7,7 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/control"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/metrics"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
@@ -103,6 +104,11 @@ func (_ *FakeP2P) MetadataSeq() uint64 {
 	return 0
 }
 
+// BandwidthForPeer -- fake.
+func (_ *FakeP2P) BandwidthForPeer(_ peer.ID) metrics.Stats {
+	return metrics.Stats{}
+}
+
 // SetStreamHandler -- fake.
 func (_ *FakeP2P) SetStreamHandler(_ string, _ network.StreamHandler) {
 
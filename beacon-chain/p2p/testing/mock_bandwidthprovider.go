@@ -0,0 +1,16 @@
+package testing
+
+import (
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// MockBandwidthProvider implements BandwidthProvider for testing.
+type MockBandwidthProvider struct {
+	Stats map[peer.ID]metrics.Stats
+}
+
+// BandwidthForPeer returns the configured stats of the peer.
+func (m *MockBandwidthProvider) BandwidthForPeer(pid peer.ID) metrics.Stats {
+	return m.Stats[pid]
+}
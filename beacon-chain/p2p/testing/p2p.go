@@ -14,6 +14,7 @@ import (
 	core "github.com/libp2p/go-libp2p/core"
 	"github.com/libp2p/go-libp2p/core/control"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/metrics"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
@@ -371,6 +372,11 @@ func (p *TestP2P) MetadataSeq() uint64 {
 	return p.LocalMetadata.SequenceNumber()
 }
 
+// BandwidthForPeer mocks the p2p func.
+func (_ *TestP2P) BandwidthForPeer(_ peer.ID) metrics.Stats {
+	return metrics.Stats{}
+}
+
 // AddPingMethod mocks the p2p func.
 func (_ *TestP2P) AddPingMethod(_ func(ctx context.Context, id peer.ID) error) {
 	// no-op
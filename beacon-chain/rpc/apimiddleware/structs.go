@@ -569,11 +569,13 @@ type MetadataJson struct {
 }
 
 type PeerJson struct {
-	PeerId    string `json:"peer_id"`
-	Enr       string `json:"enr"`
-	Address   string `json:"last_seen_p2p_address"`
-	State     string `json:"state" enum:"true"`
-	Direction string `json:"direction" enum:"true"`
+	PeerId        string `json:"peer_id"`
+	Enr           string `json:"enr"`
+	Address       string `json:"last_seen_p2p_address"`
+	State         string `json:"state" enum:"true"`
+	Direction     string `json:"direction" enum:"true"`
+	BytesReceived string `json:"bytes_received"`
+	BytesSent     string `json:"bytes_sent"`
 }
 
 type VersionJson struct {
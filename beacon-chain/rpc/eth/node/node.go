@@ -121,6 +121,7 @@ func (ns *Server) GetPeer(ctx context.Context, req *ethpb.PeerRequest) (*ethpb.P
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not handle peer direction: %v", err)
 	}
+	bandwidth := ns.BandwidthProvider.BandwidthForPeer(id)
 	return &ethpb.PeerResponse{
 		Data: &ethpb.Peer{
 			PeerId:             req.PeerId,
@@ -128,6 +129,8 @@ func (ns *Server) GetPeer(ctx context.Context, req *ethpb.PeerRequest) (*ethpb.P
 			LastSeenP2PAddress: p2pAddress.String(),
 			State:              v1ConnState,
 			Direction:          v1PeerDirection,
+			BytesReceived:      uint64(bandwidth.TotalIn),
+			BytesSent:          uint64(bandwidth.TotalOut),
 		},
 	}, nil
 }
@@ -144,7 +147,7 @@ func (ns *Server) ListPeers(ctx context.Context, req *ethpb.PeersRequest) (*ethp
 		allIds := peerStatus.All()
 		allPeers := make([]*ethpb.Peer, 0, len(allIds))
 		for _, id := range allIds {
-			p, err := peerInfo(peerStatus, id)
+			p, err := peerInfo(peerStatus, ns.BandwidthProvider, id)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "Could not get peer info: %v", err)
 			}
@@ -215,7 +218,7 @@ func (ns *Server) ListPeers(ctx context.Context, req *ethpb.PeersRequest) (*ethp
 	}
 	filteredPeers := make([]*ethpb.Peer, 0, len(filteredIds))
 	for _, id := range filteredIds {
-		p, err := peerInfo(peerStatus, id)
+		p, err := peerInfo(peerStatus, ns.BandwidthProvider, id)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not get peer info: %v", err)
 		}
@@ -331,7 +334,7 @@ func handleEmptyFilters(req *ethpb.PeersRequest) (emptyState, emptyDirection boo
 	return emptyState, emptyDirection
 }
 
-func peerInfo(peerStatus *peers.Status, id peer.ID) (*ethpb.Peer, error) {
+func peerInfo(peerStatus *peers.Status, bandwidthProvider p2p.BandwidthProvider, id peer.ID) (*ethpb.Peer, error) {
 	enr, err := peerStatus.ENR(id)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not obtain ENR")
@@ -363,10 +366,13 @@ func peerInfo(peerStatus *peers.Status, id peer.ID) (*ethpb.Peer, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not handle peer direction")
 	}
+	bandwidth := bandwidthProvider.BandwidthForPeer(id)
 	p := ethpb.Peer{
-		PeerId:    id.Pretty(),
-		State:     v1ConnState,
-		Direction: v1PeerDirection,
+		PeerId:        id.Pretty(),
+		State:         v1ConnState,
+		Direction:     v1PeerDirection,
+		BytesReceived: uint64(bandwidth.TotalIn),
+		BytesSent:     uint64(bandwidth.TotalOut),
 	}
 	if address != nil {
 		p.LastSeenP2PAddress = address.String()
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/libp2p/go-libp2p/core/metrics"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
@@ -200,7 +201,10 @@ func TestGetPeer(t *testing.T) {
 	p2pMultiAddr, err := ma.NewMultiaddr(p2pAddr)
 	require.NoError(t, err)
 	peerFetcher := &mockp2p.MockPeersProvider{}
-	s := Server{PeersFetcher: peerFetcher}
+	bandwidthProvider := &mockp2p.MockBandwidthProvider{Stats: map[peer.ID]metrics.Stats{
+		decodedId: {TotalIn: 1024, TotalOut: 2048},
+	}}
+	s := Server{PeersFetcher: peerFetcher, BandwidthProvider: bandwidthProvider}
 	peerFetcher.Peers().Add(enrRecord, decodedId, p2pMultiAddr, network.DirInbound)
 
 	t.Run("OK", func(t *testing.T) {
@@ -211,6 +215,8 @@ func TestGetPeer(t *testing.T) {
 		assert.Equal(t, "enr:yoABgmlwhAcHBwc=", resp.Data.Enr)
 		assert.Equal(t, ethpb.ConnectionState_DISCONNECTED, resp.Data.State)
 		assert.Equal(t, ethpb.PeerDirection_INBOUND, resp.Data.Direction)
+		assert.Equal(t, uint64(1024), resp.Data.BytesReceived)
+		assert.Equal(t, uint64(2048), resp.Data.BytesSent)
 	})
 
 	t.Run("Invalid ID", func(t *testing.T) {
@@ -269,7 +275,10 @@ func TestListPeers(t *testing.T) {
 		}
 	}
 
-	s := Server{PeersFetcher: peerFetcher}
+	bandwidthProvider := &mockp2p.MockBandwidthProvider{Stats: map[peer.ID]metrics.Stats{
+		ids[0]: {TotalIn: 10, TotalOut: 20},
+	}}
+	s := Server{PeersFetcher: peerFetcher, BandwidthProvider: bandwidthProvider}
 
 	t.Run("Peer data OK", func(t *testing.T) {
 		// We will check the first peer from the list.
@@ -293,6 +302,8 @@ func TestListPeers(t *testing.T) {
 		assert.Equal(t, expectedP2PAddr.String(), returnedPeer.LastSeenP2PAddress)
 		assert.Equal(t, ethpb.ConnectionState_CONNECTING, returnedPeer.State)
 		assert.Equal(t, ethpb.PeerDirection_INBOUND, returnedPeer.Direction)
+		assert.Equal(t, uint64(10), returnedPeer.BytesReceived)
+		assert.Equal(t, uint64(20), returnedPeer.BytesSent)
 	})
 
 	filterTests := []struct {
@@ -372,7 +383,7 @@ func TestListPeers(t *testing.T) {
 func TestListPeers_NoPeersReturnsEmptyArray(t *testing.T) {
 	peerFetcher := &mockp2p.MockPeersProvider{}
 	peerFetcher.ClearPeers()
-	s := Server{PeersFetcher: peerFetcher}
+	s := Server{PeersFetcher: peerFetcher, BandwidthProvider: &mockp2p.MockBandwidthProvider{}}
 
 	resp, err := s.ListPeers(context.Background(), &ethpb.PeersRequest{
 		State: []ethpb.ConnectionState{ethpb.ConnectionState_CONNECTED},
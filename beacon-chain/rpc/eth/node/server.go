@@ -21,6 +21,7 @@ type Server struct {
 	BeaconDB              db.ReadOnlyDatabase
 	PeersFetcher          p2p.PeersProvider
 	PeerManager           p2p.PeerManager
+	BandwidthProvider     p2p.BandwidthProvider
 	MetadataProvider      p2p.MetadataProvider
 	GenesisTimeFetcher    blockchain.TimeFetcher
 	HeadFetcher           blockchain.HeadFetcher
@@ -8,6 +8,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v3/api/pagination"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/filters"
@@ -241,6 +242,9 @@ func (bs *Server) StreamIndexedAttestations(
 	attestationsChannel := make(chan *feed.Event, 1)
 	attSub := bs.AttestationNotifier.OperationFeed().Subscribe(attestationsChannel)
 	defer attSub.Unsubscribe()
+	blocksChannel := make(chan *feed.Event, 1)
+	blockSub := bs.BlockNotifier.BlockFeed().Subscribe(blocksChannel)
+	defer blockSub.Unsubscribe()
 	go bs.collectReceivedAttestations(stream.Context())
 	for {
 		select {
@@ -276,6 +280,28 @@ func (bs *Server) StreamIndexedAttestations(
 				}
 				bs.ReceivedAttestationsBuffer <- data.Attestation.Aggregate
 			}
+		case blockEvent := <-blocksChannel:
+			if blockEvent.Type != blockfeed.ReceivedBlock {
+				continue
+			}
+			data, ok := blockEvent.Data.(*blockfeed.ReceivedBlockData)
+			if !ok || data.SignedBlock == nil || data.SignedBlock.IsNil() {
+				continue
+			}
+			// Attestations included in blocks are sent as they are, grouped by target.
+			attsByTarget := make(map[[32]byte][]*ethpb.Attestation)
+			for _, att := range data.SignedBlock.Block().Body().Attestations() {
+				targetRoot := bytesutil.ToBytes32(att.Data.Target.Root)
+				attsByTarget[targetRoot] = append(attsByTarget[targetRoot], att)
+			}
+			for _, atts := range attsByTarget {
+				if err := bs.sendIndexedAttestations(stream, atts); err != nil {
+					if status.Code(err) == codes.Unavailable {
+						return err
+					}
+					log.WithError(err).Debug("Could not send attestations included in block")
+				}
+			}
 		case aggAtts, ok := <-bs.CollectedAttestationsBuffer:
 			if !ok {
 				log.Error("Indexed attestations stream collected attestations channel closed")
@@ -284,49 +310,8 @@ func (bs *Server) StreamIndexedAttestations(
 			if len(aggAtts) == 0 {
 				continue
 			}
-			// All attestations we receive have the same target epoch given they
-			// have the same data root, so we just use the target epoch from
-			// the first one to determine committees for converting into indexed
-			// form.
-			targetRoot := aggAtts[0].Data.Target.Root
-			targetEpoch := aggAtts[0].Data.Target.Epoch
-			committeesBySlot, _, err := bs.retrieveCommitteesForRoot(stream.Context(), targetRoot)
-			if err != nil {
-				return status.Errorf(
-					codes.Internal,
-					"Could not retrieve committees for target root %#x: %v",
-					targetRoot,
-					err,
-				)
-			}
-			// We use the retrieved committees for the epoch to convert all attestations
-			// into indexed form effectively.
-			startSlot, err := slots.EpochStart(targetEpoch)
-			if err != nil {
-				log.Error(err)
-				continue
-			}
-			endSlot := startSlot + params.BeaconConfig().SlotsPerEpoch
-			for _, att := range aggAtts {
-				// Out of range check, the attestation slot cannot be greater
-				// the last slot of the requested epoch or smaller than its start slot
-				// given committees are accessed as a map of slot -> commitees list, where there are
-				// SLOTS_PER_EPOCH keys in the map.
-				if att.Data.Slot < startSlot || att.Data.Slot > endSlot {
-					continue
-				}
-				committeesForSlot, ok := committeesBySlot[att.Data.Slot]
-				if !ok || committeesForSlot.Committees == nil {
-					continue
-				}
-				committee := committeesForSlot.Committees[att.Data.CommitteeIndex]
-				idxAtt, err := attestation.ConvertToIndexed(stream.Context(), att, committee.ValidatorIndices)
-				if err != nil {
-					continue
-				}
-				if err := stream.Send(idxAtt); err != nil {
-					return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
-				}
+			if err := bs.sendIndexedAttestations(stream, aggAtts); err != nil {
+				return err
 			}
 		case <-bs.Ctx.Done():
 			return status.Error(codes.Canceled, "Context canceled")
@@ -336,6 +321,56 @@ func (bs *Server) StreamIndexedAttestations(
 	}
 }
 
+// sendIndexedAttestations converts attestations sharing the same target into indexed form
+// and sends them over the stream.
+func (bs *Server) sendIndexedAttestations(stream ethpb.BeaconChain_StreamIndexedAttestationsServer, atts []*ethpb.Attestation) error {
+	// All attestations we receive have the same target epoch given they
+	// have the same data root, so we just use the target epoch from
+	// the first one to determine committees for converting into indexed
+	// form.
+	targetRoot := atts[0].Data.Target.Root
+	targetEpoch := atts[0].Data.Target.Epoch
+	committeesBySlot, _, err := bs.retrieveCommitteesForRoot(stream.Context(), targetRoot)
+	if err != nil {
+		return status.Errorf(
+			codes.Internal,
+			"Could not retrieve committees for target root %#x: %v",
+			targetRoot,
+			err,
+		)
+	}
+	// We use the retrieved committees for the epoch to convert all attestations
+	// into indexed form effectively.
+	startSlot, err := slots.EpochStart(targetEpoch)
+	if err != nil {
+		log.Error(err)
+		return nil
+	}
+	endSlot := startSlot + params.BeaconConfig().SlotsPerEpoch
+	for _, att := range atts {
+		// Out of range check, the attestation slot cannot be greater
+		// the last slot of the requested epoch or smaller than its start slot
+		// given committees are accessed as a map of slot -> commitees list, where there are
+		// SLOTS_PER_EPOCH keys in the map.
+		if att.Data.Slot < startSlot || att.Data.Slot > endSlot {
+			continue
+		}
+		committeesForSlot, ok := committeesBySlot[att.Data.Slot]
+		if !ok || committeesForSlot.Committees == nil {
+			continue
+		}
+		committee := committeesForSlot.Committees[att.Data.CommitteeIndex]
+		idxAtt, err := attestation.ConvertToIndexed(stream.Context(), att, committee.ValidatorIndices)
+		if err != nil {
+			continue
+		}
+		if err := stream.Send(idxAtt); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+	}
+	return nil
+}
+
 // already being done by the attestation pool in the operations service.
 func (bs *Server) collectReceivedAttestations(ctx context.Context) {
 	attsByRoot := make(map[[32]byte][]*ethpb.Attestation)
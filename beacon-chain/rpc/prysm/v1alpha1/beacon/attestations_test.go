@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	chainMock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
@@ -832,6 +833,7 @@ func TestServer_StreamIndexedAttestations_ContextCanceled(t *testing.T) {
 	server := &Server{
 		Ctx:                 ctx,
 		AttestationNotifier: chainService.OperationNotifier(),
+		BlockNotifier:       chainService.BlockNotifier(),
 		GenesisTimeFetcher: &chainMock.ChainService{
 			Genesis: time.Now(),
 		},
@@ -940,6 +942,7 @@ func TestServer_StreamIndexedAttestations_OK(t *testing.T) {
 			Genesis: time.Now(),
 		},
 		AttestationNotifier:         chainService.OperationNotifier(),
+		BlockNotifier:               chainService.BlockNotifier(),
 		CollectedAttestationsBuffer: make(chan []*ethpb.Attestation, 1),
 		StateGen:                    stategen.New(db, doublylinkedtree.New()),
 	}
@@ -994,6 +997,78 @@ func TestServer_StreamIndexedAttestations_OK(t *testing.T) {
 	<-exitRoutine
 }
 
+func TestServer_StreamIndexedAttestations_BlockAttestations(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.BeaconConfig())
+	db := dbTest.SetupDB(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	headState, _ := util.DeterministicGenesisState(t, 64)
+	b := util.NewBeaconBlock()
+	util.SaveBlock(t, ctx, db, b)
+	gRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
+	require.NoError(t, db.SaveState(ctx, headState, gRoot))
+	activeIndices, err := helpers.ActiveValidatorIndices(ctx, headState, 0)
+	require.NoError(t, err)
+	attesterSeed, err := helpers.Seed(headState, 0, params.BeaconConfig().DomainBeaconAttester)
+	require.NoError(t, err)
+	committees, err := computeCommittees(ctx, 0, activeIndices, attesterSeed)
+	require.NoError(t, err)
+	committee := committees[1].Committees[0].ValidatorIndices
+
+	att := util.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: bitfield.NewBitlist(uint64(len(committee))),
+		Data: &ethpb.AttestationData{
+			Slot:   1,
+			Source: &ethpb.Checkpoint{Root: gRoot[:]},
+			Target: &ethpb.Checkpoint{Root: gRoot[:]},
+		},
+	})
+	att.AggregationBits.SetBitAt(0, true)
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 2
+	blk.Block.Body.Attestations = []*ethpb.Attestation{att}
+	wsb, err := consensusblocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	idxAtt, err := attestation.ConvertToIndexed(ctx, att, committee)
+	require.NoError(t, err)
+
+	chainService := &chainMock.ChainService{}
+	server := &Server{
+		BeaconDB:            db,
+		Ctx:                 ctx,
+		HeadFetcher:         &chainMock.ChainService{State: headState},
+		GenesisTimeFetcher:  &chainMock.ChainService{Genesis: time.Now()},
+		AttestationNotifier: chainService.OperationNotifier(),
+		BlockNotifier:       chainService.BlockNotifier(),
+		StateGen:            stategen.New(db, doublylinkedtree.New()),
+	}
+
+	exitRoutine := make(chan bool)
+	mockStream := mock.NewMockBeaconChain_StreamIndexedAttestationsServer(ctrl)
+	mockStream.EXPECT().Send(idxAtt).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	go func(tt *testing.T) {
+		assert.ErrorContains(tt, "Context canceled", server.StreamIndexedAttestations(&emptypb.Empty{}, mockStream))
+	}(t)
+
+	// Attestations included in blocks are streamed without waiting for the aggregation.
+	for sent := 0; sent == 0; {
+		sent = server.BlockNotifier.BlockFeed().Send(&feed.Event{
+			Type: blockfeed.ReceivedBlock,
+			Data: &blockfeed.ReceivedBlockData{SignedBlock: wsb},
+		})
+	}
+	<-exitRoutine
+}
+
 func TestServer_StreamAttestations_ContextCanceled(t *testing.T) {
 	ctx := context.Background()
 
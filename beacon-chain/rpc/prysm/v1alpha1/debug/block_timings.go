@@ -0,0 +1,68 @@
+package debug
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
+	pbrpc "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlockTimings returns the time spent by the most recent blocks in each phase of their import,
+// most recent first and at most req.Limit of them when set, along with a summary of each phase
+// over all the blocks kept.
+func (ds *Server) GetBlockTimings(_ context.Context, req *pbrpc.BlockTimingsRequest) (*pbrpc.BlockTimingsResponse, error) {
+	if ds.BlockTimings == nil {
+		return nil, status.Error(codes.Unavailable, "Block timings are not recorded")
+	}
+	timings := ds.BlockTimings.RecentBlockTimings(int(req.Limit))
+	resp := &pbrpc.BlockTimingsResponse{
+		Blocks: make([]*pbrpc.BlockTiming, len(timings)),
+	}
+	for i, t := range timings {
+		root := t.BlockRoot
+		bt := &pbrpc.BlockTiming{
+			Slot:      t.Slot,
+			BlockRoot: root[:],
+		}
+		for _, p := range cache.BlockPhases() {
+			d, ok := t.Durations[p]
+			if !ok {
+				continue
+			}
+			bt.Phases = append(bt.Phases, &pbrpc.BlockPhaseDuration{
+				Phase:      p.String(),
+				DurationMs: milliseconds(d),
+			})
+		}
+		resp.Blocks[i] = bt
+	}
+	for _, sum := range ds.BlockTimings.Summarize() {
+		bounds := make([]float64, len(sum.BucketBounds))
+		for i, b := range sum.BucketBounds {
+			bounds[i] = milliseconds(b)
+		}
+		buckets := make([]uint64, len(sum.Buckets))
+		for i, b := range sum.Buckets {
+			buckets[i] = uint64(b)
+		}
+		resp.Summary = append(resp.Summary, &pbrpc.BlockPhaseSummary{
+			Phase:          sum.Phase.String(),
+			Count:          uint64(sum.Count),
+			MeanMs:         milliseconds(sum.Mean),
+			P50Ms:          milliseconds(sum.P50),
+			P90Ms:          milliseconds(sum.P90),
+			P99Ms:          milliseconds(sum.P99),
+			MaxMs:          milliseconds(sum.Max),
+			BucketBoundsMs: bounds,
+			Buckets:        buckets,
+		})
+	}
+	return resp, nil
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
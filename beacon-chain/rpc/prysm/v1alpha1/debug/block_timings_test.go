@@ -0,0 +1,43 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
+	pbrpc "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestServer_GetBlockTimings(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{}
+	_, err := ds.GetBlockTimings(ctx, &pbrpc.BlockTimingsRequest{})
+	assert.ErrorContains(t, "Block timings are not recorded", err)
+
+	timings := cache.NewBlockTimingsCache(cache.BlockTimingsSize)
+	ds.BlockTimings = timings
+	timings.RecordBlockPhase([32]byte{'a'}, 1, cache.ArrivalPhase, 1500*time.Millisecond)
+	timings.RecordBlockPhase([32]byte{'b'}, 2, cache.ArrivalPhase, 500*time.Millisecond)
+	timings.RecordBlockPhase([32]byte{'b'}, 2, cache.StateTransitionPhase, 30*time.Millisecond)
+
+	resp, err := ds.GetBlockTimings(ctx, &pbrpc.BlockTimingsRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Blocks))
+	root := [32]byte{'b'}
+	assert.DeepEqual(t, root[:], resp.Blocks[0].BlockRoot)
+	assert.DeepEqual(t, []*pbrpc.BlockPhaseDuration{
+		{Phase: "arrival", DurationMs: 500},
+		{Phase: "state_transition", DurationMs: 30},
+	}, resp.Blocks[0].Phases)
+
+	require.Equal(t, len(cache.BlockPhases()), len(resp.Summary))
+	arrival := resp.Summary[cache.ArrivalPhase]
+	assert.Equal(t, "arrival", arrival.Phase)
+	assert.Equal(t, uint64(2), arrival.Count)
+	assert.Equal(t, float64(1000), arrival.MeanMs)
+	assert.Equal(t, float64(1500), arrival.MaxMs)
+	assert.Equal(t, len(arrival.BucketBoundsMs)+1, len(arrival.Buckets))
+}
@@ -0,0 +1,68 @@
+package debug
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+
+	pbrpc "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetENRIndex returns the number of node records seen through discovery per fork digest and
+// subnet, along with the records themselves, most recently seen first, if requested.
+func (ds *Server) GetENRIndex(_ context.Context, req *pbrpc.ENRIndexRequest) (*pbrpc.ENRIndexResponse, error) {
+	if ds.ENRIndexProvider == nil {
+		return nil, status.Error(codes.Unavailable, "ENR index is not available")
+	}
+	summary := ds.ENRIndexProvider.ENRIndex(req.IncludeRecords)
+	resp := &pbrpc.ENRIndexResponse{
+		Total: uint64(summary.Total),
+	}
+	digests := make([]string, 0, len(summary.Digests))
+	for digest := range summary.Digests {
+		digests = append(digests, digest)
+	}
+	sort.Strings(digests)
+	for _, digest := range digests {
+		forkDigest, err := hex.DecodeString(digest)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not decode fork digest %s: %v", digest, err)
+		}
+		d := summary.Digests[digest]
+		resp.Digests = append(resp.Digests, &pbrpc.ENRIndexDigest{
+			ForkDigest: forkDigest,
+			Nodes:      uint64(d.Nodes),
+			Attnets:    subnetCounts(d.Attnets),
+			Syncnets:   subnetCounts(d.Syncnets),
+		})
+	}
+	for _, e := range summary.Entries {
+		forkDigest, err := hex.DecodeString(e.ForkDigest)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not decode fork digest %s: %v", e.ForkDigest, err)
+		}
+		resp.Records = append(resp.Records, &pbrpc.ENRIndexRecord{
+			Enr:        e.ENR,
+			NodeId:     e.NodeID,
+			ForkDigest: forkDigest,
+			Attnets:    e.Attnets,
+			Syncnets:   e.Syncnets,
+			LastSeen:   timestamppb.New(e.LastSeen),
+		})
+	}
+	return resp, nil
+}
+
+func subnetCounts(counts map[uint64]int) []*pbrpc.ENRIndexSubnet {
+	subnets := make([]*pbrpc.ENRIndexSubnet, 0, len(counts))
+	for subnet, nodes := range counts {
+		subnets = append(subnets, &pbrpc.ENRIndexSubnet{Subnet: subnet, Nodes: uint64(nodes)})
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i].Subnet < subnets[j].Subnet
+	})
+	return subnets
+}
@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
+	pbrpc "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+type mockENRIndexProvider struct {
+	summary *p2p.ENRIndexSummary
+}
+
+func (m *mockENRIndexProvider) ENRIndex(withEntries bool) *p2p.ENRIndexSummary {
+	s := *m.summary
+	if !withEntries {
+		s.Entries = nil
+	}
+	return &s
+}
+
+func TestServer_GetENRIndex(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{}
+	_, err := ds.GetENRIndex(ctx, &pbrpc.ENRIndexRequest{})
+	assert.ErrorContains(t, "ENR index is not available", err)
+
+	lastSeen := time.Unix(1000, 0)
+	ds.ENRIndexProvider = &mockENRIndexProvider{summary: &p2p.ENRIndexSummary{
+		Total: 2,
+		Digests: map[string]*p2p.ENRIndexDigestSummary{
+			"b5303f2a": {Nodes: 1, Attnets: map[uint64]int{3: 1}, Syncnets: map[uint64]int{}},
+			"4a26c58b": {Nodes: 1, Attnets: map[uint64]int{7: 1, 1: 1}, Syncnets: map[uint64]int{2: 1}},
+		},
+		Entries: []*p2p.ENRIndexEntry{{
+			ENR:        "enr:-abc",
+			NodeID:     "01",
+			ForkDigest: "4a26c58b",
+			Attnets:    []uint64{1, 7},
+			Syncnets:   []uint64{2},
+			LastSeen:   lastSeen,
+		}},
+	}}
+
+	resp, err := ds.GetENRIndex(ctx, &pbrpc.ENRIndexRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), resp.Total)
+	assert.Equal(t, 0, len(resp.Records))
+	require.Equal(t, 2, len(resp.Digests))
+	assert.DeepEqual(t, []byte{0x4a, 0x26, 0xc5, 0x8b}, resp.Digests[0].ForkDigest)
+	assert.DeepEqual(t, []*pbrpc.ENRIndexSubnet{{Subnet: 1, Nodes: 1}, {Subnet: 7, Nodes: 1}}, resp.Digests[0].Attnets)
+	assert.DeepEqual(t, []*pbrpc.ENRIndexSubnet{{Subnet: 2, Nodes: 1}}, resp.Digests[0].Syncnets)
+	assert.DeepEqual(t, []byte{0xb5, 0x30, 0x3f, 0x2a}, resp.Digests[1].ForkDigest)
+
+	resp, err = ds.GetENRIndex(ctx, &pbrpc.ENRIndexRequest{IncludeRecords: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Records))
+	assert.Equal(t, "enr:-abc", resp.Records[0].Enr)
+	assert.DeepEqual(t, []byte{0x4a, 0x26, 0xc5, 0x8b}, resp.Records[0].ForkDigest)
+	assert.DeepEqual(t, []uint64{1, 7}, resp.Records[0].Attnets)
+	assert.Equal(t, lastSeen.Unix(), resp.Records[0].LastSeen.AsTime().Unix())
+}
@@ -0,0 +1,27 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/graph"
+	pbrpc "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetForkChoiceGraph returns the forkchoice block tree as a graph, with the weight of each block,
+// the balance voting for it, its optimistic status and whether it is the head, a justified or
+// finalized checkpoint or has the proposer boost, along with the graph in the Graphviz DOT language.
+func (ds *Server) GetForkChoiceGraph(ctx context.Context, _ *empty.Empty) (*pbrpc.ForkChoiceGraph, error) {
+	if ds.ForkFetcher == nil || ds.ForkFetcher.ForkChoicer() == nil {
+		return nil, status.Error(codes.Unavailable, "Forkchoice is not available")
+	}
+	dump, err := ds.ForkFetcher.ForkChoicer().ForkChoiceDump(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not dump forkchoice: %v", err)
+	}
+	g := graph.New(dump)
+	g.Dot = graph.DOT(g)
+	return g, nil
+}
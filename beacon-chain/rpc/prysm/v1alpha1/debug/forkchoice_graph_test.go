@@ -0,0 +1,33 @@
+package debug
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/types"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestServer_GetForkChoiceGraph(t *testing.T) {
+	_, err := (&Server{}).GetForkChoiceGraph(context.Background(), &empty.Empty{})
+	require.ErrorContains(t, "Forkchoice is not available", err)
+
+	store := doublylinkedtree.New()
+	fRoot := [32]byte{'a'}
+	jRoot := [32]byte{'b'}
+	require.NoError(t, store.UpdateFinalizedCheckpoint(&forkchoicetypes.Checkpoint{Epoch: 2, Root: fRoot}))
+	require.NoError(t, store.UpdateJustifiedCheckpoint(&forkchoicetypes.Checkpoint{Epoch: 3, Root: jRoot}))
+	ds := &Server{ForkFetcher: &mock.ChainService{ForkChoiceStore: store}}
+	g, err := ds.GetForkChoiceGraph(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(3), g.JustifiedCheckpoint.Epoch)
+	assert.DeepEqual(t, jRoot[:], g.JustifiedCheckpoint.Root)
+	assert.Equal(t, types.Epoch(2), g.FinalizedCheckpoint.Epoch)
+	assert.Equal(t, true, strings.HasPrefix(g.Dot, "digraph"))
+}
@@ -0,0 +1,82 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	pbrpc "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListOptimisticBlocks returns the blocks whose execution payloads have not been validated yet.
+func (ds *Server) ListOptimisticBlocks(_ context.Context, _ *empty.Empty) (*pbrpc.OptimisticBlocksResponse, error) {
+	blocks := ds.OptimisticBlocksManager.OptimisticBlocks()
+	resp := &pbrpc.OptimisticBlocksResponse{Blocks: make([]*pbrpc.OptimisticBlock, len(blocks))}
+	for i, b := range blocks {
+		resp.Blocks[i] = optimisticBlockProto(b, "")
+	}
+	return resp, nil
+}
+
+// RevalidateOptimisticBlocks sends the payloads of the optimistic blocks within the requested
+// slot range to the execution client again, pruning the blocks found invalid.
+func (ds *Server) RevalidateOptimisticBlocks(
+	ctx context.Context,
+	req *pbrpc.RevalidateOptimisticBlocksRequest,
+) (*pbrpc.OptimisticBlocksResponse, error) {
+	endSlot := req.EndSlot
+	if endSlot == 0 {
+		endSlot = ds.GenesisTimeFetcher.CurrentSlot()
+	}
+	if req.StartSlot > endSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "Start slot %d is after end slot %d", req.StartSlot, endSlot)
+	}
+	results, err := ds.OptimisticBlocksManager.RevalidateOptimisticBlocks(ctx, req.StartSlot, endSlot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not revalidate optimistic blocks: %v", err)
+	}
+	resp := &pbrpc.OptimisticBlocksResponse{Blocks: make([]*pbrpc.OptimisticBlock, len(results))}
+	for i, res := range results {
+		resp.Blocks[i] = optimisticBlockProto(res.Block, res.Status)
+	}
+	return resp, nil
+}
+
+// InvalidatePayload marks the optimistic block with the requested payload hash invalid,
+// pruning it along with its descendants.
+func (ds *Server) InvalidatePayload(
+	ctx context.Context,
+	req *pbrpc.InvalidatePayloadRequest,
+) (*pbrpc.InvalidatePayloadResponse, error) {
+	if len(req.PayloadHash) != fieldparams.RootLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Payload hash must be %d bytes long", fieldparams.RootLength)
+	}
+	invalidRoots, err := ds.OptimisticBlocksManager.InvalidatePayload(ctx, bytesutil.ToBytes32(req.PayloadHash))
+	if errors.Is(err, blockchain.ErrUnknownOptimisticPayload) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not invalidate payload: %v", err)
+	}
+	resp := &pbrpc.InvalidatePayloadResponse{InvalidatedRoots: make([][]byte, len(invalidRoots))}
+	for i := range invalidRoots {
+		resp.InvalidatedRoots[i] = bytesutil.SafeCopyBytes(invalidRoots[i][:])
+	}
+	return resp, nil
+}
+
+func optimisticBlockProto(b *forkchoicetypes.OptimisticBlock, revalidationStatus string) *pbrpc.OptimisticBlock {
+	return &pbrpc.OptimisticBlock{
+		Slot:        b.Slot,
+		BlockRoot:   bytesutil.SafeCopyBytes(b.Root[:]),
+		ParentRoot:  bytesutil.SafeCopyBytes(b.ParentRoot[:]),
+		PayloadHash: bytesutil.SafeCopyBytes(b.PayloadHash[:]),
+		Status:      revalidationStatus,
+	}
+}
@@ -0,0 +1,78 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
+	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/types"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	pbrpc "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+type mockOptimisticBlocksManager struct {
+	blocks             []*forkchoicetypes.OptimisticBlock
+	startSlot, endSlot types.Slot
+}
+
+func (m *mockOptimisticBlocksManager) OptimisticBlocks() []*forkchoicetypes.OptimisticBlock {
+	return m.blocks
+}
+
+func (m *mockOptimisticBlocksManager) RevalidateOptimisticBlocks(_ context.Context, startSlot, endSlot types.Slot) ([]*blockchain.RevalidationResult, error) {
+	m.startSlot, m.endSlot = startSlot, endSlot
+	results := make([]*blockchain.RevalidationResult, 0)
+	for _, b := range m.blocks {
+		results = append(results, &blockchain.RevalidationResult{Block: b, Status: blockchain.RevalidationValid})
+	}
+	return results, nil
+}
+
+func (m *mockOptimisticBlocksManager) InvalidatePayload(_ context.Context, payloadHash [32]byte) ([][32]byte, error) {
+	for _, b := range m.blocks {
+		if b.PayloadHash == payloadHash {
+			return [][32]byte{b.Root}, nil
+		}
+	}
+	return nil, blockchain.ErrUnknownOptimisticPayload
+}
+
+func TestServer_OptimisticBlocks(t *testing.T) {
+	ctx := context.Background()
+	currentSlot := types.Slot(10)
+	manager := &mockOptimisticBlocksManager{blocks: []*forkchoicetypes.OptimisticBlock{
+		{Slot: 3, Root: [32]byte{'a'}, ParentRoot: [32]byte{'b'}, PayloadHash: [32]byte{'c'}},
+	}}
+	ds := &Server{
+		GenesisTimeFetcher:      &mock.ChainService{Slot: &currentSlot},
+		OptimisticBlocksManager: manager,
+	}
+
+	res, err := ds.ListOptimisticBlocks(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Blocks))
+	assert.Equal(t, types.Slot(3), res.Blocks[0].Slot)
+	assert.DeepEqual(t, []byte{'a', 31: 0}, res.Blocks[0].BlockRoot)
+	assert.DeepEqual(t, []byte{'c', 31: 0}, res.Blocks[0].PayloadHash)
+
+	_, err = ds.RevalidateOptimisticBlocks(ctx, &pbrpc.RevalidateOptimisticBlocksRequest{StartSlot: 11})
+	assert.ErrorContains(t, "Start slot 11 is after end slot 10", err)
+	// The end slot defaults to the current slot.
+	res, err = ds.RevalidateOptimisticBlocks(ctx, &pbrpc.RevalidateOptimisticBlocksRequest{StartSlot: 1})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(10), manager.endSlot)
+	require.Equal(t, 1, len(res.Blocks))
+	assert.Equal(t, blockchain.RevalidationValid, res.Blocks[0].Status)
+
+	_, err = ds.InvalidatePayload(ctx, &pbrpc.InvalidatePayloadRequest{PayloadHash: []byte{'c'}})
+	assert.ErrorContains(t, "Payload hash must be 32 bytes long", err)
+	_, err = ds.InvalidatePayload(ctx, &pbrpc.InvalidatePayloadRequest{PayloadHash: make([]byte, 32)})
+	assert.ErrorContains(t, blockchain.ErrUnknownOptimisticPayload.Error(), err)
+	invalidated, err := ds.InvalidatePayload(ctx, &pbrpc.InvalidatePayloadRequest{PayloadHash: []byte{'c', 31: 0}})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{{'a', 31: 0}}, invalidated.InvalidatedRoots)
+}
@@ -145,6 +145,7 @@ func (ds *Server) getPeer(pid peer.ID) (*ethpb.DebugPeerResponse, error) {
 		BehaviourPenalty:   float32(bPenalty),
 		ValidationError:    errorToString(peers.Scorers().ValidationError(pid)),
 	}
+	bandwidth := ds.BandwidthProvider.BandwidthForPeer(pid)
 	return &ethpb.DebugPeerResponse{
 		ListeningAddresses: stringAddrs,
 		Direction:          pbDirection,
@@ -155,6 +156,8 @@ func (ds *Server) getPeer(pid peer.ID) (*ethpb.DebugPeerResponse, error) {
 		PeerStatus:         pStatus,
 		LastUpdated:        unixTime,
 		ScoreInfo:          scoreInfo,
+		BytesReceived:      uint64(bandwidth.TotalIn),
+		BytesSent:          uint64(bandwidth.TotalOut),
 	}, nil
 }
 
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
 	mockP2p "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/testing"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
@@ -14,11 +16,14 @@ import (
 func TestDebugServer_GetPeer(t *testing.T) {
 	peersProvider := &mockP2p.MockPeersProvider{}
 	mP2P := mockP2p.NewTestP2P(t)
+	firstPeer := peersProvider.Peers().All()[0]
 	ds := &Server{
 		PeersFetcher: peersProvider,
 		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost},
+		BandwidthProvider: &mockP2p.MockBandwidthProvider{Stats: map[peer.ID]metrics.Stats{
+			firstPeer: {TotalIn: 1024, TotalOut: 2048},
+		}},
 	}
-	firstPeer := peersProvider.Peers().All()[0]
 
 	res, err := ds.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
 	require.NoError(t, err)
@@ -26,14 +31,17 @@ func TestDebugServer_GetPeer(t *testing.T) {
 
 	assert.Equal(t, int(ethpb.PeerDirection_INBOUND), int(res.Direction), "Expected 1st peer to be an inbound connection")
 	assert.Equal(t, ethpb.ConnectionState_CONNECTED, res.ConnectionState, "Expected peer to be connected")
+	assert.Equal(t, uint64(1024), res.BytesReceived)
+	assert.Equal(t, uint64(2048), res.BytesSent)
 }
 
 func TestDebugServer_ListPeers(t *testing.T) {
 	peersProvider := &mockP2p.MockPeersProvider{}
 	mP2P := mockP2p.NewTestP2P(t)
 	ds := &Server{
-		PeersFetcher: peersProvider,
-		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost},
+		PeersFetcher:      peersProvider,
+		PeerManager:       &mockP2p.MockPeerManager{BHost: mP2P.BHost},
+		BandwidthProvider: &mockP2p.MockBandwidthProvider{},
 	}
 
 	res, err := ds.ListPeers(context.Background(), &empty.Empty{})
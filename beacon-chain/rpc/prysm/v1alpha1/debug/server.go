@@ -11,6 +11,7 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	golog "github.com/ipfs/go-log/v2"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
@@ -24,13 +25,18 @@ import (
 // providing RPC endpoints for runtime debugging of a node, this server is
 // gated behind the feature flag --enable-debug-rpc-endpoints.
 type Server struct {
-	BeaconDB           db.NoHeadAccessDatabase
-	GenesisTimeFetcher blockchain.TimeFetcher
-	StateGen           *stategen.State
-	HeadFetcher        blockchain.HeadFetcher
-	PeerManager        p2p.PeerManager
-	PeersFetcher       p2p.PeersProvider
-	ReplayerBuilder    stategen.ReplayerBuilder
+	BeaconDB                db.NoHeadAccessDatabase
+	GenesisTimeFetcher      blockchain.TimeFetcher
+	StateGen                *stategen.State
+	HeadFetcher             blockchain.HeadFetcher
+	ForkFetcher             blockchain.ForkFetcher
+	PeerManager             p2p.PeerManager
+	PeersFetcher            p2p.PeersProvider
+	BandwidthProvider       p2p.BandwidthProvider
+	ENRIndexProvider        p2p.ENRIndexProvider
+	ReplayerBuilder         stategen.ReplayerBuilder
+	OptimisticBlocksManager blockchain.OptimisticBlocksManager
+	BlockTimings            *cache.BlockTimingsCache
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
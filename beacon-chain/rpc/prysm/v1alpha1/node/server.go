@@ -76,11 +76,21 @@ func (ns *Server) GetGenesis(ctx context.Context, _ *empty.Empty) (*ethpb.Genesi
 	}, nil
 }
 
-// GetVersion checks the version information of the beacon node.
-func (_ *Server) GetVersion(_ context.Context, _ *empty.Empty) (*ethpb.Version, error) {
-	return &ethpb.Version{
+// GetVersion checks the version information of the beacon node. The version of the
+// execution client is given in the metadata if the beacon node is connected to one.
+func (ns *Server) GetVersion(ctx context.Context, _ *empty.Empty) (*ethpb.Version, error) {
+	v := &ethpb.Version{
 		Version: version.Version(),
-	}, nil
+	}
+	if ns.POWChainInfoFetcher != nil && ns.POWChainInfoFetcher.ExecutionClientConnected() {
+		elVersion, err := ns.POWChainInfoFetcher.ExecutionClientVersion(ctx)
+		if err != nil {
+			log.WithError(err).Debug("Could not get execution client version")
+		} else {
+			v.Metadata = version.ExecutionClientMetadata(elVersion)
+		}
+	}
+	return v, nil
 }
 
 // ListImplementedServices lists the services implemented and enabled by this node.
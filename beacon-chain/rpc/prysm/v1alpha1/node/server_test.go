@@ -77,6 +77,14 @@ func TestNodeServer_GetVersion(t *testing.T) {
 	res, err := ns.GetVersion(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, v, res.Version)
+	assert.Equal(t, "", res.Metadata)
+
+	ns.POWChainInfoFetcher = &testutil.MockExecutionChainInfoFetcher{ClientVersion: "Geth/v1.10.26-stable/linux-amd64/go1.19.3"}
+	res, err = ns.GetVersion(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	elVersion, ok := version.ExecutionClientFromMetadata(res.Metadata)
+	require.Equal(t, true, ok)
+	assert.Equal(t, "Geth/v1.10.26-stable/linux-amd64/go1.19.3", elVersion)
 }
 
 func TestNodeServer_GetImplementedServices(t *testing.T) {
@@ -0,0 +1,62 @@
+package slasher
+
+import (
+	"context"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SlashingEvidence returns the archived evidence of the slashings detected by slasher,
+// ordered by detection time, for the requested validators and epoch range.
+func (s *Server) SlashingEvidence(
+	ctx context.Context, req *ethpb.SlashingEvidenceRequest,
+) (*ethpb.SlashingEvidenceResponse, error) {
+	filter := &slashertypes.EvidenceFilter{
+		ValidatorIndices: make([]types.ValidatorIndex, len(req.ValidatorIndices)),
+		StartEpoch:       req.StartEpoch,
+		EndEpoch:         req.EndEpoch,
+	}
+	for i, idx := range req.ValidatorIndices {
+		filter.ValidatorIndices[i] = types.ValidatorIndex(idx)
+	}
+	if filter.EndEpoch == 0 {
+		filter.EndEpoch = params.BeaconConfig().FarFutureEpoch
+	}
+	if filter.StartEpoch > filter.EndEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "Start epoch %d is after end epoch %d", filter.StartEpoch, filter.EndEpoch,
+		)
+	}
+	evidence, err := s.SlashingChecker.SlashingEvidence(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get slashing evidence: %v", err)
+	}
+	resp := &ethpb.SlashingEvidenceResponse{Evidence: make([]*ethpb.SlashingEvidence, len(evidence))}
+	for i, e := range evidence {
+		root, err := e.Root()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute slashing evidence root: %v", err)
+		}
+		indices := e.ValidatorIndices()
+		resp.Evidence[i] = &ethpb.SlashingEvidence{
+			Root:             root[:],
+			Offense:          e.Offense.String(),
+			Status:           e.Status.String(),
+			Source:           e.Source,
+			DetectedAt:       timestamppb.New(e.DetectedAt),
+			ValidatorIndices: make([]uint64, len(indices)),
+			AttesterSlashing: e.AttesterSlashing,
+			ProposerSlashing: e.ProposerSlashing,
+		}
+		for j, idx := range indices {
+			resp.Evidence[i].ValidatorIndices[j] = uint64(idx)
+		}
+	}
+	return resp, nil
+}
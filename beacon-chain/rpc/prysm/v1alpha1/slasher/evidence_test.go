@@ -0,0 +1,72 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	slasherservice "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/mock"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestServer_SlashingEvidence(t *testing.T) {
+	header := func(slot types.Slot, proposer types.ValidatorIndex, bodyRoot byte) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: proposer,
+				ParentRoot:    make([]byte, 32),
+				StateRoot:     make([]byte, 32),
+				BodyRoot:      append([]byte{bodyRoot}, make([]byte, 31)...),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	detectedAt := time.Unix(1000, 0)
+	doubleProposal := func(slot types.Slot, proposer types.ValidatorIndex) *slashertypes.SlashingEvidence {
+		e := slashertypes.NewProposerSlashingEvidence(&ethpb.ProposerSlashing{
+			Header_1: header(slot, proposer, 1),
+			Header_2: header(slot, proposer, 2),
+		}, detectedAt)
+		e.Source = slasherservice.LocalEvidenceSource
+		return e
+	}
+	s := Server{SlashingChecker: &mock.MockSlashingChecker{
+		Evidence: []*slashertypes.SlashingEvidence{
+			doubleProposal(1, 1),
+			doubleProposal(params.BeaconConfig().SlotsPerEpoch*2, 2),
+		},
+	}}
+	ctx := context.Background()
+
+	resp, err := s.SlashingEvidence(ctx, &ethpb.SlashingEvidenceRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Evidence))
+	got := resp.Evidence[0]
+	assert.Equal(t, "double_proposal", got.Offense)
+	assert.Equal(t, "pending", got.Status)
+	assert.Equal(t, slasherservice.LocalEvidenceSource, got.Source)
+	assert.Equal(t, detectedAt.Unix(), got.DetectedAt.AsTime().Unix())
+	assert.DeepEqual(t, []uint64{1}, got.ValidatorIndices)
+	root, err := s.SlashingChecker.(*mock.MockSlashingChecker).Evidence[0].Root()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], got.Root)
+
+	resp, err = s.SlashingEvidence(ctx, &ethpb.SlashingEvidenceRequest{ValidatorIndices: []uint64{2, 3}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Evidence))
+	assert.DeepEqual(t, []uint64{2}, resp.Evidence[0].ValidatorIndices)
+
+	resp, err = s.SlashingEvidence(ctx, &ethpb.SlashingEvidenceRequest{StartEpoch: 1, EndEpoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resp.Evidence))
+
+	_, err = s.SlashingEvidence(ctx, &ethpb.SlashingEvidenceRequest{StartEpoch: 3, EndEpoch: 2})
+	assert.ErrorContains(t, "Start epoch 3 is after end epoch 2", err)
+}
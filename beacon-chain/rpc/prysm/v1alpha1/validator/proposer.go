@@ -16,12 +16,16 @@ import (
 	blockfeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v3/config/features"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -34,6 +38,18 @@ var eth1DataNotification bool
 
 const eth1dataTimeout = 2 * time.Second
 
+const (
+	// reorgHeadWeightThreshold is the percentage of the committee weight below which a late head
+	// block is weak enough to be reorged out by the proposer boost of the next block.
+	reorgHeadWeightThreshold = 20
+	// reorgParentWeightThreshold is the percentage of the committee weight above which the parent
+	// of a late head block is strong enough to be built upon instead.
+	reorgParentWeightThreshold = 160
+	// reorgMaxEpochsSinceFinalization is the number of epochs without finalization after which
+	// late head blocks are no longer reorged out.
+	reorgMaxEpochsSinceFinalization = 2
+)
+
 // GetBeaconBlock is called by a proposer during its assigned slot to request a block to sign
 // by passing in the slot and the signed randao reveal of the slot. Returns phase0 beacon blocks
 // before the Altair fork epoch and Altair blocks post-fork epoch.
@@ -199,6 +215,147 @@ func (vs *Server) proposeGenericBeaconBlock(ctx context.Context, blk interfaces.
 	}, nil
 }
 
+// proposerHead returns the root of the block to build upon when proposing at the given slot,
+// reorging out the head if it is a late and weak block and the feature is enabled.
+func (vs *Server) proposerHead(slot types.Slot, headRoot [32]byte) [32]byte {
+	if !features.Get().EnableReorgLateBlocks || vs.ForkFetcher == nil || vs.ForkFetcher.ForkChoicer() == nil {
+		return headRoot
+	}
+	slotStart, err := slots.ToTime(uint64(vs.TimeFetcher.GenesisTime().Unix()), slot)
+	if err != nil {
+		return headRoot
+	}
+	secondsIntoSlot := uint64(0)
+	if now := prysmTime.Now(); now.After(slotStart) {
+		secondsIntoSlot = uint64(now.Sub(slotStart) / time.Second)
+	}
+	return ProposerHead(vs.ForkFetcher.ForkChoicer(), headRoot, slot, secondsIntoSlot)
+}
+
+// ProposerHead returns the root of the block a proposer should build upon at the given slot,
+// secondsIntoSlot seconds after its start. This is the parent of the head when the head is a
+// single late block from the previous slot which received few votes while its parent received
+// many, so that the proposer boost of the new block is enough to reorg it out. It is the head
+// otherwise.
+//
+// Spec code:
+// def get_proposer_head(store: Store, head_root: Root, slot: Slot) -> Root:
+//    head_block = store.blocks[head_root]
+//    parent_root = head_block.parent_root
+//    parent_block = store.blocks[parent_root]
+//
+//    # Only re-org the head block if it arrived later than the attestation deadline.
+//    head_late = is_head_late(store, head_root)
+//
+//    # Do not re-org on an epoch boundary where the proposer shuffling could change.
+//    shuffling_stable = is_shuffling_stable(slot)
+//
+//    # Ensure that the FFG information of the new head will be competitive with the current head.
+//    ffg_competitive = is_ffg_competitive(store, head_root, parent_root)
+//
+//    # Do not re-org if the chain is not finalizing with acceptable frequency.
+//    finalization_ok = is_finalization_ok(store, slot)
+//
+//    # Only re-org if we are proposing on-time.
+//    proposing_on_time = is_proposing_on_time(store)
+//
+//    # Only re-org a single slot at most.
+//    parent_slot_ok = parent_block.slot + 1 == head_block.slot
+//    current_time_ok = head_block.slot + 1 == slot
+//    single_slot_reorg = parent_slot_ok and current_time_ok
+//
+//    # Check that the head has few enough votes to be overpowered by our proposer boost.
+//    assert store.proposer_boost_root != head_root  # ensure boost has worn off
+//    head_weak = is_head_weak(store, head_root)
+//
+//    # Check that the missing votes are assigned to the parent and not being hoarded.
+//    parent_strong = is_parent_strong(store, parent_root)
+//
+//    if all([head_late, shuffling_stable, ffg_competitive, finalization_ok,
+//            proposing_on_time, single_slot_reorg, head_weak, parent_strong]):
+//        # We can re-org the current head by building upon its parent block.
+//        return parent_root
+//    else:
+//        return head_root
+func ProposerHead(fc forkchoice.Getter, headRoot [32]byte, slot types.Slot, secondsIntoSlot uint64) [32]byte {
+	if fc.ProposerBoost() == headRoot {
+		return headRoot
+	}
+	timely, err := fc.IsTimely(headRoot)
+	if err != nil || timely {
+		return headRoot
+	}
+	if slot%params.BeaconConfig().SlotsPerEpoch == 0 {
+		return headRoot
+	}
+	if slots.ToEpoch(slot) > fc.FinalizedCheckpoint().Epoch+reorgMaxEpochsSinceFinalization {
+		return headRoot
+	}
+	if secondsIntoSlot > params.BeaconConfig().SecondsPerSlot/params.BeaconConfig().IntervalsPerSlot/2 {
+		return headRoot
+	}
+
+	parentRoot, err := fc.ParentRoot(headRoot)
+	if err != nil || parentRoot == [32]byte{} {
+		return headRoot
+	}
+	headSlot, err := fc.Slot(headRoot)
+	if err != nil {
+		return headRoot
+	}
+	parentSlot, err := fc.Slot(parentRoot)
+	if err != nil {
+		return headRoot
+	}
+	if parentSlot+1 != headSlot || headSlot+1 != slot {
+		return headRoot
+	}
+	headJustified, err := fc.UnrealizedJustifiedCheckpoint(headRoot)
+	if err != nil {
+		return headRoot
+	}
+	parentJustified, err := fc.UnrealizedJustifiedCheckpoint(parentRoot)
+	if err != nil || headJustified.Epoch != parentJustified.Epoch || headJustified.Root != parentJustified.Root {
+		return headRoot
+	}
+
+	committeeWeight := fc.CommitteeWeight()
+	headWeight, err := fc.Weight(headRoot)
+	if err != nil || headWeight >= committeeWeight*reorgHeadWeightThreshold/100 {
+		return headRoot
+	}
+	parentWeight, err := fc.Weight(parentRoot)
+	if err != nil || parentWeight <= committeeWeight*reorgParentWeightThreshold/100 {
+		return headRoot
+	}
+	return parentRoot
+}
+
+// proposalParentState returns the post state of the block with the given root, which a
+// proposal builds upon. This is the head state unless a late head block is being reorged out.
+func (vs *Server) proposalParentState(ctx context.Context, root [32]byte) (state.BeaconState, error) {
+	if features.Get().EnableReorgLateBlocks {
+		headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if bytesutil.ToBytes32(headRoot) != root {
+			return vs.StateGen.StateByRoot(ctx, root)
+		}
+	}
+	return vs.HeadFetcher.HeadState(ctx)
+}
+
+// reorgingHead returns true if the given parent root of a proposal is not the head, which
+// happens when reorging out a late head block.
+func (vs *Server) reorgingHead(ctx context.Context, parentRoot [32]byte) bool {
+	if !features.Get().EnableReorgLateBlocks {
+		return false
+	}
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	return err == nil && bytesutil.ToBytes32(headRoot) != parentRoot
+}
+
 // computeStateRoot computes the state root after a block has been processed through a state transition and
 // returns it to the validator client.
 func (vs *Server) computeStateRoot(ctx context.Context, block interfaces.SignedBeaconBlock) ([]byte, error) {
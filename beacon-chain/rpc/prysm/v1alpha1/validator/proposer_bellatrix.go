@@ -44,7 +44,8 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 		return nil, err
 	}
 
-	if !req.SkipMevBoost {
+	// The builder builds upon the payload of the head, which is not the parent when reorging out a late head block.
+	if !req.SkipMevBoost && !vs.reorgingHead(ctx, bytesutil.ToBytes32(altairBlk.ParentRoot)) {
 		registered, err := vs.validatorRegistered(ctx, altairBlk.ProposerIndex)
 		if registered && err == nil {
 			builderReady, b, err := vs.GetAndBuildBlindBlock(ctx, altairBlk)
@@ -78,7 +78,7 @@ func (vs *Server) getExecutionPayload(ctx context.Context, slot types.Slot, vIdx
 		}
 	}
 
-	st, err := vs.HeadFetcher.HeadState(ctx)
+	st, err := vs.proposalParentState(ctx, headRoot)
 	if err != nil {
 		return nil, err
 	}
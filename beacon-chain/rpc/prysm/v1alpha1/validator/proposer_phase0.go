@@ -15,6 +15,7 @@ import (
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -97,6 +98,20 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 		return nil, fmt.Errorf("could not get head state %v", err)
 	}
 
+	// Build upon the parent of the head instead if the head is a late block to reorg out.
+	if proposerHead := vs.proposerHead(req.Slot, bytesutil.ToBytes32(parentRoot)); proposerHead != bytesutil.ToBytes32(parentRoot) {
+		log.WithFields(logrus.Fields{
+			"slot":       req.Slot,
+			"headRoot":   fmt.Sprintf("%#x", bytesutil.Trunc(parentRoot)),
+			"parentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(proposerHead[:])),
+		}).Info("Reorging out late head block")
+		parentRoot = proposerHead[:]
+		head, err = vs.StateGen.StateByRoot(ctx, proposerHead)
+		if err != nil {
+			return nil, fmt.Errorf("could not get state of the head's parent %v", err)
+		}
+	}
+
 	head, err = transition.ProcessSlotsUsingNextSlotCache(ctx, head, parentRoot, req.Slot)
 	if err != nil {
 		return nil, fmt.Errorf("could not advance slots to calculate proposer index: %v", err)
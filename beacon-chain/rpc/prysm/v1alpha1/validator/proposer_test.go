@@ -21,7 +21,9 @@ import (
 	coretime "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/time"
 	dbutil "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
 	mockExecution "github.com/prysmaticlabs/prysm/v3/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/synccommittee"
@@ -2495,3 +2497,233 @@ func TestProposer_GetFeeRecipientByPubKey(t *testing.T) {
 
 	require.Equal(t, common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9728D8").Hex(), common.BytesToAddress(resp.FeeRecipient).Hex())
 }
+
+// reorgForkchoice is a forkchoice getter over a head block and its parent for testing late block reorgs.
+type reorgForkchoice struct {
+	forkchoice.Getter
+	boosted         [32]byte
+	finalizedEpoch  types.Epoch
+	committeeWeight uint64
+	nodes           map[[32]byte]*reorgNode
+}
+
+type reorgNode struct {
+	slot        types.Slot
+	parent      [32]byte
+	weight      uint64
+	timely      bool
+	unrealizedJ forkchoicetypes.Checkpoint
+}
+
+func (f *reorgForkchoice) node(root [32]byte) (*reorgNode, error) {
+	n, ok := f.nodes[root]
+	if !ok {
+		return nil, errors.New("unknown root")
+	}
+	return n, nil
+}
+
+func (f *reorgForkchoice) ProposerBoost() [fieldparams.RootLength]byte {
+	return f.boosted
+}
+
+func (f *reorgForkchoice) FinalizedCheckpoint() *forkchoicetypes.Checkpoint {
+	return &forkchoicetypes.Checkpoint{Epoch: f.finalizedEpoch}
+}
+
+func (f *reorgForkchoice) CommitteeWeight() uint64 {
+	return f.committeeWeight
+}
+
+func (f *reorgForkchoice) IsTimely(root [32]byte) (bool, error) {
+	n, err := f.node(root)
+	if err != nil {
+		return false, err
+	}
+	return n.timely, nil
+}
+
+func (f *reorgForkchoice) ParentRoot(root [32]byte) ([32]byte, error) {
+	n, err := f.node(root)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return n.parent, nil
+}
+
+func (f *reorgForkchoice) Slot(root [32]byte) (types.Slot, error) {
+	n, err := f.node(root)
+	if err != nil {
+		return 0, err
+	}
+	return n.slot, nil
+}
+
+func (f *reorgForkchoice) Weight(root [32]byte) (uint64, error) {
+	n, err := f.node(root)
+	if err != nil {
+		return 0, err
+	}
+	return n.weight, nil
+}
+
+func (f *reorgForkchoice) UnrealizedJustifiedCheckpoint(root [32]byte) (*forkchoicetypes.Checkpoint, error) {
+	n, err := f.node(root)
+	if err != nil {
+		return nil, err
+	}
+	return &n.unrealizedJ, nil
+}
+
+func TestProposerHead(t *testing.T) {
+	headRoot, parentRoot := [32]byte{'b'}, [32]byte{'a'}
+	// A late head at slot 9 with 10% of the committee weight, on top of a parent with 170%.
+	lateWeakHead := func() *reorgForkchoice {
+		return &reorgForkchoice{
+			committeeWeight: 100,
+			finalizedEpoch:  0,
+			nodes: map[[32]byte]*reorgNode{
+				parentRoot: {slot: 8, parent: [32]byte{'g'}, weight: 170},
+				headRoot:   {slot: 9, parent: parentRoot, weight: 10},
+			},
+		}
+	}
+	tests := []struct {
+		name            string
+		update          func(f *reorgForkchoice)
+		slot            types.Slot
+		secondsIntoSlot uint64
+		want            [32]byte
+	}{
+		{
+			name: "late weak head is reorged out",
+			slot: 10,
+			want: parentRoot,
+		},
+		{
+			name:   "timely head",
+			update: func(f *reorgForkchoice) { f.nodes[headRoot].timely = true },
+			slot:   10,
+			want:   headRoot,
+		},
+		{
+			name:   "boosted head",
+			update: func(f *reorgForkchoice) { f.boosted = headRoot },
+			slot:   10,
+			want:   headRoot,
+		},
+		{
+			name: "head not from the previous slot",
+			slot: 11,
+			want: headRoot,
+		},
+		{
+			name:   "parent not from the slot before the head",
+			update: func(f *reorgForkchoice) { f.nodes[parentRoot].slot = 7 },
+			slot:   10,
+			want:   headRoot,
+		},
+		{
+			name: "epoch boundary",
+			update: func(f *reorgForkchoice) {
+				f.nodes[parentRoot].slot = params.BeaconConfig().SlotsPerEpoch - 2
+				f.nodes[headRoot].slot = params.BeaconConfig().SlotsPerEpoch - 1
+			},
+			slot: params.BeaconConfig().SlotsPerEpoch,
+			want: headRoot,
+		},
+		{
+			name:            "proposing late",
+			slot:            10,
+			secondsIntoSlot: params.BeaconConfig().SecondsPerSlot / 2,
+			want:            headRoot,
+		},
+		{
+			name: "chain not finalizing",
+			update: func(f *reorgForkchoice) {
+				f.nodes[parentRoot].slot = 4*params.BeaconConfig().SlotsPerEpoch + 8
+				f.nodes[headRoot].slot = 4*params.BeaconConfig().SlotsPerEpoch + 9
+			},
+			slot: 4*params.BeaconConfig().SlotsPerEpoch + 10,
+			want: headRoot,
+		},
+		{
+			name:   "head justifies more",
+			update: func(f *reorgForkchoice) { f.nodes[headRoot].unrealizedJ.Epoch = 1 },
+			slot:   10,
+			want:   headRoot,
+		},
+		{
+			name:   "head justifies another checkpoint root",
+			update: func(f *reorgForkchoice) { f.nodes[headRoot].unrealizedJ.Root = [32]byte{'j'} },
+			slot:   10,
+			want:   headRoot,
+		},
+		{
+			name:   "strong head",
+			update: func(f *reorgForkchoice) { f.nodes[headRoot].weight = 20 },
+			slot:   10,
+			want:   headRoot,
+		},
+		{
+			name:   "weak parent",
+			update: func(f *reorgForkchoice) { f.nodes[parentRoot].weight = 160 },
+			slot:   10,
+			want:   headRoot,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := lateWeakHead()
+			if tt.update != nil {
+				tt.update(f)
+			}
+			assert.Equal(t, tt.want, ProposerHead(f, headRoot, tt.slot, tt.secondsIntoSlot))
+		})
+	}
+}
+
+func TestProposerHead_Forkchoice(t *testing.T) {
+	ctx := context.Background()
+	fc := doublylinkedtree.New()
+	// Propose at the start of the last slot of epoch 2, late enough for unrealized checkpoints to be computed.
+	proposalSlot := 3*params.BeaconConfig().SlotsPerEpoch - 1
+	fc.SetGenesisTime(uint64(time.Now().Unix()) - uint64(proposalSlot)*params.BeaconConfig().SecondsPerSlot)
+
+	genesisRoot, parentRoot, headRoot := [32]byte{'g'}, [32]byte{'a'}, [32]byte{'b'}
+	insert := func(slot types.Slot, root, parent [32]byte) {
+		st, _ := util.DeterministicGenesisStateBellatrix(t, 64)
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{ParentRoot: parent[:]}))
+		require.NoError(t, fc.InsertNode(ctx, st, root))
+	}
+	insert(0, genesisRoot, [32]byte{})
+	insert(proposalSlot-2, parentRoot, genesisRoot)
+	insert(proposalSlot-1, headRoot, parentRoot)
+
+	balances := make([]uint64, 64)
+	for i := range balances {
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	committeeSize := uint64(len(balances)) / uint64(params.BeaconConfig().SlotsPerEpoch)
+	votes := func(from, count uint64) []uint64 {
+		indices := make([]uint64, count)
+		for i := range indices {
+			indices[i] = from + uint64(i)
+		}
+		return indices
+	}
+	// The parent gets twice the committee weight, the late head gets no votes.
+	fc.ProcessAttestation(ctx, votes(0, 2*committeeSize), parentRoot, 2)
+	head, err := fc.Head(ctx, balances)
+	require.NoError(t, err)
+	require.Equal(t, headRoot, head)
+	assert.Equal(t, committeeSize*params.BeaconConfig().MaxEffectiveBalance, fc.CommitteeWeight())
+	assert.Equal(t, parentRoot, ProposerHead(fc, headRoot, proposalSlot, 0))
+
+	// Once a committee attests to the head, it is built upon.
+	fc.ProcessAttestation(ctx, votes(2*committeeSize, committeeSize), headRoot, 2)
+	_, err = fc.Head(ctx, balances)
+	require.NoError(t, err)
+	assert.Equal(t, headRoot, ProposerHead(fc, headRoot, proposalSlot, 0))
+}
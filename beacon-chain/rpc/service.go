@@ -35,6 +35,7 @@ import (
 	beaconv1alpha1 "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/beacon"
 	debugv1alpha1 "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/debug"
 	nodev1alpha1 "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/node"
+	slasherv1alpha1 "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/slasher"
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/validator"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/statefetcher"
 	slasherservice "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher"
@@ -81,6 +82,7 @@ type Config struct {
 	BeaconDB                      db.HeadAccessDatabase
 	ChainInfoFetcher              blockchain.ChainInfoFetcher
 	HeadUpdater                   blockchain.HeadUpdater
+	OptimisticBlocksManager       blockchain.OptimisticBlocksManager
 	HeadFetcher                   blockchain.HeadFetcher
 	CanonicalFetcher              blockchain.CanonicalFetcher
 	ForkFetcher                   blockchain.ForkFetcher
@@ -103,6 +105,8 @@ type Config struct {
 	Broadcaster                   p2p.Broadcaster
 	PeersFetcher                  p2p.PeersProvider
 	PeerManager                   p2p.PeerManager
+	BandwidthProvider             p2p.BandwidthProvider
+	ENRIndexProvider              p2p.ENRIndexProvider
 	MetadataProvider              p2p.MetadataProvider
 	DepositFetcher                depositcache.DepositFetcher
 	PendingDepositFetcher         depositcache.PendingDepositsFetcher
@@ -113,6 +117,7 @@ type Config struct {
 	MaxMsgSize                    int
 	ExecutionEngineCaller         execution.EngineCaller
 	ProposerIdsCache              *cache.ProposerPayloadIDsCache
+	BlockTimings                  *cache.BlockTimingsCache
 	OptimisticModeFetcher         blockchain.OptimisticModeFetcher
 	BlockBuilder                  builder.BlockBuilder
 }
@@ -265,6 +270,7 @@ func (s *Service) Start() {
 		GenesisTimeFetcher:    s.cfg.GenesisTimeFetcher,
 		PeersFetcher:          s.cfg.PeersFetcher,
 		PeerManager:           s.cfg.PeerManager,
+		BandwidthProvider:     s.cfg.BandwidthProvider,
 		MetadataProvider:      s.cfg.MetadataProvider,
 		HeadFetcher:           s.cfg.HeadFetcher,
 	}
@@ -333,13 +339,18 @@ func (s *Service) Start() {
 	if s.cfg.EnableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debugv1alpha1.Server{
-			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
-			BeaconDB:           s.cfg.BeaconDB,
-			StateGen:           s.cfg.StateGen,
-			HeadFetcher:        s.cfg.HeadFetcher,
-			PeerManager:        s.cfg.PeerManager,
-			PeersFetcher:       s.cfg.PeersFetcher,
-			ReplayerBuilder:    ch,
+			GenesisTimeFetcher:      s.cfg.GenesisTimeFetcher,
+			BeaconDB:                s.cfg.BeaconDB,
+			StateGen:                s.cfg.StateGen,
+			HeadFetcher:             s.cfg.HeadFetcher,
+			ForkFetcher:             s.cfg.ForkFetcher,
+			PeerManager:             s.cfg.PeerManager,
+			PeersFetcher:            s.cfg.PeersFetcher,
+			BandwidthProvider:       s.cfg.BandwidthProvider,
+			ENRIndexProvider:        s.cfg.ENRIndexProvider,
+			ReplayerBuilder:         ch,
+			OptimisticBlocksManager: s.cfg.OptimisticBlocksManager,
+			BlockTimings:            s.cfg.BlockTimings,
 		}
 		debugServerV1 := &debug.Server{
 			BeaconDB:    s.cfg.BeaconDB,
@@ -359,6 +370,11 @@ func (s *Service) Start() {
 	}
 	ethpbv1alpha1.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
 	ethpbservice.RegisterBeaconValidatorServer(s.grpcServer, validatorServerV1)
+	if s.cfg.SlashingChecker != nil {
+		ethpbv1alpha1.RegisterSlasherServer(s.grpcServer, &slasherv1alpha1.Server{
+			SlashingChecker: s.cfg.SlashingChecker,
+		})
+	}
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
 
@@ -1,13 +1,15 @@
 package testutil
 
 import (
+	"context"
 	"math/big"
 )
 
 // MockExecutionChainInfoFetcher is a fake implementation of the powchain.ChainInfoFetcher
 type MockExecutionChainInfoFetcher struct {
-	CurrEndpoint string
-	CurrError    error
+	CurrEndpoint  string
+	CurrError     error
+	ClientVersion string
 }
 
 func (*MockExecutionChainInfoFetcher) GenesisExecutionChainInfo() (uint64, *big.Int) {
@@ -25,3 +27,7 @@ func (m *MockExecutionChainInfoFetcher) ExecutionClientEndpoint() string {
 func (m *MockExecutionChainInfoFetcher) ExecutionClientConnectionErr() error {
 	return m.CurrError
 }
+
+func (m *MockExecutionChainInfoFetcher) ExecutionClientVersion(_ context.Context) (string, error) {
+	return m.ClientVersion, nil
+}
@@ -0,0 +1,108 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+)
+
+// BeaconNode is the beacon node slasher detects slashable offenses for. Besides the
+// attestations and blocks slasher receives, it provides the chain data slasher needs
+// and takes the slashings slasher detects.
+type BeaconNode interface {
+	NumValidators(ctx context.Context) (uint64, error)
+	HeadSlot(ctx context.Context) (types.Slot, error)
+	SlashedValidators(ctx context.Context, indices []types.ValidatorIndex) (map[types.ValidatorIndex]bool, error)
+	VerifyAttestationSignature(ctx context.Context, att *ethpb.IndexedAttestation) error
+	VerifyBlockSignature(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) error
+	SubmitAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error
+	SubmitProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error
+}
+
+// beaconNode returns the configured beacon node, or the one slasher runs in.
+func (s *Service) beaconNode() BeaconNode {
+	if s.serviceCfg.BeaconNode != nil {
+		return s.serviceCfg.BeaconNode
+	}
+	return &inProcessBeaconNode{cfg: s.serviceCfg}
+}
+
+// inProcessBeaconNode is the beacon node slasher runs in, accessed through the chain,
+// state and slashings pool services of the service configuration.
+type inProcessBeaconNode struct {
+	cfg *ServiceConfig
+}
+
+// NumValidators in the head state.
+func (n *inProcessBeaconNode) NumValidators(ctx context.Context) (uint64, error) {
+	headState, err := n.cfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(headState.NumValidators()), nil
+}
+
+// HeadSlot of the chain.
+func (n *inProcessBeaconNode) HeadSlot(_ context.Context) (types.Slot, error) {
+	return n.cfg.HeadStateFetcher.HeadSlot(), nil
+}
+
+// SlashedValidators returns the validators among the indices which are slashed in the head state.
+func (n *inProcessBeaconNode) SlashedValidators(
+	ctx context.Context, indices []types.ValidatorIndex,
+) (map[types.ValidatorIndex]bool, error) {
+	headState, err := n.cfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slashed := make(map[types.ValidatorIndex]bool)
+	for _, idx := range indices {
+		validator, err := headState.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return nil, err
+		}
+		if validator.Slashed() {
+			slashed[idx] = true
+		}
+	}
+	return slashed, nil
+}
+
+// VerifyAttestationSignature against the attestation's target state.
+func (n *inProcessBeaconNode) VerifyAttestationSignature(ctx context.Context, att *ethpb.IndexedAttestation) error {
+	preState, err := n.cfg.AttestationStateFetcher.AttestationTargetState(ctx, att.Data.Target)
+	if err != nil {
+		return err
+	}
+	return blocks.VerifyIndexedAttestation(ctx, preState, att)
+}
+
+// VerifyBlockSignature against the state of the block's parent.
+func (n *inProcessBeaconNode) VerifyBlockSignature(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) error {
+	parentState, err := n.cfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(header.Header.ParentRoot))
+	if err != nil {
+		return err
+	}
+	return blocks.VerifyBlockHeaderSignature(parentState, header)
+}
+
+// SubmitAttesterSlashing to the slashing operations pool.
+func (n *inProcessBeaconNode) SubmitAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error {
+	headState, err := n.cfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	return n.cfg.SlashingPoolInserter.InsertAttesterSlashing(ctx, headState, slashing)
+}
+
+// SubmitProposerSlashing to the slashing operations pool.
+func (n *inProcessBeaconNode) SubmitProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
+	headState, err := n.cfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	return n.cfg.SlashingPoolInserter.InsertProposerSlashing(ctx, headState, slashing)
+}
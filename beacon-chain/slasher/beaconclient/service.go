@@ -0,0 +1,364 @@
+// Package beaconclient connects a standalone slasher to a beacon node. It streams the
+// attestations and blocks received by the beacon node into the feeds slasher consumes,
+// verifies the slashings slasher detects against the beacon node's view of the validators,
+// and submits them back to the beacon node's operations pool.
+package beaconclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Time to wait before retrying a request to the beacon node, or reopening a stream.
+const reconnectPeriod = 5 * time.Second
+
+var (
+	_ slasher.BeaconNode = (*Service)(nil)
+	_ statefeed.Notifier = (*Service)(nil)
+	_ sync.Checker       = (*Service)(nil)
+)
+
+// Config for the beacon node client.
+type Config struct {
+	BeaconChainClient         ethpb.BeaconChainClient
+	NodeClient                ethpb.NodeClient
+	BeaconNodeValidatorClient ethpb.BeaconNodeValidatorClient
+	IndexedAttestationsFeed   *event.Feed
+	BeaconBlockHeadersFeed    *event.Feed
+}
+
+// Service is the beacon node a standalone slasher detects slashable offenses for.
+// Besides the slasher.BeaconNode methods, it notifies slasher of the chain start and
+// reports whether the beacon node is syncing.
+type Service struct {
+	cfg       *Config
+	ctx       context.Context
+	cancel    context.CancelFunc
+	stateFeed *event.Feed
+}
+
+// New returns a client of the beacon node from the given configuration.
+func New(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:       cfg,
+		ctx:       ctx,
+		cancel:    cancel,
+		stateFeed: new(event.Feed),
+	}
+}
+
+// Start waiting for the chain start and streaming attestations and blocks from the beacon node.
+func (s *Service) Start() {
+	go s.notifyChainStart()
+	go s.receiveAttestations()
+	go s.receiveBlocks()
+}
+
+// Stop the streams from the beacon node.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the beacon node client.
+func (_ *Service) Status() error {
+	return nil
+}
+
+// StateFeed over which slasher is notified of the chain start.
+func (s *Service) StateFeed() *event.Feed {
+	return s.stateFeed
+}
+
+// Initialized is always true, the beacon node being queried for its sync status.
+func (_ *Service) Initialized() bool {
+	return true
+}
+
+// Syncing returns true if the beacon node is syncing, or cannot be reached.
+func (s *Service) Syncing() bool {
+	res, err := s.cfg.NodeClient.GetSyncStatus(s.ctx, &emptypb.Empty{})
+	if err != nil {
+		log.WithError(err).Debug("Could not get sync status of beacon node")
+		return true
+	}
+	return res.Syncing
+}
+
+// Synced returns true if the beacon node is not syncing.
+func (s *Service) Synced() bool {
+	return !s.Syncing()
+}
+
+// Resync is not supported, the beacon node syncs on its own.
+func (_ *Service) Resync() error {
+	return errors.New("resync is not supported by a standalone slasher")
+}
+
+// NumValidators in the head state of the beacon node.
+func (s *Service) NumValidators(ctx context.Context) (uint64, error) {
+	res, err := s.cfg.BeaconChainClient.ListValidators(ctx, &ethpb.ListValidatorsRequest{PageSize: 1})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not list validators")
+	}
+	return uint64(res.TotalSize), nil
+}
+
+// HeadSlot of the beacon node.
+func (s *Service) HeadSlot(ctx context.Context) (types.Slot, error) {
+	res, err := s.cfg.BeaconChainClient.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get chain head")
+	}
+	return res.HeadSlot, nil
+}
+
+// SlashedValidators returns the validators among the indices which are slashed in the head
+// state of the beacon node, listing them in as few requests as the beacon node pages allow.
+func (s *Service) SlashedValidators(
+	ctx context.Context, indices []types.ValidatorIndex,
+) (map[types.ValidatorIndex]bool, error) {
+	validators, err := s.validators(ctx, indices)
+	if err != nil {
+		return nil, err
+	}
+	slashed := make(map[types.ValidatorIndex]bool)
+	for idx, v := range validators {
+		if v.Slashed {
+			slashed[idx] = true
+		}
+	}
+	return slashed, nil
+}
+
+// VerifyAttestationSignature against the public keys and the signature domain of the
+// attestation's target epoch known to the beacon node.
+func (s *Service) VerifyAttestationSignature(ctx context.Context, att *ethpb.IndexedAttestation) error {
+	if err := attestation.IsValidAttestationIndices(ctx, att); err != nil {
+		return err
+	}
+	domain, err := s.domain(ctx, att.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return err
+	}
+	indices := make([]types.ValidatorIndex, len(att.AttestingIndices))
+	for i, idx := range att.AttestingIndices {
+		indices[i] = types.ValidatorIndex(idx)
+	}
+	pubKeys, err := s.publicKeys(ctx, indices)
+	if err != nil {
+		return err
+	}
+	return attestation.VerifyIndexedAttestationSig(ctx, att, pubKeys, domain)
+}
+
+// VerifyBlockSignature against the public key of the proposer and the signature domain
+// of the block's epoch known to the beacon node.
+func (s *Service) VerifyBlockSignature(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) error {
+	domain, err := s.domain(ctx, slots.ToEpoch(header.Header.Slot), params.BeaconConfig().DomainBeaconProposer)
+	if err != nil {
+		return err
+	}
+	pubKeys, err := s.publicKeys(ctx, []types.ValidatorIndex{header.Header.ProposerIndex})
+	if err != nil {
+		return err
+	}
+	return signing.VerifyBlockHeaderSigningRoot(header.Header, pubKeys[0].Marshal(), header.Signature, domain)
+}
+
+// SubmitAttesterSlashing to the operations pool of the beacon node.
+func (s *Service) SubmitAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error {
+	_, err := s.cfg.BeaconChainClient.SubmitAttesterSlashing(ctx, slashing)
+	return err
+}
+
+// SubmitProposerSlashing to the operations pool of the beacon node.
+func (s *Service) SubmitProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
+	_, err := s.cfg.BeaconChainClient.SubmitProposerSlashing(ctx, slashing)
+	return err
+}
+
+// notifyChainStart waits for the beacon node to know the genesis time, then notifies
+// slasher of it. The event is sent again until slasher has subscribed to the state feed.
+func (s *Service) notifyChainStart() {
+	var genesisTime time.Time
+	for {
+		res, err := s.cfg.NodeClient.GetGenesis(s.ctx, &emptypb.Empty{})
+		if err == nil && res.GenesisTime != nil && res.GenesisTime.Seconds != 0 {
+			genesisTime = res.GenesisTime.AsTime()
+			break
+		}
+		if err != nil {
+			log.WithError(err).Warn("Could not get genesis time from beacon node, retrying")
+		}
+		select {
+		case <-time.After(reconnectPeriod):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		sent := s.stateFeed.Send(&feed.Event{
+			Type: statefeed.Initialized,
+			Data: &statefeed.InitializedData{StartTime: genesisTime},
+		})
+		if sent > 0 {
+			return
+		}
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// receiveAttestations from the beacon node, reopening the stream when it fails.
+func (s *Service) receiveAttestations() {
+	for {
+		stream, err := s.cfg.BeaconChainClient.StreamIndexedAttestations(s.ctx, &emptypb.Empty{})
+		if err == nil {
+			for {
+				var att *ethpb.IndexedAttestation
+				att, err = stream.Recv()
+				if err != nil {
+					break
+				}
+				s.cfg.IndexedAttestationsFeed.Send(att)
+			}
+		}
+		if s.ctx.Err() != nil {
+			return
+		}
+		log.WithError(err).Warn("Indexed attestations stream from beacon node failed, reconnecting")
+		select {
+		case <-time.After(reconnectPeriod):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// receiveBlocks from the beacon node, reopening the stream when it fails.
+func (s *Service) receiveBlocks() {
+	for {
+		stream, err := s.cfg.BeaconNodeValidatorClient.StreamBlocksAltair(s.ctx, &ethpb.StreamBlocksRequest{})
+		if err == nil {
+			for {
+				var res *ethpb.StreamBlocksResponse
+				res, err = stream.Recv()
+				if err != nil {
+					break
+				}
+				header, headerErr := blockHeader(res)
+				if headerErr != nil {
+					log.WithError(headerErr).Error("Could not get header of block received from beacon node")
+					continue
+				}
+				s.cfg.BeaconBlockHeadersFeed.Send(header)
+			}
+		}
+		if s.ctx.Err() != nil {
+			return
+		}
+		log.WithError(err).Warn("Blocks stream from beacon node failed, reconnecting")
+		select {
+		case <-time.After(reconnectPeriod):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func blockHeader(res *ethpb.StreamBlocksResponse) (*ethpb.SignedBeaconBlockHeader, error) {
+	var blk interface{}
+	switch b := res.Block.(type) {
+	case *ethpb.StreamBlocksResponse_Phase0Block:
+		blk = b.Phase0Block
+	case *ethpb.StreamBlocksResponse_AltairBlock:
+		blk = b.AltairBlock
+	case *ethpb.StreamBlocksResponse_BellatrixBlock:
+		blk = b.BellatrixBlock
+	default:
+		return nil, errors.Errorf("unknown block type %T", b)
+	}
+	wsb, err := blocks.NewSignedBeaconBlock(blk)
+	if err != nil {
+		return nil, err
+	}
+	return wsb.Header()
+}
+
+// domain of the given type at the given epoch, computed by the beacon node from its fork
+// schedule and genesis validators root.
+func (s *Service) domain(ctx context.Context, epoch types.Epoch, domainType [4]byte) ([]byte, error) {
+	res, err := s.cfg.BeaconNodeValidatorClient.DomainData(ctx, &ethpb.DomainRequest{
+		Epoch:  epoch,
+		Domain: domainType[:],
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get domain data at epoch %d", epoch)
+	}
+	return res.SignatureDomain, nil
+}
+
+// publicKeys of the given validators in the head state of the beacon node, in the same order.
+func (s *Service) publicKeys(ctx context.Context, indices []types.ValidatorIndex) ([]bls.PublicKey, error) {
+	validators, err := s.validators(ctx, indices)
+	if err != nil {
+		return nil, err
+	}
+	pubKeys := make([]bls.PublicKey, len(indices))
+	for i, idx := range indices {
+		v, ok := validators[idx]
+		if !ok {
+			return nil, errors.Errorf("unknown validator %d", idx)
+		}
+		pubKey, err := bls.PublicKeyFromBytes(v.PublicKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not deserialize public key of validator %d", idx)
+		}
+		pubKeys[i] = pubKey
+	}
+	return pubKeys, nil
+}
+
+// validators lists the validators with the indices in the head state of the beacon node.
+func (s *Service) validators(
+	ctx context.Context, indices []types.ValidatorIndex,
+) (map[types.ValidatorIndex]*ethpb.Validator, error) {
+	validators := make(map[types.ValidatorIndex]*ethpb.Validator, len(indices))
+	req := &ethpb.ListValidatorsRequest{Indices: indices}
+	for {
+		res, err := s.cfg.BeaconChainClient.ListValidators(ctx, req)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list validators")
+		}
+		for _, v := range res.ValidatorList {
+			validators[v.Index] = v.Validator
+		}
+		if res.NextPageToken == "" || len(res.ValidatorList) == 0 {
+			return validators, nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
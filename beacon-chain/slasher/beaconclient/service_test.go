@@ -0,0 +1,156 @@
+package beaconclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/mock"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	"google.golang.org/grpc"
+)
+
+func TestService_BeaconNode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	s := New(ctx, &Config{BeaconChainClient: beaconClient})
+
+	beaconClient.EXPECT().ListValidators(gomock.Any(), &ethpb.ListValidatorsRequest{PageSize: 1}).Return(
+		&ethpb.Validators{TotalSize: 64}, nil,
+	)
+	numVals, err := s.NumValidators(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(64), numVals)
+
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadSlot: 10}, nil)
+	headSlot, err := s.HeadSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), uint64(headSlot))
+
+	beaconClient.EXPECT().ListValidators(gomock.Any(), &ethpb.ListValidatorsRequest{
+		Indices: []types.ValidatorIndex{3, 4, 5},
+	}).Return(&ethpb.Validators{
+		ValidatorList: []*ethpb.Validators_ValidatorContainer{
+			{Index: 3, Validator: &ethpb.Validator{Slashed: true}},
+			{Index: 4, Validator: &ethpb.Validator{}},
+		},
+		NextPageToken: "1",
+	}, nil)
+	beaconClient.EXPECT().ListValidators(gomock.Any(), &ethpb.ListValidatorsRequest{
+		Indices:   []types.ValidatorIndex{3, 4, 5},
+		PageToken: "1",
+	}).Return(&ethpb.Validators{
+		ValidatorList: []*ethpb.Validators_ValidatorContainer{{Index: 5, Validator: &ethpb.Validator{Slashed: true}}},
+	}, nil)
+	slashed, err := s.SlashedValidators(ctx, []types.ValidatorIndex{3, 4, 5})
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[types.ValidatorIndex]bool{3: true, 5: true}, slashed)
+
+	slashing := &ethpb.AttesterSlashing{}
+	beaconClient.EXPECT().SubmitAttesterSlashing(gomock.Any(), slashing).Return(nil, errors.New("bad slashing"))
+	assert.ErrorContains(t, "bad slashing", s.SubmitAttesterSlashing(ctx, slashing))
+}
+
+func TestService_VerifySignatures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	validatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	s := New(ctx, &Config{BeaconChainClient: beaconClient, BeaconNodeValidatorClient: validatorClient})
+
+	keys := make([]bls.SecretKey, 2)
+	vals := make([]*ethpb.Validators_ValidatorContainer, len(keys))
+	for i := range keys {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		keys[i] = key
+		vals[i] = &ethpb.Validators_ValidatorContainer{
+			Index:     types.ValidatorIndex(i),
+			Validator: &ethpb.Validator{PublicKey: key.PublicKey().Marshal()},
+		}
+	}
+	domain := bytesutil.PadTo([]byte{'d'}, 32)
+	validatorClient.EXPECT().DomainData(gomock.Any(), gomock.Any()).Return(&ethpb.DomainResponse{SignatureDomain: domain}, nil).AnyTimes()
+	beaconClient.EXPECT().ListValidators(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.ListValidatorsRequest, _ ...grpc.CallOption) (*ethpb.Validators, error) {
+			res := &ethpb.Validators{}
+			for _, idx := range req.Indices {
+				res.ValidatorList = append(res.ValidatorList, vals[idx])
+			}
+			return res, nil
+		}).AnyTimes()
+
+	att := util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{0, 1}})
+	root, err := signing.ComputeSigningRoot(att.Data, domain)
+	require.NoError(t, err)
+	att.Signature = bls.AggregateSignatures([]common.Signature{keys[0].Sign(root[:]), keys[1].Sign(root[:])}).Marshal()
+	require.NoError(t, s.VerifyAttestationSignature(ctx, att))
+	att.AttestingIndices = []uint64{0}
+	assert.NotNil(t, s.VerifyAttestationSignature(ctx, att))
+
+	header := util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{})
+	header.Header.ProposerIndex = 1
+	root, err = signing.ComputeSigningRoot(header.Header, domain)
+	require.NoError(t, err)
+	header.Signature = keys[1].Sign(root[:]).Marshal()
+	require.NoError(t, s.VerifyBlockSignature(ctx, header))
+	header.Header.ProposerIndex = 0
+	assert.NotNil(t, s.VerifyBlockSignature(ctx, header))
+}
+
+func TestService_receiveAttestations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	stream := mock.NewMockBeaconChain_StreamIndexedAttestationsClient(ctrl)
+	attsFeed := new(event.Feed)
+	s := New(ctx, &Config{BeaconChainClient: beaconClient, IndexedAttestationsFeed: attsFeed})
+
+	att := util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}})
+	beaconClient.EXPECT().StreamIndexedAttestations(gomock.Any(), gomock.Any()).Return(stream, nil)
+	stream.EXPECT().Recv().Return(att, nil)
+	stream.EXPECT().Recv().DoAndReturn(func() (*ethpb.IndexedAttestation, error) {
+		cancel()
+		return nil, context.Canceled
+	})
+
+	attsChan := make(chan *ethpb.IndexedAttestation, 1)
+	sub := attsFeed.Subscribe(attsChan)
+	defer sub.Unsubscribe()
+	s.receiveAttestations()
+	assert.DeepEqual(t, att, <-attsChan)
+}
+
+func Test_blockHeader(t *testing.T) {
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Slot = 3
+	b.Block.ProposerIndex = 7
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	want, err := wsb.Header()
+	require.NoError(t, err)
+
+	header, err := blockHeader(&ethpb.StreamBlocksResponse{
+		Block: &ethpb.StreamBlocksResponse_BellatrixBlock{BellatrixBlock: b},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, header)
+
+	_, err = blockHeader(&ethpb.StreamBlocksResponse{})
+	assert.ErrorContains(t, "unknown block type", err)
+}
@@ -0,0 +1,108 @@
+package slasher
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/sirupsen/logrus"
+)
+
+// Archives the evidence of a detected slashing, with the outcome of its submission to
+// the operations pool of the beacon node.
+func (s *Service) archiveSlashingEvidence(ctx context.Context, evidence *slashertypes.SlashingEvidence, submitErr error) {
+	if submitErr != nil {
+		evidence.Status = slashertypes.EvidenceRejected
+	}
+	evidence.Source = s.serviceCfg.EvidenceSource
+	if evidence.Source == "" {
+		evidence.Source = LocalEvidenceSource
+	}
+	if err := s.serviceCfg.Database.SaveSlashingEvidence(
+		ctx, []*slashertypes.SlashingEvidence{evidence},
+	); err != nil {
+		log.WithError(err).Error("Could not archive slashing evidence")
+	}
+}
+
+// Marks the pending slashing evidence whose offending validators were all slashed on
+// chain as included, checking the validators of all pending evidence at once. Evidence
+// still pending once its conflicting messages are out of the history of slasher is
+// marked as expired, as the beacon node has most likely dropped the slashing by then.
+func (s *Service) updateSlashingEvidenceStatuses(ctx context.Context, currentEpoch types.Epoch) error {
+	evidence, err := s.serviceCfg.Database.SlashingEvidence(ctx, &slashertypes.EvidenceFilter{
+		EndEpoch: params.BeaconConfig().FarFutureEpoch,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not get slashing evidence")
+	}
+	pending := make([]*slashertypes.SlashingEvidence, 0)
+	indices := make([]types.ValidatorIndex, 0)
+	seen := make(map[types.ValidatorIndex]bool)
+	for _, e := range evidence {
+		if e.Status != slashertypes.EvidencePending {
+			continue
+		}
+		pending = append(pending, e)
+		for _, idx := range e.ValidatorIndices() {
+			if !seen[idx] {
+				seen[idx] = true
+				indices = append(indices, idx)
+			}
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	slashed, err := s.beaconNode().SlashedValidators(ctx, indices)
+	if err != nil {
+		return errors.Wrap(err, "could not get slashed validators")
+	}
+	for _, e := range pending {
+		status := slashertypes.EvidenceIncluded
+		for _, idx := range e.ValidatorIndices() {
+			if !slashed[idx] {
+				status = slashertypes.EvidencePending
+				break
+			}
+		}
+		if status == slashertypes.EvidencePending && s.evidenceExpired(e, currentEpoch) {
+			status = slashertypes.EvidenceExpired
+		}
+		if status == slashertypes.EvidencePending {
+			continue
+		}
+		root, err := e.Root()
+		if err != nil {
+			return err
+		}
+		if err := s.serviceCfg.Database.UpdateSlashingEvidenceStatus(ctx, root, status); err != nil {
+			return errors.Wrap(err, "could not update slashing evidence status")
+		}
+		logFields := log.WithFields(logrus.Fields{
+			"offense":          e.Offense,
+			"validatorIndices": e.ValidatorIndices(),
+			"sinceDetection":   time.Since(e.DetectedAt),
+		})
+		if status == slashertypes.EvidenceIncluded {
+			logFields.Info("Detected slashing was included on chain")
+		} else {
+			logFields.Warn("Detected slashing expired before being included on chain")
+		}
+	}
+	return nil
+}
+
+// Evidence expires once the latest of its conflicting messages is out of the history of slasher.
+func (s *Service) evidenceExpired(e *slashertypes.SlashingEvidence, currentEpoch types.Epoch) bool {
+	var latest types.Epoch
+	for _, epoch := range e.Epochs() {
+		if epoch > latest {
+			latest = epoch
+		}
+	}
+	return latest+s.params.historyLength < currentEpoch
+}
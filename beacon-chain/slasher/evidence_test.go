@@ -0,0 +1,130 @@
+package slasher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+// fakeBeaconNode rejects the slashings of the rejected proposers, and reports the
+// slashed validators as slashed.
+type fakeBeaconNode struct {
+	rejected     map[types.ValidatorIndex]bool
+	slashed      map[types.ValidatorIndex]bool
+	slashedCalls int
+}
+
+func (_ *fakeBeaconNode) NumValidators(_ context.Context) (uint64, error) {
+	return 0, nil
+}
+
+func (_ *fakeBeaconNode) HeadSlot(_ context.Context) (types.Slot, error) {
+	return 0, nil
+}
+
+func (n *fakeBeaconNode) SlashedValidators(
+	_ context.Context, indices []types.ValidatorIndex,
+) (map[types.ValidatorIndex]bool, error) {
+	n.slashedCalls++
+	slashed := make(map[types.ValidatorIndex]bool)
+	for _, idx := range indices {
+		if n.slashed[idx] {
+			slashed[idx] = true
+		}
+	}
+	return slashed, nil
+}
+
+func (_ *fakeBeaconNode) VerifyAttestationSignature(_ context.Context, _ *ethpb.IndexedAttestation) error {
+	return nil
+}
+
+func (_ *fakeBeaconNode) VerifyBlockSignature(_ context.Context, _ *ethpb.SignedBeaconBlockHeader) error {
+	return nil
+}
+
+func (_ *fakeBeaconNode) SubmitAttesterSlashing(_ context.Context, _ *ethpb.AttesterSlashing) error {
+	return nil
+}
+
+func (n *fakeBeaconNode) SubmitProposerSlashing(_ context.Context, slashing *ethpb.ProposerSlashing) error {
+	if n.rejected[slashing.Header_1.Header.ProposerIndex] {
+		return errors.New("rejected")
+	}
+	return nil
+}
+
+func TestService_SlashingEvidence(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconNode := &fakeBeaconNode{
+		rejected: map[types.ValidatorIndex]bool{2: true},
+		slashed:  make(map[types.ValidatorIndex]bool),
+	}
+	slasherParams, err := NewParams(4, 256, 8)
+	require.NoError(t, err)
+	s := &Service{
+		params: slasherParams,
+		serviceCfg: &ServiceConfig{
+			Database:       slasherDB,
+			BeaconNode:     beaconNode,
+			EvidenceSource: "127.0.0.1:4000",
+		},
+	}
+	proposerSlashing := func(slot types.Slot, proposer types.ValidatorIndex) *ethpb.ProposerSlashing {
+		return &ethpb.ProposerSlashing{
+			Header_1: createProposalWrapper(t, slot, proposer, []byte{1}).SignedBeaconBlockHeader,
+			Header_2: createProposalWrapper(t, slot, proposer, []byte{2}).SignedBeaconBlockHeader,
+		}
+	}
+	require.NoError(t, s.processProposerSlashings(ctx, []*ethpb.ProposerSlashing{
+		proposerSlashing(1, 1),
+		proposerSlashing(params.BeaconConfig().SlotsPerEpoch*2, 2),
+	}))
+	require.NoError(t, s.processAttesterSlashings(ctx, []*ethpb.AttesterSlashing{{
+		Attestation_1: createAttestationWrapper(t, 1, 3, []uint64{3, 4}, []byte{1}).IndexedAttestation,
+		Attestation_2: createAttestationWrapper(t, 0, 4, []uint64{3, 4}, []byte{2}).IndexedAttestation,
+	}}))
+
+	// Validators 3 and 4 need to be slashed for the attester slashing to be included.
+	beaconNode.slashed[1] = true
+	beaconNode.slashed[2] = true
+	beaconNode.slashed[3] = true
+	require.NoError(t, s.updateSlashingEvidenceStatuses(ctx, 4))
+	assert.Equal(t, 1, beaconNode.slashedCalls)
+
+	allEpochs := &slashertypes.EvidenceFilter{EndEpoch: params.BeaconConfig().FarFutureEpoch}
+	statuses := func() map[slashertypes.SlashableOffense][]slashertypes.EvidenceStatus {
+		evidence, err := s.SlashingEvidence(ctx, allEpochs)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(evidence))
+		statuses := make(map[slashertypes.SlashableOffense][]slashertypes.EvidenceStatus)
+		for _, e := range evidence {
+			assert.Equal(t, "127.0.0.1:4000", e.Source)
+			statuses[e.Offense] = append(statuses[e.Offense], e.Status)
+		}
+		return statuses
+	}
+	got := statuses()
+	assert.DeepEqual(t, []slashertypes.EvidenceStatus{
+		slashertypes.EvidenceIncluded, slashertypes.EvidenceRejected,
+	}, got[slashertypes.DoubleProposal])
+	assert.DeepEqual(t, []slashertypes.EvidenceStatus{slashertypes.EvidencePending}, got[slashertypes.SurroundVote])
+
+	// Only pending evidence is checked again, until it is out of the history of slasher.
+	require.NoError(t, s.updateSlashingEvidenceStatuses(ctx, 4+8))
+	assert.DeepEqual(t, []slashertypes.EvidenceStatus{slashertypes.EvidencePending}, statuses()[slashertypes.SurroundVote])
+	require.NoError(t, s.updateSlashingEvidenceStatuses(ctx, 4+9))
+	assert.DeepEqual(t, []slashertypes.EvidenceStatus{slashertypes.EvidenceExpired}, statuses()[slashertypes.SurroundVote])
+	assert.Equal(t, 3, beaconNode.slashedCalls)
+	require.NoError(t, s.updateSlashingEvidenceStatuses(ctx, 4+10))
+	assert.Equal(t, 3, beaconNode.slashedCalls)
+}
@@ -0,0 +1,166 @@
+package slasher
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/sirupsen/logrus"
+)
+
+// migrateChunkParameters re-chunks the min and max spans stored on disk when they were
+// written with parameters other than the ones slasher runs with. Spans are kept for the
+// epochs within both the old and the new history length of the current epoch, older
+// ones being dropped. Databases which do not store any parameters were written with
+// the default ones.
+func (s *Service) migrateChunkParameters(ctx context.Context, numValidators uint64, currentEpoch types.Epoch) error {
+	stored, err := s.serviceCfg.Database.ChunkParameters(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get stored chunk parameters")
+	}
+	oldParams := DefaultParams()
+	if stored != nil {
+		oldParams = paramsFromChunkParameters(stored)
+	}
+	if *oldParams == *s.params {
+		if stored == nil {
+			return s.serviceCfg.Database.SaveChunkParameters(ctx, s.params.chunkParameters())
+		}
+		return nil
+	}
+	start := time.Now()
+	log.WithFields(logrus.Fields{
+		"oldChunkSize":          oldParams.chunkSize,
+		"oldValidatorChunkSize": oldParams.validatorChunkSize,
+		"oldHistoryLength":      oldParams.historyLength,
+		"chunkSize":             s.params.chunkSize,
+		"validatorChunkSize":    s.params.validatorChunkSize,
+		"historyLength":         s.params.historyLength,
+	}).Info("Slasher parameters changed, migrating min and max spans. This may take a while")
+	if err := s.serviceCfg.Database.ResetChunkMigration(ctx); err != nil {
+		return errors.Wrap(err, "could not reset chunk migration")
+	}
+	m := &chunkMigration{
+		oldParams:    oldParams,
+		newParams:    s.params,
+		currentEpoch: currentEpoch,
+		window:       oldParams.historyLength,
+	}
+	if s.params.historyLength < m.window {
+		m.window = s.params.historyLength
+	}
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		for validatorChunkIdx := uint64(0); validatorChunkIdx*s.params.validatorChunkSize < numValidators; validatorChunkIdx++ {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := m.migrateValidatorChunk(ctx, s.serviceCfg.Database, kind, validatorChunkIdx); err != nil {
+				return errors.Wrapf(err, "could not migrate validator chunk %d", validatorChunkIdx)
+			}
+		}
+	}
+	if err := s.serviceCfg.Database.CommitChunkMigration(ctx, s.params.chunkParameters()); err != nil {
+		return errors.Wrap(err, "could not commit chunk migration")
+	}
+	log.WithField("elapsed", time.Since(start)).Info("Finished migrating min and max spans")
+	return nil
+}
+
+type chunkMigration struct {
+	oldParams    *Parameters
+	newParams    *Parameters
+	currentEpoch types.Epoch
+	// Number of epochs up to the current one for which spans are migrated.
+	window types.Epoch
+}
+
+// migrateValidatorChunk writes the chunks of the validators in a validator chunk of the new
+// parameters, reading their spans from the chunks written with the old parameters.
+func (m *chunkMigration) migrateValidatorChunk(
+	ctx context.Context, database db.SlasherDatabase, kind slashertypes.ChunkKind, validatorChunkIdx uint64,
+) error {
+	validators := m.newParams.validatorIndicesInChunk(validatorChunkIdx)
+	oldChunks := make(map[uint64][][]uint16)
+	found := false
+	for _, idx := range validators {
+		oldValidatorChunkIdx := m.oldParams.validatorChunkIndex(idx)
+		if _, ok := oldChunks[oldValidatorChunkIdx]; ok {
+			continue
+		}
+		width := uint64(m.oldParams.historyLength.Div(m.oldParams.chunkSize))
+		keys := make([][]byte, width)
+		for chunkIdx := uint64(0); chunkIdx < width; chunkIdx++ {
+			keys[chunkIdx] = m.oldParams.flatSliceID(oldValidatorChunkIdx, chunkIdx)
+		}
+		chunks, exists, err := database.LoadSlasherChunks(ctx, kind, keys)
+		if err != nil {
+			return err
+		}
+		for i := range chunks {
+			if !exists[i] {
+				chunks[i] = nil
+				continue
+			}
+			found = true
+		}
+		oldChunks[oldValidatorChunkIdx] = chunks
+	}
+	// Missing chunks are filled with neutral elements when loaded.
+	if !found {
+		return nil
+	}
+
+	width := uint64(m.newParams.historyLength.Div(m.newParams.chunkSize))
+	keys := make([][]byte, 0, width)
+	newChunks := make([][]uint16, 0, width)
+	for chunkIdx := uint64(0); chunkIdx < width; chunkIdx++ {
+		var chunk []uint16
+		if kind == slashertypes.MinSpan {
+			chunk = EmptyMinSpanChunksSlice(m.newParams).Chunk()
+		} else {
+			chunk = EmptyMaxSpanChunksSlice(m.newParams).Chunk()
+		}
+		written := false
+		for _, idx := range validators {
+			oldValidatorChunk := oldChunks[m.oldParams.validatorChunkIndex(idx)]
+			for offset := uint64(0); offset < m.newParams.chunkSize; offset++ {
+				epoch, ok := m.epochAt(chunkIdx*m.newParams.chunkSize + offset)
+				if !ok {
+					continue
+				}
+				oldChunk := oldValidatorChunk[m.oldParams.chunkIndex(epoch)]
+				if oldChunk == nil {
+					continue
+				}
+				chunk[m.newParams.cellIndex(idx, epoch)] = oldChunk[m.oldParams.cellIndex(idx, epoch)]
+				written = true
+			}
+		}
+		if !written {
+			continue
+		}
+		keys = append(keys, m.newParams.flatSliceID(validatorChunkIdx, chunkIdx))
+		newChunks = append(newChunks, chunk)
+	}
+	return database.SaveMigratedSlasherChunks(ctx, kind, keys, newChunks)
+}
+
+// epochAt returns the epoch stored at the given position of the new spans, if it is
+// within the migrated window.
+func (m *chunkMigration) epochAt(position uint64) (types.Epoch, bool) {
+	base := m.currentEpoch - m.currentEpoch.Mod(uint64(m.newParams.historyLength))
+	epoch := base.Add(position)
+	if epoch > m.currentEpoch {
+		if base < m.newParams.historyLength {
+			return 0, false
+		}
+		epoch -= m.newParams.historyLength
+	}
+	if m.currentEpoch-epoch >= m.window {
+		return 0, false
+	}
+	return epoch, true
+}
@@ -0,0 +1,111 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+// testSpanDistance is the span stored for a validator at an epoch in migration tests.
+func testSpanDistance(idx types.ValidatorIndex, epoch types.Epoch) uint16 {
+	return uint16(100*uint64(idx) + uint64(epoch) + 1)
+}
+
+func TestService_migrateChunkParameters(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	numValidators := uint64(5)
+	currentEpoch := types.Epoch(10)
+	oldParams := &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 8}
+	newParams := &Parameters{chunkSize: 3, validatorChunkSize: 3, historyLength: 6}
+
+	// Write the spans of the last 8 epochs with the old parameters.
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		for validatorChunkIdx := uint64(0); validatorChunkIdx < 3; validatorChunkIdx++ {
+			var keys [][]byte
+			var chunks [][]uint16
+			for chunkIdx := uint64(0); chunkIdx < 4; chunkIdx++ {
+				chunk := EmptyMinSpanChunksSlice(oldParams).Chunk()
+				if kind == slashertypes.MaxSpan {
+					chunk = EmptyMaxSpanChunksSlice(oldParams).Chunk()
+				}
+				for _, idx := range oldParams.validatorIndicesInChunk(validatorChunkIdx) {
+					if uint64(idx) >= numValidators {
+						continue
+					}
+					for epoch := currentEpoch - 7; epoch <= currentEpoch; epoch++ {
+						if oldParams.chunkIndex(epoch) == chunkIdx {
+							chunk[oldParams.cellIndex(idx, epoch)] = testSpanDistance(idx, epoch)
+						}
+					}
+				}
+				keys = append(keys, oldParams.flatSliceID(validatorChunkIdx, chunkIdx))
+				chunks = append(chunks, chunk)
+			}
+			require.NoError(t, slasherDB.SaveSlasherChunks(ctx, kind, keys, chunks))
+		}
+	}
+	require.NoError(t, slasherDB.SaveChunkParameters(ctx, oldParams.chunkParameters()))
+
+	s, err := New(ctx, &ServiceConfig{Database: slasherDB, Params: newParams})
+	require.NoError(t, err)
+	require.NoError(t, s.migrateChunkParameters(ctx, numValidators, currentEpoch))
+
+	stored, err := slasherDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, newParams.chunkParameters(), stored)
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		neutral := EmptyMinSpanChunksSlice(newParams).NeutralElement()
+		if kind == slashertypes.MaxSpan {
+			neutral = EmptyMaxSpanChunksSlice(newParams).NeutralElement()
+		}
+		for idx := types.ValidatorIndex(0); uint64(idx) < numValidators; idx++ {
+			// The new history only fits the last 6 epochs.
+			for epoch := currentEpoch - 5; epoch <= currentEpoch; epoch++ {
+				key := newParams.flatSliceID(newParams.validatorChunkIndex(idx), newParams.chunkIndex(epoch))
+				chunks, exists, err := slasherDB.LoadSlasherChunks(ctx, kind, [][]byte{key})
+				require.NoError(t, err)
+				require.Equal(t, true, exists[0])
+				assert.Equal(t, testSpanDistance(idx, epoch), chunks[0][newParams.cellIndex(idx, epoch)])
+			}
+		}
+		// Validator 5 is in the second validator chunk but has no spans.
+		key := newParams.flatSliceID(1, newParams.chunkIndex(currentEpoch))
+		chunks, exists, err := slasherDB.LoadSlasherChunks(ctx, kind, [][]byte{key})
+		require.NoError(t, err)
+		require.Equal(t, true, exists[0])
+		assert.Equal(t, neutral, chunks[0][newParams.cellIndex(5, currentEpoch)])
+		// Chunks of the old layout were removed.
+		_, exists, err = slasherDB.LoadSlasherChunks(ctx, kind, [][]byte{oldParams.flatSliceID(2, 3)})
+		require.NoError(t, err)
+		assert.Equal(t, false, exists[0])
+	}
+}
+
+func TestService_migrateChunkParameters_NoStoredParameters(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s, err := New(ctx, &ServiceConfig{Database: slasherDB})
+	require.NoError(t, err)
+
+	// Parameters are stored on the first run with the default ones.
+	require.NoError(t, s.migrateChunkParameters(ctx, 10, 5))
+	stored, err := slasherDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, DefaultParams().chunkParameters(), stored)
+
+	// Nothing is written for validators without any span.
+	s.params = &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 4}
+	require.NoError(t, s.migrateChunkParameters(ctx, 10, 5))
+	_, exists, err := slasherDB.LoadSlasherChunks(ctx, slashertypes.MinSpan, [][]byte{s.params.flatSliceID(0, 0)})
+	require.NoError(t, err)
+	assert.Equal(t, false, exists[0])
+	stored, err = slasherDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, s.params.chunkParameters(), stored)
+}
@@ -3,6 +3,7 @@ package mock
 import (
 	"context"
 
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
@@ -12,6 +13,7 @@ type MockSlashingChecker struct {
 	AttesterSlashingFound bool
 	ProposerSlashingFound bool
 	HighestAtts           map[types.ValidatorIndex]*ethpb.HighestAttestation
+	Evidence              []*slashertypes.SlashingEvidence
 }
 
 func (s *MockSlashingChecker) HighestAttestations(
@@ -71,3 +73,15 @@ func (s *MockSlashingChecker) IsSlashableAttestation(_ context.Context, _ *ethpb
 	}
 	return nil, nil
 }
+
+func (s *MockSlashingChecker) SlashingEvidence(
+	_ context.Context, filter *slashertypes.EvidenceFilter,
+) ([]*slashertypes.SlashingEvidence, error) {
+	evidence := make([]*slashertypes.SlashingEvidence, 0, len(s.Evidence))
+	for _, e := range s.Evidence {
+		if filter.Matches(e) {
+			evidence = append(evidence, e)
+		}
+	}
+	return evidence, nil
+}
@@ -0,0 +1,240 @@
+// Package node is the main process which handles the lifecycle of the runtime
+// services in a standalone slasher process, gracefully shutting everything down
+// upon close.
+package node
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db/slasherkv"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/beaconclient"
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	beaconflags "github.com/prysmaticlabs/prysm/v3/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v3/cmd/slasher/flags"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/monitoring/prometheus"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/runtime"
+	"github.com/prysmaticlabs/prysm/v3/runtime/debug"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Directory within the data directory the slasher database is stored in.
+const slasherDbDirName = "slasherdata"
+
+// SlasherNode defines a standalone slasher process, detecting slashable offenses among
+// the attestations and blocks received by a beacon node it connects to over gRPC.
+type SlasherNode struct {
+	cliCtx   *cli.Context
+	ctx      context.Context
+	cancel   context.CancelFunc
+	db       *slasherkv.Store
+	conn     *grpc.ClientConn
+	services *runtime.ServiceRegistry // Lifecycle and service store.
+	lock     sync.RWMutex
+	stop     chan struct{} // Channel to wait for termination notifications.
+}
+
+// New creates a new standalone slasher from the command line flags.
+func New(cliCtx *cli.Context) (*SlasherNode, error) {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		if err := params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name), nil); err != nil {
+			return nil, err
+		}
+	}
+	slasherParams, err := slasher.NewParams(
+		cliCtx.Uint64(beaconflags.SlasherChunkSize.Name),
+		cliCtx.Uint64(beaconflags.SlasherValidatorChunkSize.Name),
+		types.Epoch(cliCtx.Uint64(beaconflags.SlasherHistoryLength.Name)),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid slasher parameters")
+	}
+
+	ctx, cancel := context.WithCancel(cliCtx.Context)
+	node := &SlasherNode{
+		cliCtx:   cliCtx,
+		ctx:      ctx,
+		cancel:   cancel,
+		services: runtime.NewServiceRegistry(),
+		stop:     make(chan struct{}),
+	}
+	if err := node.startDB(); err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := node.dialBeaconNode(); err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := node.registerSlasherService(slasherParams); err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := node.registerRPCService(); err != nil {
+		cancel()
+		return nil, err
+	}
+	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
+		if err := node.registerPrometheusService(); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// Start every service in the slasher process.
+func (n *SlasherNode) Start() {
+	n.lock.Lock()
+
+	log.WithFields(logrus.Fields{
+		"version":  version.Version(),
+		"endpoint": n.cliCtx.String(flags.BeaconRPCProviderFlag.Name),
+	}).Info("Starting standalone slasher")
+
+	n.services.StartAll()
+
+	stop := n.stop
+	n.lock.Unlock()
+
+	go func() {
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+		defer signal.Stop(sigc)
+		<-sigc
+		log.Info("Got interrupt, shutting down...")
+		debug.Exit(n.cliCtx) // Ensure trace and CPU profile data are flushed.
+		go n.Close()
+		for i := 10; i > 0; i-- {
+			<-sigc
+			if i > 1 {
+				log.WithField("times", i-1).Info("Already shutting down, interrupt more to panic.")
+			}
+		}
+		panic("Panic closing the slasher")
+	}()
+
+	// Wait for stop channel to be closed.
+	<-stop
+}
+
+// Close handles graceful shutdown of the system.
+func (n *SlasherNode) Close() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	log.Info("Stopping standalone slasher")
+	n.services.StopAll()
+	if err := n.db.Close(); err != nil {
+		log.WithError(err).Error("Failed to close database")
+	}
+	if err := n.conn.Close(); err != nil {
+		log.WithError(err).Error("Failed to close connection to beacon node")
+	}
+	n.cancel()
+	close(n.stop)
+}
+
+func (n *SlasherNode) startDB() error {
+	dbPath := filepath.Join(n.cliCtx.String(cmd.DataDirFlag.Name), slasherDbDirName)
+	log.WithField("database-path", dbPath).Info("Checking DB")
+	d, err := slasherkv.NewKVStore(n.ctx, dbPath)
+	if err != nil {
+		return err
+	}
+	n.db = d
+	return nil
+}
+
+func (n *SlasherNode) dialBeaconNode() error {
+	var transportSecurity grpc.DialOption
+	if cert := n.cliCtx.String(flags.CertFlag.Name); cert != "" {
+		creds, err := credentials.NewClientTLSFromFile(cert, "")
+		if err != nil {
+			return errors.Wrap(err, "could not get valid credentials")
+		}
+		transportSecurity = grpc.WithTransportCredentials(creds)
+	} else {
+		transportSecurity = grpc.WithInsecure()
+		log.Warn("You are using an insecure gRPC connection. If you are running your beacon node and " +
+			"slasher on the same machines, you can ignore this message. If you want to know " +
+			"how to enable secure connections, see: https://docs.prylabs.network/docs/prysm-usage/secure-grpc")
+	}
+	endpoint := n.cliCtx.String(flags.BeaconRPCProviderFlag.Name)
+	conn, err := grpc.DialContext(
+		n.ctx,
+		endpoint,
+		transportSecurity,
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(n.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name))),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "could not dial beacon node at %s", endpoint)
+	}
+	n.conn = conn
+	return nil
+}
+
+func (n *SlasherNode) registerPrometheusService() error {
+	service := prometheus.NewService(
+		fmt.Sprintf("%s:%d", n.cliCtx.String(cmd.MonitoringHostFlag.Name), n.cliCtx.Int(flags.MonitoringPortFlag.Name)),
+		n.services,
+	)
+	logrus.AddHook(prometheus.NewLogrusCollector())
+	return n.services.RegisterService(service)
+}
+
+func (n *SlasherNode) registerSlasherService(slasherParams *slasher.Parameters) error {
+	indexedAttsFeed := new(event.Feed)
+	blockHeadersFeed := new(event.Feed)
+	beaconNode := beaconclient.New(n.ctx, &beaconclient.Config{
+		BeaconChainClient:         ethpb.NewBeaconChainClient(n.conn),
+		NodeClient:                ethpb.NewNodeClient(n.conn),
+		BeaconNodeValidatorClient: ethpb.NewBeaconNodeValidatorClient(n.conn),
+		IndexedAttestationsFeed:   indexedAttsFeed,
+		BeaconBlockHeadersFeed:    blockHeadersFeed,
+	})
+	if err := n.services.RegisterService(beaconNode); err != nil {
+		return err
+	}
+	slasherSrv, err := slasher.New(n.ctx, &slasher.ServiceConfig{
+		IndexedAttestationsFeed: indexedAttsFeed,
+		BeaconBlockHeadersFeed:  blockHeadersFeed,
+		Database:                n.db,
+		StateNotifier:           beaconNode,
+		SyncChecker:             beaconNode,
+		BeaconNode:              beaconNode,
+		Params:                  slasherParams,
+		EvidenceSource:          n.cliCtx.String(flags.BeaconRPCProviderFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	return n.services.RegisterService(slasherSrv)
+}
+
+func (n *SlasherNode) registerRPCService() error {
+	var slasherService *slasher.Service
+	if err := n.services.FetchService(&slasherService); err != nil {
+		return err
+	}
+	return n.services.RegisterService(newRPCService(
+		n.cliCtx.String(flags.RPCHost.Name),
+		n.cliCtx.Int(flags.RPCPort.Name),
+		slasherService,
+	))
+}
@@ -0,0 +1,64 @@
+package node
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	slasherrpc "github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/slasher"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// rpcService serves the slasher gRPC API of the standalone slasher, from which the
+// archived slashing evidence can be queried.
+type rpcService struct {
+	address    string
+	checker    slasher.SlashingChecker
+	listener   net.Listener
+	grpcServer *grpc.Server
+}
+
+func newRPCService(host string, port int, checker slasher.SlashingChecker) *rpcService {
+	return &rpcService{
+		address:    fmt.Sprintf("%s:%d", host, port),
+		checker:    checker,
+		grpcServer: grpc.NewServer(),
+	}
+}
+
+// Start listening for gRPC requests.
+func (s *rpcService) Start() {
+	lis, err := net.Listen("tcp", s.address)
+	if err != nil {
+		log.WithError(err).Errorf("Could not listen to port in Start() %s", s.address)
+		return
+	}
+	s.listener = lis
+	log.WithField("address", s.address).Info("gRPC server listening on port")
+	ethpb.RegisterSlasherServer(s.grpcServer, &slasherrpc.Server{SlashingChecker: s.checker})
+	reflection.Register(s.grpcServer)
+	go func() {
+		if err := s.grpcServer.Serve(lis); err != nil {
+			log.WithError(err).Error("Could not serve gRPC")
+		}
+	}()
+}
+
+// Stop the gRPC server.
+func (s *rpcService) Stop() error {
+	if s.listener != nil {
+		s.grpcServer.GracefulStop()
+	}
+	return nil
+}
+
+// Status returns an error if the gRPC server is not listening.
+func (s *rpcService) Status() error {
+	if s.listener == nil {
+		return errors.New("not listening")
+	}
+	return nil
+}
@@ -1,7 +1,11 @@
 package slasher
 
 import (
+	"math"
+
+	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 )
 
@@ -38,6 +42,42 @@ func DefaultParams() *Parameters {
 	}
 }
 
+// NewParams returns slasher parameters with the given chunk size, validator chunk size
+// and history length. The history length must be a multiple of the chunk size and fit
+// the span distances, which are stored as uint16.
+func NewParams(chunkSize, validatorChunkSize uint64, historyLength types.Epoch) (*Parameters, error) {
+	if chunkSize == 0 || validatorChunkSize == 0 || historyLength == 0 {
+		return nil, errors.New("slasher parameters must be greater than 0")
+	}
+	if uint64(historyLength)%chunkSize != 0 {
+		return nil, errors.Errorf("history length %d is not a multiple of chunk size %d", historyLength, chunkSize)
+	}
+	if historyLength > math.MaxUint16 {
+		return nil, errors.Errorf("history length %d is greater than %d", historyLength, math.MaxUint16)
+	}
+	return &Parameters{
+		chunkSize:          chunkSize,
+		validatorChunkSize: validatorChunkSize,
+		historyLength:      historyLength,
+	}, nil
+}
+
+func paramsFromChunkParameters(cp *slashertypes.ChunkParameters) *Parameters {
+	return &Parameters{
+		chunkSize:          cp.ChunkSize,
+		validatorChunkSize: cp.ValidatorChunkSize,
+		historyLength:      cp.HistoryLength,
+	}
+}
+
+func (p *Parameters) chunkParameters() *slashertypes.ChunkParameters {
+	return &slashertypes.ChunkParameters{
+		ChunkSize:          p.chunkSize,
+		ValidatorChunkSize: p.validatorChunkSize,
+		HistoryLength:      p.historyLength,
+	}
+}
+
 // Validator min and max spans are split into chunks of length C = chunkSize.
 // That is, if we are keeping N epochs worth of attesting history, finding what
 // chunk a certain epoch, e, falls into can be computed as (e % N) / C. For example,
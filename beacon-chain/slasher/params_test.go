@@ -7,6 +7,7 @@ import (
 	ssz "github.com/prysmaticlabs/fastssz"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
 )
 
 func TestDefaultParams(t *testing.T) {
@@ -16,6 +17,19 @@ func TestDefaultParams(t *testing.T) {
 	assert.Equal(t, true, def.historyLength > 0)
 }
 
+func TestNewParams(t *testing.T) {
+	p, err := NewParams(16, 256, 4096)
+	require.NoError(t, err)
+	assert.DeepEqual(t, DefaultParams(), p)
+
+	_, err = NewParams(0, 256, 4096)
+	assert.ErrorContains(t, "must be greater than 0", err)
+	_, err = NewParams(16, 256, 4097)
+	assert.ErrorContains(t, "not a multiple of chunk size", err)
+	_, err = NewParams(1, 256, 1<<16)
+	assert.ErrorContains(t, "is greater than", err)
+}
+
 func TestParams_cellIndex(t *testing.T) {
 	type args struct {
 		validatorIndex types.ValidatorIndex
@@ -175,11 +175,19 @@ func (s *Service) pruneSlasherData(ctx context.Context, slotTicker <-chan types.
 	for {
 		select {
 		case <-slotTicker:
-			headEpoch := slots.ToEpoch(s.serviceCfg.HeadStateFetcher.HeadSlot())
+			headSlot, err := s.beaconNode().HeadSlot(ctx)
+			if err != nil {
+				log.WithError(err).Error("Could not get head slot")
+				continue
+			}
+			headEpoch := slots.ToEpoch(headSlot)
 			if err := s.pruneSlasherDataWithinSlidingWindow(ctx, headEpoch); err != nil {
 				log.WithError(err).Error("Could not prune slasher data")
 				continue
 			}
+			if err := s.updateSlashingEvidenceStatuses(ctx, headEpoch); err != nil {
+				log.WithError(err).Error("Could not update slashing evidence statuses")
+			}
 		case <-ctx.Done():
 			return
 		}
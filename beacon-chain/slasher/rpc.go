@@ -77,3 +77,14 @@ func (s *Service) IsSlashableAttestation(
 	}
 	return attesterSlashings, nil
 }
+
+// SlashingEvidence archived for the slashings detected by slasher, selected by the filter.
+func (s *Service) SlashingEvidence(
+	ctx context.Context, filter *slashertypes.EvidenceFilter,
+) ([]*slashertypes.SlashingEvidence, error) {
+	evidence, err := s.serviceCfg.Database.SlashingEvidence(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get slashing evidence from database")
+	}
+	return evidence, nil
+}
@@ -14,6 +14,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/slashings"
+	slashertypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
@@ -39,8 +40,20 @@ type ServiceConfig struct {
 	SlashingPoolInserter    slashings.PoolInserter
 	HeadStateFetcher        blockchain.HeadFetcher
 	SyncChecker             sync.Checker
+	// Params for slashing detection, DefaultParams are used if nil.
+	Params *Parameters
+	// BeaconNode slasher runs against when standalone. If nil, the in-process chain,
+	// state and slashings pool services above are used.
+	BeaconNode BeaconNode
+	// EvidenceSource names the beacon node the slashable messages are received from in the
+	// archived slashing evidence, LocalEvidenceSource is used if empty.
+	EvidenceSource string
 }
 
+// LocalEvidenceSource is the source of the slashing evidence detected among the messages of
+// the beacon node slasher runs in.
+const LocalEvidenceSource = "local"
+
 // SlashingChecker is an interface for defining services that the beacon node may interact with to provide slashing data.
 type SlashingChecker interface {
 	IsSlashableBlock(ctx context.Context, proposal *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error)
@@ -48,6 +61,9 @@ type SlashingChecker interface {
 	HighestAttestations(
 		ctx context.Context, indices []types.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	SlashingEvidence(
+		ctx context.Context, filter *slashertypes.EvidenceFilter,
+	) ([]*slashertypes.SlashingEvidence, error)
 }
 
 // Service defining a slasher implementation as part of
@@ -71,8 +87,12 @@ type Service struct {
 // New instantiates a new slasher from configuration values.
 func New(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	p := srvCfg.Params
+	if p == nil {
+		p = DefaultParams()
+	}
 	return &Service{
-		params:                         DefaultParams(),
+		params:                         p,
 		serviceCfg:                     srvCfg,
 		indexedAttsChan:                make(chan *ethpb.IndexedAttestation, 1),
 		beaconBlockHeadersChan:         make(chan *ethpb.SignedBeaconBlockHeader, 1),
@@ -97,14 +117,18 @@ func (s *Service) run() {
 	log.Info("Completed chain sync, starting slashing detection")
 
 	// Get the latest epoch written for each validator from disk on startup.
-	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(s.ctx)
+	numVals, err := s.beaconNode().NumValidators(s.ctx)
 	if err != nil {
-		log.WithError(err).Error("Failed to fetch head state")
+		log.WithError(err).Error("Failed to fetch number of validators")
+		return
+	}
+	currentEpoch := slots.ToEpoch(slots.CurrentSlot(uint64(s.genesisTime.Unix())))
+	if err := s.migrateChunkParameters(s.ctx, numVals, currentEpoch); err != nil {
+		log.WithError(err).Error("Could not migrate slasher chunks to new parameters")
 		return
 	}
-	numVals := headState.NumValidators()
 	validatorIndices := make([]types.ValidatorIndex, numVals)
-	for i := 0; i < numVals; i++ {
+	for i := uint64(0); i < numVals; i++ {
 		validatorIndices[i] = types.ValidatorIndex(i)
 	}
 	start := time.Now()
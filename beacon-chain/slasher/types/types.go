@@ -1,8 +1,12 @@
 package types
 
 import (
+	"time"
+
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
 )
 
 // ChunkKind to differentiate what kind of span we are working
@@ -14,6 +18,14 @@ const (
 	MaxSpan
 )
 
+// ChunkParameters are the parameters the min and max span chunks of slasher
+// are laid out on disk with.
+type ChunkParameters struct {
+	ChunkSize          uint64
+	ValidatorChunkSize uint64
+	HistoryLength      types.Epoch
+}
+
 // IndexedAttestationWrapper contains an indexed attestation with its
 // signing root to reduce duplicated computation.
 type IndexedAttestationWrapper struct {
@@ -51,3 +63,171 @@ type AttestedEpochForValidator struct {
 	ValidatorIndex types.ValidatorIndex
 	Epoch          types.Epoch
 }
+
+// SlashableOffense is the kind of offense a slashing was detected for.
+type SlashableOffense uint8
+
+const (
+	DoubleVote SlashableOffense = iota
+	SurroundVote
+	DoubleProposal
+)
+
+// String returns the name of the offense.
+func (o SlashableOffense) String() string {
+	switch o {
+	case DoubleVote:
+		return "double_vote"
+	case SurroundVote:
+		return "surround_vote"
+	case DoubleProposal:
+		return "double_proposal"
+	default:
+		return "unknown"
+	}
+}
+
+// EvidenceStatus tracks a detected slashing until its inclusion on chain.
+type EvidenceStatus uint8
+
+const (
+	// EvidencePending slashings were submitted to the operations pool of the beacon node.
+	EvidencePending EvidenceStatus = iota
+	// EvidenceIncluded slashings have all their offending validators slashed on chain.
+	EvidenceIncluded
+	// EvidenceRejected slashings were not accepted in the operations pool of the beacon node.
+	EvidenceRejected
+	// EvidenceExpired slashings were still pending once their conflicting messages left the
+	// history of slasher.
+	EvidenceExpired
+)
+
+// String returns the name of the status.
+func (s EvidenceStatus) String() string {
+	switch s {
+	case EvidencePending:
+		return "pending"
+	case EvidenceIncluded:
+		return "included"
+	case EvidenceRejected:
+		return "rejected"
+	case EvidenceExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// SlashingEvidence is the archived record of a slashing detected by slasher, holding
+// either an attester or a proposer slashing with its conflicting messages.
+type SlashingEvidence struct {
+	Offense          SlashableOffense
+	Status           EvidenceStatus
+	DetectedAt       time.Time
+	Source           string
+	AttesterSlashing *ethpb.AttesterSlashing
+	ProposerSlashing *ethpb.ProposerSlashing
+}
+
+// NewAttesterSlashingEvidence for an attester slashing detected at the given time.
+func NewAttesterSlashingEvidence(slashing *ethpb.AttesterSlashing, detectedAt time.Time) *SlashingEvidence {
+	offense := SurroundVote
+	if slashing.Attestation_1.Data.Target.Epoch == slashing.Attestation_2.Data.Target.Epoch {
+		offense = DoubleVote
+	}
+	return &SlashingEvidence{
+		Offense:          offense,
+		DetectedAt:       detectedAt,
+		AttesterSlashing: slashing,
+	}
+}
+
+// NewProposerSlashingEvidence for a proposer slashing detected at the given time.
+func NewProposerSlashingEvidence(slashing *ethpb.ProposerSlashing, detectedAt time.Time) *SlashingEvidence {
+	return &SlashingEvidence{
+		Offense:          DoubleProposal,
+		DetectedAt:       detectedAt,
+		ProposerSlashing: slashing,
+	}
+}
+
+// Root is the hash tree root of the slashing, identifying the evidence.
+func (e *SlashingEvidence) Root() ([32]byte, error) {
+	if e.ProposerSlashing != nil {
+		return e.ProposerSlashing.HashTreeRoot()
+	}
+	if e.AttesterSlashing != nil {
+		return e.AttesterSlashing.HashTreeRoot()
+	}
+	return [32]byte{}, errors.New("evidence has no slashing")
+}
+
+// ValidatorIndices of the validators the slashing is for, which are the attesters of
+// both conflicting attestations, or the proposer of both conflicting blocks.
+func (e *SlashingEvidence) ValidatorIndices() []types.ValidatorIndex {
+	if e.ProposerSlashing != nil {
+		return []types.ValidatorIndex{e.ProposerSlashing.Header_1.Header.ProposerIndex}
+	}
+	if e.AttesterSlashing == nil {
+		return nil
+	}
+	attesters := make(map[uint64]bool, len(e.AttesterSlashing.Attestation_1.AttestingIndices))
+	for _, idx := range e.AttesterSlashing.Attestation_1.AttestingIndices {
+		attesters[idx] = true
+	}
+	indices := make([]types.ValidatorIndex, 0)
+	for _, idx := range e.AttesterSlashing.Attestation_2.AttestingIndices {
+		if attesters[idx] {
+			indices = append(indices, types.ValidatorIndex(idx))
+		}
+	}
+	return indices
+}
+
+// Epochs of the conflicting messages, which are the target epochs of the attestations,
+// or the epoch of the blocks.
+func (e *SlashingEvidence) Epochs() []types.Epoch {
+	if e.ProposerSlashing != nil {
+		return []types.Epoch{slots.ToEpoch(e.ProposerSlashing.Header_1.Header.Slot)}
+	}
+	if e.AttesterSlashing == nil {
+		return nil
+	}
+	return []types.Epoch{
+		e.AttesterSlashing.Attestation_1.Data.Target.Epoch,
+		e.AttesterSlashing.Attestation_2.Data.Target.Epoch,
+	}
+}
+
+// EvidenceFilter selects the archived slashing evidence for any of the validator indices,
+// or for all validators if there are none, with conflicting messages within the epochs.
+type EvidenceFilter struct {
+	ValidatorIndices []types.ValidatorIndex
+	StartEpoch       types.Epoch
+	EndEpoch         types.Epoch
+}
+
+// Matches returns true if the evidence is selected by the filter.
+func (f *EvidenceFilter) Matches(e *SlashingEvidence) bool {
+	inRange := false
+	for _, epoch := range e.Epochs() {
+		if epoch >= f.StartEpoch && epoch <= f.EndEpoch {
+			inRange = true
+			break
+		}
+	}
+	if !inRange {
+		return false
+	}
+	if len(f.ValidatorIndices) == 0 {
+		return true
+	}
+	for _, idx := range e.ValidatorIndices() {
+		for _, wanted := range f.ValidatorIndices {
+			if idx == wanted {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -4,11 +4,9 @@ package sync
 
 import (
 	"context"
-	"time"
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/peer"
-	gcache "github.com/patrickmn/go-cache"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"google.golang.org/protobuf/proto"
 )
@@ -20,8 +18,7 @@ func NewRegularSyncFuzz(opts ...Option) *Service {
 		cfg:                  &config{},
 		ctx:                  ctx,
 		cancel:               cancel,
-		slotToPendingBlocks:  gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:    make(map[[32]byte]bool),
+		pendingBlocks:        newPendingBlockDAG(maxPendingBlocks),
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
 	}
 	r.rateLimiter = newRateLimiter(r.cfg.p2p)
@@ -90,6 +90,10 @@ type blocksQueue struct {
 type blocksQueueFetchedData struct {
 	pid    peer.ID
 	blocks []interfaces.SignedBeaconBlock
+	// roots of the blocks, computed ahead of processing when syncing to the finalized epoch.
+	roots [][32]byte
+	// rootsErr is the error computing the roots, the batch is not processed when set.
+	rootsErr error
 }
 
 // newBlocksQueue creates initialized priority queue.
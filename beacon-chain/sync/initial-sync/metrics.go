@@ -0,0 +1,26 @@
+package initialsync
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	syncBlocksProcessed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "initial_sync_blocks_processed_total",
+		Help: "The number of blocks handed over for processing during initial sync.",
+	})
+	syncBlocksPerSecond = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "initial_sync_blocks_per_second",
+		Help: "The average rate at which blocks are processed during initial sync.",
+	})
+	syncBatchStageTime = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "initial_sync_batch_stage_milliseconds",
+			Help: "Captures latency of the initial sync pipeline stages for a block batch in milliseconds: " +
+				"waiting for fetched blocks, computing their roots and processing them",
+			Buckets: []float64{1, 10, 50, 100, 250, 500, 1000, 2500, 5000, 10000},
+		},
+		[]string{"stage"},
+	)
+)
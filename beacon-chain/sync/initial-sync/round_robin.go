@@ -19,6 +19,8 @@ import (
 const (
 	// counterSeconds is an interval over which an average rate will be calculated.
 	counterSeconds = 20
+	// preparedBatchesBuffer is the number of batches prepared ahead of the one being processed.
+	preparedBatchesBuffer = 2
 )
 
 // blockReceiverFn defines block receiving function.
@@ -80,8 +82,12 @@ func (s *Service) syncToFinalizedEpoch(ctx context.Context, genesis time.Time) e
 		return err
 	}
 
-	for data := range queue.fetchedData {
+	// Roots of the upcoming batches are computed while the current batch is processed.
+	waitStart := time.Now()
+	for data := range prepareFetchedData(ctx, queue.fetchedData) {
+		syncBatchStageTime.WithLabelValues("wait").Observe(float64(time.Since(waitStart).Milliseconds()))
 		s.processFetchedData(ctx, genesis, s.cfg.Chain.HeadSlot(), data)
+		waitStart = time.Now()
 	}
 
 	log.WithFields(logrus.Fields{
@@ -128,9 +134,49 @@ func (s *Service) processFetchedData(
 	defer s.updatePeerScorerStats(data.pid, startSlot)
 
 	// Use Batch Block Verify to process and verify batches directly.
-	if err := s.processBatchedBlocks(ctx, genesis, data.blocks, s.cfg.Chain.ReceiveBlockBatch); err != nil {
+	if data.rootsErr != nil {
+		log.WithError(data.rootsErr).Warn("Skip processing batched blocks")
+		return
+	}
+	start := time.Now()
+	if err := s.processBatchedBlocks(ctx, genesis, data.blocks, data.roots, s.cfg.Chain.ReceiveBlockBatch); err != nil {
 		log.WithError(err).Warn("Skip processing batched blocks")
 	}
+	syncBatchStageTime.WithLabelValues("process").Observe(float64(time.Since(start).Milliseconds()))
+}
+
+// prepareFetchedData computes the block roots of the batches received from queue on a separate
+// goroutine, so that hashing the upcoming batches overlaps with processing the current one.
+func prepareFetchedData(ctx context.Context, fetched <-chan *blocksQueueFetchedData) <-chan *blocksQueueFetchedData {
+	prepared := make(chan *blocksQueueFetchedData, preparedBatchesBuffer)
+	go func() {
+		defer close(prepared)
+		for data := range fetched {
+			start := time.Now()
+			// A failure is handed over along with the batch, to be handled as any processing failure.
+			data.roots, data.rootsErr = batchBlockRoots(data.blocks)
+			syncBatchStageTime.WithLabelValues("prepare").Observe(float64(time.Since(start).Milliseconds()))
+			select {
+			case prepared <- data:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return prepared
+}
+
+// batchBlockRoots computes the roots of the given blocks.
+func batchBlockRoots(blks []interfaces.SignedBeaconBlock) ([][32]byte, error) {
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		root, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return nil, fmt.Errorf("could not compute root of block at slot %d: %w", b.Block().Slot(), err)
+		}
+		roots[i] = root
+	}
+	return roots, nil
 }
 
 // processFetchedData processes data received from queue.
@@ -187,6 +233,8 @@ func (s *Service) highestFinalizedEpoch() types.Epoch {
 func (s *Service) logSyncStatus(genesis time.Time, blk interfaces.BeaconBlock, blkRoot [32]byte) {
 	s.counter.Incr(1)
 	rate := float64(s.counter.Rate()) / counterSeconds
+	syncBlocksProcessed.Inc()
+	syncBlocksPerSecond.Set(rate)
 	if rate == 0 {
 		rate = 1
 	}
@@ -207,6 +255,8 @@ func (s *Service) logSyncStatus(genesis time.Time, blk interfaces.BeaconBlock, b
 func (s *Service) logBatchSyncStatus(genesis time.Time, blks []interfaces.SignedBeaconBlock, blkRoot [32]byte) {
 	s.counter.Incr(int64(len(blks)))
 	rate := float64(s.counter.Rate()) / counterSeconds
+	syncBlocksProcessed.Add(float64(len(blks)))
+	syncBlocksPerSecond.Set(rate)
 	if rate == 0 {
 		rate = 1
 	}
@@ -244,46 +294,37 @@ func (s *Service) processBlock(
 	return blockReceiver(ctx, blk, blkRoot)
 }
 
+// processBatchedBlocks checks the batch is linear and triggers the batch receiver function
+// on the blocks which are not processed yet. The block roots are expected to be computed
+// by the caller.
 func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
-	blks []interfaces.SignedBeaconBlock, bFunc batchBlockReceiverFn) error {
+	blks []interfaces.SignedBeaconBlock, blockRoots [][32]byte, bFunc batchBlockReceiverFn) error {
 	if len(blks) == 0 {
 		return errors.New("0 blocks provided into method")
 	}
-	firstBlock := blks[0]
-	blkRoot, err := firstBlock.Block().HashTreeRoot()
-	if err != nil {
-		return err
+	if len(blks) != len(blockRoots) {
+		return fmt.Errorf("got %d block roots for %d blocks", len(blockRoots), len(blks))
 	}
+	firstBlock := blks[0]
 	headSlot := s.cfg.Chain.HeadSlot()
-	for headSlot >= firstBlock.Block().Slot() && s.isProcessedBlock(ctx, firstBlock, blkRoot) {
+	for headSlot >= firstBlock.Block().Slot() && s.isProcessedBlock(ctx, firstBlock, blockRoots[0]) {
 		if len(blks) == 1 {
-			return fmt.Errorf("headSlot:%d, blockSlot:%d , root %#x:%w", headSlot, firstBlock.Block().Slot(), blkRoot, errBlockAlreadyProcessed)
+			return fmt.Errorf("headSlot:%d, blockSlot:%d , root %#x:%w", headSlot, firstBlock.Block().Slot(), blockRoots[0], errBlockAlreadyProcessed)
 		}
 		blks = blks[1:]
+		blockRoots = blockRoots[1:]
 		firstBlock = blks[0]
-		blkRoot, err = firstBlock.Block().HashTreeRoot()
-		if err != nil {
-			return err
-		}
 	}
-	s.logBatchSyncStatus(genesis, blks, blkRoot)
+	s.logBatchSyncStatus(genesis, blks, blockRoots[0])
 	parentRoot := firstBlock.Block().ParentRoot()
 	if !s.cfg.Chain.HasBlock(ctx, parentRoot) {
 		return fmt.Errorf("%w: %#x (in processBatchedBlocks, slot=%d)", errParentDoesNotExist, firstBlock.Block().ParentRoot(), firstBlock.Block().Slot())
 	}
-	blockRoots := make([][32]byte, len(blks))
-	blockRoots[0] = blkRoot
 	for i := 1; i < len(blks); i++ {
-		b := blks[i]
-		if b.Block().ParentRoot() != blockRoots[i-1] {
+		if blks[i].Block().ParentRoot() != blockRoots[i-1] {
 			return fmt.Errorf("expected linear block list with parent root of %#x but received %#x",
-				blockRoots[i-1][:], b.Block().ParentRoot())
-		}
-		blkRoot, err := b.Block().HashTreeRoot()
-		if err != nil {
-			return err
+				blockRoots[i-1][:], blks[i].Block().ParentRoot())
 		}
-		blockRoots[i] = blkRoot
 	}
 	return bFunc(ctx, blks, blockRoots)
 }
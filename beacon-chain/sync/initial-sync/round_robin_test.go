@@ -445,7 +445,7 @@ func TestService_processBlockBatch(t *testing.T) {
 		}
 
 		// Process block normally.
-		err = s.processBatchedBlocks(ctx, genesis, batch, func(
+		err = s.processBatchedBlocks(ctx, genesis, batch, testBatchRoots(t, batch), func(
 			ctx context.Context, blks []interfaces.SignedBeaconBlock, blockRoots [][32]byte) error {
 			assert.NoError(t, s.cfg.Chain.ReceiveBlockBatch(ctx, blks, blockRoots))
 			return nil
@@ -453,7 +453,7 @@ func TestService_processBlockBatch(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Duplicate processing should trigger error.
-		err = s.processBatchedBlocks(ctx, genesis, batch, func(
+		err = s.processBatchedBlocks(ctx, genesis, batch, testBatchRoots(t, batch), func(
 			ctx context.Context, blocks []interfaces.SignedBeaconBlock, blockRoots [][32]byte) error {
 			return nil
 		})
@@ -469,7 +469,7 @@ func TestService_processBlockBatch(t *testing.T) {
 		}
 
 		// Bad batch should fail because it is non linear
-		err = s.processBatchedBlocks(ctx, genesis, badBatch2, func(
+		err = s.processBatchedBlocks(ctx, genesis, badBatch2, testBatchRoots(t, badBatch2), func(
 			ctx context.Context, blks []interfaces.SignedBeaconBlock, blockRoots [][32]byte) error {
 			return nil
 		})
@@ -477,7 +477,7 @@ func TestService_processBlockBatch(t *testing.T) {
 		assert.ErrorContains(t, expectedSubErr, err)
 
 		// Continue normal processing, should proceed w/o errors.
-		err = s.processBatchedBlocks(ctx, genesis, batch2, func(
+		err = s.processBatchedBlocks(ctx, genesis, batch2, testBatchRoots(t, batch2), func(
 			ctx context.Context, blks []interfaces.SignedBeaconBlock, blockRoots [][32]byte) error {
 			assert.NoError(t, s.cfg.Chain.ReceiveBlockBatch(ctx, blks, blockRoots))
 			return nil
@@ -640,3 +640,32 @@ func TestService_syncToFinalizedEpoch(t *testing.T) {
 	assert.NoError(t, s.syncToFinalizedEpoch(context.Background(), genesis))
 	assert.LogsContain(t, hook, "Already synced to finalized epoch")
 }
+
+func testBatchRoots(t *testing.T, blks []interfaces.SignedBeaconBlock) [][32]byte {
+	roots, err := batchBlockRoots(blks)
+	require.NoError(t, err)
+	return roots
+}
+
+func TestPrepareFetchedData(t *testing.T) {
+	fetched := make(chan *blocksQueueFetchedData, 2)
+	var want [][32]byte
+	for i := types.Slot(1); i <= 2; i++ {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = i
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		want = append(want, root)
+		wsb, err := blocks.NewSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		fetched <- &blocksQueueFetchedData{blocks: []interfaces.SignedBeaconBlock{wsb}}
+	}
+	close(fetched)
+
+	var got [][32]byte
+	for data := range prepareFetchedData(context.Background(), fetched) {
+		require.Equal(t, len(data.blocks), len(data.roots))
+		got = append(got, data.roots...)
+	}
+	assert.DeepEqual(t, want, got)
+}
@@ -22,6 +22,24 @@ var (
 			Help: "The number of peers subscribed to a given topic.",
 		}, []string{"topic"},
 	)
+	subnetBackbonePeerCount = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "p2p_subnet_backbone_peer_count",
+			Help: "The number of peers on a subnet required by upcoming validator duties.",
+		}, []string{"kind", "subnet"},
+	)
+	subnetBackboneShortfall = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_subnet_backbone_shortfall_total",
+			Help: "Count of times a subnet required by upcoming duties had fewer than the minimum number of peers.",
+		}, []string{"kind"},
+	)
+	subnetBackboneSearches = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_subnet_backbone_searches_total",
+			Help: "Count of peer searches started for subnets required by validator duties.",
+		}, []string{"kind"},
+	)
 	subscribedTopicPeerCount = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "p2p_subscribed_topic_peer_total",
@@ -56,6 +74,36 @@ var (
 		},
 		[]string{"topic"},
 	)
+	slasherAttestationsQueueDepth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "slasher_unaggregated_attestations_queue_depth",
+			Help: "The number of validated unaggregated attestations waiting to be sent to slasher.",
+		},
+	)
+	slasherAttestationsDroppedCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "slasher_unaggregated_attestations_dropped_total",
+			Help: "Count of validated unaggregated attestations not sent to slasher because its queue was full.",
+		},
+	)
+	pendingBlocksCount = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "pending_blocks_queue_size",
+			Help: "The number of blocks waiting in the pending queue for their ancestors.",
+		},
+	)
+	pendingBlocksMissingAncestors = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "pending_blocks_missing_ancestors",
+			Help: "The number of unknown ancestors referenced by blocks in the pending queue.",
+		},
+	)
+	pendingBlocksEvicted = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "pending_blocks_evicted_total",
+			Help: "Count of times the pending queue was full and the pending block farthest from the chain was evicted.",
+		},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"github.com/prysmaticlabs/prysm/v3/async/event"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
 	blockfeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed/state"
@@ -122,6 +123,24 @@ func WithSlasherBlockHeadersFeed(slasherBlockHeadersFeed *event.Feed) Option {
 	}
 }
 
+// WithSlasherAttestationsQueueSize sends the unaggregated attestations validated from gossip
+// to slasher through a queue of the given size, instead of all of them before validation.
+func WithSlasherAttestationsQueueSize(size int) Option {
+	return func(s *Service) error {
+		s.cfg.slasherAttsQueueSize = size
+		return nil
+	}
+}
+
+// WithBlockTimingsCache records the arrival and gossip validation times of the blocks
+// accepted over gossip.
+func WithBlockTimingsCache(c *cache.BlockTimingsCache) Option {
+	return func(s *Service) error {
+		s.cfg.blockTimings = c
+		return nil
+	}
+}
+
 func WithExecutionPayloadReconstructor(r execution.ExecutionPayloadReconstructor) Option {
 	return func(s *Service) error {
 		s.cfg.executionPayloadReconstructor = r
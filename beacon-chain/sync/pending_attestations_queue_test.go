@@ -45,6 +45,7 @@ func TestProcessPendingAtts_NoBlockRequestBlock(t *testing.T) {
 	r := &Service{
 		cfg:                  &config{p2p: p1, beaconDB: db, chain: &mock.ChainService{Genesis: prysmTime.Now(), FinalizedCheckPoint: &ethpb.Checkpoint{}}},
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
+		pendingBlocks:        newPendingBlockDAG(maxPendingBlocks),
 		chainStarted:         abool.New(),
 	}
 
@@ -154,6 +155,7 @@ func TestProcessPendingAtts_NoBroadcastWithBadSignature(t *testing.T) {
 			attPool:  attestations.NewPool(),
 		},
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
+		pendingBlocks:        newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	priv, err := bls.RandKey()
@@ -341,6 +343,7 @@ func TestProcessPendingAtts_HasBlockSaveAggregatedAtt(t *testing.T) {
 func TestValidatePendingAtts_CanPruneOldAtts(t *testing.T) {
 	s := &Service{
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
+		pendingBlocks:        newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	// 100 Attestations per block root.
@@ -389,6 +392,7 @@ func TestValidatePendingAtts_CanPruneOldAtts(t *testing.T) {
 func TestValidatePendingAtts_NoDuplicatingAggregatorIndex(t *testing.T) {
 	s := &Service{
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
+		pendingBlocks:        newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	r1 := [32]byte{'A'}
@@ -0,0 +1,291 @@
+package sync
+
+import (
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+)
+
+// maxPendingBlocks is the maximum number of blocks held in the pending queue.
+const maxPendingBlocks = 512
+
+// maxPeersPerPendingBlock is the maximum number of peers remembered as senders of a pending block.
+const maxPeersPerPendingBlock = 4
+
+// pendingBlock is a block waiting in the pending queue until its parent has been processed.
+type pendingBlock struct {
+	block      interfaces.SignedBeaconBlock
+	root       [32]byte
+	parentRoot [32]byte
+	// peers that sent us this block, most recent last.
+	peers      []peer.ID
+	insertedAt time.Time
+}
+
+// missingAncestor is the request state of a block which is referenced as a parent by the
+// pending queue but is neither pending nor known to the node.
+type missingAncestor struct {
+	requestedAt time.Time
+	attempts    int
+}
+
+// pendingBlockDAG stores blocks which arrived before their parent, keyed by block root and
+// linked to their children, together with the set of missing ancestors needed to connect
+// them back to the chain. It holds at most maxBlocks blocks, evicting the tips of the
+// chains farthest from the chain when full.
+// Note: this type is not thread safe, callers must hold the service's pendingQueueLock.
+type pendingBlockDAG struct {
+	maxBlocks int
+	blocks    map[[32]byte]*pendingBlock
+	children  map[[32]byte]map[[32]byte]bool
+	slots     map[types.Slot]map[[32]byte]bool
+	missing   map[[32]byte]*missingAncestor
+}
+
+func newPendingBlockDAG(maxBlocks int) *pendingBlockDAG {
+	return &pendingBlockDAG{
+		maxBlocks: maxBlocks,
+		blocks:    make(map[[32]byte]*pendingBlock),
+		children:  make(map[[32]byte]map[[32]byte]bool),
+		slots:     make(map[types.Slot]map[[32]byte]bool),
+		missing:   make(map[[32]byte]*missingAncestor),
+	}
+}
+
+// len returns the number of pending blocks.
+func (d *pendingBlockDAG) len() int {
+	return len(d.blocks)
+}
+
+// has returns true if the block with the given root is pending.
+func (d *pendingBlockDAG) has(root [32]byte) bool {
+	_, ok := d.blocks[root]
+	return ok
+}
+
+// get returns the pending block with the given root, or nil if there is none.
+func (d *pendingBlockDAG) get(root [32]byte) *pendingBlock {
+	return d.blocks[root]
+}
+
+// insert adds a block received from pid to the DAG. If the block is already pending, pid is
+// only recorded as one of its senders. It returns false if the block was not added, which is
+// the case when the slot already holds maxBlocksPerSlot pending blocks, or when the DAG is
+// full and the block would be the first one to be evicted.
+func (d *pendingBlockDAG) insert(b interfaces.SignedBeaconBlock, root [32]byte, pid peer.ID, now time.Time) bool {
+	if pb, ok := d.blocks[root]; ok {
+		pb.addPeer(pid)
+		return true
+	}
+	slot := b.Block().Slot()
+	if len(d.slots[slot]) >= maxBlocksPerSlot {
+		return false
+	}
+	for d.len() >= d.maxBlocks && d.len() > 0 {
+		farthest := d.farthestLeaf()
+		if farthest == nil || slot >= farthest.block.Block().Slot() {
+			return false
+		}
+		pendingBlocksEvicted.Inc()
+		d.remove(farthest.root)
+	}
+
+	pb := &pendingBlock{
+		block:      b,
+		root:       root,
+		parentRoot: b.Block().ParentRoot(),
+		insertedAt: now,
+	}
+	pb.addPeer(pid)
+	d.blocks[root] = pb
+	if d.slots[slot] == nil {
+		d.slots[slot] = make(map[[32]byte]bool)
+	}
+	d.slots[slot][root] = true
+	if d.children[pb.parentRoot] == nil {
+		d.children[pb.parentRoot] = make(map[[32]byte]bool)
+	}
+	d.children[pb.parentRoot][root] = true
+	// The block is no longer missing now that it has been received.
+	delete(d.missing, root)
+	return true
+}
+
+// remove deletes a single block from the DAG. Its descendants are kept.
+func (d *pendingBlockDAG) remove(root [32]byte) *pendingBlock {
+	pb, ok := d.blocks[root]
+	if !ok {
+		return nil
+	}
+	delete(d.blocks, root)
+	slot := pb.block.Block().Slot()
+	delete(d.slots[slot], root)
+	if len(d.slots[slot]) == 0 {
+		delete(d.slots, slot)
+	}
+	delete(d.children[pb.parentRoot], root)
+	if len(d.children[pb.parentRoot]) == 0 {
+		delete(d.children, pb.parentRoot)
+	}
+	return pb
+}
+
+// removeWithDescendants deletes the block with the given root and every pending block
+// descending from it. The removed blocks are returned, the given root first when pending.
+func (d *pendingBlockDAG) removeWithDescendants(root [32]byte) []*pendingBlock {
+	var removed []*pendingBlock
+	queue := [][32]byte{root}
+	visited := map[[32]byte]bool{root: true}
+	for len(queue) > 0 {
+		r := queue[0]
+		queue = queue[1:]
+		for c := range d.children[r] {
+			if !visited[c] {
+				visited[c] = true
+				queue = append(queue, c)
+			}
+		}
+		if pb := d.remove(r); pb != nil {
+			removed = append(removed, pb)
+		}
+	}
+	return removed
+}
+
+// farthestLeaf returns the pending block farthest from the chain, which is evicted first when
+// the DAG is full: the leaf with the highest slot, the most recently received one on ties.
+// Chains waiting for their ancestors are thus trimmed from their tip, keeping the blocks
+// closest to being connected back to the chain.
+func (d *pendingBlockDAG) farthestLeaf() *pendingBlock {
+	var farthest *pendingBlock
+	for _, pb := range d.blocks {
+		if d.hasChildren(pb.root) {
+			continue
+		}
+		if farthest == nil {
+			farthest = pb
+			continue
+		}
+		slot, farthestSlot := pb.block.Block().Slot(), farthest.block.Block().Slot()
+		if slot > farthestSlot || (slot == farthestSlot && pb.insertedAt.After(farthest.insertedAt)) {
+			farthest = pb
+		}
+	}
+	return farthest
+}
+
+// blocksAtSlot returns the pending blocks of the given slot.
+func (d *pendingBlockDAG) blocksAtSlot(slot types.Slot) []*pendingBlock {
+	pbs := make([]*pendingBlock, 0, len(d.slots[slot]))
+	for r := range d.slots[slot] {
+		pbs = append(pbs, d.blocks[r])
+	}
+	sort.Slice(pbs, func(i, j int) bool {
+		return pbs[i].insertedAt.Before(pbs[j].insertedAt)
+	})
+	return pbs
+}
+
+// sortedSlots returns the slots of the pending blocks in ascending order.
+func (d *pendingBlockDAG) sortedSlots() []types.Slot {
+	ss := make([]types.Slot, 0, len(d.slots))
+	for slot := range d.slots {
+		ss = append(ss, slot)
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+	return ss
+}
+
+// markMissing records root as a missing ancestor of the pending blocks, unless it is pending.
+func (d *pendingBlockDAG) markMissing(root [32]byte) {
+	if d.has(root) {
+		return
+	}
+	if _, ok := d.missing[root]; !ok {
+		d.missing[root] = &missingAncestor{}
+	}
+}
+
+// isMissing returns true if root is tracked as a missing ancestor.
+func (d *pendingBlockDAG) isMissing(root [32]byte) bool {
+	_, ok := d.missing[root]
+	return ok
+}
+
+// missingRoots returns the roots of all missing ancestors.
+func (d *pendingBlockDAG) missingRoots() [][32]byte {
+	roots := make([][32]byte, 0, len(d.missing))
+	for r := range d.missing {
+		roots = append(roots, r)
+	}
+	return roots
+}
+
+// dueForRequest returns true if root has not been requested within the given period.
+func (d *pendingBlockDAG) dueForRequest(root [32]byte, now time.Time, period time.Duration) bool {
+	m, ok := d.missing[root]
+	if !ok || m.requestedAt.IsZero() {
+		return true
+	}
+	return now.Sub(m.requestedAt) >= period
+}
+
+// markRequested records a request for the missing ancestor root at the given time and
+// returns the number of times it has been requested.
+func (d *pendingBlockDAG) markRequested(root [32]byte, now time.Time) int {
+	m, ok := d.missing[root]
+	if !ok {
+		m = &missingAncestor{}
+		d.missing[root] = m
+	}
+	m.requestedAt = now
+	m.attempts++
+	return m.attempts
+}
+
+// dropMissing stops tracking root as a missing ancestor.
+func (d *pendingBlockDAG) dropMissing(root [32]byte) {
+	delete(d.missing, root)
+}
+
+// childPeers returns the peers which sent the pending children of root, most recent first.
+func (d *pendingBlockDAG) childPeers(root [32]byte) []peer.ID {
+	var pids []peer.ID
+	seen := make(map[peer.ID]bool)
+	for c := range d.children[root] {
+		pb := d.blocks[c]
+		for i := len(pb.peers) - 1; i >= 0; i-- {
+			if !seen[pb.peers[i]] {
+				seen[pb.peers[i]] = true
+				pids = append(pids, pb.peers[i])
+			}
+		}
+	}
+	return pids
+}
+
+// hasChildren returns true if there is a pending block with root as its parent.
+func (d *pendingBlockDAG) hasChildren(root [32]byte) bool {
+	return len(d.children[root]) > 0
+}
+
+func (pb *pendingBlock) addPeer(pid peer.ID) {
+	if pid == "" {
+		return
+	}
+	for i, p := range pb.peers {
+		if p == pid {
+			pb.peers = append(pb.peers[:i], pb.peers[i+1:]...)
+			break
+		}
+	}
+	pb.peers = append(pb.peers, pid)
+	if len(pb.peers) > maxPeersPerPendingBlock {
+		pb.peers = pb.peers[len(pb.peers)-maxPeersPerPendingBlock:]
+	}
+}
@@ -0,0 +1,133 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+// pendingChain builds a chain of n blocks starting at slot 1 on top of parent.
+func pendingChain(t *testing.T, parent [32]byte, n int) ([]interfaces.SignedBeaconBlock, [][32]byte) {
+	blks := make([]interfaces.SignedBeaconBlock, n)
+	roots := make([][32]byte, n)
+	for i := 0; i < n; i++ {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = types.Slot(i + 1)
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		blks[i], roots[i] = wsb, r
+		parent = r
+	}
+	return blks, roots
+}
+
+func TestPendingBlockDAG_InsertAndRemoveWithDescendants(t *testing.T) {
+	d := newPendingBlockDAG(maxPendingBlocks)
+	now := time.Now()
+	blks, roots := pendingChain(t, [32]byte{'a'}, 3)
+	for i, b := range blks {
+		require.Equal(t, true, d.insert(b, roots[i], "", now))
+	}
+	assert.Equal(t, 3, d.len())
+	assert.DeepEqual(t, []types.Slot{1, 2, 3}, d.sortedSlots())
+	assert.Equal(t, true, d.hasChildren([32]byte{'a'}))
+
+	// Duplicate insert only records the sender.
+	require.Equal(t, true, d.insert(blks[0], roots[0], "peer", now))
+	assert.Equal(t, 3, d.len())
+	assert.DeepEqual(t, []peer.ID{"peer"}, d.childPeers([32]byte{'a'}))
+
+	removed := d.removeWithDescendants(roots[1])
+	require.Equal(t, 2, len(removed))
+	assert.Equal(t, roots[1], removed[0].root)
+	assert.Equal(t, 1, d.len())
+	assert.Equal(t, true, d.has(roots[0]))
+	assert.Equal(t, false, d.hasChildren(roots[0]))
+	assert.DeepEqual(t, []types.Slot{1}, d.sortedSlots())
+}
+
+func TestPendingBlockDAG_MaxBlocksPerSlot(t *testing.T) {
+	d := newPendingBlockDAG(maxPendingBlocks)
+	b := util.NewBeaconBlock()
+	for i := 0; i < maxBlocksPerSlot+1; i++ {
+		b = ethpb.CopySignedBeaconBlock(b)
+		b.Block.StateRoot = []byte{byte(i)}
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		assert.Equal(t, i < maxBlocksPerSlot, d.insert(wsb, [32]byte{byte(i)}, "", time.Now()))
+	}
+	assert.Equal(t, maxBlocksPerSlot, len(d.blocksAtSlot(0)))
+}
+
+func TestPendingBlockDAG_EvictsFarthestLeaf(t *testing.T) {
+	d := newPendingBlockDAG(3)
+	now := time.Now()
+	blks, roots := pendingChain(t, [32]byte{'a'}, 4)
+	for i := 1; i < 4; i++ {
+		require.Equal(t, true, d.insert(blks[i], roots[i], "", now))
+	}
+
+	// The queue is full, a block farther from the chain than every pending one is not added.
+	otherBlks, otherRoots := pendingChain(t, [32]byte{'b'}, 4)
+	require.Equal(t, false, d.insert(otherBlks[3], otherRoots[3], "", now.Add(time.Second)))
+	assert.Equal(t, false, d.has(otherRoots[3]))
+
+	// A fetched ancestor evicts the tip of the chain, keeping the chain connected.
+	require.Equal(t, true, d.insert(blks[0], roots[0], "", now.Add(2*time.Second)))
+	assert.Equal(t, 3, d.len())
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, true, d.has(roots[i]))
+	}
+	assert.Equal(t, false, d.has(roots[3]))
+
+	// A block closer to the chain evicts the leaf with the highest slot.
+	require.Equal(t, true, d.insert(otherBlks[0], otherRoots[0], "", now.Add(3*time.Second)))
+	assert.Equal(t, false, d.has(roots[2]))
+	assert.Equal(t, true, d.has(otherRoots[0]))
+}
+
+func TestPendingBlockDAG_MissingAncestors(t *testing.T) {
+	d := newPendingBlockDAG(maxPendingBlocks)
+	now := time.Now()
+	blks, roots := pendingChain(t, [32]byte{'a'}, 2)
+	require.Equal(t, true, d.insert(blks[1], roots[1], "p1", now))
+
+	d.markMissing(roots[0])
+	assert.Equal(t, true, d.isMissing(roots[0]))
+	assert.Equal(t, true, d.dueForRequest(roots[0], now, time.Minute))
+	assert.Equal(t, 1, d.markRequested(roots[0], now))
+	assert.Equal(t, false, d.dueForRequest(roots[0], now.Add(time.Second), time.Minute))
+	assert.Equal(t, true, d.dueForRequest(roots[0], now.Add(time.Minute), time.Minute))
+	assert.DeepEqual(t, []peer.ID{"p1"}, d.childPeers(roots[0]))
+
+	// Receiving the missing block resolves it.
+	require.Equal(t, true, d.insert(blks[0], roots[0], "p2", now))
+	assert.Equal(t, false, d.isMissing(roots[0]))
+	assert.Equal(t, 0, len(d.missingRoots()))
+
+	// Pending blocks are never tracked as missing.
+	d.markMissing(roots[0])
+	assert.Equal(t, false, d.isMissing(roots[0]))
+}
+
+func TestPendingBlock_AddPeer(t *testing.T) {
+	pb := &pendingBlock{}
+	pb.addPeer("")
+	assert.Equal(t, 0, len(pb.peers))
+	for _, pid := range []peer.ID{"a", "b", "c", "d", "e", "b"} {
+		pb.addPeer(pid)
+	}
+	assert.DeepEqual(t, []peer.ID{"c", "d", "e", "b"}, pb.peers)
+}
@@ -3,10 +3,10 @@ package sync
 import (
 	"context"
 	"encoding/hex"
-	"sort"
 	"sync"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/async"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
@@ -17,8 +17,8 @@ import (
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/crypto/rand"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
-	"github.com/prysmaticlabs/prysm/v3/encoding/ssz/equality"
 	"github.com/prysmaticlabs/prysm/v3/monitoring/tracing"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	"github.com/sirupsen/logrus"
 	"github.com/trailofbits/go-mutexasserts"
@@ -31,6 +31,13 @@ const maxPeerRequest = 50
 const numOfTries = 5
 const maxBlocksPerSlot = 3
 
+// maxAncestorRequestRounds is the number of consecutive requests made to the same peer
+// when walking up the ancestor chain of a pending block.
+const maxAncestorRequestRounds = 8
+
+// missingAncestorRetryPeriod is the minimum time between two requests for the same missing ancestor.
+var missingAncestorRetryPeriod = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+
 // processes pending blocks queue on every processPendingBlocksPeriod
 func (s *Service) processPendingBlocksQueue() {
 	// Prevents multiple queue processing goroutines (invoked by RunEvery) from contending for data.
@@ -58,14 +65,12 @@ func (s *Service) processPendingBlocks(ctx context.Context) error {
 		return errors.Wrap(err, "could not validate pending slots")
 	}
 	ss := s.sortedPendingSlots()
-	var parentRoots [][32]byte
 
 	span.AddAttributes(
 		trace.Int64Attribute("numSlots", int64(len(ss))),
 		trace.Int64Attribute("numPeers", int64(len(pids))),
 	)
 
-	randGen := rand.NewGenerator()
 	for _, slot := range ss {
 		// process the blocks during their respective slot.
 		// otherwise wait for the right slot to process the block.
@@ -77,83 +82,58 @@ func (s *Service) processPendingBlocks(ctx context.Context) error {
 		span.AddAttributes(trace.Int64Attribute("slot", int64(slot))) // lint:ignore uintcast -- This conversion is OK for tracing.
 
 		s.pendingQueueLock.RLock()
-		bs := s.pendingBlocksInCache(slot)
+		pbs := s.pendingBlocks.blocksAtSlot(slot)
+		s.pendingQueueLock.RUnlock()
 		// Skip if there's no block in the queue.
-		if len(bs) == 0 {
-			s.pendingQueueLock.RUnlock()
+		if len(pbs) == 0 {
 			span.End()
 			continue
 		}
-		s.pendingQueueLock.RUnlock()
 
-		// Loop through the pending queue and mark the potential parent blocks as seen.
-		for _, b := range bs {
-			if b == nil || b.IsNil() || b.Block().IsNil() {
-				span.End()
-				continue
-			}
-
-			blkRoot, err := b.Block().HashTreeRoot()
-			if err != nil {
-				tracing.AnnotateError(span, err)
-				span.End()
-				return err
-			}
+		for _, pending := range pbs {
+			b, blkRoot := pending.block, pending.root
 
 			inDB := s.cfg.beaconDB.HasBlock(ctx, blkRoot)
 			// No need to process the same block twice.
 			if inDB {
 				s.pendingQueueLock.Lock()
-				if err = s.deleteBlockFromPendingQueue(slot, b, blkRoot); err != nil {
-					s.pendingQueueLock.Unlock()
-					return err
-				}
+				s.deleteBlockFromPendingQueue(blkRoot)
 				s.pendingQueueLock.Unlock()
 				span.End()
 				continue
 			}
 
-			s.pendingQueueLock.RLock()
-			inPendingQueue := s.seenPendingBlocks[b.Block().ParentRoot()]
-			s.pendingQueueLock.RUnlock()
-
-			keepProcessing, err := s.checkIfBlockIsBad(ctx, span, slot, b, blkRoot)
-			if err != nil {
-				return err
-			}
-			if !keepProcessing {
-				continue
-			}
-
-			parentInDb := s.cfg.beaconDB.HasBlock(ctx, b.Block().ParentRoot())
-			hasPeer := len(pids) != 0
-
-			// Only request for missing parent block if it's not in beaconDB, not in pending cache
-			// and has peer in the peer list.
-			parentRoot := b.Block().ParentRoot()
-			if !inPendingQueue && !parentInDb && hasPeer {
-				log.WithFields(logrus.Fields{
-					"currentSlot": b.Block().Slot(),
-					"parentRoot":  hex.EncodeToString(bytesutil.Trunc(parentRoot[:])),
-				}).Debug("Requesting parent block")
-				parentRoots = append(parentRoots, b.Block().ParentRoot())
-
+			if keepProcessing := s.checkIfBlockIsBad(ctx, b, blkRoot); !keepProcessing {
 				span.End()
 				continue
 			}
 
-			if !parentInDb {
+			// Wait for the parent if it is still pending, otherwise track it as a missing
+			// ancestor to be requested from the peers that sent us its descendants.
+			parentRoot := b.Block().ParentRoot()
+			if !s.cfg.beaconDB.HasBlock(ctx, parentRoot) {
+				s.pendingQueueLock.Lock()
+				if !s.pendingBlocks.has(parentRoot) && !s.pendingBlocks.isMissing(parentRoot) {
+					log.WithFields(logrus.Fields{
+						"currentSlot": b.Block().Slot(),
+						"parentRoot":  hex.EncodeToString(bytesutil.Trunc(parentRoot[:])),
+					}).Debug("Requesting parent block")
+				}
+				s.pendingBlocks.markMissing(parentRoot)
+				s.pendingQueueLock.Unlock()
 				span.End()
 				continue
 			}
 
-			err = s.validateBeaconBlock(ctx, b, blkRoot)
+			err := s.validateBeaconBlock(ctx, b, blkRoot)
 			switch {
 			case errors.Is(ErrOptimisticParent, err): // Ok to continue process block with parent that is an optimistic candidate.
 			case err != nil:
 				log.WithError(err).WithField("slot", b.Block().Slot()).Debug("Could not validate block")
 				s.setBadBlock(ctx, blkRoot)
 				tracing.AnnotateError(span, err)
+				// Drop the block together with its pending descendants.
+				s.checkIfBlockIsBad(ctx, b, blkRoot)
 				span.End()
 				continue
 			default:
@@ -170,8 +150,9 @@ func (s *Service) processPendingBlocks(ctx context.Context) error {
 				}
 				log.WithError(err).WithField("slot", b.Block().Slot()).Debug("Could not process block")
 
-				// In the next iteration of the queue, this block will be removed from
-				// the pending queue as it has been marked as a 'bad' block.
+				// Drop the block together with its pending descendants if it has been marked
+				// as a 'bad' block.
+				s.checkIfBlockIsBad(ctx, b, blkRoot)
 				span.End()
 				continue
 			}
@@ -189,10 +170,7 @@ func (s *Service) processPendingBlocks(ctx context.Context) error {
 			}
 
 			s.pendingQueueLock.Lock()
-			if err := s.deleteBlockFromPendingQueue(slot, b, blkRoot); err != nil {
-				s.pendingQueueLock.Unlock()
-				return err
-			}
+			s.deleteBlockFromPendingQueue(blkRoot)
 			s.pendingQueueLock.Unlock()
 
 			log.WithFields(logrus.Fields{
@@ -204,16 +182,25 @@ func (s *Service) processPendingBlocks(ctx context.Context) error {
 		}
 	}
 
-	return s.sendBatchRootRequest(ctx, parentRoots, randGen)
+	s.pendingQueueLock.RLock()
+	pendingBlocksCount.Set(float64(s.pendingBlocks.len()))
+	pendingBlocksMissingAncestors.Set(float64(len(s.pendingBlocks.missingRoots())))
+	s.pendingQueueLock.RUnlock()
+
+	if len(pids) == 0 {
+		return nil
+	}
+	return s.requestMissingAncestors(ctx, pids)
 }
 
+// checkIfBlockIsBad returns false if the block or its parent is a known bad block. In that
+// case the block is removed from the queue and all of its pending descendants are rejected
+// right away, as they can never become valid.
 func (s *Service) checkIfBlockIsBad(
 	ctx context.Context,
-	span *trace.Span,
-	slot types.Slot,
 	b interfaces.SignedBeaconBlock,
 	blkRoot [32]byte,
-) (keepProcessing bool, err error) {
+) (keepProcessing bool) {
 	parentIsBad := s.hasBadBlock(b.Block().ParentRoot())
 	blockIsBad := s.hasBadBlock(blkRoot)
 	// Check if parent is a bad block.
@@ -222,18 +209,127 @@ func (s *Service) checkIfBlockIsBad(
 		if parentIsBad {
 			s.setBadBlock(ctx, blkRoot)
 		}
-		// Remove block from queue.
+		// Remove block and its descendants from queue.
 		s.pendingQueueLock.Lock()
-		if err = s.deleteBlockFromPendingQueue(slot, b, blkRoot); err != nil {
-			s.pendingQueueLock.Unlock()
-			return false, err
-		}
+		removed := s.pendingBlocks.removeWithDescendants(blkRoot)
 		s.pendingQueueLock.Unlock()
-		span.End()
-		return false, nil
+		for _, pb := range removed {
+			if pb.root != blkRoot {
+				s.setBadBlock(ctx, pb.root)
+			}
+		}
+		return false
 	}
 
-	return true, nil
+	return true
+}
+
+// requestMissingAncestors requests the missing ancestors of the pending blocks. Each one is
+// requested from a connected peer which sent us one of its pending children, rotating through
+// them on retries, and is requested again at most once every missingAncestorRetryPeriod.
+// Ancestors which could not be fetched within numOfTries attempts are given up on, along with
+// their pending descendants. Ancestors without a connected sender are requested from randomly
+// chosen best peers instead.
+func (s *Service) requestMissingAncestors(ctx context.Context, connected []peer.ID) error {
+	ctx, span := trace.StartSpan(ctx, "requestMissingAncestors")
+	defer span.End()
+
+	isConnected := make(map[peer.ID]bool, len(connected))
+	for _, pid := range connected {
+		isConnected[pid] = true
+	}
+
+	now := prysmTime.Now()
+	rootsByPeer := make(map[peer.ID][][32]byte)
+	var orphanRoots [][32]byte
+	s.pendingQueueLock.Lock()
+	for _, r := range s.pendingBlocks.missingRoots() {
+		if !s.pendingBlocks.dueForRequest(r, now, missingAncestorRetryPeriod) {
+			continue
+		}
+		attempts := s.pendingBlocks.markRequested(r, now)
+		if attempts > numOfTries {
+			s.pendingBlocks.dropMissing(r)
+			removed := s.pendingBlocks.removeWithDescendants(r)
+			log.WithFields(logrus.Fields{
+				"root":          hex.EncodeToString(bytesutil.Trunc(r[:])),
+				"droppedBlocks": len(removed),
+			}).Debug("Could not fetch missing ancestor, dropping its pending descendants")
+			continue
+		}
+		var pids []peer.ID
+		for _, pid := range s.pendingBlocks.childPeers(r) {
+			if isConnected[pid] {
+				pids = append(pids, pid)
+			}
+		}
+		if len(pids) == 0 {
+			orphanRoots = append(orphanRoots, r)
+			continue
+		}
+		pid := pids[(attempts-1)%len(pids)]
+		rootsByPeer[pid] = append(rootsByPeer[pid], r)
+	}
+	s.pendingQueueLock.Unlock()
+
+	span.AddAttributes(
+		trace.Int64Attribute("numPeers", int64(len(rootsByPeer))),
+		trace.Int64Attribute("numOrphanRoots", int64(len(orphanRoots))),
+	)
+
+	var wg sync.WaitGroup
+	for pid, roots := range rootsByPeer {
+		wg.Add(1)
+		go func(pid peer.ID, roots [][32]byte) {
+			defer wg.Done()
+			s.requestAncestorChain(ctx, pid, roots)
+		}(pid, roots)
+	}
+	wg.Wait()
+
+	return s.sendBatchRootRequest(ctx, orphanRoots, rand.NewGenerator())
+}
+
+// requestAncestorChain requests the given roots from pid, then keeps walking up the chain
+// with the same peer by requesting the unknown parents of the blocks it returned, for at
+// most maxAncestorRequestRounds round trips.
+func (s *Service) requestAncestorChain(ctx context.Context, pid peer.ID, roots [][32]byte) {
+	for i := 0; i < maxAncestorRequestRounds && len(roots) > 0; i++ {
+		req := p2ptypes.BeaconBlockByRootsReq(roots)
+		if len(roots) > int(params.BeaconNetworkConfig().MaxRequestBlocks) {
+			req = roots[:params.BeaconNetworkConfig().MaxRequestBlocks]
+		}
+		if err := s.sendRecentBeaconBlocksRequest(ctx, &req, pid); err != nil {
+			log.WithError(err).WithField("peer", pid).Debug("Could not request missing ancestors")
+			return
+		}
+
+		var parents [][32]byte
+		s.pendingQueueLock.RLock()
+		for _, r := range req {
+			pb := s.pendingBlocks.get(r)
+			if pb == nil || s.pendingBlocks.has(pb.parentRoot) {
+				continue
+			}
+			parents = append(parents, pb.parentRoot)
+		}
+		s.pendingQueueLock.RUnlock()
+
+		// Stop at ancestors the node already knows about.
+		now := prysmTime.Now()
+		roots = make([][32]byte, 0, len(parents))
+		for _, r := range s.dedupRoots(parents) {
+			if s.hasBadBlock(r) || s.cfg.beaconDB.HasBlock(ctx, r) {
+				continue
+			}
+			s.pendingQueueLock.Lock()
+			if s.pendingBlocks.dueForRequest(r, now, missingAncestorRetryPeriod) {
+				s.pendingBlocks.markRequested(r, now)
+				roots = append(roots, r)
+			}
+			s.pendingQueueLock.Unlock()
+		}
+	}
 }
 
 func (s *Service) sendBatchRootRequest(ctx context.Context, roots [][32]byte, randGen *rand.Rand) error {
@@ -264,7 +360,7 @@ func (s *Service) sendBatchRootRequest(ctx context.Context, roots [][32]byte, ra
 		newRoots := make([][32]byte, 0, len(roots))
 		s.pendingQueueLock.RLock()
 		for _, rt := range roots {
-			if !s.seenPendingBlocks[rt] {
+			if !s.pendingBlocks.has(rt) {
 				newRoots = append(newRoots, rt)
 			}
 		}
@@ -284,164 +380,74 @@ func (s *Service) sortedPendingSlots() []types.Slot {
 	s.pendingQueueLock.RLock()
 	defer s.pendingQueueLock.RUnlock()
 
-	items := s.slotToPendingBlocks.Items()
-
-	ss := make([]types.Slot, 0, len(items))
-	for k := range items {
-		slot := cacheKeyToSlot(k)
-		ss = append(ss, slot)
-	}
-	sort.Slice(ss, func(i, j int) bool {
-		return ss[i] < ss[j]
-	})
-	return ss
+	return s.pendingBlocks.sortedSlots()
 }
 
 // validatePendingSlots validates the pending blocks
 // by their slot. If they are before the current finalized
-// checkpoint, these blocks are removed from the queue.
+// checkpoint, or have been waiting in the queue for longer
+// than pendingBlockExpTime, these blocks are removed from
+// the queue along with their descendants.
 func (s *Service) validatePendingSlots() error {
 	s.pendingQueueLock.Lock()
 	defer s.pendingQueueLock.Unlock()
-	oldBlockRoots := make(map[[32]byte]bool)
 
 	cp := s.cfg.chain.FinalizedCheckpt()
 	finalizedEpoch := cp.Epoch
-	if s.slotToPendingBlocks == nil {
-		return errors.New("slotToPendingBlocks cache can't be nil")
+	if s.pendingBlocks == nil {
+		return errors.New("pending blocks queue can't be nil")
 	}
-	items := s.slotToPendingBlocks.Items()
-	for k := range items {
-		slot := cacheKeyToSlot(k)
-		blks := s.pendingBlocksInCache(slot)
-		for _, b := range blks {
-			epoch := slots.ToEpoch(slot)
-			// remove all descendant blocks of old blocks
-			if oldBlockRoots[b.Block().ParentRoot()] {
-				root, err := b.Block().HashTreeRoot()
-				if err != nil {
-					return err
-				}
-				oldBlockRoots[root] = true
-				if err := s.deleteBlockFromPendingQueue(slot, b, root); err != nil {
-					return err
-				}
+	now := prysmTime.Now()
+	for _, slot := range s.pendingBlocks.sortedSlots() {
+		for _, pb := range s.pendingBlocks.blocksAtSlot(slot) {
+			// Already removed as the descendant of an old block.
+			if !s.pendingBlocks.has(pb.root) {
 				continue
 			}
+			epoch := slots.ToEpoch(slot)
+			expired := now.Sub(pb.insertedAt) > pendingBlockExpTime
 			// don't process old blocks
-			if finalizedEpoch > 0 && epoch <= finalizedEpoch {
-				blkRoot, err := b.Block().HashTreeRoot()
-				if err != nil {
-					return err
-				}
-				oldBlockRoots[blkRoot] = true
-				if err := s.deleteBlockFromPendingQueue(slot, b, blkRoot); err != nil {
-					return err
-				}
+			if expired || (finalizedEpoch > 0 && epoch <= finalizedEpoch) {
+				s.pendingBlocks.removeWithDescendants(pb.root)
 			}
 		}
 	}
+	// Forget missing ancestors no pending block is waiting for anymore.
+	for _, r := range s.pendingBlocks.missingRoots() {
+		if !s.pendingBlocks.hasChildren(r) {
+			s.pendingBlocks.dropMissing(r)
+		}
+	}
 	return nil
 }
 
 func (s *Service) clearPendingSlots() {
 	s.pendingQueueLock.Lock()
 	defer s.pendingQueueLock.Unlock()
-	s.slotToPendingBlocks.Flush()
-	s.seenPendingBlocks = make(map[[32]byte]bool)
+	s.pendingBlocks = newPendingBlockDAG(maxPendingBlocks)
 }
 
-// Delete block from the list from the pending queue using the slot as key.
+// Delete block from the pending queue using its root as key.
 // Note: this helper is not thread safe.
-func (s *Service) deleteBlockFromPendingQueue(slot types.Slot, b interfaces.SignedBeaconBlock, r [32]byte) error {
+func (s *Service) deleteBlockFromPendingQueue(r [32]byte) {
 	mutexasserts.AssertRWMutexLocked(&s.pendingQueueLock)
 
-	blks := s.pendingBlocksInCache(slot)
-	if len(blks) == 0 {
-		return nil
-	}
-
-	// Defensive check to ignore nil blocks
-	if err := blocks.BeaconBlockIsNil(b); err != nil {
-		return err
-	}
-
-	newBlks := make([]interfaces.SignedBeaconBlock, 0, len(blks))
-	for _, blk := range blks {
-		blkPb, err := blk.Proto()
-		if err != nil {
-			return err
-		}
-		bPb, err := b.Proto()
-		if err != nil {
-			return err
-		}
-		if equality.DeepEqual(blkPb, bPb) {
-			continue
-		}
-		newBlks = append(newBlks, blk)
-	}
-	if len(newBlks) == 0 {
-		s.slotToPendingBlocks.Delete(slotToCacheKey(slot))
-		delete(s.seenPendingBlocks, r)
-		return nil
-	}
-
-	// Decrease exp time in proportion to how many blocks are still in the cache for slot key.
-	d := pendingBlockExpTime / time.Duration(len(newBlks))
-	if err := s.slotToPendingBlocks.Replace(slotToCacheKey(slot), newBlks, d); err != nil {
-		return err
-	}
-	delete(s.seenPendingBlocks, r)
-	return nil
+	s.pendingBlocks.remove(r)
 }
 
-// Insert block to the list in the pending queue using the slot as key.
+// Insert block received from the given peer to the pending queue using its root as key.
+// Blocks descending from a known bad block are rejected right away.
 // Note: this helper is not thread safe.
-func (s *Service) insertBlockToPendingQueue(_ types.Slot, b interfaces.SignedBeaconBlock, r [32]byte) error {
+func (s *Service) insertBlockToPendingQueue(b interfaces.SignedBeaconBlock, r [32]byte, pid peer.ID) error {
 	mutexasserts.AssertRWMutexLocked(&s.pendingQueueLock)
 
-	if s.seenPendingBlocks[r] {
-		return nil
-	}
-
-	if err := s.addPendingBlockToCache(b); err != nil {
-		return err
-	}
-
-	s.seenPendingBlocks[r] = true
-	return nil
-}
-
-// This returns signed beacon blocks given input key from slotToPendingBlocks.
-func (s *Service) pendingBlocksInCache(slot types.Slot) []interfaces.SignedBeaconBlock {
-	k := slotToCacheKey(slot)
-	value, ok := s.slotToPendingBlocks.Get(k)
-	if !ok {
-		return []interfaces.SignedBeaconBlock{}
-	}
-	blks, ok := value.([]interfaces.SignedBeaconBlock)
-	if !ok {
-		return []interfaces.SignedBeaconBlock{}
-	}
-	return blks
-}
-
-// This adds input signed beacon block to slotToPendingBlocks cache.
-func (s *Service) addPendingBlockToCache(b interfaces.SignedBeaconBlock) error {
 	if err := blocks.BeaconBlockIsNil(b); err != nil {
 		return err
 	}
-
-	blks := s.pendingBlocksInCache(b.Block().Slot())
-
-	if len(blks) >= maxBlocksPerSlot {
+	if s.hasBadBlock(b.Block().ParentRoot()) || s.hasBadBlock(r) {
 		return nil
 	}
-
-	blks = append(blks, b)
-	k := slotToCacheKey(b.Block().Slot())
-	s.slotToPendingBlocks.Set(k, blks, pendingBlockExpTime)
+	s.pendingBlocks.insert(b, r, pid, prysmTime.Now())
 	return nil
 }
 
@@ -450,15 +456,3 @@ func (s *Service) addPendingBlockToCache(b interfaces.SignedBeaconBlock) error {
 func (s *Service) isGenesisTimeSet() bool {
 	return s.cfg.chain.GenesisTime().Unix() != 0
 }
-
-// This converts input string to slot.
-func cacheKeyToSlot(s string) types.Slot {
-	b := []byte(s)
-	return bytesutil.BytesToSlotBigEndian(b)
-}
-
-// This converts input slot to a key to be used for slotToPendingBlocks cache.
-func slotToCacheKey(s types.Slot) string {
-	b := bytesutil.SlotToBytesBigEndian(s)
-	return string(b)
-}
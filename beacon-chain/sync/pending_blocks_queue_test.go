@@ -10,7 +10,6 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/protocol"
-	gcache "github.com/patrickmn/go-cache"
 	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
@@ -25,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
@@ -50,8 +50,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks1(t *testing.T) {
 			},
 			stateGen: stategen.New(db, doublylinkedtree.New()),
 		},
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 	r.initCaches()
 
@@ -72,22 +71,22 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks1(t *testing.T) {
 	b2 := util.NewBeaconBlock()
 	b2.Block.Slot = 2
 	b2.Block.ParentRoot = b1Root[:]
-	b2Root, err := b1.Block.HashTreeRoot()
+	b2Root, err := b2.Block.HashTreeRoot()
 	require.NoError(t, err)
 
 	// Add b2 to the cache
 	wsb, err := blocks.NewSignedBeaconBlock(b2)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b2.Block.Slot, wsb, b2Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b2Root, ""))
 
 	require.NoError(t, r.processPendingBlocks(context.Background()))
-	assert.Equal(t, 1, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 1, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 1, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 1, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 
 	// Add b1 to the cache
 	wsb, err = blocks.NewSignedBeaconBlock(b1)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b1.Block.Slot, wsb, b1Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b1Root, ""))
 	util.SaveBlock(t, context.Background(), r.cfg.beaconDB, b1)
 
 	nBlock := util.NewBeaconBlock()
@@ -98,12 +97,12 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks1(t *testing.T) {
 	// Insert bad b1 in the cache to verify the good one doesn't get replaced.
 	wsb, err = blocks.NewSignedBeaconBlock(nBlock)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(nBlock.Block.Slot, wsb, nRoot))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, nRoot, ""))
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Marks a block as bad
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Bad block removed on second run
 
-	assert.Equal(t, 1, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 2, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 1, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 1, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 }
 
 func TestRegularSyncBeaconBlockSubscriber_OptimisticStatus(t *testing.T) {
@@ -122,8 +121,7 @@ func TestRegularSyncBeaconBlockSubscriber_OptimisticStatus(t *testing.T) {
 			},
 			stateGen: stategen.New(db, doublylinkedtree.New()),
 		},
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 	r.initCaches()
 
@@ -144,22 +142,22 @@ func TestRegularSyncBeaconBlockSubscriber_OptimisticStatus(t *testing.T) {
 	b2 := util.NewBeaconBlock()
 	b2.Block.Slot = 2
 	b2.Block.ParentRoot = b1Root[:]
-	b2Root, err := b1.Block.HashTreeRoot()
+	b2Root, err := b2.Block.HashTreeRoot()
 	require.NoError(t, err)
 
 	// Add b2 to the cache
 	wsb, err := blocks.NewSignedBeaconBlock(b2)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b2.Block.Slot, wsb, b2Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b2Root, ""))
 
 	require.NoError(t, r.processPendingBlocks(context.Background()))
-	assert.Equal(t, 1, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 1, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 1, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 1, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 
 	// Add b1 to the cache
 	wsb, err = blocks.NewSignedBeaconBlock(b1)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b1.Block.Slot, wsb, b1Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b1Root, ""))
 	util.SaveBlock(t, context.Background(), r.cfg.beaconDB, b1)
 
 	nBlock := util.NewBeaconBlock()
@@ -170,12 +168,12 @@ func TestRegularSyncBeaconBlockSubscriber_OptimisticStatus(t *testing.T) {
 	// Insert bad b1 in the cache to verify the good one doesn't get replaced.
 	wsb, err = blocks.NewSignedBeaconBlock(nBlock)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(nBlock.Block.Slot, wsb, nRoot))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, nRoot, ""))
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Marks a block as bad
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Bad block removed on second run
 
-	assert.Equal(t, 1, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 2, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 1, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 1, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 }
 
 func TestRegularSyncBeaconBlockSubscriber_ExecutionEngineTimesOut(t *testing.T) {
@@ -194,8 +192,7 @@ func TestRegularSyncBeaconBlockSubscriber_ExecutionEngineTimesOut(t *testing.T)
 			},
 			stateGen: stategen.New(db, doublylinkedtree.New()),
 		},
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 	r.initCaches()
 
@@ -216,22 +213,22 @@ func TestRegularSyncBeaconBlockSubscriber_ExecutionEngineTimesOut(t *testing.T)
 	b2 := util.NewBeaconBlock()
 	b2.Block.Slot = 2
 	b2.Block.ParentRoot = b1Root[:]
-	b2Root, err := b1.Block.HashTreeRoot()
+	b2Root, err := b2.Block.HashTreeRoot()
 	require.NoError(t, err)
 
 	// Add b2 to the cache
 	wsb, err := blocks.NewSignedBeaconBlock(b2)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b2.Block.Slot, wsb, b2Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b2Root, ""))
 
 	require.NoError(t, r.processPendingBlocks(context.Background()))
-	assert.Equal(t, 1, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 1, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 1, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 1, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 
 	// Add b1 to the cache
 	wsb, err = blocks.NewSignedBeaconBlock(b1)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b1.Block.Slot, wsb, b1Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b1Root, ""))
 	util.SaveBlock(t, context.Background(), r.cfg.beaconDB, b1)
 
 	nBlock := util.NewBeaconBlock()
@@ -242,12 +239,12 @@ func TestRegularSyncBeaconBlockSubscriber_ExecutionEngineTimesOut(t *testing.T)
 	// Insert bad b1 in the cache to verify the good one doesn't get replaced.
 	wsb, err = blocks.NewSignedBeaconBlock(nBlock)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(nBlock.Block.Slot, wsb, nRoot))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, nRoot, ""))
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Marks a block as bad
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Bad block removed on second run
 
-	assert.Equal(t, 1, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 2, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 1, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 1, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 	require.Equal(t, 1, len(r.badBlockCache.Keys())) // Account for the bad block above
 	require.Equal(t, 0, len(r.seenBlockCache.Keys()))
 }
@@ -267,8 +264,7 @@ func TestRegularSync_InsertDuplicateBlocks(t *testing.T) {
 				},
 			},
 		},
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 	r.initCaches()
 
@@ -284,25 +280,25 @@ func TestRegularSync_InsertDuplicateBlocks(t *testing.T) {
 
 	wsb, err := blocks.NewSignedBeaconBlock(b0)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b0.Block.Slot, wsb, b0r))
-	require.Equal(t, 1, len(r.pendingBlocksInCache(b0.Block.Slot)), "Block was not added to map")
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b0r, ""))
+	require.Equal(t, 1, len(r.pendingBlocks.blocksAtSlot(b0.Block.Slot)), "Block was not added to map")
 
 	wsb, err = blocks.NewSignedBeaconBlock(b1)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b1.Block.Slot, wsb, b1r))
-	require.Equal(t, 1, len(r.pendingBlocksInCache(b1.Block.Slot)), "Block was not added to map")
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b1r, ""))
+	require.Equal(t, 1, len(r.pendingBlocks.blocksAtSlot(b1.Block.Slot)), "Block was not added to map")
 
 	// Add duplicate block which should not be saved.
 	wsb, err = blocks.NewSignedBeaconBlock(b0)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b0.Block.Slot, wsb, b0r))
-	require.Equal(t, 1, len(r.pendingBlocksInCache(b0.Block.Slot)), "Block was added to map")
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b0r, ""))
+	require.Equal(t, 1, len(r.pendingBlocks.blocksAtSlot(b0.Block.Slot)), "Block was added to map")
 
 	// Add duplicate block which should not be saved.
 	wsb, err = blocks.NewSignedBeaconBlock(b1)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b1.Block.Slot, wsb, b1r))
-	require.Equal(t, 1, len(r.pendingBlocksInCache(b1.Block.Slot)), "Block was added to map")
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b1r, ""))
+	require.Equal(t, 1, len(r.pendingBlocks.blocksAtSlot(b1.Block.Slot)), "Block was added to map")
 
 }
 
@@ -321,8 +317,7 @@ func TestRegularSyncBeaconBlockSubscriber_DoNotReprocessBlock(t *testing.T) {
 			},
 			stateGen: stategen.New(db, doublylinkedtree.New()),
 		},
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 	r.initCaches()
 
@@ -341,11 +336,11 @@ func TestRegularSyncBeaconBlockSubscriber_DoNotReprocessBlock(t *testing.T) {
 	// Add b3 to the cache
 	wsb, err := blocks.NewSignedBeaconBlock(b3)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b3.Block.Slot, wsb, b3Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b3Root, ""))
 
 	require.NoError(t, r.processPendingBlocks(context.Background()))
-	assert.Equal(t, 0, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 0, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 0, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 0, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 }
 
 //    /- b1 - b2 - b5
@@ -386,8 +381,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks_2Chains(t *testin
 			},
 			stateGen: stategen.New(db, doublylinkedtree.New()),
 		},
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 	r.initCaches()
 
@@ -430,41 +424,41 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks_2Chains(t *testin
 
 	wsb, err := blocks.NewSignedBeaconBlock(b4)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b4.Block.Slot, wsb, b4Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b4Root, ""))
 	wsb, err = blocks.NewSignedBeaconBlock(b5)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b5.Block.Slot, wsb, b5Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b5Root, ""))
 
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Marks a block as bad
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Bad block removed on second run
 
-	assert.Equal(t, 2, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 2, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 2, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 2, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 
 	// Add b3 to the cache
 	wsb, err = blocks.NewSignedBeaconBlock(b3)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b3.Block.Slot, wsb, b3Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b3Root, ""))
 	util.SaveBlock(t, context.Background(), r.cfg.beaconDB, b3)
 
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Marks a block as bad
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Bad block removed on second run
 
-	assert.Equal(t, 1, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 1, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 1, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 1, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 
 	// Add b2 to the cache
 	wsb, err = blocks.NewSignedBeaconBlock(b2)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b2.Block.Slot, wsb, b2Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b2Root, ""))
 
 	util.SaveBlock(t, context.Background(), r.cfg.beaconDB, b2)
 
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Marks a block as bad
 	require.NoError(t, r.processPendingBlocks(context.Background())) // Bad block removed on second run
 
-	assert.Equal(t, 0, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 0, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 0, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 0, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 }
 
 func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
@@ -485,8 +479,7 @@ func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
 				},
 			},
 		},
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 	r.initCaches()
 
@@ -529,41 +522,41 @@ func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
 
 	wsb, err := blocks.NewSignedBeaconBlock(b2)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b2.Block.Slot, wsb, b2Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b2Root, ""))
 	wsb, err = blocks.NewSignedBeaconBlock(b3)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b3.Block.Slot, wsb, b3Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b3Root, ""))
 	wsb, err = blocks.NewSignedBeaconBlock(b4)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b4.Block.Slot, wsb, b4Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b4Root, ""))
 	wsb, err = blocks.NewSignedBeaconBlock(b5)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b5.Block.Slot, wsb, b5Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b5Root, ""))
 
 	require.NoError(t, r.processPendingBlocks(context.Background()))
-	assert.Equal(t, 0, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 0, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 0, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 0, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 }
 
 func TestService_sortedPendingSlots(t *testing.T) {
 	r := &Service{
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
+	r.initCaches()
 
 	var lastSlot types.Slot = math.MaxUint64
 	wsb, err := blocks.NewSignedBeaconBlock(util.HydrateSignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: lastSlot}}))
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(lastSlot, wsb, [32]byte{1}))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, [32]byte{1}, ""))
 	wsb, err = blocks.NewSignedBeaconBlock(util.HydrateSignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: lastSlot - 3}}))
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(lastSlot-3, wsb, [32]byte{2}))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, [32]byte{2}, ""))
 	wsb, err = blocks.NewSignedBeaconBlock(util.HydrateSignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: lastSlot - 5}}))
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(lastSlot-5, wsb, [32]byte{3}))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, [32]byte{3}, ""))
 	wsb, err = blocks.NewSignedBeaconBlock(util.HydrateSignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: lastSlot - 2}}))
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(lastSlot-2, wsb, [32]byte{4}))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, [32]byte{4}, ""))
 
 	want := []types.Slot{lastSlot - 5, lastSlot - 3, lastSlot - 2, lastSlot}
 	assert.DeepEqual(t, want, r.sortedPendingSlots(), "Unexpected pending slots list")
@@ -589,8 +582,7 @@ func TestService_BatchRootRequest(t *testing.T) {
 				Genesis:        time.Now(),
 			},
 		},
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 	r.initCaches()
 
@@ -657,15 +649,15 @@ func TestService_BatchRootRequest(t *testing.T) {
 	if util.WaitTimeout(&wg, 1*time.Second) {
 		t.Fatal("Did not receive stream within 1 sec")
 	}
-	assert.Equal(t, 4, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
-	assert.Equal(t, 4, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
+	assert.Equal(t, 4, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
+	assert.Equal(t, 4, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 }
 
 func TestService_AddPendingBlockToQueueOverMax(t *testing.T) {
 	r := &Service{
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
+	r.initCaches()
 
 	b := util.NewBeaconBlock()
 	b1 := ethpb.CopySignedBeaconBlock(b)
@@ -674,20 +666,20 @@ func TestService_AddPendingBlockToQueueOverMax(t *testing.T) {
 	b2.Block.StateRoot = []byte{'b'}
 	wsb, err := blocks.NewSignedBeaconBlock(b)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(0, wsb, [32]byte{}))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, [32]byte{}, ""))
 	wsb, err = blocks.NewSignedBeaconBlock(b1)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(0, wsb, [32]byte{1}))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, [32]byte{1}, ""))
 	wsb, err = blocks.NewSignedBeaconBlock(b2)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(0, wsb, [32]byte{2}))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, [32]byte{2}, ""))
 
 	b3 := ethpb.CopySignedBeaconBlock(b)
 	b3.Block.StateRoot = []byte{'c'}
 	wsb, err = blocks.NewSignedBeaconBlock(b2)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(0, wsb, [32]byte{3}))
-	require.Equal(t, maxBlocksPerSlot, len(r.pendingBlocksInCache(0)))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, [32]byte{3}, ""))
+	require.Equal(t, maxBlocksPerSlot, len(r.pendingBlocks.blocksAtSlot(0)))
 }
 
 func TestService_ProcessPendingBlockOnCorrectSlot(t *testing.T) {
@@ -706,8 +698,7 @@ func TestService_ProcessPendingBlockOnCorrectSlot(t *testing.T) {
 			chain:    &mockChain,
 			stateGen: stategen.New(db, doublylinkedtree.New()),
 		},
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 	r.initCaches()
 
@@ -752,20 +743,20 @@ func TestService_ProcessPendingBlockOnCorrectSlot(t *testing.T) {
 	// Add block1 for slot1
 	wsb, err := blocks.NewSignedBeaconBlock(b1)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b1.Block.Slot, wsb, b1Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b1Root, ""))
 	// Add block2 for slot2
 	wsb, err = blocks.NewSignedBeaconBlock(b2)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b2.Block.Slot, wsb, b2Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b2Root, ""))
 	// Add block3 for slot3
 	wsb, err = blocks.NewSignedBeaconBlock(b3)
 	require.NoError(t, err)
-	require.NoError(t, r.insertBlockToPendingQueue(b3.Block.Slot, wsb, b3Root))
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, b3Root, ""))
 
 	// processPendingBlocks should process only blocks of the current slot. i.e. slot 1.
 	// Then check if the other two blocks are still in the pendingQueue.
 	require.NoError(t, r.processPendingBlocks(context.Background()))
-	assert.Equal(t, 2, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
+	assert.Equal(t, 2, len(r.sortedPendingSlots()), "Incorrect number of pending slots")
 }
 
 func TestService_ProcessBadPendingBlocks(t *testing.T) {
@@ -784,8 +775,7 @@ func TestService_ProcessBadPendingBlocks(t *testing.T) {
 			chain:    &mockChain,
 			stateGen: stategen.New(db, doublylinkedtree.New()),
 		},
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 	r.initCaches()
 
@@ -822,9 +812,114 @@ func TestService_ProcessBadPendingBlocks(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Add block1 for slot 55
-	require.NoError(t, r.insertBlockToPendingQueue(b.Block.Slot, bA, b1Root))
-	bB, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
-	assert.NoError(t, err)
-	// remove with a different block from the same slot.
-	require.NoError(t, r.deleteBlockFromPendingQueue(b.Block.Slot, bB, b1Root))
+	require.NoError(t, r.insertBlockToPendingQueue(bA, b1Root, ""))
+	require.Equal(t, true, r.pendingBlocks.has(b1Root))
+	r.deleteBlockFromPendingQueue(b1Root)
+	require.Equal(t, false, r.pendingBlocks.has(b1Root))
+}
+
+// b0 - b1 - b2 - b3
+// Test that when only b3 is received, b2 and b1 are fetched one after the other from the peer which sent b3.
+func TestService_ProcessPendingBlocks_RequestsAncestorChainFromSender(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	p1.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirOutbound)
+	p1.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
+	p1.Peers().SetChainState(p2.PeerID(), &ethpb.Status{})
+
+	currentSlot := types.Slot(10)
+	r := &Service{
+		cfg: &config{
+			p2p:      p1,
+			beaconDB: db,
+			chain: &mock.ChainService{
+				FinalizedCheckPoint: &ethpb.Checkpoint{
+					Epoch: 0,
+				},
+				ValidatorsRoot: [32]byte{},
+				Genesis:        time.Now(),
+				Slot:           &currentSlot,
+			},
+		},
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
+	}
+	r.initCaches()
+
+	b0 := util.NewBeaconBlock()
+	util.SaveBlock(t, context.Background(), r.cfg.beaconDB, b0)
+	b0Root, err := b0.Block.HashTreeRoot()
+	require.NoError(t, err)
+	chain := make([]*ethpb.SignedBeaconBlock, 3)
+	roots := make([][32]byte, 3)
+	parent := b0Root
+	for i := range chain {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = types.Slot(i + 1)
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		roots[i], err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		chain[i] = b
+		parent = roots[i]
+	}
+	byRoot := map[[32]byte]*ethpb.SignedBeaconBlock{roots[0]: chain[0], roots[1]: chain[1]}
+
+	pcl := protocol.ID("/eth2/beacon_chain/req/beacon_blocks_by_root/1/ssz_snappy")
+	var wg sync.WaitGroup
+	wg.Add(2)
+	var requested []p2ptypes.BeaconBlockByRootsReq
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		var out p2ptypes.BeaconBlockByRootsReq
+		assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, &out))
+		requested = append(requested, out)
+		for _, rt := range out {
+			_, err := stream.Write([]byte{responseCodeSuccess})
+			assert.NoError(t, err, "Could not write to stream")
+			_, err = p2.Encoding().EncodeWithMaxLength(stream, byRoot[rt])
+			assert.NoError(t, err, "Could not send response back")
+		}
+		assert.NoError(t, stream.Close())
+	})
+
+	wsb, err := blocks.NewSignedBeaconBlock(chain[2])
+	require.NoError(t, err)
+	require.NoError(t, r.insertBlockToPendingQueue(wsb, roots[2], p2.PeerID()))
+	require.NoError(t, r.processPendingBlocks(context.Background()))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive streams within 1 sec")
+	}
+	assert.DeepEqual(t, []p2ptypes.BeaconBlockByRootsReq{{roots[1]}, {roots[0]}}, requested)
+	assert.Equal(t, 3, r.pendingBlocks.len(), "Incorrect number of pending blocks")
+	assert.Equal(t, 0, len(r.pendingBlocks.missingRoots()))
+}
+
+// b0 - b1 - b2 - b3
+// Test that once b1 is known to be bad, its pending descendants are rejected right away.
+func TestService_CheckIfBlockIsBad_RejectsDescendants(t *testing.T) {
+	r := &Service{
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
+	}
+	r.initCaches()
+
+	blks, roots := pendingChain(t, [32]byte{'a'}, 3)
+	for i, b := range blks {
+		require.NoError(t, r.insertBlockToPendingQueue(b, roots[i], ""))
+	}
+	require.Equal(t, 3, r.pendingBlocks.len())
+
+	ctx := context.Background()
+	assert.Equal(t, true, r.checkIfBlockIsBad(ctx, blks[0], roots[0]))
+	r.setBadBlock(ctx, roots[0])
+	assert.Equal(t, false, r.checkIfBlockIsBad(ctx, blks[0], roots[0]))
+	assert.Equal(t, 0, r.pendingBlocks.len(), "Incorrect number of pending blocks")
+	assert.Equal(t, true, r.hasBadBlock(roots[1]))
+	assert.Equal(t, true, r.hasBadBlock(roots[2]))
+
+	// Descendants of bad blocks are not queued again.
+	require.NoError(t, r.insertBlockToPendingQueue(blks[1], roots[1], ""))
+	assert.Equal(t, 0, r.pendingBlocks.len(), "Incorrect number of pending blocks")
 }
@@ -24,11 +24,8 @@ func (s *Service) sendRecentBeaconBlocksRequest(ctx context.Context, blockRoots
 			return err
 		}
 		s.pendingQueueLock.Lock()
-		if err := s.insertBlockToPendingQueue(blk.Block().Slot(), blk, blkRoot); err != nil {
-			return err
-		}
-		s.pendingQueueLock.Unlock()
-		return nil
+		defer s.pendingQueueLock.Unlock()
+		return s.insertBlockToPendingQueue(blk, blkRoot, id)
 	})
 	return err
 }
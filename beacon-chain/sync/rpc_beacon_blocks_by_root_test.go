@@ -12,7 +12,6 @@ import (
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/protocol"
-	gcache "github.com/patrickmn/go-cache"
 	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/transition"
 	db "github.com/prysmaticlabs/prysm/v3/beacon-chain/db/testing"
@@ -215,11 +214,11 @@ func TestRecentBeaconBlocks_RPCRequestSent(t *testing.T) {
 				ValidatorsRoot:      [32]byte{},
 			},
 		},
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
-		ctx:                 context.Background(),
-		rateLimiter:         newRateLimiter(p1),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
+		ctx:           context.Background(),
+		rateLimiter:   newRateLimiter(p1),
 	}
+	r.initCaches()
 
 	// Setup streams
 	pcl := protocol.ID("/eth2/beacon_chain/req/beacon_blocks_by_root/1/ssz_snappy")
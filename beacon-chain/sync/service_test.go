@@ -5,7 +5,6 @@ import (
 	"testing"
 	"time"
 
-	gcache "github.com/patrickmn/go-cache"
 	"github.com/prysmaticlabs/prysm/v3/async/abool"
 	mockChain "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/feed"
@@ -98,8 +97,8 @@ func TestSyncHandlers_WaitForChainStart(t *testing.T) {
 			stateNotifier: chainService.StateNotifier(),
 			initialSync:   &mockSync.Sync{IsSyncing: false},
 		},
-		chainStarted:        abool.New(),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
+		chainStarted:  abool.New(),
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	go r.registerHandlers()
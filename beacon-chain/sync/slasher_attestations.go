@@ -0,0 +1,58 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	eth "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/attestation"
+)
+
+// queueAttestationForSlasher queues a validated unaggregated attestation to be sent to slasher,
+// dropping it if the queue is full so that the validation of gossiped attestations never waits
+// for slasher.
+func (s *Service) queueAttestationForSlasher(att *eth.Attestation) {
+	select {
+	case s.slasherAttsQueue <- att:
+	default:
+		slasherAttestationsDroppedCount.Inc()
+	}
+	slasherAttestationsQueueDepth.Set(float64(len(s.slasherAttsQueue)))
+}
+
+// sendQueuedAttestationsToSlasher sends the queued unaggregated attestations to slasher,
+// once converted to indexed attestations.
+func (s *Service) sendQueuedAttestationsToSlasher() {
+	for {
+		select {
+		case att := <-s.slasherAttsQueue:
+			slasherAttestationsQueueDepth.Set(float64(len(s.slasherAttsQueue)))
+			indexedAtt, err := s.indexedAttestationForSlasher(s.ctx, att)
+			if err != nil {
+				log.WithError(err).Error("Could not send unaggregated attestation to slasher")
+				continue
+			}
+			s.cfg.slasherAttestationsFeed.Send(indexedAtt)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// indexedAttestationForSlasher converts an attestation to the indexed attestation slasher consumes.
+func (s *Service) indexedAttestationForSlasher(ctx context.Context, att *eth.Attestation) (*eth.IndexedAttestation, error) {
+	preState, err := s.cfg.chain.AttestationTargetState(ctx, att.Data.Target)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve pre state")
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, preState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation committee")
+	}
+	indexedAtt, err := attestation.ConvertToIndexed(ctx, att, committee)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert to indexed attestation")
+	}
+	return indexedAtt, nil
+}
@@ -0,0 +1,57 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	mockChain "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+)
+
+func TestService_queueAttestationForSlasher(t *testing.T) {
+	s := &Service{ctx: context.Background(), slasherAttsQueue: make(chan *ethpb.Attestation, 1)}
+	first := util.HydrateAttestation(&ethpb.Attestation{})
+	second := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}})
+
+	s.queueAttestationForSlasher(first)
+	// The queue is full, so the second attestation is dropped.
+	s.queueAttestationForSlasher(second)
+	require.Equal(t, 1, len(s.slasherAttsQueue))
+	assert.DeepEqual(t, first, <-s.slasherAttsQueue)
+}
+
+func TestService_sendQueuedAttestationsToSlasher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	beaconState, _ := util.DeterministicGenesisState(t, 64)
+	attsFeed := new(event.Feed)
+	s := &Service{
+		ctx: ctx,
+		cfg: &config{
+			chain:                   &mockChain.ChainService{State: beaconState},
+			slasherAttestationsFeed: attsFeed,
+		},
+		slasherAttsQueue: make(chan *ethpb.Attestation, 1),
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, beaconState, 0, 0)
+	require.NoError(t, err)
+	aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggregationBits.SetBitAt(1, true)
+	att := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: aggregationBits})
+
+	indexedAttsChan := make(chan *ethpb.IndexedAttestation, 1)
+	sub := attsFeed.Subscribe(indexedAttsChan)
+	defer sub.Unsubscribe()
+	go s.sendQueuedAttestationsToSlasher()
+	s.queueAttestationForSlasher(att)
+
+	indexedAtt := <-indexedAttsChan
+	assert.DeepEqual(t, []uint64{uint64(committee[1])}, indexedAtt.AttestingIndices)
+	assert.DeepEqual(t, att.Data, indexedAtt.Data)
+}
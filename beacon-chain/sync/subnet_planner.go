@@ -0,0 +1,233 @@
+package sync
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v3/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+)
+
+const (
+	attestationSubnetKind = "attestation"
+	syncSubnetKind        = "sync"
+)
+
+// plannedSubnet is a single subnet the node needs a healthy mesh for, along with
+// the earliest slot at which a duty on that subnet has to be performed. Subnets the
+// node has to receive messages from, rather than only publish to, are subscribed to
+// for as long as they remain planned.
+type plannedSubnet struct {
+	kind      string
+	index     uint64
+	dutySlot  types.Slot
+	topic     string
+	subscribe bool
+}
+
+// subnetPlan holds all the attestation and sync subnets required by the validator
+// duties known to the node, looking ahead from the current slot.
+type subnetPlan struct {
+	subnets []*plannedSubnet
+}
+
+// subscribedIndices returns the indices of the planned subnets of the given kind
+// which have to be subscribed to.
+func (p *subnetPlan) subscribedIndices(kind string) []uint64 {
+	var indices []uint64
+	for _, sub := range p.subnets {
+		if sub.kind == kind && sub.subscribe {
+			indices = append(indices, sub.index)
+		}
+	}
+	return indices
+}
+
+// subnetLabel identifies the peer count gauge of a planned subnet.
+type subnetLabel struct {
+	kind   string
+	subnet string
+}
+
+// planSubnets looks through the persistent, attester, aggregator and sync committee
+// subscriptions known to the node for the current slot up to the end of the next epoch,
+// and returns the set of subnets the node must keep peers for, ordered by the
+// slot at which they are first needed. Persistent, aggregator and sync committee
+// subnets are marked to be subscribed to, so that their subscriptions are set up
+// ahead of the duty and kept across the whole lookahead.
+func (s *Service) planSubnets(currSlot types.Slot, digest [4]byte) *subnetPlan {
+	attSlots := make(map[uint64]types.Slot)
+	attSubscribe := make(map[uint64]bool)
+	addAtt := func(idx uint64, slot types.Slot, subscribe bool) {
+		if existing, ok := attSlots[idx]; !ok || slot < existing {
+			attSlots[idx] = slot
+		}
+		attSubscribe[idx] = attSubscribe[idx] || subscribe
+	}
+	for _, idx := range s.persistentSubnetIndices() {
+		addAtt(idx, currSlot, true)
+	}
+	endEpoch := slots.ToEpoch(currSlot) + 1
+	endSlot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(endEpoch + 1))
+	for slot := currSlot; slot < endSlot; slot++ {
+		for _, idx := range cache.SubnetIDs.GetAttesterSubnetIDs(slot) {
+			addAtt(idx, slot, false)
+		}
+		for _, idx := range cache.SubnetIDs.GetAggregatorSubnetIDs(slot) {
+			addAtt(idx, slot, true)
+		}
+	}
+
+	syncSlots := make(map[uint64]types.Slot)
+	currEpoch := slots.ToEpoch(currSlot)
+	for _, idx := range s.retrieveActiveSyncSubnets(currEpoch) {
+		syncSlots[idx] = currSlot
+	}
+	nextEpochStart, err := slots.EpochStart(currEpoch + 1)
+	if err == nil {
+		for _, idx := range s.retrieveActiveSyncSubnets(currEpoch + 1) {
+			if _, ok := syncSlots[idx]; !ok {
+				syncSlots[idx] = nextEpochStart
+			}
+		}
+	}
+
+	attTopic := p2p.GossipTypeMapping[reflect.TypeOf(&ethpb.Attestation{})]
+	syncTopic := p2p.GossipTypeMapping[reflect.TypeOf(&ethpb.SyncCommitteeMessage{})]
+	plan := &subnetPlan{subnets: make([]*plannedSubnet, 0, len(attSlots)+len(syncSlots))}
+	for idx, slot := range attSlots {
+		plan.subnets = append(plan.subnets, &plannedSubnet{
+			kind:      attestationSubnetKind,
+			index:     idx,
+			dutySlot:  slot,
+			topic:     fmt.Sprintf(attTopic, digest, idx),
+			subscribe: attSubscribe[idx],
+		})
+	}
+	for idx, slot := range syncSlots {
+		plan.subnets = append(plan.subnets, &plannedSubnet{
+			kind:      syncSubnetKind,
+			index:     idx,
+			dutySlot:  slot,
+			topic:     fmt.Sprintf(syncTopic, digest, idx),
+			subscribe: true,
+		})
+	}
+	sort.Slice(plan.subnets, func(i, j int) bool {
+		if plan.subnets[i].dutySlot != plan.subnets[j].dutySlot {
+			return plan.subnets[i].dutySlot < plan.subnets[j].dutySlot
+		}
+		if plan.subnets[i].kind != plan.subnets[j].kind {
+			return plan.subnets[i].kind < plan.subnets[j].kind
+		}
+		return plan.subnets[i].index < plan.subnets[j].index
+	})
+	return plan
+}
+
+// Is a background routine that exports the health of every subnet required by
+// upcoming duties. Peers for those subnets are searched for by the subnet subscribers,
+// which follow the same plan.
+func (s *Service) subnetBackboneRoutine() {
+	slotTicker := slots.NewSlotTicker(s.cfg.chain.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	for {
+		select {
+		case currSlot := <-slotTicker.C():
+			if s.chainStarted.IsSet() && s.cfg.initialSync.Syncing() {
+				continue
+			}
+			s.reportSubnetBackbone(currSlot)
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			slotTicker.Done()
+			return
+		}
+	}
+}
+
+// reportSubnetBackbone exports the peer count of every subnet required by upcoming
+// duties, and removes the peer counts of the subnets which are no longer planned.
+func (s *Service) reportSubnetBackbone(currSlot types.Slot) {
+	digest, err := s.currentForkDigest()
+	if err != nil {
+		log.WithError(err).Debug("Could not compute fork digest")
+		return
+	}
+	plan := s.planSubnets(currSlot, digest)
+	minPeers := flags.Get().MinimumPeersPerSubnet
+	planned := make(map[subnetLabel]bool, len(plan.subnets))
+	for _, sub := range plan.subnets {
+		label := subnetLabel{kind: sub.kind, subnet: strconv.FormatUint(sub.index, 10)}
+		planned[label] = true
+		count := len(s.cfg.p2p.PubSub().ListPeers(sub.topic + s.cfg.p2p.Encoding().ProtocolSuffix()))
+		subnetBackbonePeerCount.WithLabelValues(label.kind, label.subnet).Set(float64(count))
+		if count < minPeers {
+			subnetBackboneShortfall.WithLabelValues(sub.kind).Inc()
+		}
+	}
+	for label := range s.backboneSubnets {
+		if !planned[label] {
+			subnetBackbonePeerCount.DeleteLabelValues(label.kind, label.subnet)
+		}
+	}
+	s.backboneSubnets = planned
+}
+
+// protectedSubnetPeers selects the peers that have to be kept connected so that every
+// planned subnet retains at least the minimum number of peers. Subnets with the fewest
+// peers are considered first, and peers that already cover a scarcer subnet, or that
+// cover the most planned subnets, are preferred so that as few peers as possible
+// are shielded from pruning.
+func (s *Service) protectedSubnetPeers(plan *subnetPlan) map[peer.ID]bool {
+	minPeers := flags.Get().MinimumPeersPerSubnet
+	subnetPeers := make([][]peer.ID, 0, len(plan.subnets))
+	coverage := make(map[peer.ID]int)
+	for _, sub := range plan.subnets {
+		ps := s.cfg.p2p.PubSub().ListPeers(sub.topic + s.cfg.p2p.Encoding().ProtocolSuffix())
+		subnetPeers = append(subnetPeers, ps)
+		for _, p := range ps {
+			coverage[p]++
+		}
+	}
+	sort.SliceStable(subnetPeers, func(i, j int) bool {
+		return len(subnetPeers[i]) < len(subnetPeers[j])
+	})
+
+	protected := make(map[peer.ID]bool)
+	for _, ps := range subnetPeers {
+		if len(ps) <= minPeers {
+			for _, p := range ps {
+				protected[p] = true
+			}
+			continue
+		}
+		kept := 0
+		candidates := make([]peer.ID, 0, len(ps))
+		for _, p := range ps {
+			if protected[p] {
+				kept++
+				continue
+			}
+			candidates = append(candidates, p)
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return coverage[candidates[i]] > coverage[candidates[j]]
+		})
+		for _, p := range candidates {
+			if kept >= minPeers {
+				break
+			}
+			protected[p] = true
+			kept++
+		}
+	}
+	return protected
+}
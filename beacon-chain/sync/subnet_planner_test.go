@@ -0,0 +1,184 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v3/async/abool"
+	mockChain "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
+	p2ptest "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v3/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestPlanSubnets_LooksAheadThroughDuties(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig().Copy())
+	defer cache.SubnetIDs.EmptyAllCaches()
+	defer cache.SyncSubnetIDs.EmptyAllCaches()
+
+	currSlot := types.Slot(100)
+	r := Service{
+		ctx: context.Background(),
+		cfg: &config{
+			chain: &mockChain.ChainService{
+				Genesis:        time.Now(),
+				ValidatorsRoot: [32]byte{'A'},
+				Slot:           &currSlot,
+			},
+			p2p: p2ptest.NewTestP2P(t),
+		},
+	}
+	cache.SubnetIDs.AddAttesterSubnetID(currSlot+40, 3)
+	cache.SubnetIDs.AddAttesterSubnetID(currSlot+2, 3)
+	cache.SubnetIDs.AddAggregatorSubnetID(currSlot+5, 7)
+	// Beyond the end of the next epoch, should not be planned.
+	cache.SubnetIDs.AddAttesterSubnetID(currSlot+200, 9)
+	cache.SubnetIDs.AddPersistentCommittee([]byte{'a'}, []uint64{11}, time.Minute)
+	cache.SyncSubnetIDs.AddSyncCommitteeSubnets([]byte{'b'}, 0, []uint64{2}, time.Minute)
+
+	plan := r.planSubnets(currSlot, [4]byte{'A'})
+	got := make(map[string]map[uint64]types.Slot)
+	for _, sub := range plan.subnets {
+		if got[sub.kind] == nil {
+			got[sub.kind] = make(map[uint64]types.Slot)
+		}
+		got[sub.kind][sub.index] = sub.dutySlot
+	}
+	assert.DeepEqual(t, map[uint64]types.Slot{3: currSlot + 2, 7: currSlot + 5, 11: currSlot}, got[attestationSubnetKind])
+	assert.DeepEqual(t, map[uint64]types.Slot{2: currSlot}, got[syncSubnetKind])
+
+	// Subnets are ordered by the slot at which they are first needed.
+	for i := 1; i < len(plan.subnets); i++ {
+		assert.Equal(t, true, plan.subnets[i-1].dutySlot <= plan.subnets[i].dutySlot)
+	}
+	// Attesters only publish to their subnet, so it is not subscribed to.
+	assert.DeepEqual(t, []uint64{11, 7}, plan.subscribedIndices(attestationSubnetKind))
+	assert.DeepEqual(t, []uint64{2}, plan.subscribedIndices(syncSubnetKind))
+}
+
+func TestReportSubnetBackbone_RemovesUnplannedSubnets(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig().Copy())
+	defer cache.SubnetIDs.EmptyAllCaches()
+
+	currSlot := types.Slot(100)
+	r := Service{
+		ctx: context.Background(),
+		cfg: &config{
+			chain: &mockChain.ChainService{
+				Genesis:        time.Now(),
+				ValidatorsRoot: [32]byte{'A'},
+				Slot:           &currSlot,
+			},
+			p2p: p2ptest.NewTestP2P(t),
+		},
+	}
+	cache.SubnetIDs.AddAttesterSubnetID(currSlot+1, 21)
+	r.reportSubnetBackbone(currSlot)
+	assert.DeepEqual(t, map[subnetLabel]bool{{kind: attestationSubnetKind, subnet: "21"}: true}, r.backboneSubnets)
+
+	cache.SubnetIDs.EmptyAllCaches()
+	r.reportSubnetBackbone(currSlot + 2)
+	assert.Equal(t, 0, len(r.backboneSubnets))
+	// The peer count of the subnet was already removed.
+	assert.Equal(t, false, subnetBackbonePeerCount.DeleteLabelValues(attestationSubnetKind, "21"))
+}
+
+func TestFilterNeededPeers_ProtectsSyncSubnets(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.MainnetConfig().Copy()
+	cfg.SecondsPerSlot = 1
+	params.OverrideBeaconConfig(cfg)
+
+	gFlags := new(flags.GlobalFlags)
+	gFlags.MinimumPeersPerSubnet = 2
+	flags.Init(gFlags)
+	defer flags.Init(new(flags.GlobalFlags))
+	defer cache.SyncSubnetIDs.EmptyAllCaches()
+
+	p := p2ptest.NewTestP2P(t)
+	currSlot := types.Slot(100)
+	r := Service{
+		ctx: context.Background(),
+		cfg: &config{
+			chain: &mockChain.ChainService{
+				Genesis:        time.Now(),
+				ValidatorsRoot: [32]byte{'A'},
+				Slot:           &currSlot,
+			},
+			p2p: p,
+		},
+		chainStarted: abool.New(),
+		subHandler:   newSubTopicHandler(),
+	}
+	digest, err := r.currentForkDigest()
+	require.NoError(t, err)
+	defaultTopic := "/eth2/%x/sync_committee_%d" + r.cfg.p2p.Encoding().ProtocolSuffix()
+	subnet1 := r.addDigestAndIndexToTopic(defaultTopic, digest, 1)
+	cache.SyncSubnetIDs.AddSyncCommitteeSubnets([]byte{'a'}, 0, []uint64{1}, time.Minute)
+
+	p1 := createPeer(t, subnet1)
+	p2 := createPeer(t, subnet1)
+	p3 := createPeer(t, subnet1)
+	p4 := createPeer(t)
+	p.Connect(p1)
+	p.Connect(p2)
+	p.Connect(p3)
+	p.Connect(p4)
+	time.Sleep(100 * time.Millisecond)
+
+	recPeers := r.filterNeededPeers([]peer.ID{p1.PeerID(), p2.PeerID(), p3.PeerID(), p4.PeerID()})
+	// Two of the sync subnet peers are kept, leaving one of them along with the
+	// unrelated peer available for pruning.
+	assert.Equal(t, 2, len(recPeers))
+	assert.Equal(t, true, containsPeer(recPeers, p4.PeerID()))
+}
+
+func TestProtectedSubnetPeers_PrefersPeersCoveringScarceSubnets(t *testing.T) {
+	gFlags := new(flags.GlobalFlags)
+	gFlags.MinimumPeersPerSubnet = 1
+	flags.Init(gFlags)
+	defer flags.Init(new(flags.GlobalFlags))
+
+	p := p2ptest.NewTestP2P(t)
+	r := Service{
+		ctx: context.Background(),
+		cfg: &config{p2p: p},
+	}
+	scarce := "/eth2/00000000/beacon_attestation_1"
+	busy := "/eth2/00000000/beacon_attestation_2"
+	suffix := r.cfg.p2p.Encoding().ProtocolSuffix()
+
+	shared := createPeer(t, scarce+suffix, busy+suffix)
+	other1 := createPeer(t, busy+suffix)
+	other2 := createPeer(t, busy+suffix)
+	p.Connect(shared)
+	p.Connect(other1)
+	p.Connect(other2)
+	time.Sleep(100 * time.Millisecond)
+
+	plan := &subnetPlan{subnets: []*plannedSubnet{
+		{kind: attestationSubnetKind, index: 2, topic: busy},
+		{kind: attestationSubnetKind, index: 1, topic: scarce},
+	}}
+	protected := r.protectedSubnetPeers(plan)
+	// The single peer on the scarce subnet also satisfies the busy subnet.
+	assert.Equal(t, 1, len(protected))
+	assert.Equal(t, true, protected[shared.PeerID()])
+}
+
+func containsPeer(pids []peer.ID, pid peer.ID) bool {
+	for _, p := range pids {
+		if p == pid {
+			return true
+		}
+	}
+	return false
+}
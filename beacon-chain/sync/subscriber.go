@@ -400,18 +400,22 @@ func (s *Service) subscribeDynamicWithSubnets(
 					ticker.Done()
 					return
 				}
-				wantedSubs := s.retrievePersistentSubs(currentSlot)
+				plan := s.planSubnets(currentSlot, digest)
+				wantedSubs := plan.subscribedIndices(attestationSubnetKind)
 				// Resize as appropriate.
 				s.reValidateSubscriptions(subscriptions, wantedSubs, topicFormat, digest)
 
-				// subscribe desired aggregator subnets.
-				for _, idx := range wantedSubs {
-					s.subscribeAggregatorSubnet(subscriptions, idx, digest, validate, handle)
-				}
-				// find desired subs for attesters
-				attesterSubs := s.attesterSubnetIndices(currentSlot)
-				for _, idx := range attesterSubs {
-					s.lookupAttesterSubnets(digest, idx)
+				// subscribe desired persistent and aggregator subnets ahead of their duties,
+				// and find peers for the subnets attesters only publish to.
+				for _, sub := range plan.subnets {
+					if sub.kind != attestationSubnetKind {
+						continue
+					}
+					if sub.subscribe {
+						s.subscribeAggregatorSubnet(subscriptions, sub.index, digest, validate, handle)
+						continue
+					}
+					s.lookupAttesterSubnets(digest, sub.index)
 				}
 			}
 		}
@@ -457,6 +461,7 @@ func (s *Service) subscribeAggregatorSubnet(
 	if !s.validPeersExist(subnetTopic) {
 		log.Debugf("No peers found subscribed to attestation gossip subnet with "+
 			"committee index %d. Searching network for peers subscribed to the subnet.", idx)
+		subnetBackboneSearches.WithLabelValues(attestationSubnetKind).Inc()
 		_, err := s.cfg.p2p.FindPeersWithSubnet(s.ctx, subnetTopic, idx, flags.Get().MinimumPeersPerSubnet)
 		if err != nil {
 			log.WithError(err).Debug("Could not search for peers")
@@ -483,6 +488,7 @@ func (s *Service) subscribeSyncSubnet(
 	if !s.validPeersExist(subnetTopic) {
 		log.Debugf("No peers found subscribed to sync gossip subnet with "+
 			"committee index %d. Searching network for peers subscribed to the subnet.", idx)
+		subnetBackboneSearches.WithLabelValues(syncSubnetKind).Inc()
 		_, err := s.cfg.p2p.FindPeersWithSubnet(s.ctx, subnetTopic, idx, flags.Get().MinimumPeersPerSubnet)
 		if err != nil {
 			log.WithError(err).Debug("Could not search for peers")
@@ -600,7 +606,9 @@ func (s *Service) subscribeDynamicWithSyncSubnets(
 					return
 				}
 
-				wantedSubs := s.retrieveActiveSyncSubnets(slots.ToEpoch(currentSlot))
+				// Subnets of sync committee members in the next epoch are subscribed to
+				// ahead of time, and kept for as long as they remain planned.
+				wantedSubs := s.planSubnets(currentSlot, digest).subscribedIndices(syncSubnetKind)
 				// Resize as appropriate.
 				s.reValidateSubscriptions(subscriptions, wantedSubs, topicFormat, digest)
 
@@ -621,6 +629,7 @@ func (s *Service) lookupAttesterSubnets(digest [4]byte, idx uint64) {
 		log.Debugf("No peers found subscribed to attestation gossip subnet with "+
 			"committee index %d. Searching network for peers subscribed to the subnet.", idx)
 		// perform a search for peers with the desired committee index.
+		subnetBackboneSearches.WithLabelValues(attestationSubnetKind).Inc()
 		_, err := s.cfg.p2p.FindPeersWithSubnet(s.ctx, subnetTopic, idx, flags.Get().MinimumPeersPerSubnet)
 		if err != nil {
 			log.WithError(err).Debug("Could not search for peers")
@@ -649,23 +658,13 @@ func (s *Service) validPeersExist(subnetTopic string) bool {
 	return len(numOfPeers) >= flags.Get().MinimumPeersPerSubnet
 }
 
-func (s *Service) retrievePersistentSubs(currSlot types.Slot) []uint64 {
-	// Persistent subscriptions from validators
-	persistentSubs := s.persistentSubnetIndices()
-	// Update desired topic indices for aggregator
-	wantedSubs := s.aggregatorSubnetIndices(currSlot)
-
-	// Combine subscriptions to get all requested subscriptions
-	return slice.SetUint64(append(persistentSubs, wantedSubs...))
-}
-
 func (_ *Service) retrieveActiveSyncSubnets(currEpoch types.Epoch) []uint64 {
 	subs := cache.SyncSubnetIDs.GetAllSubnets(currEpoch)
 	return slice.SetUint64(subs)
 }
 
 // filters out required peers for the node to function, not
-// pruning peers who are in our attestation subnets.
+// pruning peers who are in our attestation or sync subnets.
 func (s *Service) filterNeededPeers(pids []peer.ID) []peer.ID {
 	// Exit early if nothing to filter.
 	if len(pids) == 0 {
@@ -676,30 +675,8 @@ func (s *Service) filterNeededPeers(pids []peer.ID) []peer.ID {
 		log.WithError(err).Error("Could not compute fork digest")
 		return pids
 	}
-	currSlot := s.cfg.chain.CurrentSlot()
-	wantedSubs := s.retrievePersistentSubs(currSlot)
-	wantedSubs = slice.SetUint64(append(wantedSubs, s.attesterSubnetIndices(currSlot)...))
-	topic := p2p.GossipTypeMapping[reflect.TypeOf(&ethpb.Attestation{})]
-
-	// Map of peers in subnets
-	peerMap := make(map[peer.ID]bool)
-
-	for _, sub := range wantedSubs {
-		subnetTopic := fmt.Sprintf(topic, digest, sub) + s.cfg.p2p.Encoding().ProtocolSuffix()
-		ps := s.cfg.p2p.PubSub().ListPeers(subnetTopic)
-		if len(ps) > flags.Get().MinimumPeersPerSubnet {
-			// In the event we have more than the minimum, we can
-			// mark the remaining as viable for pruning.
-			ps = ps[:flags.Get().MinimumPeersPerSubnet]
-		}
-		// Add peer to peer map.
-		for _, p := range ps {
-			// Even if the peer id has
-			// already been seen we still set
-			// it, as the outcome is the same.
-			peerMap[p] = true
-		}
-	}
+	plan := s.planSubnets(s.cfg.chain.CurrentSlot(), digest)
+	peerMap := s.protectedSubnetPeers(plan)
 
 	// Clear out necessary peers from the peers to prune.
 	newPeers := make([]peer.ID, 0, len(pids))
@@ -49,13 +49,3 @@ func (_ *Service) aggregatorSubnetIndices(currentSlot types.Slot) []uint64 {
 	}
 	return slice.SetUint64(commIds)
 }
-
-func (_ *Service) attesterSubnetIndices(currentSlot types.Slot) []uint64 {
-	endEpoch := slots.ToEpoch(currentSlot) + 1
-	endSlot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(endEpoch))
-	var commIds []uint64
-	for i := currentSlot; i <= endSlot; i++ {
-		commIds = append(commIds, cache.SubnetIDs.GetAttesterSubnetIDs(i)...)
-	}
-	return slice.SetUint64(commIds)
-}
@@ -20,7 +20,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v3/monitoring/tracing"
 	eth "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
-	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/attestation"
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	"go.opencensus.io/trace"
 )
@@ -86,28 +85,16 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return pubsub.ValidationReject, err
 	}
 
-	if features.Get().EnableSlasher {
-		// Feed the indexed attestation to slasher if enabled. This action
-		// is done in the background to avoid adding more load to this critical code path.
+	// Feed the indexed attestation to slasher if enabled, unless only validated attestations
+	// are queued for slasher by the subscriber. This action is done in the background to avoid
+	// adding more load to this critical code path.
+	if features.Get().EnableSlasher && s.slasherAttsQueue == nil {
 		go func() {
-			// Using a different context to prevent timeouts as this operation can be expensive
+			// Using the service context to prevent timeouts as this operation can be expensive
 			// and we want to avoid affecting the critical code path.
-			ctx := context.TODO()
-			preState, err := s.cfg.chain.AttestationTargetState(ctx, att.Data.Target)
+			indexedAtt, err := s.indexedAttestationForSlasher(s.ctx, att)
 			if err != nil {
-				log.WithError(err).Error("Could not retrieve pre state")
-				tracing.AnnotateError(span, err)
-				return
-			}
-			committee, err := helpers.BeaconCommitteeFromState(ctx, preState, att.Data.Slot, att.Data.CommitteeIndex)
-			if err != nil {
-				log.WithError(err).Error("Could not get attestation committee")
-				tracing.AnnotateError(span, err)
-				return
-			}
-			indexedAtt, err := attestation.ConvertToIndexed(ctx, att, committee)
-			if err != nil {
-				log.WithError(err).Error("Could not convert to indexed attestation")
+				log.WithError(err).Error("Could not send attestation to slasher")
 				tracing.AnnotateError(span, err)
 				return
 			}
@@ -116,7 +103,20 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	}
 
 	// Verify this the first attestation received for the participating validator for the slot.
-	if s.hasSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits) {
+	// When validated attestations are queued for slasher, an attestation already seen is still
+	// verified if it votes for other data than the votes already sent to slasher, as it may be
+	// a double vote.
+	seen := s.hasSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits)
+	var dataRoot [32]byte
+	if s.slasherAttsQueue != nil {
+		dataRoot, err = att.Data.HashTreeRoot()
+		if err != nil {
+			return pubsub.ValidationIgnore, err
+		}
+		if s.hasSeenSlasherAttestation(att, dataRoot) {
+			return pubsub.ValidationIgnore, nil
+		}
+	} else if seen {
 		return pubsub.ValidationIgnore, nil
 	}
 
@@ -132,7 +132,9 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	blockRoot := bytesutil.ToBytes32(att.Data.BeaconBlockRoot)
 	if !s.hasBlockAndState(ctx, blockRoot) {
 		// A node doesn't have the block, it'll request from peer while saving the pending attestation to a queue.
-		s.savePendingAtt(&eth.SignedAggregateAttestationAndProof{Message: &eth.AggregateAttestationAndProof{Aggregate: att}})
+		if !seen {
+			s.savePendingAtt(&eth.SignedAggregateAttestationAndProof{Message: &eth.AggregateAttestationAndProof{Aggregate: att}})
+		}
 		return pubsub.ValidationIgnore, nil
 	}
 
@@ -162,6 +164,14 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return validationRes, err
 	}
 
+	if s.slasherAttsQueue != nil {
+		s.setSeenSlasherAttestation(att, dataRoot)
+		s.queueAttestationForSlasher(att)
+	}
+	if seen {
+		return pubsub.ValidationIgnore, nil
+	}
+
 	s.setSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits)
 
 	msg.ValidatorData = att
@@ -249,6 +259,25 @@ func (s *Service) setSeenCommitteeIndicesSlot(slot types.Slot, committeeID types
 	s.seenUnAggregatedAttestationCache.Add(string(b), true)
 }
 
+// Returns true if an attestation of the committee's indices with the same data was already queued for slasher.
+func (s *Service) hasSeenSlasherAttestation(att *eth.Attestation, dataRoot [32]byte) bool {
+	s.seenUnAggregatedAttestationLock.RLock()
+	defer s.seenUnAggregatedAttestationLock.RUnlock()
+	b := append(bytesutil.Bytes32(uint64(att.Data.CommitteeIndex)), dataRoot[:]...)
+	b = append(b, att.AggregationBits...)
+	_, seen := s.seenSlasherAttestationCache.Get(string(b))
+	return seen
+}
+
+// Set the committee's indices and attestation data as queued for slasher.
+func (s *Service) setSeenSlasherAttestation(att *eth.Attestation, dataRoot [32]byte) {
+	s.seenUnAggregatedAttestationLock.Lock()
+	defer s.seenUnAggregatedAttestationLock.Unlock()
+	b := append(bytesutil.Bytes32(uint64(att.Data.CommitteeIndex)), dataRoot[:]...)
+	b = append(b, bytesutil.SafeCopyBytes(att.AggregationBits)...)
+	s.seenSlasherAttestationCache.Add(string(b), true)
+}
+
 // hasBlockAndState returns true if the beacon node knows about a block and associated state in the
 // database or cache.
 func (s *Service) hasBlockAndState(ctx context.Context, blockRoot [32]byte) bool {
@@ -330,3 +330,92 @@ func TestService_setSeenCommitteeIndicesSlot(t *testing.T) {
 	require.Equal(t, false, s.hasSeenCommitteeIndicesSlot(0, 2, b1))
 	require.Equal(t, true, s.hasSeenCommitteeIndicesSlot(1, 2, b1))
 }
+
+func TestService_validateCommitteeIndexBeaconAttestation_QueuesSeenForSlasher(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	db := dbtest.SetupDB(t)
+	chain := &mockChain.ChainService{
+		// 1 slot ago.
+		Genesis:          time.Now().Add(time.Duration(-1*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second),
+		ValidatorsRoot:   [32]byte{'A'},
+		ValidAttestation: true,
+		DB:               db,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Service{
+		ctx: ctx,
+		cfg: &config{
+			initialSync:         &mockSync.Sync{IsSyncing: false},
+			p2p:                 p,
+			beaconDB:            db,
+			chain:               chain,
+			attestationNotifier: (&mockChain.ChainService{}).OperationNotifier(),
+		},
+		blkRootToPendingAtts:             make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
+		seenUnAggregatedAttestationCache: lruwrpr.New(10),
+		signatureChan:                    make(chan *signatureVerifier, verifierLimit),
+		slasherAttsQueue:                 make(chan *ethpb.Attestation, 2),
+	}
+	s.initCaches()
+	go s.verifierRoutine()
+
+	digest, err := s.currentForkDigest()
+	require.NoError(t, err)
+
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 1
+	util.SaveBlock(t, ctx, db, blk)
+	validBlockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Root: validBlockRoot[:]}
+
+	savedState, keys := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, savedState.SetSlot(1))
+	require.NoError(t, db.SaveState(ctx, savedState, validBlockRoot))
+	chain.State = savedState
+
+	att := &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0b101},
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: validBlockRoot[:],
+			Slot:            1,
+			Target:          &ethpb.Checkpoint{Root: validBlockRoot[:]},
+			Source:          &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+		},
+	}
+	com, err := helpers.BeaconCommitteeFromState(ctx, savedState, att.Data.Slot, att.Data.CommitteeIndex)
+	require.NoError(t, err)
+	domain, err := signing.Domain(savedState.Fork(), 0, params.BeaconConfig().DomainBeaconAttester, savedState.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	attRoot, err := signing.ComputeSigningRoot(att.Data, domain)
+	require.NoError(t, err)
+	att.Signature = keys[com[0]].Sign(attRoot[:]).Marshal()
+
+	topic := fmt.Sprintf("/eth2/%x/beacon_attestation_1", digest)
+	validate := func() pubsub.ValidationResult {
+		buf := new(bytes.Buffer)
+		_, err := p.Encoding().EncodeGossip(buf, att)
+		require.NoError(t, err)
+		res, err := s.validateCommitteeIndexBeaconAttestation(ctx, "", &pubsub.Message{
+			Message: &pubsubpb.Message{Data: buf.Bytes(), Topic: &topic},
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	// The same vote gossiped again is ignored without being sent to slasher again.
+	require.Equal(t, pubsub.ValidationAccept, validate())
+	require.Equal(t, pubsub.ValidationIgnore, validate())
+	require.Equal(t, 1, len(s.slasherAttsQueue))
+
+	// Another vote of the validator for the slot is ignored on gossip but still sent to slasher.
+	att.Data.Source = &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte{'B'}, fieldparams.RootLength)}
+	attRoot, err = signing.ComputeSigningRoot(att.Data, domain)
+	require.NoError(t, err)
+	att.Signature = keys[com[0]].Sign(attRoot[:]).Marshal()
+	require.Equal(t, pubsub.ValidationIgnore, validate())
+	require.Equal(t, 2, len(s.slasherAttsQueue))
+	require.Equal(t, pubsub.ValidationIgnore, validate())
+	require.Equal(t, 2, len(s.slasherAttsQueue))
+}
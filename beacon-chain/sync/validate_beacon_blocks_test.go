@@ -11,9 +11,9 @@ import (
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
-	gcache "github.com/patrickmn/go-cache"
 	"github.com/prysmaticlabs/prysm/v3/async/abool"
 	mock "github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	coreTime "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/time"
@@ -84,6 +84,7 @@ func TestValidateBeaconBlockPubSub_InvalidSignature(t *testing.T) {
 		},
 		seenBlockCache: lruwrpr.New(10),
 		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	buf := new(bytes.Buffer)
@@ -126,6 +127,7 @@ func TestValidateBeaconBlockPubSub_BlockAlreadyPresentInDB(t *testing.T) {
 		},
 		seenBlockCache: lruwrpr.New(10),
 		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	buf := new(bytes.Buffer)
@@ -186,10 +188,9 @@ func TestValidateBeaconBlockPubSub_CanRecoverStateSummary(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenBlockCache: lruwrpr.New(10),
+		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 	buf := new(bytes.Buffer)
 	_, err = p.Encoding().EncodeGossip(buf, msg)
@@ -251,10 +252,9 @@ func TestValidateBeaconBlockPubSub_IsInCache(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenBlockCache: lruwrpr.New(10),
+		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 	buf := new(bytes.Buffer)
 	_, err = p.Encoding().EncodeGossip(buf, msg)
@@ -315,11 +315,11 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 			chain:         chainService,
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
+			blockTimings:  cache.NewBlockTimingsCache(cache.BlockTimingsSize),
 		},
-		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenBlockCache: lruwrpr.New(10),
+		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 	buf := new(bytes.Buffer)
 	_, err = p.Encoding().EncodeGossip(buf, msg)
@@ -339,6 +339,16 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 	result := res == pubsub.ValidationAccept
 	assert.Equal(t, true, result)
 	assert.NotNil(t, m.ValidatorData, "Decoded message was not set on the message validator data")
+
+	root, err := msg.Block.HashTreeRoot()
+	require.NoError(t, err)
+	bt, ok := r.cfg.blockTimings.BlockTiming(root)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Slot(1), bt.Slot)
+	_, ok = bt.Durations[cache.ArrivalPhase]
+	assert.Equal(t, true, ok)
+	_, ok = bt.Durations[cache.GossipValidationPhase]
+	assert.Equal(t, true, ok)
 }
 
 func TestValidateBeaconBlockPubSub_WithLookahead(t *testing.T) {
@@ -383,11 +393,10 @@ func TestValidateBeaconBlockPubSub_WithLookahead(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
-		subHandler:          newSubTopicHandler(),
+		seenBlockCache: lruwrpr.New(10),
+		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
+		subHandler:     newSubTopicHandler(),
 	}
 	buf := new(bytes.Buffer)
 	_, err = p.Encoding().EncodeGossip(buf, msg)
@@ -451,10 +460,9 @@ func TestValidateBeaconBlockPubSub_AdvanceEpochsForState(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenBlockCache: lruwrpr.New(10),
+		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 	buf := new(bytes.Buffer)
 	_, err = p.Encoding().EncodeGossip(buf, msg)
@@ -500,6 +508,7 @@ func TestValidateBeaconBlockPubSub_Syncing(t *testing.T) {
 			chain:         chainService,
 			blockNotifier: chainService.BlockNotifier(),
 		},
+		pendingBlocks: newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	buf := new(bytes.Buffer)
@@ -556,11 +565,10 @@ func TestValidateBeaconBlockPubSub_IgnoreAndQueueBlocksFromNearFuture(t *testing
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		chainStarted:        abool.New(),
-		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		chainStarted:   abool.New(),
+		seenBlockCache: lruwrpr.New(10),
+		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	buf := new(bytes.Buffer)
@@ -581,7 +589,7 @@ func TestValidateBeaconBlockPubSub_IgnoreAndQueueBlocksFromNearFuture(t *testing
 	assert.Equal(t, res, pubsub.ValidationIgnore, "early block should be ignored and queued")
 
 	// check if the block is inserted in the Queue
-	assert.Equal(t, true, len(r.pendingBlocksInCache(msg.Block.Slot)) == 1)
+	assert.Equal(t, true, len(r.pendingBlocks.blocksAtSlot(msg.Block.Slot)) == 1)
 }
 
 func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
@@ -606,11 +614,10 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
 			chain:         chainService,
 			blockNotifier: chainService.BlockNotifier(),
 		},
-		chainStarted:        abool.New(),
-		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		chainStarted:   abool.New(),
+		seenBlockCache: lruwrpr.New(10),
+		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	buf := new(bytes.Buffer)
@@ -661,6 +668,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromThePast(t *testing.T) {
 		},
 		seenBlockCache: lruwrpr.New(10),
 		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	buf := new(bytes.Buffer)
@@ -716,10 +724,9 @@ func TestValidateBeaconBlockPubSub_SeenProposerSlot(t *testing.T) {
 			chain:         chainService,
 			blockNotifier: chainService.BlockNotifier(),
 		},
-		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenBlockCache: lruwrpr.New(10),
+		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	buf := new(bytes.Buffer)
@@ -769,6 +776,7 @@ func TestValidateBeaconBlockPubSub_FilterByFinalizedEpoch(t *testing.T) {
 		},
 		seenBlockCache: lruwrpr.New(10),
 		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	b := util.NewBeaconBlock()
@@ -849,10 +857,9 @@ func TestValidateBeaconBlockPubSub_ParentNotFinalizedDescendant(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenBlockCache: lruwrpr.New(10),
+		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 	buf := new(bytes.Buffer)
 	_, err = p.Encoding().EncodeGossip(buf, msg)
@@ -914,10 +921,9 @@ func TestValidateBeaconBlockPubSub_InvalidParentBlock(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenBlockCache: lruwrpr.New(10),
+		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 	buf := new(bytes.Buffer)
 	_, err = p.Encoding().EncodeGossip(buf, msg)
@@ -1023,10 +1029,9 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromBadParent(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
-		badBlockCache:       lruwrpr.New(10),
-		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		seenBlockCache: lruwrpr.New(10),
+		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 	r.setBadBlock(ctx, bytesutil.ToBytes32(msg.Block.ParentRoot))
 
@@ -1127,6 +1132,7 @@ func TestValidateBeaconBlockPubSub_ValidExecutionPayload(t *testing.T) {
 		},
 		seenBlockCache: lruwrpr.New(10),
 		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	buf := new(bytes.Buffer)
@@ -1199,6 +1205,7 @@ func TestValidateBeaconBlockPubSub_InvalidPayloadTimestamp(t *testing.T) {
 		},
 		seenBlockCache: lruwrpr.New(10),
 		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	buf := new(bytes.Buffer)
@@ -1243,6 +1250,7 @@ func Test_validateBellatrixBeaconBlock(t *testing.T) {
 		},
 		seenBlockCache: lruwrpr.New(10),
 		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	st, _ := util.DeterministicGenesisStateAltair(t, 1)
@@ -1301,6 +1309,7 @@ func Test_validateBellatrixBeaconBlockParentValidation(t *testing.T) {
 		},
 		seenBlockCache: lruwrpr.New(10),
 		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	blk, err := blocks.NewSignedBeaconBlock(msg)
@@ -1358,6 +1367,7 @@ func Test_validateBeaconBlockProcessingWhenParentIsOptimistic(t *testing.T) {
 		},
 		seenBlockCache: lruwrpr.New(10),
 		badBlockCache:  lruwrpr.New(10),
+		pendingBlocks:  newPendingBlockDAG(maxPendingBlocks),
 	}
 
 	buf := new(bytes.Buffer)
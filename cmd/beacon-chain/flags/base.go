@@ -182,6 +182,38 @@ var (
 		Name:  "historical-slasher-node",
 		Usage: "Enables required flags for serving historical data to a slasher client. Results in additional storage usage",
 	}
+	// SlasherHistoryLength defines the number of epochs of min and max spans kept by slasher.
+	SlasherHistoryLength = &cli.Uint64Flag{
+		Name: "slasher-history-length",
+		Usage: "Number of epochs of attestation history slasher keeps for surround vote detection. " +
+			"Must be a multiple of --slasher-chunk-size. Existing slasher data is migrated on startup when changed",
+		Value: 4096,
+	}
+	// SlasherChunkSize defines the number of epochs of a validator span stored in a slasher chunk.
+	SlasherChunkSize = &cli.Uint64Flag{
+		Name:  "slasher-chunk-size",
+		Usage: "Number of epochs of a validator's min and max spans stored together in a slasher chunk",
+		Value: 16,
+	}
+	// SlasherValidatorChunkSize defines the number of validators whose spans are stored in a slasher chunk.
+	SlasherValidatorChunkSize = &cli.Uint64Flag{
+		Name:  "slasher-validator-chunk-size",
+		Usage: "Number of validators whose min and max spans are stored together in a slasher chunk",
+		Value: 256,
+	}
+	// SlasherUnaggregatedAttestations sends the validated unaggregated attestations to slasher through a bounded queue.
+	SlasherUnaggregatedAttestations = &cli.BoolFlag{
+		Name: "slasher-unaggregated-attestations",
+		Usage: "Sends the unaggregated attestations validated from attestation subnets to slasher through a bounded queue, " +
+			"instead of every unaggregated attestation before validation",
+	}
+	// SlasherUnaggregatedAttestationsQueueSize defines the number of unaggregated attestations waiting to be sent to slasher.
+	SlasherUnaggregatedAttestationsQueueSize = &cli.IntFlag{
+		Name: "slasher-unaggregated-attestations-queue-size",
+		Usage: "Maximum number of validated unaggregated attestations waiting to be sent to slasher. " +
+			"Attestations are dropped when the queue is full",
+		Value: 8192,
+	}
 	// ChainID defines a flag to set the chain id. If none is set, it derives this value from NetworkConfig
 	ChainID = &cli.Uint64Flag{
 		Name:  "chain-id",
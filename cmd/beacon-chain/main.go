@@ -63,6 +63,11 @@ var appFlags = []cli.Flag{
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
+	flags.SlasherHistoryLength,
+	flags.SlasherChunkSize,
+	flags.SlasherValidatorChunkSize,
+	flags.SlasherUnaggregatedAttestations,
+	flags.SlasherUnaggregatedAttestationsQueueSize,
 	flags.ChainID,
 	flags.NetworkID,
 	flags.WeakSubjectivityCheckpoint,
@@ -94,6 +99,8 @@ var appFlags = []cli.Flag{
 	cmd.P2PMetadata,
 	cmd.P2PAllowList,
 	cmd.P2PDenyList,
+	cmd.P2PUploadLimit,
+	cmd.P2PPeerUploadLimit,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
@@ -117,6 +117,11 @@ var appHelpFlagGroups = []flagGroup{
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
+			flags.SlasherHistoryLength,
+			flags.SlasherChunkSize,
+			flags.SlasherValidatorChunkSize,
+			flags.SlasherUnaggregatedAttestations,
+			flags.SlasherUnaggregatedAttestationsQueueSize,
 			flags.ChainID,
 			flags.NetworkID,
 			flags.WeakSubjectivityCheckpoint,
@@ -153,6 +158,8 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PMetadata,
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
+			cmd.P2PUploadLimit,
+			cmd.P2PPeerUploadLimit,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
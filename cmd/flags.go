@@ -171,6 +171,18 @@ var (
 			"192.168.0.0/16 would deny connections from peers on your local network only. The " +
 			"default is to accept all connections.",
 	}
+	// P2PUploadLimit caps the rate at which responses are uploaded to all peers.
+	P2PUploadLimit = &cli.Uint64Flag{
+		Name: "p2p-upload-limit",
+		Usage: "The maximum rate, in bytes per second, at which req/resp responses such as historical " +
+			"blocks are uploaded to all peers. Gossip is not affected. The default of 0 means no limit.",
+	}
+	// P2PPeerUploadLimit caps the rate at which responses are uploaded to a single peer.
+	P2PPeerUploadLimit = &cli.Uint64Flag{
+		Name: "p2p-peer-upload-limit",
+		Usage: "The maximum rate, in bytes per second, at which req/resp responses such as historical " +
+			"blocks are uploaded to a single peer. Gossip is not affected. The default of 0 means no limit.",
+	}
 	// ForceClearDB removes any previously stored data at the data directory.
 	ForceClearDB = &cli.BoolFlag{
 		Name:  "force-clear-db",
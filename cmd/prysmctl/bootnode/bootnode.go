@@ -0,0 +1,217 @@
+package bootnode
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prysmaticlabs/prysm/v3/async"
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/network"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var flags = struct {
+	DataDir               string
+	PrivateKey            string
+	DiscoveryPort         uint
+	HTTPPort              uint
+	ExternalIP            string
+	ForkVersion           string
+	GenesisValidatorsRoot string
+	SeedNodes             *cli.StringSlice
+	FilterForkDigest      bool
+	LookupInterval        time.Duration
+	Debug                 bool
+}{
+	SeedNodes: cli.NewStringSlice(),
+}
+
+var Commands = []*cli.Command{
+	{
+		Name: "bootnode",
+		Usage: "run a discv5 bootnode, persisting its node table and serving metrics along with the list " +
+			"of known ENRs over HTTP",
+		Action: cliActionBootnode,
+		Flags: []cli.Flag{
+			cmd.ChainConfigFileFlag,
+			&cli.StringFlag{
+				Name:        "datadir",
+				Usage:       "directory in which the node table and private key are persisted, the node table is kept in memory if empty",
+				Destination: &flags.DataDir,
+			},
+			&cli.StringFlag{
+				Name:        "private-key",
+				Usage:       "hex encoded secp256k1 private key of the bootnode, read from or generated into the datadir if empty",
+				Destination: &flags.PrivateKey,
+			},
+			&cli.UintFlag{
+				Name:        "discovery-port",
+				Usage:       "UDP port to listen for discv5 connections on",
+				Destination: &flags.DiscoveryPort,
+				Value:       4000,
+			},
+			&cli.UintFlag{
+				Name:        "http-port",
+				Usage:       "port to serve /metrics and the /enr listing on",
+				Destination: &flags.HTTPPort,
+				Value:       5000,
+			},
+			&cli.StringFlag{
+				Name:        "external-ip",
+				Usage:       "external IP advertised in the bootnode's ENR, detected if empty",
+				Destination: &flags.ExternalIP,
+			},
+			&cli.StringFlag{
+				Name:        "fork-version",
+				Usage:       "hex encoded fork version used to compute the fork digest, the genesis fork version of the configured network if empty",
+				Destination: &flags.ForkVersion,
+			},
+			&cli.StringFlag{
+				Name:        "genesis-root",
+				Usage:       "hex encoded genesis validators root of the network, used to compute the fork digest",
+				Destination: &flags.GenesisValidatorsRoot,
+				Required:    true,
+			},
+			&cli.StringSliceFlag{
+				Name:        "seed-node",
+				Usage:       "ENR(s) of external nodes to join the DHT through",
+				Destination: flags.SeedNodes,
+			},
+			&cli.BoolFlag{
+				Name: "filter-fork-digest",
+				Usage: "only accept, persist and serve node records advertising the bootnode's eth2 fork digest, " +
+					"keeping nodes of other networks out of the DHT",
+				Destination: &flags.FilterForkDigest,
+			},
+			&cli.DurationFlag{
+				Name:        "lookup-interval",
+				Usage:       "interval between the random lookups refreshing the node table",
+				Destination: &flags.LookupInterval,
+				Value:       30 * time.Second,
+			},
+			&cli.BoolFlag{
+				Name:        "debug",
+				Usage:       "enable debug logging, including the discv5 traces",
+				Destination: &flags.Debug,
+			},
+		},
+	},
+}
+
+func cliActionBootnode(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
+			return err
+		}
+	}
+	if flags.Debug {
+		logrus.SetLevel(logrus.DebugLevel)
+		glogger := gethlog.NewGlogHandler(gethlog.StreamHandler(os.Stderr, gethlog.TerminalFormat(false)))
+		glogger.Verbosity(gethlog.LvlTrace)
+		gethlog.Root().SetHandler(glogger)
+	}
+	log.WithField("version", version.Version()).Info("Starting bootnode")
+
+	privKey, err := loadPrivateKey(flags.DataDir, flags.PrivateKey)
+	if err != nil {
+		return err
+	}
+	forkVersion, err := parseForkVersion(flags.ForkVersion)
+	if err != nil {
+		return err
+	}
+	digest, err := forkDigest(forkVersion, flags.GenesisValidatorsRoot)
+	if err != nil {
+		return err
+	}
+	ipAddr := flags.ExternalIP
+	if ipAddr == "" {
+		ipAddr, err = network.ExternalIP()
+		if err != nil {
+			return errors.Wrap(err, "could not determine external IP")
+		}
+	}
+	seeds := make([]*enode.Node, 0, len(flags.SeedNodes.Value()))
+	for _, s := range flags.SeedNodes.Value() {
+		node, err := enode.Parse(enode.ValidSchemes, s)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse seed node %s", s)
+		}
+		seeds = append(seeds, node)
+	}
+
+	b, err := newBootnode(&config{
+		dataDir:          flags.DataDir,
+		privKey:          privKey,
+		ipAddr:           ipAddr,
+		port:             int(flags.DiscoveryPort),
+		forkDigest:       digest,
+		forkVersion:      forkVersion,
+		filterForkDigest: flags.FilterForkDigest,
+		seeds:            seeds,
+	})
+	if err != nil {
+		return err
+	}
+	defer b.close()
+	log.WithFields(logrus.Fields{
+		"enr":              b.listener.Self().String(),
+		"forkDigest":       fmt.Sprintf("%#x", digest),
+		"filterForkDigest": flags.FilterForkDigest,
+		"persistent":       flags.DataDir != "",
+	}).Info("Running bootnode")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	async.RunEvery(ctx, flags.LookupInterval, b.lookup)
+	async.RunEvery(ctx, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second, b.updateMetrics)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/enr", b.enrHandler)
+	srv := &http.Server{
+		Addr:              fmt.Sprintf(":%d", flags.HTTPPort),
+		Handler:           mux,
+		ReadHeaderTimeout: time.Second,
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("Failed to serve HTTP")
+			cancel()
+		}
+	}()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	select {
+	case <-sigc:
+		log.Info("Shutting down bootnode")
+	case <-ctx.Done():
+	}
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	return srv.Shutdown(shutdownCtx)
+}
+
+// lookup walks the DHT towards a random target, refreshing the node table.
+func (b *bootnode) lookup() {
+	var target enode.ID
+	copy(target[:], randomBytes(len(target)))
+	found := b.listener.Lookup(target)
+	lookupsTotal.Inc()
+	lookupNodesFound.Add(float64(len(found)))
+}
@@ -0,0 +1,130 @@
+package bootnode
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func testBootnode(t *testing.T, port int, digest [4]byte, filter bool, seeds ...*enode.Node) *bootnode {
+	privKey, err := loadPrivateKey("", "")
+	require.NoError(t, err)
+	b, err := newBootnode(&config{
+		privKey:          privKey,
+		ipAddr:           "127.0.0.1",
+		port:             port,
+		forkDigest:       digest,
+		filterForkDigest: filter,
+		seeds:            seeds,
+	})
+	require.NoError(t, err)
+	t.Cleanup(b.close)
+	return b
+}
+
+func TestForkDigestScheme(t *testing.T) {
+	digest := [4]byte{'A', 'B', 'C', 'D'}
+	scheme := &forkDigestScheme{IdentityScheme: enode.ValidSchemes, forkDigest: digest}
+
+	matching := testBootnode(t, 14100, digest, false).listener.Self()
+	other := testBootnode(t, 14101, [4]byte{'E', 'F', 'G', 'H'}, false).listener.Self()
+
+	_, err := enode.New(scheme, matching.Record())
+	require.NoError(t, err)
+	_, err = enode.New(scheme, other.Record())
+	assert.ErrorContains(t, errForkDigestMismatch.Error(), err)
+}
+
+func TestBootnode_FiltersForkDigest(t *testing.T) {
+	digest := [4]byte{'A', 'B', 'C', 'D'}
+	b := testBootnode(t, 14102, digest, true)
+	seed, err := enode.Parse(enode.ValidSchemes, b.listener.Self().String())
+	require.NoError(t, err)
+	matching := testBootnode(t, 14103, digest, false, seed)
+	testBootnode(t, 14104, [4]byte{'E', 'F', 'G', 'H'}, false, seed)
+
+	require.NoError(t, matching.listener.Ping(b.listener.Self()))
+	time.Sleep(time.Second)
+
+	nodes := b.nodes()
+	for _, n := range nodes {
+		assert.Equal(t, true, hasForkDigest(n.Record(), digest))
+	}
+
+	rr := httptest.NewRecorder()
+	b.enrHandler(rr, httptest.NewRequest(http.MethodGet, "/enr", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var records []*nodeRecord
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &records))
+	assert.Equal(t, len(nodes), len(records))
+	for _, r := range records {
+		assert.Equal(t, "41424344", r.ForkDigest)
+	}
+}
+
+func TestLoadPrivateKey_PersistsInDataDir(t *testing.T) {
+	dataDir := filepath.Join(t.TempDir(), "bootnode")
+	key, err := loadPrivateKey(dataDir, "")
+	require.NoError(t, err)
+	reloaded, err := loadPrivateKey(dataDir, "")
+	require.NoError(t, err)
+	assert.DeepEqual(t, key.D.Bytes(), reloaded.D.Bytes())
+
+	// An explicit key takes precedence over the persisted one.
+	explicit, err := loadPrivateKey(dataDir, "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	require.NoError(t, err)
+	assert.NotEqual(t, key.D.String(), explicit.D.String())
+}
+
+func TestForkDigest(t *testing.T) {
+	_, err := parseForkVersion("0x0000")
+	assert.ErrorContains(t, "invalid fork version size", err)
+	_, err = forkDigest(params.BeaconConfig().GenesisForkVersion, "0x00")
+	assert.ErrorContains(t, "invalid genesis validators root size", err)
+	_, err = forkDigest(params.BeaconConfig().GenesisForkVersion, "")
+	assert.ErrorContains(t, "genesis validators root is required", err)
+	genesisRoot := "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
+	v1, err := parseForkVersion("0x00000001")
+	require.NoError(t, err)
+	d1, err := forkDigest(v1, genesisRoot)
+	require.NoError(t, err)
+	v2, err := parseForkVersion("0x00000002")
+	require.NoError(t, err)
+	d2, err := forkDigest(v2, genesisRoot)
+	require.NoError(t, err)
+	assert.NotEqual(t, d1, d2)
+	d3, err := forkDigest(v1, "0x"+strings.Repeat("00", 32))
+	require.NoError(t, err)
+	assert.NotEqual(t, d1, d3)
+}
+
+func TestCreateLocalNode_NextForkVersion(t *testing.T) {
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	defer db.Close()
+	privKey, err := loadPrivateKey("", "")
+	require.NoError(t, err)
+	forkVersion := []byte{0x02, 0x00, 0x00, 0x00}
+	localNode, err := createLocalNode(db, privKey, net.ParseIP("127.0.0.1"), 14105, [4]byte{'A', 'B', 'C', 'D'}, forkVersion)
+	require.NoError(t, err)
+
+	// The record advertises the configured fork, rather than genesis, as its next fork.
+	var enc []byte
+	require.NoError(t, localNode.Node().Record().Load(enr.WithEntry(eth2ENRKey, &enc)))
+	forkID := &pb.ENRForkID{}
+	require.NoError(t, forkID.UnmarshalSSZ(enc))
+	assert.DeepEqual(t, forkVersion, forkID.NextForkVersion)
+	assert.Equal(t, params.BeaconConfig().FarFutureEpoch, forkID.NextForkEpoch)
+}
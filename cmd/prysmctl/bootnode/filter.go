@@ -0,0 +1,53 @@
+package bootnode
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+)
+
+var errForkDigestMismatch = errors.New("record does not advertise the expected fork digest")
+
+// forkDigestScheme wraps the identity scheme used by discv5 to verify the records it
+// receives, additionally rejecting the records which do not advertise the expected
+// eth2 fork digest. Nodes learnt through lookups are therefore only added to the table,
+// and served to other nodes, when they belong to the bootnode's network. Nodes contacting
+// the bootnode directly are verified by discv5 itself, and are instead left out of the
+// ENR listing and metrics.
+type forkDigestScheme struct {
+	enr.IdentityScheme
+	forkDigest [4]byte
+}
+
+// Verify checks the record signature, then its fork digest.
+func (s *forkDigestScheme) Verify(r *enr.Record, sig []byte) error {
+	if err := s.IdentityScheme.Verify(r, sig); err != nil {
+		return err
+	}
+	if !hasForkDigest(r, s.forkDigest) {
+		filteredRecords.Inc()
+		return errForkDigestMismatch
+	}
+	return nil
+}
+
+// hasForkDigest returns whether the record has an eth2 entry with the given fork digest.
+func hasForkDigest(r *enr.Record, digest [4]byte) bool {
+	d, err := recordForkDigest(r)
+	return err == nil && bytes.Equal(d, digest[:])
+}
+
+// recordForkDigest reads the current fork digest from the eth2 entry of the record.
+func recordForkDigest(r *enr.Record) ([]byte, error) {
+	sszEncodedForkEntry := make([]byte, 16)
+	if err := r.Load(enr.WithEntry(eth2ENRKey, &sszEncodedForkEntry)); err != nil {
+		return nil, err
+	}
+	forkEntry := &pb.ENRForkID{}
+	if err := forkEntry.UnmarshalSSZ(sszEncodedForkEntry); err != nil {
+		return nil, err
+	}
+	return forkEntry.CurrentForkDigest, nil
+}
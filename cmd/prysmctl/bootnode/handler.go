@@ -0,0 +1,56 @@
+package bootnode
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// nodeRecord is the JSON representation of a node of the table.
+type nodeRecord struct {
+	ENR        string `json:"enr"`
+	NodeID     string `json:"node_id"`
+	Seq        uint64 `json:"seq"`
+	IP         string `json:"ip,omitempty"`
+	UDPPort    int    `json:"udp_port,omitempty"`
+	TCPPort    int    `json:"tcp_port,omitempty"`
+	ForkDigest string `json:"fork_digest,omitempty"`
+}
+
+// enrHandler lists the known nodes as JSON.
+func (b *bootnode) enrHandler(w http.ResponseWriter, _ *http.Request) {
+	nodes := b.nodes()
+	records := make([]*nodeRecord, len(nodes))
+	for i, n := range nodes {
+		records[i] = newNodeRecord(n)
+	}
+	enc, err := json.Marshal(records)
+	if err != nil {
+		log.WithError(err).Error("Could not marshal node records")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(enc); err != nil {
+		log.WithError(err).Error("Failed to write to http response")
+	}
+}
+
+func newNodeRecord(n *enode.Node) *nodeRecord {
+	r := &nodeRecord{
+		ENR:     n.String(),
+		NodeID:  n.ID().String(),
+		Seq:     n.Seq(),
+		UDPPort: n.UDP(),
+		TCPPort: n.TCP(),
+	}
+	if n.IP() != nil {
+		r.IP = n.IP().String()
+	}
+	if digest, err := recordForkDigest(n.Record()); err == nil {
+		r.ForkDigest = hex.EncodeToString(digest)
+	}
+	return r
+}
@@ -0,0 +1,5 @@
+package bootnode
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "bootnode")
@@ -0,0 +1,72 @@
+package bootnode
+
+import (
+	"net"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	tableSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bootnode_table_nodes",
+		Help: "The number of nodes in the discv5 table, in total and advertising the bootnode's fork digest.",
+	}, []string{"filter"})
+	lookupsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "bootnode_lookups_total",
+		Help: "The number of random lookups performed to refresh the discv5 table.",
+	})
+	lookupNodesFound = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "bootnode_lookup_nodes_found_total",
+		Help: "The number of nodes returned by the random lookups.",
+	})
+	filteredRecords = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "bootnode_filtered_records_total",
+		Help: "The number of received records rejected for advertising a different fork digest.",
+	})
+	packetsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bootnode_discv5_packets_total",
+		Help: "The number of discv5 packets received and sent, including the lookup requests served.",
+	}, []string{"direction"})
+	bytesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bootnode_discv5_bytes_total",
+		Help: "The number of discv5 bytes received and sent.",
+	}, []string{"direction"})
+)
+
+func (b *bootnode) updateMetrics() {
+	all := b.listener.AllNodes()
+	matching := 0
+	for _, n := range all {
+		if hasForkDigest(n.Record(), b.cfg.forkDigest) {
+			matching++
+		}
+	}
+	tableSize.WithLabelValues("all").Set(float64(len(all)))
+	tableSize.WithLabelValues("matching").Set(float64(matching))
+}
+
+// meteredConn counts the discv5 traffic of the bootnode.
+type meteredConn struct {
+	*net.UDPConn
+}
+
+// ReadFromUDP reads a packet from the connection.
+func (c *meteredConn) ReadFromUDP(b []byte) (int, *net.UDPAddr, error) {
+	n, addr, err := c.UDPConn.ReadFromUDP(b)
+	if err == nil {
+		packetsTotal.WithLabelValues("in").Inc()
+		bytesTotal.WithLabelValues("in").Add(float64(n))
+	}
+	return n, addr, err
+}
+
+// WriteToUDP writes a packet to the connection.
+func (c *meteredConn) WriteToUDP(b []byte, addr *net.UDPAddr) (int, error) {
+	n, err := c.UDPConn.WriteToUDP(b, addr)
+	if err == nil {
+		packetsTotal.WithLabelValues("out").Inc()
+		bytesTotal.WithLabelValues("out").Add(float64(n))
+	}
+	return n, err
+}
@@ -0,0 +1,253 @@
+package bootnode
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	gcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	ecdsaprysm "github.com/prysmaticlabs/prysm/v3/crypto/ecdsa"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+)
+
+const (
+	// Name of the node database directory within the datadir.
+	nodeDBName = "nodes"
+	// Name of the file holding the bootnode's private key within the datadir.
+	privateKeyFileName = "bootnode.key"
+	// ENR key of the eth2 fork entry.
+	eth2ENRKey = "eth2"
+)
+
+type config struct {
+	dataDir          string
+	privKey          *ecdsa.PrivateKey
+	ipAddr           string
+	port             int
+	forkDigest       [4]byte
+	forkVersion      []byte
+	filterForkDigest bool
+	seeds            []*enode.Node
+}
+
+// bootnode is a discv5 node whose only purpose is to let other nodes find each other.
+type bootnode struct {
+	cfg      *config
+	db       *enode.DB
+	listener *discover.UDPv5
+}
+
+func newBootnode(cfg *config) (*bootnode, error) {
+	ip := net.ParseIP(cfg.ipAddr)
+	if ip == nil {
+		return nil, errors.Errorf("invalid IP address %s", cfg.ipAddr)
+	}
+	bindIP, networkVersion := net.IPv4zero, "udp4"
+	if ip.To4() == nil {
+		bindIP, networkVersion = net.IPv6zero, "udp6"
+	}
+
+	dbPath := ""
+	if cfg.dataDir != "" {
+		if err := file.MkdirAll(cfg.dataDir); err != nil {
+			return nil, errors.Wrap(err, "could not create datadir")
+		}
+		dbPath = filepath.Join(cfg.dataDir, nodeDBName)
+	}
+	db, err := enode.OpenDB(dbPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open node database")
+	}
+	localNode, err := createLocalNode(db, cfg.privKey, ip, cfg.port, cfg.forkDigest, cfg.forkVersion)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	conn, err := net.ListenUDP(networkVersion, &net.UDPAddr{IP: bindIP, Port: cfg.port})
+	if err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "could not listen for discv5")
+	}
+	dCfg := discover.Config{
+		PrivateKey: cfg.privKey,
+		Bootnodes:  cfg.seeds,
+	}
+	if cfg.filterForkDigest {
+		dCfg.ValidSchemes = &forkDigestScheme{IdentityScheme: enode.ValidSchemes, forkDigest: cfg.forkDigest}
+	}
+	listener, err := discover.ListenV5(&meteredConn{UDPConn: conn}, localNode, dCfg)
+	if err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "could not start discv5")
+	}
+	return &bootnode{
+		cfg:      cfg,
+		db:       db,
+		listener: listener,
+	}, nil
+}
+
+func (b *bootnode) close() {
+	b.listener.Close()
+	b.db.Close()
+}
+
+// nodes returns the nodes of the table, restricted to those advertising the bootnode's
+// fork digest when filtering is enabled.
+func (b *bootnode) nodes() []*enode.Node {
+	all := b.listener.AllNodes()
+	if !b.cfg.filterForkDigest {
+		return all
+	}
+	nodes := make([]*enode.Node, 0, len(all))
+	for _, n := range all {
+		if hasForkDigest(n.Record(), b.cfg.forkDigest) {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// createLocalNode sets up the record of the bootnode. As the bootnode does not follow the
+// chain, it advertises no upcoming fork: the next fork version is the one its fork digest
+// is computed from, scheduled at the far future epoch.
+func createLocalNode(
+	db *enode.DB,
+	privKey *ecdsa.PrivateKey,
+	ip net.IP,
+	port int,
+	digest [4]byte,
+	forkVersion []byte,
+) (*enode.LocalNode, error) {
+	if forkVersion == nil {
+		forkVersion = params.BeaconConfig().GenesisForkVersion
+	}
+	forkID := &pb.ENRForkID{
+		CurrentForkDigest: digest[:],
+		NextForkVersion:   forkVersion,
+		NextForkEpoch:     params.BeaconConfig().FarFutureEpoch,
+	}
+	forkEntry, err := forkID.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal fork id")
+	}
+	localNode := enode.NewLocalNode(db, privKey)
+	localNode.Set(enr.WithEntry(eth2ENRKey, forkEntry))
+	localNode.Set(enr.WithEntry("attnets", bitfield.NewBitvector64()))
+	localNode.SetFallbackIP(ip)
+	localNode.SetFallbackUDP(port)
+	return localNode, nil
+}
+
+// parseForkVersion decodes the fork version the bootnode runs on, defaulting to the
+// genesis fork version of the configured network.
+func parseForkVersion(forkVersion string) ([]byte, error) {
+	if forkVersion == "" {
+		return params.BeaconConfig().GenesisForkVersion, nil
+	}
+	v, err := hex.DecodeString(strings.TrimPrefix(forkVersion, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode fork version")
+	}
+	if len(v) != 4 {
+		return nil, errors.Errorf("invalid fork version size, expected %d but got %d", 4, len(v))
+	}
+	return v, nil
+}
+
+// forkDigest computes the fork digest advertised, and optionally enforced, by the bootnode.
+// The genesis validators root is required, as it differs on every network.
+func forkDigest(fVersion []byte, genesisValidatorsRoot string) ([4]byte, error) {
+	if genesisValidatorsRoot == "" {
+		return [4]byte{}, errors.New("genesis validators root is required to compute the fork digest")
+	}
+	genRoot, err := hex.DecodeString(strings.TrimPrefix(genesisValidatorsRoot, "0x"))
+	if err != nil {
+		return [4]byte{}, errors.Wrap(err, "could not decode genesis validators root")
+	}
+	if len(genRoot) != 32 {
+		return [4]byte{}, errors.Errorf("invalid genesis validators root size, expected 32 but got %d", len(genRoot))
+	}
+	digest, err := signing.ComputeForkDigest(fVersion, genRoot)
+	if err != nil {
+		return [4]byte{}, errors.Wrap(err, "could not compute fork digest")
+	}
+	return digest, nil
+}
+
+// loadPrivateKey decodes the given hex encoded key. Without one, the key persisted in
+// the datadir is used, so that the bootnode keeps the same ENR across restarts, and
+// generated on first use.
+func loadPrivateKey(dataDir, hexKey string) (*ecdsa.PrivateKey, error) {
+	keyPath := ""
+	if hexKey == "" && dataDir != "" {
+		keyPath = filepath.Join(dataDir, privateKeyFileName)
+		if file.FileExists(keyPath) {
+			enc, err := os.ReadFile(keyPath) // #nosec G304 -- path is built from the configured datadir.
+			if err != nil {
+				return nil, errors.Wrap(err, "could not read private key")
+			}
+			hexKey = strings.TrimSpace(string(enc))
+		}
+	}
+	var priv crypto.PrivKey
+	if hexKey != "" {
+		dst, err := hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode private key")
+		}
+		priv, err = crypto.UnmarshalSecp256k1PrivateKey(dst)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal private key")
+		}
+	} else {
+		var err error
+		priv, _, err = crypto.GenerateSecp256k1Key(rand.Reader)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate private key")
+		}
+		if keyPath == "" {
+			log.Warn("No private key or datadir provided, using a random private key")
+		} else {
+			raw, err := priv.Raw()
+			if err != nil {
+				return nil, err
+			}
+			if err := file.MkdirAll(dataDir); err != nil {
+				return nil, errors.Wrap(err, "could not create datadir")
+			}
+			if err := file.WriteFile(keyPath, []byte(hex.EncodeToString(raw))); err != nil {
+				return nil, errors.Wrap(err, "could not persist private key")
+			}
+			log.WithField("path", keyPath).Info("Generated new private key")
+		}
+	}
+	privKey, err := ecdsaprysm.ConvertFromInterfacePrivKey(priv)
+	if err != nil {
+		return nil, err
+	}
+	privKey.Curve = gcrypto.S256()
+	return privKey, nil
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		log.WithError(err).Error("Could not read random bytes")
+	}
+	return b
+}
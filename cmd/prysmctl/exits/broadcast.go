@@ -0,0 +1,99 @@
+package exits
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/api/client/beacon"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/validator/exits"
+	"github.com/urfave/cli/v2"
+)
+
+var broadcastFlags = struct {
+	ExitsDir       string
+	BeaconNodeHost string
+	Timeout        time.Duration
+}{}
+
+var broadcastCmd = &cli.Command{
+	Name: "broadcast",
+	Usage: "Broadcast the pre-signed voluntary exits of a directory to a beacon node. The progress is saved in the " +
+		"directory, so that an interrupted broadcast resumes where it stopped and failed exits are retried",
+	Action: cliActionBroadcast,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "exits-dir",
+			Usage:       "directory of the pre-signed voluntary exits",
+			Destination: &broadcastFlags.ExitsDir,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node connection",
+			Destination: &broadcastFlags.BeaconNodeHost,
+			Value:       "localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-url (uses duration format, ex: 2m31s). default: 30s",
+			Destination: &broadcastFlags.Timeout,
+			Value:       time.Second * 30,
+		},
+	},
+}
+
+func cliActionBroadcast(c *cli.Context) error {
+	f := broadcastFlags
+	client, err := beacon.NewClient(f.BeaconNodeHost, beacon.WithTimeout(f.Timeout))
+	if err != nil {
+		return err
+	}
+	return broadcast(c.Context, client, f.ExitsDir)
+}
+
+func broadcast(ctx context.Context, client *beacon.Client, dir string) error {
+	signedExits, err := exits.Load(dir)
+	if err != nil {
+		return err
+	}
+	if len(signedExits) == 0 {
+		return errors.Errorf("no voluntary exits found in %s", dir)
+	}
+	progress, err := exits.LoadProgress(dir)
+	if err != nil {
+		return err
+	}
+	genesis, err := client.GetGenesis(ctx)
+	if err != nil {
+		return err
+	}
+	genesisTimeSec, err := strconv.ParseInt(genesis.GenesisTime, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid genesis time %s", genesis.GenesisTime)
+	}
+	submit := func(ctx context.Context, signedExit *ethpb.SignedVoluntaryExit) error {
+		body, err := json.Marshal(exits.ToJSON(signedExit))
+		if err != nil {
+			return err
+		}
+		return client.SubmitVoluntaryExit(ctx, body)
+	}
+	if err := exits.Broadcast(ctx, signedExits, submit, time.Unix(genesisTimeSec, 0), progress); err != nil {
+		return err
+	}
+	r := progress.Report()
+	if r.Failed > 0 {
+		return errors.Errorf("%d of %d voluntary exits could not be submitted, run the command again to retry them", r.Failed, r.Total)
+	}
+	if r.Held > 0 {
+		log.WithField("exitsDir", dir).Warnf(
+			"%d voluntary exits are for epochs which have not started yet, run the command again once they have to submit them", r.Held,
+		)
+	}
+	log.WithField("exitsDir", dir).Infof("Submitted %d voluntary exits", r.Submitted)
+	return nil
+}
@@ -0,0 +1,18 @@
+package exits
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var log = logrus.WithField("prefix", "exits")
+
+var Commands = []*cli.Command{
+	{
+		Name:  "exits",
+		Usage: "commands for voluntary exits pre-signed with the `validator accounts presign-exits` command",
+		Subcommands: []*cli.Command{
+			broadcastCmd,
+		},
+	},
+}
@@ -0,0 +1,14 @@
+package forkchoice
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:    "forkchoice",
+		Aliases: []string{"fc"},
+		Usage:   "commands for inspecting the forkchoice store of a beacon node",
+		Subcommands: []*cli.Command{
+			renderCmd,
+		},
+	},
+}
@@ -0,0 +1,138 @@
+package forkchoice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/forkchoice/graph"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	renderFlags = struct {
+		Input         string
+		BeaconNodeURL string
+		Output        string
+		Format        string
+		Timeout       time.Duration
+	}{}
+	log       = logrus.WithField("prefix", "forkchoice")
+	renderCmd = &cli.Command{
+		Name:   "render",
+		Usage:  "Render the forkchoice block tree of a beacon node, or of a saved forkchoice graph, with Graphviz",
+		Action: cliActionRender,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "input",
+				Usage:       "Path to a forkchoice graph previously saved in JSON from the beacon node's /eth/v1alpha1/debug/forkchoice_graph endpoint",
+				Destination: &renderFlags.Input,
+			},
+			&cli.StringFlag{
+				Name:        "beacon-node-url",
+				Usage:       "URL of the beacon node API to fetch the forkchoice graph from, used when no input is given",
+				Destination: &renderFlags.BeaconNodeURL,
+				Value:       "http://localhost:3500",
+			},
+			&cli.StringFlag{
+				Name:        "output",
+				Usage:       "Output filename of the rendered graph",
+				Destination: &renderFlags.Output,
+				Value:       "forkchoice.svg",
+			},
+			&cli.StringFlag{
+				Name:        "format",
+				Usage:       "Output format, one of svg, png or dot. Rendering to svg and png requires the Graphviz dot binary",
+				Destination: &renderFlags.Format,
+				Value:       "svg",
+			},
+			&cli.DurationFlag{
+				Name:        "http-timeout",
+				Usage:       "Timeout for the request made to beacon-node-url (uses duration format, ex: 2m31s)",
+				Destination: &renderFlags.Timeout,
+				Value:       time.Minute,
+			},
+		},
+	}
+)
+
+func cliActionRender(_ *cli.Context) error {
+	f := renderFlags
+	if f.Format != "svg" && f.Format != "png" && f.Format != "dot" {
+		return fmt.Errorf("unsupported format %s, expected one of svg, png or dot", f.Format)
+	}
+	var g *ethpb.ForkChoiceGraph
+	var err error
+	if f.Input != "" {
+		g, err = readGraph(f.Input)
+	} else {
+		g, err = fetchGraph(f.BeaconNodeURL, f.Timeout)
+	}
+	if err != nil {
+		return err
+	}
+	if err := render(g, f.Format, f.Output); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"nodes":  len(g.Nodes),
+		"output": f.Output,
+	}).Info("Rendered forkchoice graph")
+	return nil
+}
+
+// readGraph reads a forkchoice graph saved in JSON.
+func readGraph(path string) (*ethpb.ForkChoiceGraph, error) {
+	enc, err := os.ReadFile(path) // #nosec G304 -- The path is provided by the user.
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", path)
+	}
+	g := &ethpb.ForkChoiceGraph{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(enc, g); err != nil {
+		return nil, errors.Wrapf(err, "could not decode forkchoice graph from %s", path)
+	}
+	return g, nil
+}
+
+// fetchGraph requests the forkchoice graph from the debug API of a beacon node.
+func fetchGraph(beaconNodeURL string, timeout time.Duration) (*ethpb.ForkChoiceGraph, error) {
+	client, err := beacon.NewClient(beaconNodeURL, beacon.WithTimeout(timeout))
+	if err != nil {
+		return nil, err
+	}
+	return client.GetForkChoiceGraph(context.Background())
+}
+
+// render writes the graph to the output file, in the DOT language or rendered to an image
+// by the Graphviz dot binary. The DOT rendering of the beacon node is used when present.
+func render(g *ethpb.ForkChoiceGraph, format, output string) error {
+	dot := g.Dot
+	if dot == "" {
+		dot = graph.DOT(g)
+	}
+	if format == "dot" {
+		return file.WriteFile(output, []byte(dot))
+	}
+	bin, err := exec.LookPath("dot")
+	if err != nil {
+		return errors.Wrap(err, "could not find the Graphviz dot binary, install Graphviz or use the dot format")
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(bin, "-T"+format, "-o", output) // #nosec G204 -- The format is one of a fixed set.
+	cmd.Stdin = strings.NewReader(dot)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "could not render forkchoice graph: %s", strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package forkchoice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func testGraph() *ethpb.ForkChoiceGraph {
+	a, b, c := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}
+	return &ethpb.ForkChoiceGraph{
+		JustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: a[:]},
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: a[:]},
+		ProposerBoostRoot:   c[:],
+		HeadRoot:            b[:],
+		Nodes: []*ethpb.ForkChoiceGraphNode{
+			{Slot: 32, Root: a[:], ParentRoot: make([]byte, 32), Weight: 96, Justified: true, Finalized: true},
+			{Slot: 33, Root: b[:], ParentRoot: a[:], Weight: 64, Balance: 64, Head: true},
+			{Slot: 34, Root: c[:], ParentRoot: a[:], Weight: 32, Balance: 32, ExecutionOptimistic: true, ProposerBoost: true},
+		},
+	}
+}
+
+func TestFetchGraph(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		enc, err := protojson.Marshal(testGraph())
+		require.NoError(t, err)
+		_, err = w.Write(enc)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	g, err := fetchGraph(srv.URL, time.Second)
+	require.NoError(t, err)
+	assert.DeepEqual(t, testGraph(), g)
+}
+
+func TestReadGraph_RenderDOT(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "forkchoice.json")
+	enc, err := protojson.Marshal(testGraph())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(input, enc, 0600))
+
+	g, err := readGraph(input)
+	require.NoError(t, err)
+	output := filepath.Join(dir, "forkchoice.dot")
+	require.NoError(t, render(g, "dot", output))
+	out, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, true, strings.HasPrefix(string(out), "digraph"))
+	assert.Equal(t, 2, strings.Count(string(out), "->"))
+	assert.Equal(t, true, strings.Contains(string(out), "boosted, optimistic"))
+
+	// The DOT rendering of the beacon node is written as is.
+	g.Dot = "digraph {}"
+	require.NoError(t, render(g, "dot", output))
+	out, err = os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, "digraph {}", string(out))
+
+	_, err = readGraph(filepath.Join(dir, "missing.json"))
+	require.ErrorContains(t, "could not read", err)
+}
@@ -3,10 +3,14 @@ package main
 import (
 	"os"
 
+	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/bootnode"
 	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/checkpointsync"
 	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/deprecated"
+	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/exits"
+	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/forkchoice"
 	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/p2p"
 	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/testnet"
+	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/threshold"
 	"github.com/prysmaticlabs/prysm/v3/cmd/prysmctl/weaksubjectivity"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -29,8 +33,12 @@ func init() {
 	// pointing to their new locations
 	prysmctlCommands = append(prysmctlCommands, deprecated.Commands...)
 
+	prysmctlCommands = append(prysmctlCommands, bootnode.Commands...)
 	prysmctlCommands = append(prysmctlCommands, checkpointsync.Commands...)
+	prysmctlCommands = append(prysmctlCommands, exits.Commands...)
+	prysmctlCommands = append(prysmctlCommands, forkchoice.Commands...)
 	prysmctlCommands = append(prysmctlCommands, p2p.Commands...)
 	prysmctlCommands = append(prysmctlCommands, testnet.Commands...)
+	prysmctlCommands = append(prysmctlCommands, threshold.Commands...)
 	prysmctlCommands = append(prysmctlCommands, weaksubjectivity.Commands...)
 }
@@ -45,18 +45,42 @@ type client struct {
 }
 
 func newClient(beaconEndpoints []string, clientPort uint) (*client, error) {
+	h, meta, err := newHost(clientPort)
+	if err != nil {
+		return nil, err
+	}
+	if len(beaconEndpoints) == 0 {
+		return nil, errors.New("no specified beacon API endpoints")
+	}
+	conn, err := grpc.Dial(beaconEndpoints[0], grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	beaconClient := pb.NewBeaconChainClient(conn)
+	nodeClient := pb.NewNodeClient(conn)
+	return &client{
+		host:         h,
+		meta:         meta,
+		beaconClient: beaconClient,
+		nodeClient:   nodeClient,
+	}, nil
+}
+
+// Sets up a libp2p host listening on the given TCP port, along with the
+// default metadata the client advertises to its peers.
+func newHost(clientPort uint) (host.Host, metadata.Metadata, error) {
 	ipAdd := ipAddr()
 	priv, err := privKey()
 	if err != nil {
-		return nil, errors.Wrap(err, "could not set up p2p private key")
+		return nil, nil, errors.Wrap(err, "could not set up p2p private key")
 	}
 	meta, err := readMetadata()
 	if err != nil {
-		return nil, errors.Wrap(err, "could not set up p2p metadata")
+		return nil, nil, errors.Wrap(err, "could not set up p2p metadata")
 	}
 	listen, err := p2p.MultiAddressBuilder(ipAdd.String(), clientPort)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not set up listening multiaddr")
+		return nil, nil, errors.Wrap(err, "could not set up listening multiaddr")
 	}
 	options := []libp2p.Option{
 		privKeyOption(priv),
@@ -68,24 +92,10 @@ func newClient(beaconEndpoints []string, clientPort uint) (*client, error) {
 	options = append(options, libp2p.Ping(false))
 	h, err := libp2p.New(options...)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not start libp2p")
+		return nil, nil, errors.Wrap(err, "could not start libp2p")
 	}
 	h.RemoveStreamHandler(identify.IDDelta)
-	if len(beaconEndpoints) == 0 {
-		return nil, errors.New("no specified beacon API endpoints")
-	}
-	conn, err := grpc.Dial(beaconEndpoints[0], grpc.WithInsecure())
-	if err != nil {
-		return nil, err
-	}
-	beaconClient := pb.NewBeaconChainClient(conn)
-	nodeClient := pb.NewNodeClient(conn)
-	return &client{
-		host:         h,
-		meta:         meta,
-		beaconClient: beaconClient,
-		nodeClient:   nodeClient,
-	}, nil
+	return h, meta, nil
 }
 
 func (c *client) Close() {
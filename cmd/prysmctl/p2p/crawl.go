@@ -0,0 +1,140 @@
+package p2p
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	p2ptypes "github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var crawlFlags = struct {
+	Bootnodes     *cli.StringSlice
+	ClientPort    uint
+	DiscoveryPort uint
+	Duration      time.Duration
+	DialTimeout   time.Duration
+	Concurrency   uint
+	Output        string
+}{
+	Bootnodes: cli.NewStringSlice(),
+}
+
+var crawlCmd = &cli.Command{
+	Name: "crawl",
+	Usage: "Crawl the network with discv5 from the configured bootnodes, performing the status and " +
+		"metadata handshake with every reachable peer and writing a census report",
+	Action: cliActionCrawl,
+	Flags: []cli.Flag{
+		cmd.ChainConfigFileFlag,
+		&cli.StringSliceFlag{
+			Name:        "bootstrap-node",
+			Usage:       "discv5 ENR(s) of the bootnodes to start crawling from, defaults to the bootnodes of the configured network",
+			Destination: crawlFlags.Bootnodes,
+		},
+		&cli.UintFlag{
+			Name:        "client-port",
+			Usage:       "TCP port to use for the client as a libp2p host",
+			Destination: &crawlFlags.ClientPort,
+			Value:       13001,
+		},
+		&cli.UintFlag{
+			Name:        "discovery-port",
+			Usage:       "UDP port to use for discv5",
+			Destination: &crawlFlags.DiscoveryPort,
+			Value:       12001,
+		},
+		&cli.DurationFlag{
+			Name:        "duration",
+			Usage:       "how long to keep discovering new peers before writing the report",
+			Destination: &crawlFlags.Duration,
+			Value:       10 * time.Minute,
+		},
+		&cli.DurationFlag{
+			Name:        "dial-timeout",
+			Usage:       "time allowed to connect to and handshake with a single peer",
+			Destination: &crawlFlags.DialTimeout,
+			Value:       10 * time.Second,
+		},
+		&cli.UintFlag{
+			Name:        "concurrency",
+			Usage:       "number of peers to handshake with concurrently",
+			Destination: &crawlFlags.Concurrency,
+			Value:       16,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "path of the JSON report to write",
+			Destination: &crawlFlags.Output,
+			Value:       "crawl-report.json",
+		},
+	},
+}
+
+func cliActionCrawl(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
+			return err
+		}
+	}
+	p2ptypes.InitializeDataMaps()
+
+	bootnodes := crawlFlags.Bootnodes.Value()
+	if len(bootnodes) == 0 {
+		bootnodes = params.BeaconNetworkConfig().BootstrapNodes
+	}
+	if len(bootnodes) == 0 {
+		return errors.New("no bootnodes provided")
+	}
+	if crawlFlags.Concurrency == 0 {
+		return errors.New("concurrency must be greater than 0")
+	}
+
+	ctx, cancel := context.WithTimeout(cliCtx.Context, crawlFlags.Duration)
+	defer cancel()
+	// Allow the crawl to be stopped early while still writing out what was found so far.
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	go func() {
+		select {
+		case <-sigc:
+			log.Info("Interrupted, stopping crawl")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	c, err := newCrawler(&crawlerConfig{
+		bootnodes:     bootnodes,
+		clientPort:    crawlFlags.ClientPort,
+		discoveryPort: crawlFlags.DiscoveryPort,
+		dialTimeout:   crawlFlags.DialTimeout,
+		concurrency:   int(crawlFlags.Concurrency),
+	})
+	if err != nil {
+		return err
+	}
+	defer c.close()
+
+	log.WithFields(logrus.Fields{
+		"bootnodes": len(bootnodes),
+		"duration":  crawlFlags.Duration,
+	}).Info("Starting network crawl")
+	report := c.crawl(ctx)
+
+	if err := report.writeJSON(crawlFlags.Output); err != nil {
+		return errors.Wrap(err, "could not write crawl report")
+	}
+	report.logSummary()
+	log.WithField("path", crawlFlags.Output).Info("Wrote crawl report")
+	return nil
+}
@@ -0,0 +1,123 @@
+package p2p
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+const unknownClient = "unknown"
+
+// crawledPeer is the census entry for a single node found during a crawl.
+type crawledPeer struct {
+	NodeID          string      `json:"node_id"`
+	ENR             string      `json:"enr"`
+	PeerID          string      `json:"peer_id,omitempty"`
+	IP              string      `json:"ip,omitempty"`
+	TCPPort         int         `json:"tcp_port,omitempty"`
+	UDPPort         int         `json:"udp_port,omitempty"`
+	Agent           string      `json:"agent,omitempty"`
+	Client          string      `json:"client,omitempty"`
+	ForkDigest      string      `json:"fork_digest,omitempty"`
+	NextForkVersion string      `json:"next_fork_version,omitempty"`
+	NextForkEpoch   types.Epoch `json:"next_fork_epoch,omitempty"`
+	HeadSlot        types.Slot  `json:"head_slot,omitempty"`
+	FinalizedEpoch  types.Epoch `json:"finalized_epoch,omitempty"`
+	Attnets         string      `json:"attnets,omitempty"`
+	Syncnets        string      `json:"syncnets,omitempty"`
+	Reachable       bool        `json:"reachable"`
+	Error           string      `json:"error,omitempty"`
+	CrawledAt       time.Time   `json:"crawled_at"`
+}
+
+// crawlSummary aggregates the census by client and by fork digest.
+type crawlSummary struct {
+	Discovered   int            `json:"discovered"`
+	Reachable    int            `json:"reachable"`
+	ByClient     map[string]int `json:"by_client"`
+	ByForkDigest map[string]int `json:"by_fork_digest"`
+}
+
+// crawlReport is the full output of a crawl, written to disk as JSON.
+type crawlReport struct {
+	Started  time.Time      `json:"started"`
+	Finished time.Time      `json:"finished"`
+	Summary  *crawlSummary  `json:"summary"`
+	Peers    []*crawledPeer `json:"peers"`
+}
+
+func newCrawlReport(peers []*crawledPeer, started, finished time.Time) *crawlReport {
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].NodeID < peers[j].NodeID
+	})
+	return &crawlReport{
+		Started:  started,
+		Finished: finished,
+		Summary:  summarizeCrawl(peers),
+		Peers:    peers,
+	}
+}
+
+// Client counts only include reachable peers, as the agent string is learnt
+// over libp2p. Fork digests are counted for every peer, as they are also advertised
+// in the ENR.
+func summarizeCrawl(peers []*crawledPeer) *crawlSummary {
+	s := &crawlSummary{
+		Discovered:   len(peers),
+		ByClient:     make(map[string]int),
+		ByForkDigest: make(map[string]int),
+	}
+	for _, p := range peers {
+		if p.Reachable {
+			s.Reachable++
+			s.ByClient[p.Client]++
+		}
+		if p.ForkDigest != "" {
+			s.ByForkDigest[p.ForkDigest]++
+		}
+	}
+	return s
+}
+
+func (r *crawlReport) writeJSON(path string) error {
+	enc, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return file.WriteFile(path, enc)
+}
+
+func (r *crawlReport) logSummary() {
+	log.WithFields(logrus.Fields{
+		"discovered": r.Summary.Discovered,
+		"reachable":  r.Summary.Reachable,
+		"elapsed":    r.Finished.Sub(r.Started),
+	}).Info("Crawl finished")
+	for client, count := range r.Summary.ByClient {
+		log.WithFields(logrus.Fields{
+			"client": client,
+			"peers":  count,
+		}).Info("Peers by client")
+	}
+	for digest, count := range r.Summary.ByForkDigest {
+		log.WithFields(logrus.Fields{
+			"forkDigest": digest,
+			"peers":      count,
+		}).Info("Peers by fork digest")
+	}
+}
+
+// clientName extracts the client implementation from a libp2p agent string, such
+// as "Prysm/v3.1.2/3d6d0a1" or "Lighthouse/v3.2.1-aa022f4/x86_64-linux".
+func clientName(agent string) string {
+	name := strings.ToLower(strings.TrimSpace(strings.Split(agent, "/")[0]))
+	if name == "" {
+		return unknownClient
+	}
+	return name
+}
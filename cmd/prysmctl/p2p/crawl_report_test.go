@@ -0,0 +1,56 @@
+package p2p
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestClientName(t *testing.T) {
+	tests := []struct {
+		agent string
+		want  string
+	}{
+		{agent: "Prysm/v3.1.2/3d6d0a1", want: "prysm"},
+		{agent: "Lighthouse/v3.2.1-aa022f4/x86_64-linux", want: "lighthouse"},
+		{agent: "teku/teku/v22.10.1/linux-x86_64/-eclipseadoptium-openjdk64bitservervm-java-17", want: "teku"},
+		{agent: "nimbus", want: "nimbus"},
+		{agent: "", want: unknownClient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.agent, func(t *testing.T) {
+			assert.Equal(t, tt.want, clientName(tt.agent))
+		})
+	}
+}
+
+func TestCrawlReport_Summary(t *testing.T) {
+	peers := []*crawledPeer{
+		{NodeID: "c", Client: "prysm", ForkDigest: "4a26c58b", Reachable: true},
+		{NodeID: "a", Client: "lighthouse", ForkDigest: "4a26c58b", Reachable: true},
+		{NodeID: "b", Client: "prysm", ForkDigest: "afcaaba0", Reachable: true},
+		{NodeID: "d", ForkDigest: "4a26c58b"},
+		{NodeID: "e"},
+	}
+	report := newCrawlReport(peers, time.Now(), time.Now())
+
+	assert.Equal(t, 5, report.Summary.Discovered)
+	assert.Equal(t, 3, report.Summary.Reachable)
+	assert.DeepEqual(t, map[string]int{"prysm": 2, "lighthouse": 1}, report.Summary.ByClient)
+	assert.DeepEqual(t, map[string]int{"4a26c58b": 3, "afcaaba0": 1}, report.Summary.ByForkDigest)
+	assert.Equal(t, "a", report.Peers[0].NodeID)
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, report.writeJSON(path))
+	enc, err := os.ReadFile(path)
+	require.NoError(t, err)
+	decoded := &crawlReport{}
+	require.NoError(t, json.Unmarshal(enc, decoded))
+	assert.Equal(t, 5, len(decoded.Peers))
+	assert.Equal(t, 3, decoded.Summary.Reachable)
+}
@@ -0,0 +1,424 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	libp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/v3/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	ecdsaprysm "github.com/prysmaticlabs/prysm/v3/crypto/ecdsa"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+type crawlerConfig struct {
+	bootnodes     []string
+	clientPort    uint
+	discoveryPort uint
+	dialTimeout   time.Duration
+	concurrency   int
+}
+
+// A crawler walks the discv5 DHT from a set of bootnodes and performs the
+// status and metadata handshake with every peer it is able to reach.
+type crawler struct {
+	cfg        *crawlerConfig
+	client     *client
+	listener   *discover.UDPv5
+	nodeDB     *enode.DB
+	statusLock sync.RWMutex
+	statuses   map[[4]byte]*pb.Status
+}
+
+func newCrawler(cfg *crawlerConfig) (*crawler, error) {
+	h, meta, err := newHost(cfg.clientPort)
+	if err != nil {
+		return nil, err
+	}
+	c := &client{
+		host: h,
+		meta: meta,
+	}
+	db, err := enode.OpenDB("")
+	if err != nil {
+		c.Close()
+		return nil, errors.Wrap(err, "could not open node database")
+	}
+	listener, err := startCrawlerDiscovery(cfg, db)
+	if err != nil {
+		db.Close()
+		c.Close()
+		return nil, err
+	}
+	cr := &crawler{
+		cfg:      cfg,
+		client:   c,
+		listener: listener,
+		nodeDB:   db,
+		statuses: make(map[[4]byte]*pb.Status),
+	}
+	// Peers may open the handshake themselves, and drop us if we do not answer it.
+	c.registerRPCHandler(p2p.RPCPingTopicV1, c.pingHandler)
+	c.registerRPCHandler(p2p.RPCGoodByeTopicV1, c.goodbyeHandler)
+	c.registerRPCHandler(p2p.RPCStatusTopicV1, cr.statusRPCHandler)
+	c.registerRPCHandler(p2p.RPCMetaDataTopicV1, c.metaDataV0Handler)
+	c.registerRPCHandler(p2p.RPCMetaDataTopicV2, c.metaDataHandler)
+	return cr, nil
+}
+
+func (cr *crawler) close() {
+	cr.listener.Close()
+	cr.nodeDB.Close()
+	cr.client.Close()
+}
+
+// crawl keeps walking random nodes of the DHT until the context is done, visiting
+// each newly discovered node once, and returns a report of everything it found.
+func (cr *crawler) crawl(ctx context.Context) *crawlReport {
+	start := time.Now()
+	iterator := cr.listener.RandomNodes()
+	go func() {
+		<-ctx.Done()
+		iterator.Close()
+	}()
+
+	var lock sync.Mutex
+	peers := make([]*crawledPeer, 0)
+	nodes := make(chan *enode.Node)
+	var wg sync.WaitGroup
+	for i := 0; i < cr.cfg.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for node := range nodes {
+				cp := cr.visit(ctx, node)
+				lock.Lock()
+				peers = append(peers, cp)
+				lock.Unlock()
+			}
+		}()
+	}
+
+	seen := make(map[enode.ID]bool)
+	for iterator.Next() {
+		node := iterator.Node()
+		if seen[node.ID()] {
+			continue
+		}
+		seen[node.ID()] = true
+		select {
+		case nodes <- node:
+		case <-ctx.Done():
+		}
+		if len(seen)%100 == 0 {
+			log.WithField("discovered", len(seen)).Info("Crawl progress")
+		}
+	}
+	close(nodes)
+	wg.Wait()
+	return newCrawlReport(peers, start, time.Now())
+}
+
+// visit records the details a node advertises in its ENR, then dials it and
+// performs the status and metadata handshake.
+func (cr *crawler) visit(ctx context.Context, node *enode.Node) *crawledPeer {
+	cp := peerFromENR(node)
+	info, err := addrInfoFromNode(node)
+	if err != nil {
+		cp.Error = err.Error()
+		return cp
+	}
+	cp.PeerID = info.ID.String()
+
+	ctx, cancel := context.WithTimeout(ctx, cr.cfg.dialTimeout)
+	defer cancel()
+	if err := cr.client.host.Connect(ctx, *info); err != nil {
+		cp.Error = errors.Wrap(err, "could not connect").Error()
+		return cp
+	}
+	defer func() {
+		if err := cr.client.host.Network().ClosePeer(info.ID); err != nil {
+			log.WithError(err).Debug("Could not disconnect from peer")
+		}
+	}()
+	cp.Reachable = true
+	rawAgent, err := cr.client.host.Peerstore().Get(info.ID, "AgentVersion")
+	if agent, ok := rawAgent.(string); err == nil && ok {
+		cp.Agent = agent
+	}
+	cp.Client = clientName(cp.Agent)
+
+	if cp.ForkDigest == "" {
+		cp.Error = "no eth2 entry in ENR, skipping handshake"
+		return cp
+	}
+	digest, err := hex.DecodeString(cp.ForkDigest)
+	if err != nil {
+		cp.Error = errors.Wrap(err, "could not decode fork digest").Error()
+		return cp
+	}
+	status, err := cr.requestStatus(ctx, info.ID, digest)
+	if err != nil {
+		cp.Error = errors.Wrap(err, "status handshake failed").Error()
+		return cp
+	}
+	cr.recordStatus(status)
+	cp.ForkDigest = hex.EncodeToString(status.ForkDigest)
+	cp.HeadSlot = status.HeadSlot
+	cp.FinalizedEpoch = status.FinalizedEpoch
+
+	md, err := cr.requestMetadata(ctx, info.ID)
+	if err != nil {
+		cp.Error = errors.Wrap(err, "metadata request failed").Error()
+		return cp
+	}
+	cp.Attnets = hex.EncodeToString(md.Attnets)
+	cp.Syncnets = hex.EncodeToString(md.Syncnets)
+	log.WithFields(logrus.Fields{
+		"peer":     cp.PeerID,
+		"agent":    cp.Agent,
+		"headSlot": cp.HeadSlot,
+	}).Debug("Completed handshake with peer")
+	return cp
+}
+
+// requestStatus sends a status message using the fork digest the peer advertises,
+// so that the peer does not drop us before answering.
+func (cr *crawler) requestStatus(ctx context.Context, pid peer.ID, digest []byte) (*pb.Status, error) {
+	stream, err := cr.client.Send(ctx, cr.status(digest), p2p.RPCStatusTopicV1, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer closeStream(stream)
+	prysmsync.SetStreamReadDeadline(stream, cr.cfg.dialTimeout)
+	code, errMsg, err := prysmsync.ReadStatusCode(stream, cr.client.Encoding())
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return nil, errors.New(errMsg)
+	}
+	msg := &pb.Status{}
+	if err := cr.client.Encoding().DecodeWithMaxLength(stream, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// requestMetadata requests the v2 metadata of the peer, falling back to v1 for
+// peers which have not upgraded past phase 0.
+func (cr *crawler) requestMetadata(ctx context.Context, pid peer.ID) (*pb.MetaDataV1, error) {
+	md := &pb.MetaDataV1{}
+	err := cr.readMetadata(ctx, pid, p2p.RPCMetaDataTopicV2, md)
+	if err == nil {
+		return md, nil
+	}
+	mdV0 := &pb.MetaDataV0{}
+	if v0Err := cr.readMetadata(ctx, pid, p2p.RPCMetaDataTopicV1, mdV0); v0Err != nil {
+		return nil, err
+	}
+	return &pb.MetaDataV1{
+		SeqNumber: mdV0.SeqNumber,
+		Attnets:   mdV0.Attnets,
+	}, nil
+}
+
+func (cr *crawler) readMetadata(ctx context.Context, pid peer.ID, topic string, msg ssz.Unmarshaler) error {
+	// Metadata requests have an empty body.
+	stream, err := cr.client.Send(ctx, nil, topic, pid)
+	if err != nil {
+		return err
+	}
+	defer closeStream(stream)
+	prysmsync.SetStreamReadDeadline(stream, cr.cfg.dialTimeout)
+	code, errMsg, err := prysmsync.ReadStatusCode(stream, cr.client.Encoding())
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		return errors.New(errMsg)
+	}
+	return cr.client.Encoding().DecodeWithMaxLength(stream, msg)
+}
+
+// status returns the status the crawler advertises on the given fork digest. As the
+// crawler does not follow the chain, it advertises the most advanced status it has
+// received from peers on that fork digest, so that peers find it consistent with
+// their view of the chain.
+func (cr *crawler) status(digest []byte) *pb.Status {
+	cr.statusLock.RLock()
+	defer cr.statusLock.RUnlock()
+	if best, ok := cr.statuses[bytesutil.ToBytes4(digest)]; ok {
+		return best
+	}
+	return &pb.Status{
+		ForkDigest:     digest,
+		FinalizedRoot:  params.BeaconConfig().ZeroHash[:],
+		FinalizedEpoch: 0,
+		HeadRoot:       params.BeaconConfig().ZeroHash[:],
+		HeadSlot:       0,
+	}
+}
+
+// recordStatus keeps the status of a peer if it is the most advanced one seen on its fork digest.
+func (cr *crawler) recordStatus(status *pb.Status) {
+	digest := bytesutil.ToBytes4(status.ForkDigest)
+	cr.statusLock.Lock()
+	defer cr.statusLock.Unlock()
+	best, ok := cr.statuses[digest]
+	if ok && (best.FinalizedEpoch > status.FinalizedEpoch ||
+		best.FinalizedEpoch == status.FinalizedEpoch && best.HeadSlot >= status.HeadSlot) {
+		return
+	}
+	cr.statuses[digest] = status
+}
+
+// statusRPCHandler answers the status request of a peer with the status the crawler
+// advertises on the fork digest of the peer.
+func (cr *crawler) statusRPCHandler(_ context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	defer closeStream(stream)
+	m, ok := msg.(*pb.Status)
+	if !ok {
+		return errors.Errorf("wrong message type for status, got %T", msg)
+	}
+	cr.recordStatus(m)
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	_, err := cr.client.Encoding().EncodeWithMaxLength(stream, cr.status(m.ForkDigest))
+	return err
+}
+
+// metaDataHandler responds to metadata requests with the metadata of the client.
+func (c *client) metaDataHandler(_ context.Context, _ interface{}, stream libp2pcore.Stream) error {
+	defer closeStream(stream)
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	_, err := c.Encoding().EncodeWithMaxLength(stream, c.meta.MetadataObjV1())
+	return err
+}
+
+// metaDataV0Handler responds to phase 0 metadata requests with the metadata of the client.
+func (c *client) metaDataV0Handler(_ context.Context, _ interface{}, stream libp2pcore.Stream) error {
+	defer closeStream(stream)
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	md := c.meta.MetadataObjV1()
+	_, err := c.Encoding().EncodeWithMaxLength(stream, &pb.MetaDataV0{
+		SeqNumber: md.SeqNumber,
+		Attnets:   md.Attnets,
+	})
+	return err
+}
+
+// Records everything a node advertises about itself in its ENR.
+func peerFromENR(node *enode.Node) *crawledPeer {
+	cp := &crawledPeer{
+		NodeID:    node.ID().String(),
+		ENR:       node.String(),
+		TCPPort:   node.TCP(),
+		UDPPort:   node.UDP(),
+		CrawledAt: time.Now(),
+	}
+	if node.IP() != nil {
+		cp.IP = node.IP().String()
+	}
+	record := node.Record()
+	sszEncodedForkEntry := make([]byte, 16)
+	if err := record.Load(enr.WithEntry(params.BeaconNetworkConfig().ETH2Key, &sszEncodedForkEntry)); err == nil {
+		forkID := &pb.ENRForkID{}
+		if err := forkID.UnmarshalSSZ(sszEncodedForkEntry); err == nil {
+			cp.ForkDigest = hex.EncodeToString(forkID.CurrentForkDigest)
+			cp.NextForkVersion = hex.EncodeToString(forkID.NextForkVersion)
+			cp.NextForkEpoch = forkID.NextForkEpoch
+		}
+	}
+	attnets := bitfield.NewBitvector64()
+	if err := record.Load(enr.WithEntry(params.BeaconNetworkConfig().AttSubnetKey, &attnets)); err == nil {
+		cp.Attnets = hex.EncodeToString(attnets)
+	}
+	syncnets := bitfield.Bitvector4{byte(0x00)}
+	if err := record.Load(enr.WithEntry(params.BeaconNetworkConfig().SyncCommsSubnetKey, &syncnets)); err == nil {
+		cp.Syncnets = hex.EncodeToString(syncnets)
+	}
+	return cp
+}
+
+// Converts a discovered node into the libp2p address info used to dial it over TCP.
+func addrInfoFromNode(node *enode.Node) (*peer.AddrInfo, error) {
+	if node.IP() == nil || node.TCP() == 0 {
+		return nil, errors.New("node does not advertise a TCP endpoint")
+	}
+	pubkey, err := ecdsaprysm.ConvertToInterfacePubkey(node.Pubkey())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pubkey")
+	}
+	id, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get peer id")
+	}
+	addr, err := p2p.MultiAddressBuilder(node.IP().String(), uint(node.TCP()))
+	if err != nil {
+		return nil, err
+	}
+	return &peer.AddrInfo{ID: id, Addrs: []multiaddr.Multiaddr{addr}}, nil
+}
+
+// Starts a discv5 listener with a throwaway identity, seeded with the provided bootnodes.
+func startCrawlerDiscovery(cfg *crawlerConfig, db *enode.DB) (*discover.UDPv5, error) {
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := ecdsaprysm.ConvertFromInterfacePrivKey(priv)
+	if err != nil {
+		return nil, err
+	}
+	bootnodes := make([]*enode.Node, 0, len(cfg.bootnodes))
+	for _, addr := range cfg.bootnodes {
+		bootNode, err := enode.Parse(enode.ValidSchemes, addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse bootnode %s", addr)
+		}
+		bootnodes = append(bootnodes, bootNode)
+	}
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4zero, Port: int(cfg.discoveryPort)})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not listen to UDP")
+	}
+	localNode := enode.NewLocalNode(db, privKey)
+	ip := ipAddr()
+	localNode.Set(enr.IP(ip))
+	localNode.Set(enr.UDP(cfg.discoveryPort))
+	localNode.SetFallbackIP(ip)
+	localNode.SetFallbackUDP(int(cfg.discoveryPort))
+	listener, err := discover.ListenV5(conn, localNode, discover.Config{
+		PrivateKey: privKey,
+		Bootnodes:  bootnodes,
+	})
+	if err != nil {
+		if closeErr := conn.Close(); closeErr != nil {
+			log.WithError(closeErr).Debug("Could not close UDP connection")
+		}
+		return nil, errors.Wrap(err, "could not listen to discV5")
+	}
+	return listener, nil
+}
@@ -0,0 +1,98 @@
+package p2p
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ecdsaprysm "github.com/prysmaticlabs/prysm/v3/crypto/ecdsa"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func newTestCrawler(t *testing.T) *crawler {
+	cr, err := newCrawler(&crawlerConfig{
+		dialTimeout: 5 * time.Second,
+		concurrency: 1,
+	})
+	require.NoError(t, err)
+	t.Cleanup(cr.close)
+	return cr
+}
+
+// Returns the node record of a crawler, advertising the given fork digest.
+func crawlerNode(t *testing.T, cr *crawler, digest [4]byte) *enode.Node {
+	h := cr.client.host
+	priv, err := ecdsaprysm.ConvertFromInterfacePrivKey(h.Peerstore().PrivKey(h.ID()))
+	require.NoError(t, err)
+	rawPort, err := h.Addrs()[0].ValueForProtocol(ma.P_TCP)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(rawPort)
+	require.NoError(t, err)
+	forkEntry, err := (&pb.ENRForkID{
+		CurrentForkDigest: digest[:],
+		NextForkVersion:   params.BeaconConfig().GenesisForkVersion,
+		NextForkEpoch:     params.BeaconConfig().FarFutureEpoch,
+	}).MarshalSSZ()
+	require.NoError(t, err)
+
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+	localNode := enode.NewLocalNode(db, priv)
+	// The host of the crawler listens on the external address of the machine.
+	localNode.SetStaticIP(ipAddr())
+	localNode.Set(enr.TCP(port))
+	localNode.Set(enr.WithEntry(params.BeaconNetworkConfig().ETH2Key, forkEntry))
+	return localNode.Node()
+}
+
+func TestCrawler_Visit(t *testing.T) {
+	digest := [4]byte{1, 2, 3, 4}
+	crawling := newTestCrawler(t)
+	crawled := newTestCrawler(t)
+	crawled.recordStatus(&pb.Status{
+		ForkDigest:     digest[:],
+		FinalizedRoot:  bytesutil.PadTo([]byte("finalized"), 32),
+		FinalizedEpoch: 3,
+		HeadRoot:       bytesutil.PadTo([]byte("head"), 32),
+		HeadSlot:       100,
+	})
+
+	cp := crawling.visit(context.Background(), crawlerNode(t, crawled, digest))
+	require.Equal(t, "", cp.Error)
+	assert.Equal(t, true, cp.Reachable)
+	assert.Equal(t, "01020304", cp.ForkDigest)
+	assert.Equal(t, types.Slot(100), cp.HeadSlot)
+	assert.Equal(t, types.Epoch(3), cp.FinalizedEpoch)
+	assert.Equal(t, "0000000000000000", cp.Attnets)
+
+	// The crawler advertises the most advanced status it has seen from then on.
+	status := crawling.status(digest[:])
+	assert.Equal(t, types.Slot(100), status.HeadSlot)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte("finalized"), 32), status.FinalizedRoot)
+	// Less advanced statuses are not kept.
+	crawling.recordStatus(&pb.Status{ForkDigest: digest[:], FinalizedEpoch: 3, HeadSlot: 90})
+	assert.Equal(t, types.Slot(100), crawling.status(digest[:]).HeadSlot)
+}
+
+func TestCrawler_Visit_Canceled(t *testing.T) {
+	digest := [4]byte{1, 2, 3, 4}
+	crawling := newTestCrawler(t)
+	crawled := newTestCrawler(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cp := crawling.visit(ctx, crawlerNode(t, crawled, digest))
+	assert.Equal(t, false, cp.Reachable)
+	assert.Equal(t, true, strings.Contains(cp.Error, "context canceled"))
+}
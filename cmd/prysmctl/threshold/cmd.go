@@ -0,0 +1,19 @@
+package threshold
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var log = logrus.WithField("prefix", "threshold")
+
+var Commands = []*cli.Command{
+	{
+		Name:  "threshold",
+		Usage: "commands for threshold signing, where validating keys are split into shares held by several co-signers",
+		Subcommands: []*cli.Command{
+			splitCmd,
+			cosignCmd,
+		},
+	},
+}
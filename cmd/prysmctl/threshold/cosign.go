@@ -0,0 +1,190 @@
+package threshold
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/threshold"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	cosignFlags = struct {
+		WalletDir             string
+		WalletPasswordFile    string
+		DataDir               string
+		GenesisValidatorsRoot string
+		HTTPHost              string
+		HTTPPort              uint
+		TLSCertPath           string
+		TLSKeyPath            string
+	}{}
+	cosignCmd = &cli.Command{
+		Name: "cosign",
+		Usage: "Serve partial signatures with the key shares of a participant's threshold wallet to its co-signers, " +
+			"applying slashing protection to every block and attestation. Requests must bear the auth token of the participant",
+		Action: cliActionCosign,
+		Flags: []cli.Flag{
+			cmd.ChainConfigFileFlag,
+			&cli.StringFlag{
+				Name:        "wallet-dir",
+				Usage:       "Path to the threshold wallet of the participant, as written by the split command",
+				Destination: &cosignFlags.WalletDir,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "wallet-password-file",
+				Usage:       "Path to a file containing the password of the wallet",
+				Destination: &cosignFlags.WalletPasswordFile,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "datadir",
+				Usage:       "Directory of the slashing protection database of the co-signer",
+				Destination: &cosignFlags.DataDir,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "genesis-validators-root",
+				Usage:       "Hex encoded genesis validators root of the network, which signature domains are derived from",
+				Destination: &cosignFlags.GenesisValidatorsRoot,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "http-host",
+				Usage:       "Host on which the co-signer listens",
+				Destination: &cosignFlags.HTTPHost,
+				Value:       "127.0.0.1",
+			},
+			&cli.UintFlag{
+				Name:        "http-port",
+				Usage:       "Port on which the co-signer listens",
+				Destination: &cosignFlags.HTTPPort,
+				Value:       7600,
+			},
+			&cli.StringFlag{
+				Name:        "tls-cert",
+				Usage:       "Path to a certificate to serve partial signatures over TLS, along with --tls-key",
+				Destination: &cosignFlags.TLSCertPath,
+			},
+			&cli.StringFlag{
+				Name:        "tls-key",
+				Usage:       "Path to the private key of the --tls-cert certificate",
+				Destination: &cosignFlags.TLSKeyPath,
+			},
+		},
+	}
+)
+
+func cliActionCosign(cliCtx *cli.Context) error {
+	f := cosignFlags
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		if err := params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name), nil); err != nil {
+			return err
+		}
+	}
+	if (f.TLSCertPath == "") != (f.TLSKeyPath == "") {
+		return errors.New("--tls-cert and --tls-key must be set together")
+	}
+	genesisValidatorsRoot, err := hexutil.Decode(f.GenesisValidatorsRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not decode genesis validators root")
+	}
+	password, err := readPassword(f.WalletPasswordFile)
+	if err != nil {
+		return err
+	}
+	walletDir, err := file.ExpandPath(f.WalletDir)
+	if err != nil {
+		return err
+	}
+	configFile, err := os.Open(filepath.Join(walletDir, keymanager.Threshold.String(), wallet.KeymanagerConfigFileName)) // #nosec G304
+	if err != nil {
+		return errors.Wrap(err, "could not open keymanager config")
+	}
+	opts, err := threshold.UnmarshalOptionsFile(configFile)
+	if err != nil {
+		return errors.Wrap(err, "could not unmarshal keymanager config file")
+	}
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, len(opts.Validators))
+	for i, v := range opts.Validators {
+		pubKey, err := hexutil.Decode(v.PublicKey)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode public key %s", v.PublicKey)
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	db, err := kv.NewKVStore(cliCtx.Context, f.DataDir, &kv.Config{PubKeys: pubKeys})
+	if err != nil {
+		return errors.Wrap(err, "could not open slashing protection database")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close slashing protection database")
+		}
+	}()
+	c, err := threshold.NewCosignerServer(&threshold.CosignerConfig{
+		Opts:                  opts,
+		Password:              password,
+		DB:                    db,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(cliCtx.Context)
+	defer cancel()
+	srv := &http.Server{
+		Addr:              fmt.Sprintf("%s:%d", f.HTTPHost, f.HTTPPort),
+		Handler:           c,
+		ReadHeaderTimeout: time.Second,
+	}
+	go func() {
+		var err error
+		if f.TLSCertPath != "" {
+			err = srv.ListenAndServeTLS(f.TLSCertPath, f.TLSKeyPath)
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("Failed to serve HTTP")
+			cancel()
+		}
+	}()
+	log.WithFields(logrus.Fields{
+		"address":    srv.Addr,
+		"tls":        f.TLSCertPath != "",
+		"index":      opts.Index,
+		"validators": len(opts.Validators),
+	}).Info("Serving partial signatures")
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	select {
+	case <-sigc:
+		log.Info("Shutting down co-signer")
+	case <-ctx.Done():
+	}
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	return srv.Shutdown(shutdownCtx)
+}
@@ -0,0 +1,241 @@
+package threshold
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/threshold"
+	"github.com/urfave/cli/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// authTokenLength in bytes of the auth tokens of the co-signers.
+const authTokenLength = 32
+
+var (
+	splitFlags = struct {
+		Keystores            string
+		KeystorePasswordFile string
+		WalletPasswordFile   string
+		Threshold            uint64
+		CosignerURLs         *cli.StringSlice
+		OutputDir            string
+	}{
+		CosignerURLs: cli.NewStringSlice(),
+	}
+	splitCmd = &cli.Command{
+		Name: "split",
+		Usage: "Split EIP-2335 keystores into key shares, writing a threshold wallet for each participant. " +
+			"The original keystores should be removed from any validator once split",
+		Action: cliActionSplit,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "keystores",
+				Usage:       "Path to an EIP-2335 keystore file, or to a directory of keystore files, to split",
+				Destination: &splitFlags.Keystores,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "keystore-password-file",
+				Usage:       "Path to a file containing the password of the keystores",
+				Destination: &splitFlags.KeystorePasswordFile,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "wallet-password-file",
+				Usage:       "Path to a file containing the password the key shares of every participant wallet are encrypted with",
+				Destination: &splitFlags.WalletPasswordFile,
+				Required:    true,
+			},
+			&cli.Uint64Flag{
+				Name:        "threshold",
+				Usage:       "Number of participants needed to sign",
+				Destination: &splitFlags.Threshold,
+				Required:    true,
+			},
+			&cli.StringSliceFlag{
+				Name:        "cosigner-url",
+				Usage:       "URL of the co-signer of a participant, once per participant in order of share index",
+				Destination: splitFlags.CosignerURLs,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "output-dir",
+				Usage:       "Directory in which the wallet of each participant is written, as participant-<index>",
+				Destination: &splitFlags.OutputDir,
+				Value:       "threshold-wallets",
+			},
+		},
+	}
+)
+
+func cliActionSplit(_ *cli.Context) error {
+	f := splitFlags
+	keystorePassword, err := readPassword(f.KeystorePasswordFile)
+	if err != nil {
+		return err
+	}
+	walletPassword, err := readPassword(f.WalletPasswordFile)
+	if err != nil {
+		return err
+	}
+	keystores, err := readKeystores(f.Keystores)
+	if err != nil {
+		return err
+	}
+	secretKeys := make([]bls.SecretKey, len(keystores))
+	decryptor := keystorev4.New()
+	for i, ks := range keystores {
+		secretKeyBytes, err := decryptor.Decrypt(ks.Crypto, keystorePassword)
+		if err != nil {
+			return errors.Wrapf(err, "could not decrypt keystore for public key %s", ks.Pubkey)
+		}
+		if secretKeys[i], err = bls.SecretKeyFromBytes(secretKeyBytes); err != nil {
+			return errors.Wrapf(err, "invalid secret key in keystore for public key %s", ks.Pubkey)
+		}
+	}
+	participants, err := split(secretKeys, f.Threshold, f.CosignerURLs.Value(), walletPassword)
+	if err != nil {
+		return err
+	}
+	for _, opts := range participants {
+		dir, err := writeParticipant(f.OutputDir, opts)
+		if err != nil {
+			return err
+		}
+		log.WithField("walletDir", dir).Infof("Wrote wallet of participant %d", opts.Index)
+	}
+	log.Infof("Split %d keys into %d shares with a threshold of %d", len(secretKeys), len(participants), f.Threshold)
+	return nil
+}
+
+// split the secret keys into a share per co-signer URL, returning the keymanager options of each participant.
+// Every participant is given a random auth token its co-signer requires from the others.
+func split(secretKeys []bls.SecretKey, t uint64, urls []string, walletPassword string) ([]*threshold.KeymanagerOpts, error) {
+	n := uint64(len(urls))
+	authTokens := make([]string, n)
+	for i := range authTokens {
+		token := make([]byte, authTokenLength)
+		if _, err := rand.NewGenerator().Read(token); err != nil {
+			return nil, errors.Wrap(err, "could not generate auth token")
+		}
+		authTokens[i] = hexutil.Encode(token)
+	}
+	participants := make([]*threshold.KeymanagerOpts, n)
+	for i := range participants {
+		index := uint64(i + 1)
+		var cosigners []*threshold.Cosigner
+		for j, url := range urls {
+			if uint64(j+1) != index {
+				cosigners = append(cosigners, &threshold.Cosigner{Index: uint64(j + 1), URL: url, AuthToken: authTokens[j]})
+			}
+		}
+		participants[i] = &threshold.KeymanagerOpts{
+			Threshold: t,
+			Index:     index,
+			AuthToken: authTokens[i],
+			Cosigners: cosigners,
+		}
+	}
+	encryptor := keystorev4.New()
+	for _, secretKey := range secretKeys {
+		shares, err := bls.SplitSecretKey(secretKey, t, n)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not split secret key")
+		}
+		sharePublicKeys := make(map[uint64]string, n)
+		for i, s := range shares {
+			sharePublicKeys[uint64(i+1)] = fmt.Sprintf("%#x", s.PublicKey().Marshal())
+		}
+		for i, s := range shares {
+			cryptoFields, err := encryptor.Encrypt(s.Marshal(), walletPassword)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not encrypt key share")
+			}
+			id, err := uuid.NewRandom()
+			if err != nil {
+				return nil, err
+			}
+			participants[i].Validators = append(participants[i].Validators, &threshold.Validator{
+				PublicKey:       fmt.Sprintf("%#x", secretKey.PublicKey().Marshal()),
+				SharePublicKeys: sharePublicKeys,
+				Keystore: &keymanager.Keystore{
+					Crypto:  cryptoFields,
+					ID:      id.String(),
+					Pubkey:  fmt.Sprintf("%x", s.PublicKey().Marshal()),
+					Version: encryptor.Version(),
+					Name:    encryptor.Name(),
+				},
+			})
+		}
+	}
+	return participants, nil
+}
+
+// writeParticipant writes the keymanager options of a participant as a threshold wallet, returning the wallet directory.
+func writeParticipant(outputDir string, opts *threshold.KeymanagerOpts) (string, error) {
+	walletDir := filepath.Join(outputDir, fmt.Sprintf("participant-%d", opts.Index))
+	accountsDir := filepath.Join(walletDir, keymanager.Threshold.String())
+	if err := file.MkdirAll(accountsDir); err != nil {
+		return "", errors.Wrapf(err, "could not create wallet directory %s", accountsDir)
+	}
+	enc, err := json.MarshalIndent(opts, "", "\t")
+	if err != nil {
+		return "", err
+	}
+	if err := file.WriteFile(filepath.Join(accountsDir, wallet.KeymanagerConfigFileName), enc); err != nil {
+		return "", errors.Wrap(err, "could not write keymanager config")
+	}
+	return walletDir, nil
+}
+
+// readKeystores reads a keystore file, or every JSON keystore file of a directory.
+func readKeystores(path string) ([]*keymanager.Keystore, error) {
+	expanded, err := file.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	paths := []string{expanded}
+	isDir, err := file.HasDir(expanded)
+	if err != nil {
+		return nil, err
+	}
+	if isDir {
+		if paths, err = filepath.Glob(filepath.Join(expanded, "*.json")); err != nil {
+			return nil, err
+		}
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no keystore found at %s", path)
+	}
+	keystores := make([]*keymanager.Keystore, len(paths))
+	for i, p := range paths {
+		enc, err := file.ReadFileAsBytes(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read keystore %s", p)
+		}
+		keystores[i] = &keymanager.Keystore{}
+		if err := json.Unmarshal(enc, keystores[i]); err != nil {
+			return nil, errors.Wrapf(err, "could not decode keystore %s", p)
+		}
+	}
+	return keystores, nil
+}
+
+func readPassword(path string) (string, error) {
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not read password file %s", path)
+	}
+	return strings.TrimRight(string(enc), "\r\n"), nil
+}
@@ -0,0 +1,86 @@
+package threshold
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/threshold"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestSplit(t *testing.T) {
+	const password = "passw0rd"
+	secretKeys := make([]bls.SecretKey, 2)
+	for i := range secretKeys {
+		var err error
+		secretKeys[i], err = bls.RandKey()
+		require.NoError(t, err)
+	}
+	urls := []string{"http://cosigner-1", "http://cosigner-2", "http://cosigner-3"}
+	participants, err := split(secretKeys, 2, urls, password)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(participants))
+
+	// Participants present the auth token of a co-signer when requesting its partial signatures.
+	for _, opts := range participants {
+		for _, c := range opts.Cosigners {
+			assert.Equal(t, participants[c.Index-1].AuthToken, c.AuthToken)
+		}
+	}
+	assert.NotEqual(t, participants[0].AuthToken, participants[1].AuthToken)
+
+	outputDir := t.TempDir()
+	for _, opts := range participants {
+		_, err := writeParticipant(outputDir, opts)
+		require.NoError(t, err)
+	}
+
+	msg := []byte("hello")
+	decryptor := keystorev4.New()
+	partials := make([][]bls.Signature, len(secretKeys))
+	for i := range participants {
+		index := uint64(i + 1)
+		configFile, err := os.Open(filepath.Join(outputDir, fmt.Sprintf("participant-%d", index), keymanager.Threshold.String(), wallet.KeymanagerConfigFileName))
+		require.NoError(t, err)
+		opts, err := threshold.UnmarshalOptionsFile(configFile)
+		require.NoError(t, err)
+		assert.Equal(t, index, opts.Index)
+		assert.Equal(t, uint64(2), opts.Threshold)
+		require.Equal(t, 2, len(opts.Cosigners))
+		for _, c := range opts.Cosigners {
+			assert.Equal(t, urls[c.Index-1], c.URL)
+		}
+		// The wallet of every participant loads, its shares matching the split keys.
+		_, err = threshold.NewKeymanager(context.Background(), &threshold.SetupConfig{Opts: opts, Password: password})
+		require.NoError(t, err)
+
+		for j, v := range opts.Validators {
+			secretKeyBytes, err := decryptor.Decrypt(v.Keystore.Crypto, password)
+			require.NoError(t, err)
+			share, err := bls.SecretKeyFromBytes(secretKeyBytes)
+			require.NoError(t, err)
+			partials[j] = append(partials[j], share.Sign(msg))
+		}
+	}
+
+	for i, secretKey := range secretKeys {
+		sig, err := bls.RecoverSignature([]uint64{1, 3}, []bls.Signature{partials[i][0], partials[i][2]})
+		require.NoError(t, err)
+		assert.DeepEqual(t, secretKey.Sign(msg).Marshal(), sig.Marshal())
+	}
+}
+
+func TestSplit_InvalidThreshold(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	_, err = split([]bls.SecretKey{secretKey}, 3, []string{"http://cosigner-1", "http://cosigner-2"}, "passw0rd")
+	assert.ErrorContains(t, "threshold must be between 1 and the number of shares", err)
+}
@@ -0,0 +1,39 @@
+// Package flags contains all configuration runtime flags for
+// the standalone slasher.
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
+	BeaconRPCProviderFlag = &cli.StringFlag{
+		Name:  "beacon-rpc-provider",
+		Usage: "Beacon node RPC provider endpoint slasher receives attestations and blocks from",
+		Value: "127.0.0.1:4000",
+	}
+	// CertFlag defines a flag for the beacon node's TLS certificate.
+	CertFlag = &cli.StringFlag{
+		Name:  "tls-cert",
+		Usage: "Certificate for secure gRPC connections to the beacon node",
+	}
+	// RPCHost defines the host on which the slasher gRPC server listens.
+	RPCHost = &cli.StringFlag{
+		Name:  "rpc-host",
+		Usage: "Host on which the slasher RPC server should listen",
+		Value: "127.0.0.1",
+	}
+	// RPCPort defines the port on which the slasher gRPC server listens.
+	RPCPort = &cli.IntFlag{
+		Name:  "rpc-port",
+		Usage: "RPC port exposed by the slasher, serving the detected slashing evidence",
+		Value: 4002,
+	}
+	// MonitoringPortFlag defines the http port used to serve prometheus metrics.
+	MonitoringPortFlag = &cli.IntFlag{
+		Name:  "monitoring-port",
+		Usage: "Port used to listening and respond metrics for prometheus.",
+		Value: 8082,
+	}
+)
@@ -0,0 +1,132 @@
+// Package main defines a standalone slasher, detecting slashable offenses among the
+// attestations and blocks received by a beacon node it connects to over gRPC.
+package main
+
+import (
+	"fmt"
+	"os"
+	runtimeDebug "runtime/debug"
+
+	joonix "github.com/joonix/log"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/node"
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	beaconflags "github.com/prysmaticlabs/prysm/v3/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v3/cmd/slasher/flags"
+	"github.com/prysmaticlabs/prysm/v3/io/logs"
+	"github.com/prysmaticlabs/prysm/v3/monitoring/journald"
+	"github.com/prysmaticlabs/prysm/v3/runtime/debug"
+	prefixed "github.com/prysmaticlabs/prysm/v3/runtime/logging/logrus-prefixed-formatter"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var appFlags = []cli.Flag{
+	flags.BeaconRPCProviderFlag,
+	flags.CertFlag,
+	flags.RPCHost,
+	flags.RPCPort,
+	flags.MonitoringPortFlag,
+	beaconflags.SlasherHistoryLength,
+	beaconflags.SlasherChunkSize,
+	beaconflags.SlasherValidatorChunkSize,
+	cmd.DataDirFlag,
+	cmd.ChainConfigFileFlag,
+	cmd.GrpcMaxCallRecvMsgSizeFlag,
+	cmd.MonitoringHostFlag,
+	cmd.DisableMonitoringFlag,
+	cmd.VerbosityFlag,
+	cmd.LogFormat,
+	cmd.LogFileName,
+	cmd.ConfigFileFlag,
+	debug.PProfFlag,
+	debug.PProfAddrFlag,
+	debug.PProfPortFlag,
+	debug.MemProfileRateFlag,
+	debug.CPUProfileFlag,
+	debug.TraceFlag,
+}
+
+func init() {
+	appFlags = cmd.WrapFlags(appFlags)
+}
+
+func main() {
+	app := cli.App{}
+	app.Name = "slasher"
+	app.Usage = "standalone slasher detecting slashable offenses among the attestations and blocks received by a beacon node"
+	app.Action = run
+	app.Version = version.Version()
+	app.Flags = appFlags
+
+	app.Before = func(ctx *cli.Context) error {
+		// Load flags from config file, if specified.
+		if err := cmd.LoadFlagsFromConfig(ctx, app.Flags); err != nil {
+			return err
+		}
+
+		verbosity := ctx.String(cmd.VerbosityFlag.Name)
+		level, err := logrus.ParseLevel(verbosity)
+		if err != nil {
+			return err
+		}
+		logrus.SetLevel(level)
+
+		format := ctx.String(cmd.LogFormat.Name)
+		switch format {
+		case "text":
+			formatter := new(prefixed.TextFormatter)
+			formatter.TimestampFormat = "2006-01-02 15:04:05"
+			formatter.FullTimestamp = true
+			// If persistent log files are written - we disable the log messages coloring because
+			// the colors are ANSI codes and seen as gibberish in the log files.
+			formatter.DisableColors = ctx.String(cmd.LogFileName.Name) != ""
+			logrus.SetFormatter(formatter)
+		case "fluentd":
+			f := joonix.NewFormatter()
+			if err := joonix.DisableTimestampFormat(f); err != nil {
+				panic(err)
+			}
+			logrus.SetFormatter(f)
+		case "json":
+			logrus.SetFormatter(&logrus.JSONFormatter{})
+		case "journald":
+			if err := journald.Enable(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown log format %s", format)
+		}
+
+		logFileName := ctx.String(cmd.LogFileName.Name)
+		if logFileName != "" {
+			if err := logs.ConfigurePersistentLogging(logFileName); err != nil {
+				log.WithError(err).Error("Failed to configuring logging to disk.")
+			}
+		}
+		if err := cmd.ValidateNoArgs(ctx); err != nil {
+			return err
+		}
+		return debug.Setup(ctx)
+	}
+
+	defer func() {
+		if x := recover(); x != nil {
+			log.Errorf("Runtime panic: %v\n%v", x, string(runtimeDebug.Stack()))
+			panic(x)
+		}
+	}()
+
+	if err := app.Run(os.Args); err != nil {
+		log.Error(err.Error())
+	}
+}
+
+func run(ctx *cli.Context) error {
+	slasherNode, err := node.New(ctx)
+	if err != nil {
+		return err
+	}
+	slasherNode.Start()
+	return nil
+}
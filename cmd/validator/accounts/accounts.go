@@ -119,6 +119,42 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "export",
+			Description: "export accounts into EIP-2335 compliant keystore files written to a desired output directory, " +
+				"each encrypted with its own password and a selectable key derivation function. Accounts to export can " +
+				"also be specified programmatically via a --export-public-keys flag which specifies a comma-separated " +
+				"list of hex string public keys",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.ExportDirFlag,
+				flags.ExportPublicKeysFlag,
+				flags.ExportPasswordsFileFlag,
+				flags.KDFFlag,
+				flags.KDFCostFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.RopstenTestnet,
+				features.SepoliaTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := accountsExport(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not export accounts")
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "import",
 			Description: `imports Ethereum validator accounts stored in EIP-2335 keystore.json files from an external directory`,
@@ -150,6 +186,38 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "performance",
+			Description: "prints a summary of the performance of validator accounts over a range of epochs, " +
+				"as recorded in the validator database: attestation inclusion and correctness, proposals and " +
+				"sync committee participation",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.PerformanceStartEpochFlag,
+				flags.PerformanceEndEpochFlag,
+				flags.PerformancePublicKeysFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.RopstenTestnet,
+				features.SepoliaTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := accountsPerformance(cliCtx, os.Stdout); err != nil {
+					log.WithError(err).Fatal("Could not report validator performance")
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "voluntary-exit",
 			Description: "Performs a voluntary exit on selected accounts",
@@ -187,5 +255,48 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "presign-exits",
+			Description: "Signs voluntary exits of selected accounts for a future epoch without a beacon node, and saves " +
+				"them to files. The exits can be broadcast later with prysmctl or the validator keymanager API",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.AccountPasswordFileFlag,
+				flags.VoluntaryExitPublicKeysFlag,
+				flags.ExitAllFlag,
+				flags.ExitEpochFlag,
+				flags.ExitsDirFlag,
+				flags.GenesisValidatorsRootFlag,
+				flags.ExitForkVersionFlag,
+				flags.ValidatorIndicesFileFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.RopstenTestnet,
+				features.SepoliaTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := accountsPresignExits(cliCtx, os.Stdin); err != nil {
+					log.WithError(err).Fatal("Could not pre-sign voluntary exits")
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -77,6 +77,11 @@ type testWalletConfig struct {
 	accountPasswordFile     string
 	walletPasswordFile      string
 	backupPasswordFile      string
+	exportPasswordsFile     string
+	exportPublicKeys        string
+	exportDir               string
+	kdf                     string
+	kdfCost                 int
 	backupPublicKeys        string
 	voluntaryExitPublicKeys string
 	deletePublicKeys        string
@@ -107,6 +112,11 @@ func setupWalletCtx(
 	set.Bool(flags.SkipMnemonic25thWordCheckFlag.Name, true, "")
 	set.Bool(flags.ExitAllFlag.Name, cfg.exitAll, "")
 	set.String(flags.GrpcHeadersFlag.Name, cfg.grpcHeaders, "")
+	set.String(flags.ExportPasswordsFileFlag.Name, cfg.exportPasswordsFile, "")
+	set.String(flags.ExportPublicKeysFlag.Name, cfg.exportPublicKeys, "")
+	set.String(flags.ExportDirFlag.Name, cfg.exportDir, "")
+	set.String(flags.KDFFlag.Name, cfg.kdf, "")
+	set.Int(flags.KDFCostFlag.Name, cfg.kdfCost, "")
 
 	if cfg.privateKeyFile != "" {
 		set.String(flags.ImportPrivateKeyFileFlag.Name, cfg.privateKeyFile, "")
@@ -127,6 +137,11 @@ func setupWalletCtx(
 	assert.NoError(tb, set.Set(flags.SkipDepositConfirmationFlag.Name, strconv.FormatBool(cfg.skipDepositConfirm)))
 	assert.NoError(tb, set.Set(flags.ExitAllFlag.Name, strconv.FormatBool(cfg.exitAll)))
 	assert.NoError(tb, set.Set(flags.GrpcHeadersFlag.Name, cfg.grpcHeaders))
+	assert.NoError(tb, set.Set(flags.ExportPasswordsFileFlag.Name, cfg.exportPasswordsFile))
+	assert.NoError(tb, set.Set(flags.ExportPublicKeysFlag.Name, cfg.exportPublicKeys))
+	assert.NoError(tb, set.Set(flags.ExportDirFlag.Name, cfg.exportDir))
+	assert.NoError(tb, set.Set(flags.KDFFlag.Name, cfg.kdf))
+	assert.NoError(tb, set.Set(flags.KDFCostFlag.Name, strconv.Itoa(cfg.kdfCost)))
 	return cli.NewContext(&app, set, nil)
 }
 
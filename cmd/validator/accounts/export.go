@@ -0,0 +1,110 @@
+package accounts
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/io/prompt"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/userprompt"
+	"github.com/urfave/cli/v2"
+)
+
+const exportPromptText = "Enter the directory where your exported keystore files will be written to"
+
+func accountsExport(c *cli.Context) error {
+	w, km, err := walletWithKeymanager(c)
+	if err != nil {
+		return err
+	}
+	kdf, err := keystore.NewKDFParams(c.String(flags.KDFFlag.Name), c.Int(flags.KDFCostFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	// Get full set of public keys from the keymanager.
+	publicKeys, err := km.FetchValidatingPublicKeys(c.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	// Filter keys either from CLI flag or from interactive session.
+	filteredPubKeys, err := accounts.FilterPublicKeysFromUserInput(
+		c,
+		flags.ExportPublicKeysFlag,
+		publicKeys,
+		userprompt.SelectAccountsExportPromptText,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not filter public keys for export")
+	}
+
+	// Input the directory where they wish to export their accounts.
+	exportDir, err := userprompt.InputDirectory(c, exportPromptText, flags.ExportDirFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not parse export directory")
+	}
+	passwords, err := inputExportPasswords(c)
+	if err != nil {
+		return errors.Wrap(err, "could not determine passwords for exported accounts")
+	}
+	if len(passwords) != 1 && len(passwords) != len(filteredPubKeys) {
+		return errors.Errorf(
+			"%d passwords were provided for %d accounts, expected one password per account or a single one",
+			len(passwords),
+			len(filteredPubKeys),
+		)
+	}
+
+	acc, err := accounts.NewCLIManager(
+		accounts.WithWallet(w),
+		accounts.WithKeymanager(km),
+		accounts.WithFilteredPubKeys(filteredPubKeys),
+		accounts.WithExportDir(exportDir),
+		accounts.WithExportPasswords(passwords),
+		accounts.WithKDFParams(kdf),
+	)
+	if err != nil {
+		return err
+	}
+	return acc.Export(c.Context)
+}
+
+// inputExportPasswords reads one password per line from the passwords file, or asks
+// the user for a single password used for all exported accounts.
+func inputExportPasswords(c *cli.Context) ([]string, error) {
+	if !c.IsSet(flags.ExportPasswordsFileFlag.Name) {
+		password, err := prompt.InputPassword(
+			c,
+			flags.ExportPasswordsFileFlag,
+			"Enter a new password for your exported accounts",
+			"Confirm new password",
+			true,
+			prompt.ValidatePasswordInput,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return []string{password}, nil
+	}
+	passwordsFile, err := file.ExpandPath(c.String(flags.ExportPasswordsFileFlag.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine absolute path of passwords file")
+	}
+	data, err := os.ReadFile(passwordsFile) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read passwords file")
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\r\n"), "\n")
+	passwords := make([]string, len(lines))
+	for i, line := range lines {
+		passwords[i] = strings.TrimRight(line, "\r")
+		if err := prompt.ValidatePasswordInput(passwords[i]); err != nil {
+			return nil, errors.Wrapf(err, "password on line %d did not pass validation", i+1)
+		}
+	}
+	return passwords, nil
+}
@@ -0,0 +1,83 @@
+package accounts
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestExportAccounts_Noninteractive(t *testing.T) {
+	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
+	keysDir := filepath.Join(t.TempDir(), "keysDir")
+	require.NoError(t, os.MkdirAll(keysDir, os.ModePerm))
+	k1, _ := createKeystore(t, keysDir)
+	time.Sleep(time.Second)
+	k2, _ := createKeystore(t, keysDir)
+
+	// Write a password per exported account to a file.
+	exportDir := filepath.Join(t.TempDir(), "exportDir")
+	exportPasswords := []string{"Passw0rdz4938%%", "0therPassw0rd$$"}
+	exportPasswordsFile := filepath.Join(t.TempDir(), "exportpasswords.txt")
+	require.NoError(t, os.WriteFile(
+		exportPasswordsFile,
+		[]byte(strings.Join(exportPasswords, "\n")+"\n"),
+		params.BeaconIoConfig().ReadWritePermissions,
+	))
+
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:           walletDir,
+		keymanagerKind:      keymanager.Local,
+		walletPasswordFile:  passwordFilePath,
+		accountPasswordFile: passwordFilePath,
+		keysDir:             keysDir,
+		exportPublicKeys:    strings.Join([]string{k1.Pubkey, k2.Pubkey}, ","),
+		exportPasswordsFile: exportPasswordsFile,
+		exportDir:           exportDir,
+		kdf:                 keystore.ScryptKDF,
+		kdfCost:             1024,
+	})
+	acc, err := accounts.NewCLIManager(
+		accounts.WithWalletDir(walletDir),
+		accounts.WithKeymanagerType(keymanager.Local),
+		accounts.WithWalletPassword(password),
+	)
+	require.NoError(t, err)
+	_, err = acc.WalletCreate(cliCtx.Context)
+	require.NoError(t, err)
+	require.NoError(t, accountsImport(cliCtx))
+
+	require.NoError(t, accountsExport(cliCtx))
+
+	for i, k := range []*keymanager.Keystore{k1, k2} {
+		encoded, err := os.ReadFile(filepath.Join(exportDir, "keystore-"+k.Pubkey+".json"))
+		require.NoError(t, err)
+		exported := &keymanager.Keystore{}
+		require.NoError(t, json.Unmarshal(encoded, exported))
+		assert.Equal(t, k.Pubkey, exported.Pubkey)
+		kdf, err := keystore.EIP2335KDFParams(exported.Crypto)
+		require.NoError(t, err)
+		assert.DeepEqual(t, &keystore.KDFParams{Function: keystore.ScryptKDF, Cost: 1024}, kdf)
+		secretKey, err := keystorev4.New().Decrypt(exported.Crypto, exportPasswords[i])
+		require.NoError(t, err)
+		sk, err := bls.SecretKeyFromBytes(secretKey)
+		require.NoError(t, err)
+		assert.Equal(t, k.Pubkey, hex.EncodeToString(sk.PublicKey().Marshal()))
+	}
+
+	// Exporting again does not overwrite the keystore files.
+	err = accountsExport(cliCtx)
+	assert.ErrorContains(t, "keystore file already exists", err)
+}
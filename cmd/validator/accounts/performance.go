@@ -0,0 +1,130 @@
+package accounts
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/userprompt"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+// Reads the performance history of validators from their database, and writes a summary table of
+// their performance over the range of epochs given with the CLI flags.
+func accountsPerformance(c *cli.Context, w io.Writer) error {
+	var err error
+	dataDir := c.String(cmd.DataDirFlag.Name)
+	if !c.IsSet(cmd.DataDirFlag.Name) {
+		dataDir, err = userprompt.InputDirectory(c, userprompt.DataDirDirPromptText, cmd.DataDirFlag)
+		if err != nil {
+			return errors.Wrapf(err, "could not read directory value from input")
+		}
+	}
+	found, _, err := file.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
+	if err != nil {
+		return errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+	}
+	if !found {
+		return fmt.Errorf("validator.db file (validator database) was not found at path %s", dataDir)
+	}
+
+	startEpoch := types.Epoch(c.Uint64(flags.PerformanceStartEpochFlag.Name))
+	endEpoch := types.Epoch(math.MaxUint64)
+	if c.IsSet(flags.PerformanceEndEpochFlag.Name) {
+		endEpoch = types.Epoch(c.Uint64(flags.PerformanceEndEpochFlag.Name))
+	}
+	if startEpoch > endEpoch {
+		return fmt.Errorf("start epoch %d is after end epoch %d", startEpoch, endEpoch)
+	}
+	var pubKeys [][fieldparams.BLSPubkeyLength]byte
+	if rawPubKeys := c.String(flags.PerformancePublicKeysFlag.Name); rawPubKeys != "" {
+		for _, rawPubKey := range strings.Split(rawPubKeys, ",") {
+			pubKey, err := hexutil.Decode(strings.TrimSpace(rawPubKey))
+			if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+				return fmt.Errorf("%s is not a valid bls public key", rawPubKey)
+			}
+			pubKeys = append(pubKeys, bytesutil.ToBytes48(pubKey))
+		}
+	}
+
+	validatorDB, err := kv.NewKVStore(c.Context, dataDir, &kv.Config{ReadOnly: true})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close validator DB")
+		}
+	}()
+	records, err := validatorDB.PerformanceRecords(c.Context, pubKeys, startEpoch, endEpoch)
+	if err != nil {
+		return errors.Wrap(err, "could not read validator performance history")
+	}
+	if len(records) == 0 {
+		log.Warn("No performance history was found in the validator database for the given epochs")
+		return nil
+	}
+	if len(pubKeys) == 0 {
+		for pubKey := range records {
+			pubKeys = append(pubKeys, pubKey)
+		}
+		sort.Slice(pubKeys, func(i, j int) bool {
+			return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) < 0
+		})
+	}
+	return writePerformanceTable(w, pubKeys, records)
+}
+
+func writePerformanceTable(
+	w io.Writer, pubKeys [][fieldparams.BLSPubkeyLength]byte, records map[[fieldparams.BLSPubkeyLength]byte][]*kv.PerformanceRecord,
+) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PUBLIC KEY\tEPOCHS\tINCLUDED\tSOURCE\tTARGET\tHEAD\tAVG DELAY\tPROPOSALS\tSYNC MESSAGES\tBALANCE CHANGE (ETH)")
+	gweiPerEth := float64(params.BeaconConfig().GweiPerEth)
+	for _, pubKey := range pubKeys {
+		history := records[pubKey]
+		if len(history) == 0 {
+			continue
+		}
+		summary := kv.SummarizePerformance(history)
+		fmt.Fprintf(
+			tw,
+			"%#x\t%d-%d\t%s\t%s\t%s\t%s\t%.2f\t%d/%d\t%d/%d\t%.6f\n",
+			bytesutil.Trunc(pubKey[:]),
+			history[0].Epoch,
+			history[len(history)-1].Epoch,
+			percentage(summary.AttestationsIncluded, summary.Epochs),
+			percentage(summary.CorrectSource, summary.Epochs),
+			percentage(summary.CorrectTarget, summary.Epochs),
+			percentage(summary.CorrectHead, summary.Epochs),
+			summary.AverageInclusionDelay,
+			summary.ProposalsMade,
+			summary.ProposalsAssigned,
+			summary.SyncMessagesSubmitted,
+			summary.SyncMessagesExpected,
+			float64(summary.BalanceChange)/gweiPerEth,
+		)
+	}
+	return tw.Flush()
+}
+
+func percentage(count, total uint64) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", float64(count)/float64(total)*100)
+}
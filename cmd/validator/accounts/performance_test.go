@@ -0,0 +1,73 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+func TestAccountsPerformance(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	pubKey1 := [fieldparams.BLSPubkeyLength]byte{1}
+	pubKey2 := [fieldparams.BLSPubkeyLength]byte{2}
+	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{
+		PubKeys: [][fieldparams.BLSPubkeyLength]byte{pubKey1, pubKey2},
+	})
+	require.NoError(t, err)
+	for epoch := types.Epoch(1); epoch <= 4; epoch++ {
+		require.NoError(t, validatorDB.SavePerformanceRecords(ctx, map[[fieldparams.BLSPubkeyLength]byte]*kv.PerformanceRecord{
+			pubKey1: {
+				Epoch:               epoch,
+				AttestationIncluded: true,
+				CorrectSource:       true,
+				CorrectTarget:       true,
+				CorrectHead:         epoch%2 == 0,
+				InclusionDelay:      1,
+				BalanceBefore:       32000000000 + uint64(epoch-1)*10000,
+				BalanceAfter:        32000000000 + uint64(epoch)*10000,
+			},
+			pubKey2: {Epoch: epoch, ProposalsAssigned: 1, ProposalsMade: uint64(epoch % 2)},
+		}))
+	}
+	require.NoError(t, validatorDB.Close())
+
+	performance := func(startEpoch, endEpoch uint64, pubKeys string) string {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(cmd.DataDirFlag.Name, dataDir, "")
+		set.Uint64(flags.PerformanceStartEpochFlag.Name, startEpoch, "")
+		set.Uint64(flags.PerformanceEndEpochFlag.Name, endEpoch, "")
+		set.String(flags.PerformancePublicKeysFlag.Name, pubKeys, "")
+		require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+		require.NoError(t, set.Set(flags.PerformanceEndEpochFlag.Name, strconv.FormatUint(endEpoch, 10)))
+		out := &bytes.Buffer{}
+		require.NoError(t, accountsPerformance(cli.NewContext(&app, set, nil), out))
+		return out.String()
+	}
+
+	out := performance(1, 4, "")
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Equal(t, 3, len(lines))
+	assert.Equal(t, true, strings.HasPrefix(lines[0], "PUBLIC KEY"))
+	assert.DeepEqual(t, []string{"0x010000000000", "1-4", "100%", "100%", "100%", "50%", "1.00", "0/0", "0/0", "0.000040"}, strings.Fields(lines[1]))
+	assert.DeepEqual(t, []string{"0x020000000000", "1-4", "0%", "0%", "0%", "0%", "0.00", "2/4", "0/0", "0.000000"}, strings.Fields(lines[2]))
+
+	out = performance(2, 3, hexutil.Encode(pubKey2[:]))
+	lines = strings.Split(strings.TrimSpace(out), "\n")
+	require.Equal(t, 2, len(lines))
+	assert.DeepEqual(t, []string{"0x020000000000", "2-3", "0%", "0%", "0%", "0%", "0.00", "1/2", "0/0", "0.000000"}, strings.Fields(lines[1]))
+}
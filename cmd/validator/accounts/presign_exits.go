@@ -0,0 +1,126 @@
+package accounts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/network/forks"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v3/validator/client"
+	"github.com/urfave/cli/v2"
+)
+
+func accountsPresignExits(c *cli.Context, r io.Reader) error {
+	if !c.IsSet(flags.ExitEpochFlag.Name) {
+		return errors.New("the epoch of the voluntary exits must be set with --" + flags.ExitEpochFlag.Name)
+	}
+	exitEpoch := types.Epoch(c.Uint64(flags.ExitEpochFlag.Name))
+	genesisValidatorsRoot, err := hexutil.Decode(c.String(flags.GenesisValidatorsRootFlag.Name))
+	if err != nil || len(genesisValidatorsRoot) != fieldparams.RootLength {
+		return errors.New("a 32 bytes hex encoded genesis validators root must be set with --" + flags.GenesisValidatorsRootFlag.Name)
+	}
+	var forkVersion []byte
+	if c.IsSet(flags.ExitForkVersionFlag.Name) {
+		forkVersion, err = hexutil.Decode(c.String(flags.ExitForkVersionFlag.Name))
+		if err != nil || len(forkVersion) != fieldparams.VersionLength {
+			return errors.New("the fork version must be a 4 bytes hex encoded value")
+		}
+	} else {
+		version, err := forks.NewOrderedSchedule(params.BeaconConfig()).VersionForEpoch(exitEpoch)
+		if err != nil {
+			return errors.Wrapf(err, "could not determine the fork version at epoch %d", exitEpoch)
+		}
+		forkVersion = version[:]
+	}
+	var indices map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex
+	if c.IsSet(flags.ValidatorIndicesFileFlag.Name) {
+		indices, err = readValidatorIndices(c.String(flags.ValidatorIndicesFileFlag.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	w, km, err := walletWithKeymanager(c)
+	if err != nil {
+		return err
+	}
+	dialOpts := client.ConstructDialOptions(
+		c.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		c.String(flags.CertFlag.Name),
+		c.Uint(flags.GrpcRetriesFlag.Name),
+		c.Duration(flags.GrpcRetryDelayFlag.Name),
+	)
+	grpcHeaders := strings.Split(c.String(flags.GrpcHeadersFlag.Name), ",")
+
+	opts := []accounts.Option{
+		accounts.WithWallet(w),
+		accounts.WithKeymanager(km),
+		accounts.WithGRPCDialOpts(dialOpts),
+		accounts.WithBeaconRPCProvider(c.String(flags.BeaconRPCProviderFlag.Name)),
+		accounts.WithGRPCHeaders(grpcHeaders),
+		accounts.WithExitEpoch(exitEpoch),
+		accounts.WithExitForkVersion(forkVersion),
+		accounts.WithGenesisValidatorsRoot(genesisValidatorsRoot),
+		accounts.WithValidatorIndices(indices),
+		accounts.WithExitsDir(c.String(flags.ExitsDirFlag.Name)),
+	}
+
+	validatingPublicKeys, err := km.FetchValidatingPublicKeys(c.Context)
+	if err != nil {
+		return err
+	}
+	if len(validatingPublicKeys) == 0 {
+		return errors.New("wallet is empty, no accounts to exit")
+	}
+	// Filter keys either from CLI flag or from interactive session.
+	rawPubKeys, formattedPubKeys, err := accounts.FilterExitAccountsFromUserInput(c, r, validatingPublicKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not filter public keys for voluntary exit")
+	}
+	opts = append(opts, accounts.WithRawPubKeys(rawPubKeys))
+	opts = append(opts, accounts.WithFormattedPubKeys(formattedPubKeys))
+
+	acc, err := accounts.NewCLIManager(opts...)
+	if err != nil {
+		return err
+	}
+	return acc.PresignExits(c.Context)
+}
+
+// readValidatorIndices reads a JSON file mapping hex encoded public keys to validator indices. The indices
+// may be numbers or decimal strings, as returned by the beacon node API.
+func readValidatorIndices(path string) (map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex, error) {
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read validator indices file %s", path)
+	}
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(enc, &raw); err != nil {
+		return nil, errors.Wrapf(err, "could not decode validator indices file %s", path)
+	}
+	indices := make(map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex, len(raw))
+	for rawPubKey, rawIndex := range raw {
+		pubKey, err := hexutil.Decode(rawPubKey)
+		if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+			return nil, fmt.Errorf("%s is not a valid bls public key", rawPubKey)
+		}
+		index, err := strconv.ParseUint(strings.Trim(string(rawIndex), `"`), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid validator index of %s", rawPubKey)
+		}
+		indices[bytesutil.ToBytes48(pubKey)] = types.ValidatorIndex(index)
+	}
+	return indices, nil
+}
@@ -0,0 +1,37 @@
+package accounts
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestReadValidatorIndices(t *testing.T) {
+	pubKey1 := hexutil.Encode(bytes.Repeat([]byte{1}, fieldparams.BLSPubkeyLength))
+	pubKey2 := hexutil.Encode(bytes.Repeat([]byte{2}, fieldparams.BLSPubkeyLength))
+	path := filepath.Join(t.TempDir(), "indices.json")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"`+pubKey1+`": 5, "`+pubKey2+`": "12"}`), 0600))
+	indices, err := readValidatorIndices(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex{
+		bytesutil.ToBytes48(bytes.Repeat([]byte{1}, fieldparams.BLSPubkeyLength)): 5,
+		bytesutil.ToBytes48(bytes.Repeat([]byte{2}, fieldparams.BLSPubkeyLength)): 12,
+	}, indices)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"0x01": 5}`), 0600))
+	_, err = readValidatorIndices(path)
+	require.ErrorContains(t, "not a valid bls public key", err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"`+pubKey1+`": "five"}`), 0600))
+	_, err = readValidatorIndices(path)
+	require.ErrorContains(t, "invalid validator index", err)
+}
@@ -0,0 +1,50 @@
+package auditcmd
+
+import (
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for the signing audit log.
+var Commands = &cli.Command{
+	Name:     "audit",
+	Category: "audit",
+	Usage:    "defines commands for verifying and exporting the signing audit log of the validator client",
+	Subcommands: []*cli.Command{
+		{
+			Name:        "verify",
+			Description: `verifies that the entries of the signing audit log are complete, in order and unaltered`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SigningAuditLogDirFlag,
+				cmd.DataDirFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := verifyAuditLog(cliCtx); err != nil {
+					log.WithError(err).Fatal("Signing audit log verification failed")
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "export",
+			Description: `exports the entries of the signing audit log as JSON lines`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SigningAuditLogDirFlag,
+				flags.SigningAuditExportFileFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := exportAuditLog(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not export signing audit log")
+				}
+				return nil
+			},
+		},
+	},
+}
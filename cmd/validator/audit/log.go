@@ -0,0 +1,5 @@
+package auditcmd
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "auditcmd")
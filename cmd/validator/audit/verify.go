@@ -0,0 +1,91 @@
+package auditcmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/cmd"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/validator/audit"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+func auditLogDir(cliCtx *cli.Context) (string, error) {
+	dir := cliCtx.String(flags.SigningAuditLogDirFlag.Name)
+	if dir == "" {
+		return "", fmt.Errorf("--%s is required", flags.SigningAuditLogDirFlag.Name)
+	}
+	return dir, nil
+}
+
+func verifyAuditLog(cliCtx *cli.Context) error {
+	dir, err := auditLogDir(cliCtx)
+	if err != nil {
+		return err
+	}
+	head, err := auditLogHead(cliCtx)
+	if err != nil {
+		return err
+	}
+	count, err := audit.Verify(dir, head)
+	if err != nil {
+		return err
+	}
+	log.WithField("entries", count).Info("Signing audit log is intact")
+	return nil
+}
+
+// auditLogHead reads the last entry of the audit log recorded in the validator database, if --datadir is set.
+func auditLogHead(cliCtx *cli.Context) (*kv.SigningAuditLogHead, error) {
+	if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		log.Warnf(
+			"Set --%s to the directory of the validator database to also detect the removal of the last entries",
+			cmd.DataDirFlag.Name,
+		)
+		return nil, nil
+	}
+	dataDir, err := file.ExpandPath(cliCtx.String(cmd.DataDirFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	validatorDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{ReadOnly: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open validator database")
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	return validatorDB.SigningAuditLogHead(cliCtx.Context)
+}
+
+func exportAuditLog(cliCtx *cli.Context) error {
+	dir, err := auditLogDir(cliCtx)
+	if err != nil {
+		return err
+	}
+	var w io.Writer = os.Stdout
+	if path := cliCtx.String(flags.SigningAuditExportFileFlag.Name); path != "" {
+		path, err = file.ExpandPath(path)
+		if err != nil {
+			return err
+		}
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+		if err != nil {
+			return errors.Wrapf(err, "could not create export file %s", path)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.WithError(err).Error("Could not close export file")
+			}
+		}()
+		w = f
+	}
+	return audit.Export(dir, w)
+}
@@ -0,0 +1,51 @@
+package auditcmd
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/audit"
+	"github.com/urfave/cli/v2"
+)
+
+func setupCliCtx(dir, exportFile string) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.SigningAuditLogDirFlag.Name, dir, "")
+	set.String(flags.SigningAuditExportFileFlag.Name, exportFile, "")
+	return cli.NewContext(&app, set, nil)
+}
+
+func TestVerifyAndExportAuditLog(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "audit")
+	exportFile := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	require.ErrorContains(t, "--signing-audit-log-dir is required", verifyAuditLog(setupCliCtx("", "")))
+	require.ErrorContains(t, "no audit log in directory", verifyAuditLog(setupCliCtx(t.TempDir(), "")))
+
+	l, err := audit.Open(context.Background(), dir, 0, nil)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, l.Append(context.Background(), audit.NewEntry(&validatorpb.SignRequest{
+			PublicKey:   make([]byte, 48),
+			SigningRoot: make([]byte, 32),
+			Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+		}, audit.NotProtected, nil)))
+	}
+	require.NoError(t, l.Close())
+
+	cliCtx := setupCliCtx(dir, exportFile)
+	require.NoError(t, verifyAuditLog(cliCtx))
+	require.NoError(t, exportAuditLog(cliCtx))
+	exported, err := os.ReadFile(exportFile)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(strings.Split(strings.TrimSpace(string(exported)), "\n")))
+}
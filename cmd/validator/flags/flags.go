@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
 	"github.com/prysmaticlabs/prysm/v3/io/file"
 	"github.com/urfave/cli/v2"
 )
@@ -214,6 +215,36 @@ var (
 		Name:  "exit-all",
 		Usage: "Exit all validators. This will still require the staker to confirm a userprompt for the action",
 	}
+	// ExitEpochFlag defines the epoch of pre-signed voluntary exits.
+	ExitEpochFlag = &cli.Uint64Flag{
+		Name:  "exit-epoch",
+		Usage: "Epoch from which the pre-signed voluntary exits are valid. Exits cannot be included in a block before this epoch",
+	}
+	// ExitsDirFlag defines the directory where pre-signed voluntary exits are saved.
+	ExitsDirFlag = &cli.StringFlag{
+		Name:  "exits-dir",
+		Usage: "Path to a directory where pre-signed voluntary exits will be saved, one file per validator",
+		Value: filepath.Join(DefaultValidatorDir(), "exits"),
+	}
+	// GenesisValidatorsRootFlag defines the genesis validators root of the network, which is needed to sign
+	// voluntary exits without a beacon node.
+	GenesisValidatorsRootFlag = &cli.StringFlag{
+		Name:  "genesis-validators-root",
+		Usage: "Hex encoded genesis validators root of the network, used to sign voluntary exits without a beacon node",
+	}
+	// ExitForkVersionFlag defines the fork version used to sign voluntary exits without a beacon node.
+	ExitForkVersionFlag = &cli.StringFlag{
+		Name: "exit-fork-version",
+		Usage: "Hex encoded fork version used to sign voluntary exits without a beacon node. The version of the fork " +
+			"active at the exit epoch in the network configuration is used if unset",
+	}
+	// ValidatorIndicesFileFlag defines a JSON file mapping public keys to validator indices.
+	ValidatorIndicesFileFlag = &cli.StringFlag{
+		Name: "validator-indices-file",
+		Usage: "Path to a JSON file mapping hex encoded public keys to validator indices, so that voluntary exits " +
+			"can be signed without a beacon node. The indices of validators missing from the file are requested " +
+			"from the beacon node",
+	}
 	// BackupPasswordFile for encrypting accounts a user wishes to back up.
 	BackupPasswordFile = &cli.StringFlag{
 		Name:  "backup-password-file",
@@ -226,6 +257,63 @@ var (
 		Usage: "Path to a directory where accounts will be backed up into a zip file",
 		Value: DefaultValidatorDir(),
 	}
+	// ExportPublicKeysFlag defines a comma-separated list of hex string public keys
+	// for accounts which a user desires to export from their wallet.
+	ExportPublicKeysFlag = &cli.StringFlag{
+		Name:  "export-public-keys",
+		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to export",
+		Value: "",
+	}
+	// ExportPasswordsFileFlag for encrypting accounts a user wishes to export.
+	ExportPasswordsFileFlag = &cli.StringFlag{
+		Name: "export-passwords-file",
+		Usage: "Path to a plain-text, .txt file containing one password per line for the exported accounts, " +
+			"in the order of their public keys. A single password is used for all accounts",
+		Value: "",
+	}
+	// ExportDirFlag defines the path to the directory where keystores of exported accounts are written.
+	ExportDirFlag = &cli.StringFlag{
+		Name:  "export-dir",
+		Usage: "Path to a directory where accounts will be exported into EIP-2335 keystore files",
+		Value: DefaultValidatorDir(),
+	}
+	// NewWalletPasswordFileFlag is the path to a file containing the new password of a re-encrypted wallet.
+	NewWalletPasswordFileFlag = &cli.StringFlag{
+		Name:  "new-wallet-password-file",
+		Usage: "Path to a plain-text, .txt file containing the new password of your wallet",
+		Value: "",
+	}
+	// KDFFlag defines the key derivation function of keystores encrypted by the wallet.
+	KDFFlag = &cli.StringFlag{
+		Name:  "kdf",
+		Usage: "Key derivation function of EIP-2335 keystores encrypted by the wallet: scrypt or pbkdf2",
+		Value: keystore.PBKDF2KDF,
+	}
+	// KDFCostFlag defines the cost of the key derivation function of keystores encrypted by the wallet.
+	KDFCostFlag = &cli.IntFlag{
+		Name: "kdf-cost",
+		Usage: "Cost of the key derivation function, which is the N parameter of scrypt or the iteration count " +
+			"of pbkdf2. The recommended cost of the function is used if unset",
+		Value: 0,
+	}
+	// PerformanceStartEpochFlag defines the first epoch of the performance history to report.
+	PerformanceStartEpochFlag = &cli.Uint64Flag{
+		Name:  "start-epoch",
+		Usage: "First epoch of the validator performance history to report",
+		Value: 0,
+	}
+	// PerformanceEndEpochFlag defines the last epoch of the performance history to report.
+	PerformanceEndEpochFlag = &cli.Uint64Flag{
+		Name:  "end-epoch",
+		Usage: "Last epoch of the validator performance history to report, the most recent epoch if unset",
+	}
+	// PerformancePublicKeysFlag defines a comma-separated list of hex string public keys
+	// for accounts which a user desires to report the performance of.
+	PerformancePublicKeysFlag = &cli.StringFlag{
+		Name:  "performance-public-keys",
+		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to report, all of them if unset",
+		Value: "",
+	}
 	// SlashingProtectionJSONFileFlag is used to enter the file path of the slashing protection JSON.
 	SlashingProtectionJSONFileFlag = &cli.StringFlag{
 		Name:  "slashing-protection-json-file",
@@ -269,6 +357,18 @@ var (
 		Usage: "/path/to/ca.crt for establishing a secure, TLS gRPC connection to a remote signer server",
 		Value: "",
 	}
+	// PKCS11LibraryFlag defines the path to the PKCS#11 module of a token holding validating keys.
+	PKCS11LibraryFlag = &cli.StringFlag{
+		Name:  "pkcs11-library",
+		Usage: "/path/to/module.so of the PKCS#11 token holding validating keys, such as /usr/lib/softhsm/libsofthsm2.so",
+		Value: "",
+	}
+	// PKCS11TokenLabelFlag defines the label of a PKCS#11 token holding validating keys.
+	PKCS11TokenLabelFlag = &cli.StringFlag{
+		Name:  "pkcs11-token-label",
+		Usage: "Label of the PKCS#11 token holding validating keys",
+		Value: "",
+	}
 	// Web3SignerURLFlag defines the URL for a web3signer to connect to.
 	// example:--validators-external-signer-url=http://localhost:9000
 	// web3signer documentation can be found in Consensys' web3signer project docs
@@ -287,6 +387,14 @@ var (
 		Usage: "comma separated list of public keys OR an external url endpoint for the validator to retrieve public keys from for usage with web3signer",
 	}
 
+	// Web3SignerKeyRefreshIntervalFlag defines how often the web3signer is polled for its health and,
+	// when its public keys are fetched from an external url, for keys added or removed.
+	Web3SignerKeyRefreshIntervalFlag = &cli.DurationFlag{
+		Name:  "validators-external-signer-key-refresh-interval",
+		Usage: "How often the web3signer upcheck endpoint and public keys url are polled for changes. Set to a negative duration to disable polling",
+		Value: time.Minute,
+	}
+
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
@@ -353,6 +461,35 @@ var (
 		Usage: "Sets gas limit for the builder to use for constructing a payload for all the validators",
 		Value: fmt.Sprint(params.BeaconConfig().DefaultBuilderGasLimit),
 	}
+
+	// TenantsFileFlag defines the path to a file with the tenants hosted by the validator client.
+	TenantsFileFlag = &cli.StringFlag{
+		Name: "tenants-file",
+		Usage: "Path to a YAML or JSON file defining the tenants hosted by the validator client, each with its own wallet, " +
+			"wallet password file, slashing protection database and proposer settings file. The keymanager API auth token " +
+			"of each tenant is kept in its wallet directory, and only gives access to the keys of the tenant. Cannot be used along with web3signer, " +
+			"interop keys or the web UI",
+		Value: "",
+	}
+	// SigningAuditLogDirFlag defines the directory of the signing audit log.
+	SigningAuditLogDirFlag = &cli.StringFlag{
+		Name: "signing-audit-log-dir",
+		Usage: "Path to a directory where an append-only, hash-chained audit log of every signing operation of the " +
+			"validator client is written, along with the decisions of slashing protection. Disabled if unset",
+		Value: "",
+	}
+	// SigningAuditLogMaxFileSizeFlag defines the size, in megabytes, above which a new signing audit log file is started.
+	SigningAuditLogMaxFileSizeFlag = &cli.Uint64Flag{
+		Name:  "signing-audit-log-max-file-size",
+		Usage: "Size in megabytes above which the signing audit log is rotated to a new file. Rotated files are kept",
+		Value: 100,
+	}
+	// SigningAuditExportFileFlag defines the file the signing audit log is exported to.
+	SigningAuditExportFileFlag = &cli.StringFlag{
+		Name:  "signing-audit-export-file",
+		Usage: "Path of the JSON lines file the signing audit log is exported to, standard output if unset",
+		Value: "",
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
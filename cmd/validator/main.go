@@ -13,6 +13,7 @@ import (
 	joonix "github.com/joonix/log"
 	"github.com/prysmaticlabs/prysm/v3/cmd"
 	accountcommands "github.com/prysmaticlabs/prysm/v3/cmd/validator/accounts"
+	auditcommands "github.com/prysmaticlabs/prysm/v3/cmd/validator/audit"
 	dbcommands "github.com/prysmaticlabs/prysm/v3/cmd/validator/db"
 	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
 	slashingprotectioncommands "github.com/prysmaticlabs/prysm/v3/cmd/validator/slashing-protection"
@@ -74,11 +75,15 @@ var appFlags = []cli.Flag{
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
+	flags.Web3SignerKeyRefreshIntervalFlag,
 	flags.SuggestedFeeRecipientFlag,
 	flags.ProposerSettingsURLFlag,
 	flags.ProposerSettingsFlag,
 	flags.EnableBuilderFlag,
 	flags.BuilderGasLimitFlag,
+	flags.TenantsFileFlag,
+	flags.SigningAuditLogDirFlag,
+	flags.SigningAuditLogMaxFileSizeFlag,
 	////////////////////
 	cmd.DisableMonitoringFlag,
 	cmd.MonitoringHostFlag,
@@ -127,6 +132,7 @@ func main() {
 		slashingprotectioncommands.Commands,
 		dbcommands.Commands,
 		web.Commands,
+		auditcommands.Commands,
 	}
 
 	app.Flags = appFlags
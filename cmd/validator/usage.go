@@ -107,11 +107,15 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GraffitiFileFlag,
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
+			flags.Web3SignerKeyRefreshIntervalFlag,
 			flags.ProposerSettingsFlag,
 			flags.ProposerSettingsURLFlag,
 			flags.SuggestedFeeRecipientFlag,
 			flags.EnableBuilderFlag,
 			flags.BuilderGasLimitFlag,
+			flags.TenantsFileFlag,
+			flags.SigningAuditLogDirFlag,
+			flags.SigningAuditLogMaxFileSizeFlag,
 		},
 	},
 	{
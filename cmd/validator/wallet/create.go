@@ -121,6 +121,13 @@ func ConstructCLIManagerOpts(cliCtx *cli.Context, keymanagerKind keymanager.Kind
 		}
 		cliOpts = append(cliOpts, accounts.WithKeymanagerOpts(opts))
 	}
+	if keymanagerKind == keymanager.PKCS11 {
+		opts, err := userprompt.InputPKCS11KeymanagerConfig(cliCtx)
+		if err != nil {
+			return []accounts.Option{}, errors.Wrap(err, "could not input pkcs11 keymanager config")
+		}
+		cliOpts = append(cliOpts, accounts.WithPKCS11KeymanagerOpts(opts))
+	}
 	if keymanagerKind == keymanager.Web3Signer {
 		return []accounts.Option{}, errors.New("web3signer keymanager does not require persistent wallets.")
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/pkcs11"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -188,6 +189,44 @@ func TestCreateWallet_Remote(t *testing.T) {
 	assert.DeepEqual(t, wantCfg, cfg)
 }
 
+func TestCreateWallet_PKCS11(t *testing.T) {
+	walletDir, _, walletPasswordFile := setupWalletAndPasswordsDir(t)
+	wantCfg := &pkcs11.KeymanagerOpts{
+		Library:    "/usr/lib/softhsm/libsofthsm2.so",
+		TokenLabel: "validator",
+	}
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	keymanagerKind := "pkcs11"
+	set.String(flags.WalletDirFlag.Name, walletDir, "")
+	set.String(flags.WalletPasswordFileFlag.Name, walletDir, "")
+	set.String(flags.KeymanagerKindFlag.Name, keymanagerKind, "")
+	set.String(flags.PKCS11LibraryFlag.Name, wantCfg.Library, "")
+	set.String(flags.PKCS11TokenLabelFlag.Name, wantCfg.TokenLabel, "")
+	assert.NoError(t, set.Set(flags.WalletDirFlag.Name, walletDir))
+	assert.NoError(t, set.Set(flags.WalletPasswordFileFlag.Name, walletPasswordFile))
+	assert.NoError(t, set.Set(flags.KeymanagerKindFlag.Name, keymanagerKind))
+	assert.NoError(t, set.Set(flags.PKCS11LibraryFlag.Name, wantCfg.Library))
+	assert.NoError(t, set.Set(flags.PKCS11TokenLabelFlag.Name, wantCfg.TokenLabel))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	_, err := CreateAndSaveWalletCli(cliCtx)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{
+		WalletDir: walletDir,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, keymanager.PKCS11, w.KeymanagerKind())
+
+	encoded, err := w.ReadKeymanagerConfigFromDisk(ctx)
+	assert.NoError(t, err)
+	cfg, err := pkcs11.UnmarshalOptionsFile(encoded)
+	assert.NoError(t, err)
+	assert.DeepEqual(t, wantCfg, cfg)
+}
+
 func TestInputKeymanagerKind(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -231,6 +270,12 @@ func TestInputKeymanagerKind(t *testing.T) {
 			want:    keymanager.Remote,
 			wantErr: false,
 		},
+		{
+			name:    "pkcs11 returns pkcs11 kind",
+			args:    "pkcs11",
+			want:    keymanager.PKCS11,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -45,6 +45,9 @@ type testWalletConfig struct {
 	accountPasswordFile     string
 	walletPasswordFile      string
 	backupPasswordFile      string
+	newWalletPasswordFile   string
+	kdf                     string
+	kdfCost                 int
 	backupPublicKeys        string
 	voluntaryExitPublicKeys string
 	deletePublicKeys        string
@@ -75,6 +78,9 @@ func setupWalletCtx(
 	set.Bool(flags.SkipMnemonic25thWordCheckFlag.Name, true, "")
 	set.Bool(flags.ExitAllFlag.Name, cfg.exitAll, "")
 	set.String(flags.GrpcHeadersFlag.Name, cfg.grpcHeaders, "")
+	set.String(flags.NewWalletPasswordFileFlag.Name, cfg.newWalletPasswordFile, "")
+	set.String(flags.KDFFlag.Name, cfg.kdf, "")
+	set.Int(flags.KDFCostFlag.Name, cfg.kdfCost, "")
 
 	if cfg.privateKeyFile != "" {
 		set.String(flags.ImportPrivateKeyFileFlag.Name, cfg.privateKeyFile, "")
@@ -95,6 +101,9 @@ func setupWalletCtx(
 	assert.NoError(tb, set.Set(flags.SkipDepositConfirmationFlag.Name, strconv.FormatBool(cfg.skipDepositConfirm)))
 	assert.NoError(tb, set.Set(flags.ExitAllFlag.Name, strconv.FormatBool(cfg.exitAll)))
 	assert.NoError(tb, set.Set(flags.GrpcHeadersFlag.Name, cfg.grpcHeaders))
+	assert.NoError(tb, set.Set(flags.NewWalletPasswordFileFlag.Name, cfg.newWalletPasswordFile))
+	assert.NoError(tb, set.Set(flags.KDFFlag.Name, cfg.kdf))
+	assert.NoError(tb, set.Set(flags.KDFCostFlag.Name, strconv.Itoa(cfg.kdfCost)))
 	return cli.NewContext(&app, set, nil)
 }
 
@@ -0,0 +1,59 @@
+package wallet
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v3/io/prompt"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/urfave/cli/v2"
+)
+
+func walletReencrypt(c *cli.Context) error {
+	kdf, err := keystore.NewKDFParams(c.String(flags.KDFFlag.Name), c.Int(flags.KDFCostFlag.Name))
+	if err != nil {
+		return err
+	}
+	w, err := wallet.OpenWalletOrElseCli(c, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	km, err := w.InitializeKeymanager(c.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+		return errors.New("wrong wallet password entered")
+	}
+	if err != nil {
+		return errors.Wrap(err, accounts.ErrCouldNotInitializeKeymanager)
+	}
+	newPassword, err := prompt.InputPassword(
+		c,
+		flags.NewWalletPasswordFileFlag,
+		wallet.NewWalletPasswordPromptText,
+		wallet.ConfirmPasswordPromptText,
+		true, /* Should confirm password */
+		prompt.ValidatePasswordInput,
+	)
+	if err != nil {
+		return err
+	}
+
+	acc, err := accounts.NewCLIManager(
+		accounts.WithWallet(w),
+		accounts.WithKeymanager(km),
+		accounts.WithNewWalletPassword(newPassword),
+		accounts.WithKDFParams(kdf),
+		// The password file the wallet was opened with is updated with the new password.
+		accounts.WithPasswordFilePath(c.String(flags.WalletPasswordFileFlag.Name)),
+	)
+	if err != nil {
+		return err
+	}
+	return acc.WalletReencrypt(c.Context)
+}
@@ -0,0 +1,115 @@
+package wallet
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
+	constant "github.com/prysmaticlabs/prysm/v3/validator/testing"
+)
+
+func TestReencryptWallet_Noninteractive(t *testing.T) {
+	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
+	require.NoError(t, os.Chmod(passwordFilePath, params.BeaconIoConfig().ReadWritePermissions))
+	newPassword := "N3wPassw0rd$2022"
+	newPasswordFilePath := filepath.Join(t.TempDir(), "newpassword.txt")
+	require.NoError(t, os.WriteFile(newPasswordFilePath, []byte(newPassword), os.ModePerm))
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:             walletDir,
+		keymanagerKind:        keymanager.Derived,
+		walletPasswordFile:    passwordFilePath,
+		newWalletPasswordFile: newPasswordFilePath,
+		kdf:                   keystore.ScryptKDF,
+		kdfCost:               1024,
+	})
+	acc, err := accounts.NewCLIManager(
+		accounts.WithWalletDir(walletDir),
+		accounts.WithKeymanagerType(keymanager.Derived),
+		accounts.WithWalletPassword(password),
+	)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(cliCtx.Context)
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	derivedKM, ok := km.(*derived.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, derivedKM.RecoverAccountsFromMnemonic(cliCtx.Context, constant.TestMnemonic, "", 2))
+	wantKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+
+	require.NoError(t, walletReencrypt(cliCtx))
+
+	// The wallet no longer opens with the previous password.
+	w, err = wallet.OpenWallet(cliCtx.Context, &wallet.Config{WalletDir: walletDir, WalletPassword: password})
+	require.NoError(t, err)
+	_, err = w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	assert.ErrorContains(t, keymanager.IncorrectPasswordErrMsg, err)
+
+	w, err = wallet.OpenWallet(cliCtx.Context, &wallet.Config{WalletDir: walletDir, WalletPassword: newPassword})
+	require.NoError(t, err)
+	km, err = w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantKeys, keys)
+
+	// The wallet password file holds the new password.
+	enc, err := os.ReadFile(passwordFilePath)
+	require.NoError(t, err)
+	assert.Equal(t, newPassword, string(enc))
+}
+
+func TestReencryptWallet_PasswordFileNotWritable(t *testing.T) {
+	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
+	require.NoError(t, os.Chmod(passwordFilePath, 0400))
+	newPasswordFilePath := filepath.Join(t.TempDir(), "newpassword.txt")
+	require.NoError(t, os.WriteFile(newPasswordFilePath, []byte("N3wPassw0rd$2022"), os.ModePerm))
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:             walletDir,
+		keymanagerKind:        keymanager.Local,
+		walletPasswordFile:    passwordFilePath,
+		newWalletPasswordFile: newPasswordFilePath,
+		kdf:                   keystore.PBKDF2KDF,
+		kdfCost:               1000,
+	})
+	acc, err := accounts.NewCLIManager(
+		accounts.WithWalletDir(walletDir),
+		accounts.WithKeymanagerType(keymanager.Local),
+		accounts.WithWalletPassword(password),
+	)
+	require.NoError(t, err)
+	_, err = acc.WalletCreate(cliCtx.Context)
+	require.NoError(t, err)
+
+	err = walletReencrypt(cliCtx)
+	assert.ErrorContains(t, "cannot be updated with the new password", err)
+
+	// The wallet still opens with the password of the password file.
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{WalletDir: walletDir, WalletPassword: password})
+	require.NoError(t, err)
+	_, err = w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+}
+
+func TestReencryptWallet_InvalidKDF(t *testing.T) {
+	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:             walletDir,
+		keymanagerKind:        keymanager.Local,
+		walletPasswordFile:    passwordFilePath,
+		newWalletPasswordFile: passwordFilePath,
+		kdf:                   "argon2",
+	})
+	err := walletReencrypt(cliCtx)
+	assert.ErrorContains(t, "unsupported key derivation function", err)
+}
@@ -29,6 +29,8 @@ var Commands = &cli.Command{
 				flags.RemoteSignerCertPathFlag,
 				flags.RemoteSignerKeyPathFlag,
 				flags.RemoteSignerCACertPathFlag,
+				flags.PKCS11LibraryFlag,
+				flags.PKCS11TokenLabelFlag,
 				flags.WalletPasswordFileFlag,
 				flags.Mnemonic25thWordFileFlag,
 				flags.SkipMnemonic25thWordCheckFlag,
@@ -87,6 +89,38 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "reencrypt",
+			Usage: "re-encrypts the keystores of a wallet with a new password and a selectable key derivation " +
+				"function, atomically replacing them on disk",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.NewWalletPasswordFileFlag,
+				flags.KDFFlag,
+				flags.KDFCostFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.RopstenTestnet,
+				features.SepoliaTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := walletReencrypt(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not re-encrypt wallet")
+				}
+				return nil
+			},
+		},
 		{
 			Name:  "recover",
 			Usage: "uses a derived wallet seed recovery phase to recreate an existing HD wallet",
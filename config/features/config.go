@@ -68,6 +68,7 @@ type Flags struct {
 	EnableBatchGossipAggregation      bool // EnableBatchGossipAggregation specifies whether to further aggregate our gossip batches before verifying them.
 	EnableOnlyBlindedBeaconBlocks     bool // EnableOnlyBlindedBeaconBlocks enables only storing blinded beacon blocks in the DB post-Bellatrix fork.
 	EnableStartOptimistic             bool // EnableStartOptimistic treats every block as optimistic at startup.
+	EnableReorgLateBlocks             bool // EnableReorgLateBlocks lets the proposer build on the parent of a late and weakly attested head.
 
 	DisableStakinContractCheck bool // Disables check for deposit contract when proposing blocks
 
@@ -256,6 +257,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(enableFullSSZDataLogging)
 		cfg.EnableFullSSZDataLogging = true
 	}
+	if ctx.Bool(enableReorgLateBlocks.Name) {
+		logEnabled(enableReorgLateBlocks)
+		cfg.EnableReorgLateBlocks = true
+	}
 	Init(cfg)
 	return nil
 }
@@ -128,6 +128,10 @@ var (
 		Name:  "enable-full-ssz-data-logging",
 		Usage: "Enables displaying logs for full ssz data on rejected gossip messages",
 	}
+	enableReorgLateBlocks = &cli.BoolFlag{
+		Name:  "enable-reorg-late-blocks",
+		Usage: "Enables the proposer to build on the parent of a late and weakly attested head block, reorging it out",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -173,6 +177,7 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	enableStartupOptimistic,
 	disableDefensivePull,
 	enableFullSSZDataLogging,
+	enableReorgLateBlocks,
 }...)...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -68,3 +68,18 @@ func NewAggregateSignature() common.Signature {
 func RandKey() (common.SecretKey, error) {
 	return blst.RandKey()
 }
+
+// SplitSecretKey splits a private key into shares, any threshold of which can sign on its behalf.
+func SplitSecretKey(secretKey SecretKey, threshold, shares uint64) ([]SecretKey, error) {
+	return blst.SplitSecretKey(secretKey, threshold, shares)
+}
+
+// RecoverSignature combines signatures by the key shares with the given indices into a signature of the split key.
+func RecoverSignature(indices []uint64, sigs []common.Signature) (common.Signature, error) {
+	return blst.RecoverSignature(indices, sigs)
+}
+
+// RecoverPublicKey combines public keys of the key shares with the given indices into the public key of the split key.
+func RecoverPublicKey(indices []uint64, pubKeys []PublicKey) (PublicKey, error) {
+	return blst.RecoverPublicKey(indices, pubKeys)
+}
@@ -81,3 +81,9 @@ func (s *bls12SecretKey) Marshal() []byte {
 	keyBytes := s.p.Serialize()
 	return keyBytes
 }
+
+// Zeroize wipes the secret key from memory, after which it must not be used. It is not part of
+// the SecretKey interface, callers holding keys only briefly check for it instead.
+func (s *bls12SecretKey) Zeroize() {
+	s.p.Zeroize()
+}
@@ -96,3 +96,12 @@ func TestZeroKey(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, false, blst.IsZero(zKey[:]))
 }
+
+func TestSecretKey_Zeroize(t *testing.T) {
+	rk, err := blst.RandKey()
+	require.NoError(t, err)
+	zk, ok := rk.(interface{ Zeroize() })
+	require.Equal(t, true, ok)
+	zk.Zeroize()
+	assert.DeepEqual(t, make([]byte, 32), rk.Marshal())
+}
@@ -152,3 +152,18 @@ func RandKey() (common.SecretKey, error) {
 func VerifyCompressed(_, _, _ []byte) bool {
 	panic(err)
 }
+
+// SplitSecretKey -- stub
+func SplitSecretKey(_ common.SecretKey, _, _ uint64) ([]common.SecretKey, error) {
+	panic(err)
+}
+
+// RecoverSignature -- stub
+func RecoverSignature(_ []uint64, _ []common.Signature) (common.Signature, error) {
+	panic(err)
+}
+
+// RecoverPublicKey -- stub
+func RecoverPublicKey(_ []uint64, _ []common.PublicKey) (common.PublicKey, error) {
+	panic(err)
+}
@@ -0,0 +1,139 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+
+package blst
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// curveOrder is the order r of the BLS12-381 groups, secret keys and key shares are scalars modulo r.
+var curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// SplitSecretKey splits a secret key into the given number of shares using Shamir's secret sharing,
+// so that the signatures of any threshold of shares can be combined into a signature of the secret key.
+// The share at position i of the returned slice has the index i+1.
+func SplitSecretKey(secretKey common.SecretKey, threshold, shares uint64) ([]common.SecretKey, error) {
+	if threshold == 0 || threshold > shares {
+		return nil, fmt.Errorf("threshold must be between 1 and the number of shares, got %d of %d", threshold, shares)
+	}
+	// The polynomial f(x) = s + a_1 x + ... + a_{t-1} x^{t-1}, the secret key being f(0).
+	coefficients := make([]*big.Int, threshold)
+	coefficients[0] = new(big.Int).SetBytes(secretKey.Marshal())
+	for i := uint64(1); i < threshold; i++ {
+		c, err := rand.Int(rand.Reader, curveOrder)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate polynomial coefficient")
+		}
+		coefficients[i] = c
+	}
+	keys := make([]common.SecretKey, shares)
+	for i := uint64(1); i <= shares; i++ {
+		x := new(big.Int).SetUint64(i)
+		y := new(big.Int)
+		// Horner's method, from the highest degree coefficient down.
+		for j := len(coefficients) - 1; j >= 0; j-- {
+			y.Mul(y, x)
+			y.Add(y, coefficients[j])
+			y.Mod(y, curveOrder)
+		}
+		share, err := SecretKeyFromBytes(y.FillBytes(make([]byte, scalarBytes)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create key share %d", i)
+		}
+		keys[i-1] = share
+	}
+	return keys, nil
+}
+
+// RecoverSignature combines the signatures of a message by key shares with the given indices into the
+// signature of the message by the secret key they were split from. At least threshold signatures are
+// required, more are ignored.
+func RecoverSignature(indices []uint64, signatures []common.Signature) (common.Signature, error) {
+	if len(indices) != len(signatures) {
+		return nil, fmt.Errorf("got %d indices for %d signatures", len(indices), len(signatures))
+	}
+	coefficients, err := lagrangeCoefficients(indices)
+	if err != nil {
+		return nil, err
+	}
+	acc := new(blst.P2)
+	for i, s := range signatures {
+		sig, ok := s.(*Signature)
+		if !ok || sig.s == nil {
+			return nil, errors.New("unsupported signature type")
+		}
+		p := new(blst.P2)
+		p.FromAffine(sig.s)
+		acc.AddAssign(p.MultAssign(coefficients[i]))
+	}
+	return &Signature{s: acc.ToAffine()}, nil
+}
+
+// RecoverPublicKey combines the public keys of key shares with the given indices into the public key of
+// the secret key they were split from.
+func RecoverPublicKey(indices []uint64, publicKeys []common.PublicKey) (common.PublicKey, error) {
+	if len(indices) != len(publicKeys) {
+		return nil, fmt.Errorf("got %d indices for %d public keys", len(indices), len(publicKeys))
+	}
+	coefficients, err := lagrangeCoefficients(indices)
+	if err != nil {
+		return nil, err
+	}
+	acc := new(blst.P1)
+	for i, k := range publicKeys {
+		pub, ok := k.(*PublicKey)
+		if !ok || pub.p == nil {
+			return nil, errors.New("unsupported public key type")
+		}
+		p := new(blst.P1)
+		p.FromAffine(pub.p)
+		acc.AddAssign(p.MultAssign(coefficients[i]))
+	}
+	return &PublicKey{p: acc.ToAffine()}, nil
+}
+
+// lagrangeCoefficients returns, for each index x_i, the Lagrange basis polynomial evaluated at zero:
+// the product over j != i of x_j / (x_j - x_i) modulo the curve order.
+func lagrangeCoefficients(indices []uint64) ([]*blst.Scalar, error) {
+	if len(indices) == 0 {
+		return nil, errors.New("no shares provided")
+	}
+	seen := make(map[uint64]bool, len(indices))
+	for _, x := range indices {
+		if x == 0 {
+			return nil, errors.New("share index must not be zero")
+		}
+		if seen[x] {
+			return nil, fmt.Errorf("duplicate share index %d", x)
+		}
+		seen[x] = true
+	}
+	coefficients := make([]*blst.Scalar, len(indices))
+	for i, xi := range indices {
+		num, den := big.NewInt(1), big.NewInt(1)
+		for j, xj := range indices {
+			if i == j {
+				continue
+			}
+			num.Mul(num, new(big.Int).SetUint64(xj))
+			num.Mod(num, curveOrder)
+			diff := new(big.Int).Sub(new(big.Int).SetUint64(xj), new(big.Int).SetUint64(xi))
+			den.Mul(den, diff.Mod(diff, curveOrder))
+			den.Mod(den, curveOrder)
+		}
+		l := num.Mul(num, den.ModInverse(den, curveOrder))
+		l.Mod(l, curveOrder)
+		scalar := new(blst.Scalar).Deserialize(l.FillBytes(make([]byte, scalarBytes)))
+		if scalar == nil {
+			return nil, fmt.Errorf("could not compute lagrange coefficient for share index %d", xi)
+		}
+		coefficients[i] = scalar
+	}
+	return coefficients, nil
+}
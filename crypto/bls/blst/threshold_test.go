@@ -0,0 +1,86 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+
+package blst_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestSplitSecretKey_RecoverSignature(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	shares, err := blst.SplitSecretKey(priv, 3, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(shares))
+
+	msg := []byte("hello")
+	want := priv.Sign(msg)
+	tests := []struct {
+		name    string
+		indices []uint64
+	}{
+		{name: "first shares", indices: []uint64{1, 2, 3}},
+		{name: "last shares", indices: []uint64{3, 4, 5}},
+		{name: "unordered shares", indices: []uint64{5, 1, 3}},
+		{name: "more than threshold", indices: []uint64{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sigs := make([]common.Signature, len(tt.indices))
+			pubs := make([]common.PublicKey, len(tt.indices))
+			for i, idx := range tt.indices {
+				sigs[i] = shares[idx-1].Sign(msg)
+				pubs[i] = shares[idx-1].PublicKey()
+			}
+			sig, err := blst.RecoverSignature(tt.indices, sigs)
+			require.NoError(t, err)
+			assert.DeepEqual(t, want.Marshal(), sig.Marshal())
+			assert.Equal(t, true, sig.Verify(priv.PublicKey(), msg))
+
+			pub, err := blst.RecoverPublicKey(tt.indices, pubs)
+			require.NoError(t, err)
+			assert.DeepEqual(t, priv.PublicKey().Marshal(), pub.Marshal())
+		})
+	}
+}
+
+func TestRecoverSignature_BelowThreshold(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	shares, err := blst.SplitSecretKey(priv, 3, 5)
+	require.NoError(t, err)
+
+	msg := []byte("hello")
+	sig, err := blst.RecoverSignature([]uint64{1, 2}, []common.Signature{shares[0].Sign(msg), shares[1].Sign(msg)})
+	require.NoError(t, err)
+	assert.Equal(t, false, sig.Verify(priv.PublicKey(), msg))
+}
+
+func TestSplitSecretKey_InvalidThreshold(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	_, err = blst.SplitSecretKey(priv, 0, 3)
+	assert.ErrorContains(t, "threshold must be between 1 and the number of shares", err)
+	_, err = blst.SplitSecretKey(priv, 4, 3)
+	assert.ErrorContains(t, "threshold must be between 1 and the number of shares", err)
+}
+
+func TestRecoverSignature_InvalidIndices(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("hello"))
+
+	_, err = blst.RecoverSignature([]uint64{1}, []common.Signature{sig, sig})
+	assert.ErrorContains(t, "got 1 indices for 2 signatures", err)
+	_, err = blst.RecoverSignature([]uint64{1, 1}, []common.Signature{sig, sig})
+	assert.ErrorContains(t, "duplicate share index 1", err)
+	_, err = blst.RecoverSignature([]uint64{0, 1}, []common.Signature{sig, sig})
+	assert.ErrorContains(t, "share index must not be zero", err)
+	_, err = blst.RecoverSignature(nil, nil)
+	assert.ErrorContains(t, "no shares provided", err)
+}
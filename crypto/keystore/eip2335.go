@@ -0,0 +1,243 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/minio/sha256-simd"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// ScryptKDF is the scrypt key derivation function of EIP-2335 keystores.
+	ScryptKDF = "scrypt"
+	// PBKDF2KDF is the PBKDF2 key derivation function of EIP-2335 keystores.
+	PBKDF2KDF = "pbkdf2"
+
+	// DefaultScryptN is the default scrypt cost, as recommended by EIP-2335.
+	DefaultScryptN = 262144
+	// DefaultPBKDF2C is the default PBKDF2 iteration count, as recommended by EIP-2335.
+	DefaultPBKDF2C = 262144
+	// MaxScryptN is the highest scrypt cost accepted, which takes 1 GiB of memory to derive a key.
+	MaxScryptN = 1 << 20
+	// MaxPBKDF2C is the highest PBKDF2 iteration count accepted.
+	MaxPBKDF2C = 1 << 24
+
+	eip2335KeyLen  = 32
+	eip2335ScryptR = 8
+	eip2335ScryptP = 1
+	eip2335PRF     = "hmac-sha256"
+)
+
+// KDFParams selects the key derivation function of an EIP-2335 keystore and its cost,
+// which is the N parameter of scrypt or the iteration count of PBKDF2.
+type KDFParams struct {
+	Function string `json:"function"`
+	Cost     int    `json:"cost"`
+}
+
+// DefaultKDFParams returns the key derivation function used by Prysm for new keystores.
+func DefaultKDFParams() *KDFParams {
+	return &KDFParams{Function: PBKDF2KDF, Cost: DefaultPBKDF2C}
+}
+
+// NewKDFParams returns the parameters of a key derivation function, a zero cost selecting
+// the default cost of the function.
+func NewKDFParams(function string, cost int) (*KDFParams, error) {
+	p := &KDFParams{Function: function, Cost: cost}
+	if cost == 0 {
+		switch function {
+		case ScryptKDF:
+			p.Cost = DefaultScryptN
+		case PBKDF2KDF:
+			p.Cost = DefaultPBKDF2C
+		}
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Validate the function and cost of key derivation parameters. The cost is capped, as deriving
+// a key with an arbitrary cost could exhaust the memory or CPU of the validator client.
+func (p *KDFParams) Validate() error {
+	if p == nil {
+		return errors.New("no key derivation parameters")
+	}
+	switch p.Function {
+	case ScryptKDF:
+		if p.Cost < 2 || p.Cost&(p.Cost-1) != 0 {
+			return fmt.Errorf("scrypt cost must be a power of 2 greater than 1, got %d", p.Cost)
+		}
+		if p.Cost > MaxScryptN {
+			return fmt.Errorf("scrypt cost must be at most %d, got %d", MaxScryptN, p.Cost)
+		}
+	case PBKDF2KDF:
+		if p.Cost < 1 {
+			return fmt.Errorf("pbkdf2 cost must be positive, got %d", p.Cost)
+		}
+		if p.Cost > MaxPBKDF2C {
+			return fmt.Errorf("pbkdf2 cost must be at most %d, got %d", MaxPBKDF2C, p.Cost)
+		}
+	default:
+		return fmt.Errorf("unsupported key derivation function %q, expected %s or %s", p.Function, ScryptKDF, PBKDF2KDF)
+	}
+	return nil
+}
+
+// String of key derivation parameters, such as scrypt(n=262144).
+func (p *KDFParams) String() string {
+	if p.Function == ScryptKDF {
+		return fmt.Sprintf("%s(n=%d)", p.Function, p.Cost)
+	}
+	return fmt.Sprintf("%s(c=%d)", p.Function, p.Cost)
+}
+
+type eip2335Module struct {
+	Function string                 `json:"function"`
+	Params   map[string]interface{} `json:"params"`
+	Message  string                 `json:"message"`
+}
+
+type eip2335Crypto struct {
+	KDF      *eip2335Module `json:"kdf"`
+	Checksum *eip2335Module `json:"checksum"`
+	Cipher   *eip2335Module `json:"cipher"`
+}
+
+// EncryptEIP2335 encrypts a secret into the crypto fields of an EIP-2335 keystore,
+// deriving the key from the password with the given function and cost. The resulting
+// fields can be decrypted by any EIP-2335 implementation.
+func EncryptEIP2335(secret []byte, password string, kdf *KDFParams) (map[string]interface{}, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("no secret to encrypt")
+	}
+	if err := kdf.Validate(); err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("could not generate salt: %w", err)
+	}
+	normedPassword := normalizeEIP2335Password(password)
+	var decryptionKey []byte
+	var kdfParams map[string]interface{}
+	switch kdf.Function {
+	case ScryptKDF:
+		var err error
+		decryptionKey, err = scrypt.Key(normedPassword, salt, kdf.Cost, eip2335ScryptR, eip2335ScryptP, eip2335KeyLen)
+		if err != nil {
+			return nil, fmt.Errorf("could not derive key: %w", err)
+		}
+		kdfParams = map[string]interface{}{
+			"dklen": eip2335KeyLen,
+			"n":     kdf.Cost,
+			"r":     eip2335ScryptR,
+			"p":     eip2335ScryptP,
+			"salt":  hex.EncodeToString(salt),
+		}
+	case PBKDF2KDF:
+		decryptionKey = pbkdf2.Key(normedPassword, salt, kdf.Cost, eip2335KeyLen, sha256.New)
+		kdfParams = map[string]interface{}{
+			"dklen": eip2335KeyLen,
+			"c":     kdf.Cost,
+			"prf":   eip2335PRF,
+			"salt":  hex.EncodeToString(salt),
+		}
+	}
+
+	iv := make([]byte, 16)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, fmt.Errorf("could not generate iv: %w", err)
+	}
+	block, err := aes.NewCipher(decryptionKey[:16])
+	if err != nil {
+		return nil, err
+	}
+	cipherMsg := make([]byte, len(secret))
+	cipher.NewCTR(block, iv).XORKeyStream(cipherMsg, secret)
+	h := sha256.New()
+	if _, err := h.Write(decryptionKey[16:32]); err != nil {
+		return nil, err
+	}
+	if _, err := h.Write(cipherMsg); err != nil {
+		return nil, err
+	}
+
+	enc, err := json.Marshal(&eip2335Crypto{
+		KDF: &eip2335Module{Function: kdf.Function, Params: kdfParams},
+		Checksum: &eip2335Module{
+			Function: "sha256",
+			Params:   map[string]interface{}{},
+			Message:  hex.EncodeToString(h.Sum(nil)),
+		},
+		Cipher: &eip2335Module{
+			Function: "aes-128-ctr",
+			Params:   map[string]interface{}{"iv": hex.EncodeToString(iv)},
+			Message:  hex.EncodeToString(cipherMsg),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Keystores carry their crypto fields as a generic map.
+	var cryptoFields map[string]interface{}
+	if err := json.Unmarshal(enc, &cryptoFields); err != nil {
+		return nil, err
+	}
+	return cryptoFields, nil
+}
+
+// EIP2335KDFParams returns the key derivation function and cost of the crypto fields of an EIP-2335 keystore.
+func EIP2335KDFParams(cryptoFields map[string]interface{}) (*KDFParams, error) {
+	enc, err := json.Marshal(cryptoFields)
+	if err != nil {
+		return nil, err
+	}
+	c := &eip2335Crypto{}
+	if err := json.Unmarshal(enc, c); err != nil {
+		return nil, fmt.Errorf("could not decode keystore crypto fields: %w", err)
+	}
+	if c.KDF == nil {
+		return nil, errors.New("keystore has no key derivation function")
+	}
+	p := &KDFParams{Function: c.KDF.Function}
+	costParam := "c"
+	if c.KDF.Function == ScryptKDF {
+		costParam = "n"
+	}
+	if cost, ok := c.KDF.Params[costParam].(float64); ok {
+		p.Cost = int(cost)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// normalizeEIP2335Password NFKD normalizes a password and strips its control codes, as per EIP-2335.
+// It normalizes exactly as the EIP-2335 decryptor used by Prysm, so that keystores always decrypt.
+func normalizeEIP2335Password(password string) []byte {
+	var res []byte
+	iter := &norm.Iter{}
+	iter.InitString(norm.NFKD, password)
+	for !iter.Done() {
+		r, _ := utf8.DecodeRune(iter.Next())
+		buf := make([]byte, utf8.RuneLen(r))
+		utf8.EncodeRune(buf, r)
+		if len(buf) == 1 && (buf[0] < 0x20 || buf[0] == 0x7f) {
+			continue
+		}
+		res = norm.NFKD.Append(res, buf...)
+	}
+	return res
+}
@@ -0,0 +1,70 @@
+package keystore
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestEncryptEIP2335(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	// The password holds control codes and characters changed by normalization.
+	const password = "päss\x7fẃΩrd\n"
+	for _, kdf := range []*KDFParams{
+		{Function: ScryptKDF, Cost: 1024},
+		{Function: PBKDF2KDF, Cost: 1000},
+	} {
+		t.Run(kdf.Function, func(t *testing.T) {
+			cryptoFields, err := EncryptEIP2335(secretKey.Marshal(), password, kdf)
+			require.NoError(t, err)
+			decrypted, err := keystorev4.New().Decrypt(cryptoFields, password)
+			require.NoError(t, err)
+			assert.DeepEqual(t, secretKey.Marshal(), decrypted)
+			_, err = keystorev4.New().Decrypt(cryptoFields, "wrong")
+			assert.ErrorContains(t, "invalid checksum", err)
+
+			params, err := EIP2335KDFParams(cryptoFields)
+			require.NoError(t, err)
+			assert.DeepEqual(t, kdf, params)
+		})
+	}
+}
+
+func TestEIP2335KDFParams_Keystorev4(t *testing.T) {
+	cryptoFields, err := keystorev4.New(keystorev4.WithCipher("scrypt")).Encrypt([]byte("secret"), "password")
+	require.NoError(t, err)
+	params, err := EIP2335KDFParams(cryptoFields)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &KDFParams{Function: ScryptKDF, Cost: DefaultScryptN}, params)
+}
+
+func TestNewKDFParams(t *testing.T) {
+	tests := []struct {
+		function string
+		cost     int
+		want     *KDFParams
+		wantErr  string
+	}{
+		{function: ScryptKDF, want: &KDFParams{Function: ScryptKDF, Cost: DefaultScryptN}},
+		{function: PBKDF2KDF, want: &KDFParams{Function: PBKDF2KDF, Cost: DefaultPBKDF2C}},
+		{function: ScryptKDF, cost: 4096, want: &KDFParams{Function: ScryptKDF, Cost: 4096}},
+		{function: ScryptKDF, cost: 1000, wantErr: "scrypt cost must be a power of 2"},
+		{function: ScryptKDF, cost: MaxScryptN * 2, wantErr: "scrypt cost must be at most"},
+		{function: PBKDF2KDF, cost: -1, wantErr: "pbkdf2 cost must be positive"},
+		{function: PBKDF2KDF, cost: MaxPBKDF2C + 1, wantErr: "pbkdf2 cost must be at most"},
+		{function: "argon2", wantErr: "unsupported key derivation function"},
+	}
+	for _, tt := range tests {
+		got, err := NewKDFParams(tt.function, tt.cost)
+		if tt.wantErr != "" {
+			assert.ErrorContains(t, tt.wantErr, err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.DeepEqual(t, tt.want, got)
+	}
+}
@@ -104,6 +104,67 @@ func WriteFile(file string, data []byte) error {
 	return os.WriteFile(expanded, data, params.BeaconIoConfig().ReadWritePermissions)
 }
 
+// WriteFileAtomic writes binary data to a file like WriteFile, but atomically: the data is
+// written and synced to a temporary file in the same directory, which is then renamed over the
+// file, so that a crash leaves either the previous or the new content, never a partial write.
+func WriteFileAtomic(file string, data []byte) error {
+	expanded, err := ExpandPath(file)
+	if err != nil {
+		return err
+	}
+	if FileExists(expanded) {
+		info, err := os.Stat(expanded)
+		if err != nil {
+			return err
+		}
+		if info.Mode() != params.BeaconIoConfig().ReadWritePermissions {
+			return errors.New("file already exists without proper 0600 permissions")
+		}
+	}
+	dir := filepath.Dir(expanded)
+	tmp, err := os.CreateTemp(dir, filepath.Base(expanded)+".tmp*")
+	if err != nil {
+		return errors.Wrap(err, "could not create temporary file")
+	}
+	tmpName := tmp.Name()
+	defer func() {
+		// The temporary file only remains if the rename did not happen.
+		if err := os.Remove(tmpName); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Errorf("Could not remove temporary file %s", tmpName)
+		}
+	}()
+	if err := tmp.Chmod(params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		return errors.Wrap(err, "could not set permissions of temporary file")
+	}
+	if _, err := tmp.Write(data); err != nil {
+		if err := tmp.Close(); err != nil {
+			log.WithError(err).Error("Could not close temporary file")
+		}
+		return errors.Wrap(err, "could not write temporary file")
+	}
+	if err := tmp.Sync(); err != nil {
+		if err := tmp.Close(); err != nil {
+			log.WithError(err).Error("Could not close temporary file")
+		}
+		return errors.Wrap(err, "could not sync temporary file")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "could not close temporary file")
+	}
+	if err := os.Rename(tmpName, expanded); err != nil {
+		return errors.Wrap(err, "could not replace file")
+	}
+	// The rename itself is only durable once the directory is synced.
+	d, err := os.Open(filepath.Clean(dir))
+	if err != nil {
+		return errors.Wrap(err, "could not open directory")
+	}
+	if err := d.Sync(); err != nil {
+		log.WithError(err).Debug("Could not sync directory")
+	}
+	return d.Close()
+}
+
 // HomeDir for a user.
 func HomeDir() string {
 	if home := os.Getenv("HOME"); home != "" {
@@ -135,6 +135,27 @@ func TestWriteFile_OK(t *testing.T) {
 	assert.Equal(t, true, exists)
 }
 
+func TestWriteFileAtomic(t *testing.T) {
+	dirName := t.TempDir()
+	someFileName := filepath.Join(dirName, "somefile.txt")
+	require.NoError(t, file.WriteFileAtomic(someFileName, []byte("hi")))
+	require.NoError(t, file.WriteFileAtomic(someFileName, []byte("hello")))
+	content, err := os.ReadFile(someFileName)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("hello"), content)
+	info, err := os.Stat(someFileName)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconIoConfig().ReadWritePermissions, info.Mode())
+	// No temporary file is left behind.
+	entries, err := os.ReadDir(dirName)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(entries))
+
+	require.NoError(t, os.Chmod(someFileName, os.ModePerm))
+	err = file.WriteFileAtomic(someFileName, []byte("hi"))
+	assert.ErrorContains(t, "already exists without proper 0600 permissions", err)
+}
+
 func TestCopyFile(t *testing.T) {
 	fName := t.TempDir() + "testfile"
 	err := os.WriteFile(fName, []byte{1, 2, 3}, params.BeaconIoConfig().ReadWritePermissions)
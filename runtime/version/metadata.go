@@ -0,0 +1,29 @@
+package version
+
+import "strings"
+
+// executionClientMetadataKey prefixes the version of the execution client in the metadata of a beacon node version.
+const executionClientMetadataKey = "execution_client="
+
+// ExecutionClientMetadata returns the version metadata of a beacon node connected to an
+// execution client of the given version, as returned by web3_clientVersion.
+func ExecutionClientMetadata(executionClientVersion string) string {
+	return executionClientMetadataKey + executionClientVersion
+}
+
+// ExecutionClientFromMetadata returns the version of the execution client found in the
+// version metadata of a beacon node, if any.
+func ExecutionClientFromMetadata(metadata string) (string, bool) {
+	for _, field := range strings.Split(metadata, ";") {
+		if strings.HasPrefix(field, executionClientMetadataKey) {
+			return strings.TrimPrefix(field, executionClientMetadataKey), true
+		}
+	}
+	return "", false
+}
+
+// ExecutionClientName returns the name of an execution client from its version, such as
+// Geth for Geth/v1.10.26-stable/linux-amd64/go1.19.3.
+func ExecutionClientName(executionClientVersion string) string {
+	return strings.SplitN(executionClientVersion, "/", 2)[0]
+}
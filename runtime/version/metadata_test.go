@@ -0,0 +1,22 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+)
+
+func TestExecutionClientMetadata(t *testing.T) {
+	const elVersion = "Geth/v1.10.26-stable/linux-amd64/go1.19.3"
+	v, ok := ExecutionClientFromMetadata(ExecutionClientMetadata(elVersion))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, elVersion, v)
+	assert.Equal(t, "Geth", ExecutionClientName(v))
+
+	v, ok = ExecutionClientFromMetadata("other=1;" + ExecutionClientMetadata("Nethermind/v1.14.5"))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "Nethermind", ExecutionClientName(v))
+
+	_, ok = ExecutionClientFromMetadata("")
+	assert.Equal(t, false, ok)
+}
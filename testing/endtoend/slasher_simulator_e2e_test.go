@@ -47,6 +47,14 @@ func (mockSyncChecker) IsSynced(_ context.Context) (bool, error) {
 }
 
 func TestEndToEnd_SlasherSimulator(t *testing.T) {
+	runSlasherSimulator(t, false /* standalone */)
+}
+
+func TestEndToEnd_SlasherSimulator_Standalone(t *testing.T) {
+	runSlasherSimulator(t, true /* standalone */)
+}
+
+func runSlasherSimulator(t *testing.T, standalone bool) {
 	params.SetupTestConfigCleanup(t)
 	params.OverrideBeaconConfig(params.E2ETestConfig().Copy())
 
@@ -97,6 +105,7 @@ func TestEndToEnd_SlasherSimulator(t *testing.T) {
 		PrivateKeysByValidatorIndex: privKeys,
 		SlashingsPool:               &mockslashings.PoolMock{},
 		SyncChecker:                 mockSyncChecker{},
+		Standalone:                  standalone,
 	})
 	require.NoError(t, err)
 	sim.Start()
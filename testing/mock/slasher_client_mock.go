@@ -95,3 +95,23 @@ func (mr *MockSlasherClientMockRecorder) IsSlashableBlock(arg0, arg1 interface{}
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSlashableBlock", reflect.TypeOf((*MockSlasherClient)(nil).IsSlashableBlock), varargs...)
 }
+
+// SlashingEvidence mocks base method.
+func (m *MockSlasherClient) SlashingEvidence(arg0 context.Context, arg1 *eth.SlashingEvidenceRequest, arg2 ...grpc.CallOption) (*eth.SlashingEvidenceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SlashingEvidence", varargs...)
+	ret0, _ := ret[0].(*eth.SlashingEvidenceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashingEvidence indicates an expected call of SlashingEvidence.
+func (mr *MockSlasherClientMockRecorder) SlashingEvidence(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashingEvidence", reflect.TypeOf((*MockSlasherClient)(nil).SlashingEvidence), varargs...)
+}
@@ -0,0 +1,234 @@
+package simulator
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Size of the in-memory buffer the simulated beacon node is served over.
+const beaconNodeBufferSize = 1 << 20
+
+// beaconNode simulates the gRPC API of a beacon node a standalone slasher connects to.
+// It streams the attestations and blocks produced by the simulator, and inserts the
+// slashings submitted by slasher into the slashings pool of the simulator.
+type beaconNode struct {
+	srvConfig   *ServiceConfig
+	listener    *bufconn.Listener
+	server      *grpc.Server
+	attsFeed    *event.Feed
+	blocksFeed  *event.Feed
+	lock        sync.RWMutex
+	genesisTime time.Time
+}
+
+func newBeaconNode(srvConfig *ServiceConfig) *beaconNode {
+	n := &beaconNode{
+		srvConfig:  srvConfig,
+		listener:   bufconn.Listen(beaconNodeBufferSize),
+		server:     grpc.NewServer(),
+		attsFeed:   new(event.Feed),
+		blocksFeed: new(event.Feed),
+	}
+	ethpb.RegisterBeaconChainServer(n.server, &beaconChainServer{node: n})
+	ethpb.RegisterNodeServer(n.server, &nodeServer{node: n})
+	ethpb.RegisterBeaconNodeValidatorServer(n.server, &beaconNodeValidatorServer{node: n})
+	return n
+}
+
+func (n *beaconNode) start() {
+	go func() {
+		if err := n.server.Serve(n.listener); err != nil {
+			log.WithError(err).Error("Could not serve simulated beacon node")
+		}
+	}()
+}
+
+func (n *beaconNode) stop() {
+	n.server.Stop()
+}
+
+// dial the simulated beacon node over its in-memory listener.
+func (n *beaconNode) dial(ctx context.Context) (*grpc.ClientConn, error) {
+	return grpc.DialContext(
+		ctx,
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return n.listener.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+}
+
+func (n *beaconNode) setGenesisTime(genesisTime time.Time) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.genesisTime = genesisTime
+}
+
+type beaconChainServer struct {
+	ethpb.UnimplementedBeaconChainServer
+	node *beaconNode
+}
+
+// StreamIndexedAttestations produced by the simulator.
+func (s *beaconChainServer) StreamIndexedAttestations(
+	_ *emptypb.Empty, stream ethpb.BeaconChain_StreamIndexedAttestationsServer,
+) error {
+	attsChan := make(chan *ethpb.IndexedAttestation, 1)
+	sub := s.node.attsFeed.Subscribe(attsChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case att := <-attsChan:
+			if err := stream.Send(att); err != nil {
+				return err
+			}
+		case err := <-sub.Err():
+			return err
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// GetChainHead returns the slot of the head state.
+func (s *beaconChainServer) GetChainHead(_ context.Context, _ *emptypb.Empty) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{HeadSlot: s.node.srvConfig.HeadStateFetcher.HeadSlot()}, nil
+}
+
+// ListValidators reports the number of simulated validators, along with the requested
+// validators of the head state.
+func (s *beaconChainServer) ListValidators(ctx context.Context, req *ethpb.ListValidatorsRequest) (*ethpb.Validators, error) {
+	res := &ethpb.Validators{TotalSize: int32(s.node.srvConfig.Params.NumValidators)}
+	if len(req.Indices) == 0 {
+		return res, nil
+	}
+	headState, err := s.node.srvConfig.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	for _, idx := range req.Indices {
+		validator, err := headState.ValidatorAtIndex(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "Could not get validator: %v", err)
+		}
+		res.ValidatorList = append(res.ValidatorList, &ethpb.Validators_ValidatorContainer{Index: idx, Validator: validator})
+	}
+	return res, nil
+}
+
+// GetValidator from the head state, by index.
+func (s *beaconChainServer) GetValidator(ctx context.Context, req *ethpb.GetValidatorRequest) (*ethpb.Validator, error) {
+	headState, err := s.node.srvConfig.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	validator, err := headState.ValidatorAtIndex(req.GetIndex())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Could not get validator: %v", err)
+	}
+	return validator, nil
+}
+
+// SubmitAttesterSlashing to the slashings pool.
+func (s *beaconChainServer) SubmitAttesterSlashing(
+	ctx context.Context, slashing *ethpb.AttesterSlashing,
+) (*ethpb.SubmitSlashingResponse, error) {
+	headState, err := s.node.srvConfig.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if err := s.node.srvConfig.SlashingsPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not insert attester slashing into pool: %v", err)
+	}
+	return &ethpb.SubmitSlashingResponse{}, nil
+}
+
+// SubmitProposerSlashing to the slashings pool.
+func (s *beaconChainServer) SubmitProposerSlashing(
+	ctx context.Context, slashing *ethpb.ProposerSlashing,
+) (*ethpb.SubmitSlashingResponse, error) {
+	headState, err := s.node.srvConfig.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if err := s.node.srvConfig.SlashingsPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not insert proposer slashing into pool: %v", err)
+	}
+	return &ethpb.SubmitSlashingResponse{}, nil
+}
+
+type nodeServer struct {
+	ethpb.UnimplementedNodeServer
+	node *beaconNode
+}
+
+// GetGenesis returns the genesis time of the simulation, once it started.
+func (s *nodeServer) GetGenesis(_ context.Context, _ *emptypb.Empty) (*ethpb.Genesis, error) {
+	s.node.lock.RLock()
+	defer s.node.lock.RUnlock()
+	if s.node.genesisTime.IsZero() {
+		return nil, status.Error(codes.Unavailable, "Simulation has not started yet")
+	}
+	return &ethpb.Genesis{GenesisTime: timestamppb.New(s.node.genesisTime)}, nil
+}
+
+// GetSyncStatus reports the simulated beacon node as synced.
+func (_ *nodeServer) GetSyncStatus(_ context.Context, _ *emptypb.Empty) (*ethpb.SyncStatus, error) {
+	return &ethpb.SyncStatus{Syncing: false}, nil
+}
+
+type beaconNodeValidatorServer struct {
+	ethpb.UnimplementedBeaconNodeValidatorServer
+	node *beaconNode
+}
+
+// DomainData computed from the fork and genesis validators root of the head state, as the
+// simulator signs with.
+func (s *beaconNodeValidatorServer) DomainData(ctx context.Context, req *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
+	headState, err := s.node.srvConfig.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	domain, err := signing.Domain(headState.Fork(), req.Epoch, bytesutil.ToBytes4(req.Domain), headState.GenesisValidatorsRoot())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute domain: %v", err)
+	}
+	return &ethpb.DomainResponse{SignatureDomain: domain}, nil
+}
+
+// StreamBlocksAltair produced by the simulator.
+func (s *beaconNodeValidatorServer) StreamBlocksAltair(
+	_ *ethpb.StreamBlocksRequest, stream ethpb.BeaconNodeValidator_StreamBlocksAltairServer,
+) error {
+	blocksChan := make(chan *ethpb.SignedBeaconBlock, 1)
+	sub := s.node.blocksFeed.Subscribe(blocksChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case blk := <-blocksChan:
+			if err := stream.Send(&ethpb.StreamBlocksResponse{
+				Block: &ethpb.StreamBlocksResponse_Phase0Block{Phase0Block: blk},
+			}); err != nil {
+				return err
+			}
+		case err := <-sub.Err():
+			return err
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
@@ -6,17 +6,19 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v3/crypto/rand"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
 )
 
-func (s *Simulator) generateBlockHeadersForSlot(
+func (s *Simulator) generateBlocksForSlot(
 	ctx context.Context, slot types.Slot,
-) ([]*ethpb.SignedBeaconBlockHeader, []*ethpb.ProposerSlashing, error) {
-	blocks := make([]*ethpb.SignedBeaconBlockHeader, 0)
+) ([]*ethpb.SignedBeaconBlock, []*ethpb.ProposerSlashing, error) {
+	blks := make([]*ethpb.SignedBeaconBlock, 0)
 	slashings := make([]*ethpb.ProposerSlashing, 0)
 	proposer := rand.NewGenerator().Uint64() % s.srvConfig.Params.NumValidators
 
@@ -25,47 +27,64 @@ func (s *Simulator) generateBlockHeadersForSlot(
 	if err != nil {
 		return nil, nil, err
 	}
-	block := &ethpb.SignedBeaconBlockHeader{
-		Header: &ethpb.BeaconBlockHeader{
-			Slot:          slot,
-			ProposerIndex: types.ValidatorIndex(proposer),
-			ParentRoot:    bytesutil.PadTo([]byte{}, 32),
-			StateRoot:     bytesutil.PadTo([]byte{}, 32),
-			BodyRoot:      bytesutil.PadTo([]byte("good block"), 32),
-		},
-	}
-	sig, err := s.signBlockHeader(beaconState, block)
+	block, header, err := s.signedBlock(beaconState, slot, types.ValidatorIndex(proposer), "good block")
 	if err != nil {
 		return nil, nil, err
 	}
-	block.Signature = sig.Marshal()
 
-	blocks = append(blocks, block)
+	blks = append(blks, block)
 	if rand.NewGenerator().Float64() < s.srvConfig.Params.ProposerSlashingProbab {
 		log.WithField("proposerIndex", proposer).Infof("Slashable block made")
-		slashableBlock := &ethpb.SignedBeaconBlockHeader{
-			Header: &ethpb.BeaconBlockHeader{
-				Slot:          slot,
-				ProposerIndex: types.ValidatorIndex(proposer),
-				ParentRoot:    bytesutil.PadTo([]byte{}, 32),
-				StateRoot:     bytesutil.PadTo([]byte{}, 32),
-				BodyRoot:      bytesutil.PadTo([]byte("bad block"), 32),
-			},
-			Signature: sig.Marshal(),
-		}
-		sig, err = s.signBlockHeader(beaconState, slashableBlock)
+		slashableBlock, slashableHeader, err := s.signedBlock(beaconState, slot, types.ValidatorIndex(proposer), "bad block")
 		if err != nil {
 			return nil, nil, err
 		}
-		slashableBlock.Signature = sig.Marshal()
 
-		blocks = append(blocks, slashableBlock)
+		blks = append(blks, slashableBlock)
 		slashings = append(slashings, &ethpb.ProposerSlashing{
-			Header_1: block,
-			Header_2: slashableBlock,
+			Header_1: header,
+			Header_2: slashableHeader,
 		})
 	}
-	return blocks, slashings, nil
+	return blks, slashings, nil
+}
+
+// signedBlock returns a block signed by the proposer, along with its signed header.
+// As a block and its header share the same root, the signature is valid for both.
+func (s *Simulator) signedBlock(
+	beaconState state.BeaconState,
+	slot types.Slot,
+	proposer types.ValidatorIndex,
+	graffiti string,
+) (*ethpb.SignedBeaconBlock, *ethpb.SignedBeaconBlockHeader, error) {
+	block := util.HydrateSignedBeaconBlock(&ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: proposer,
+			Body: &ethpb.BeaconBlockBody{
+				Graffiti: bytesutil.PadTo([]byte(graffiti), 32),
+			},
+		},
+	})
+	header, err := blockHeader(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	sig, err := s.signBlockHeader(beaconState, header)
+	if err != nil {
+		return nil, nil, err
+	}
+	block.Signature = sig.Marshal()
+	header.Signature = sig.Marshal()
+	return block, header, nil
+}
+
+func blockHeader(block *ethpb.SignedBeaconBlock) (*ethpb.SignedBeaconBlockHeader, error) {
+	wsb, err := blocks.NewSignedBeaconBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	return wsb.Header()
 }
 
 func (s *Simulator) signBlockHeader(
@@ -8,7 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 )
 
-func TestGenerateBlockHeadersForSlot_Slashing(t *testing.T) {
+func TestGenerateBlocksForSlot_Slashing(t *testing.T) {
 	ctx := context.Background()
 	simParams := &Parameters{
 		AggregationPercent:     1,
@@ -17,15 +17,26 @@ func TestGenerateBlockHeadersForSlot_Slashing(t *testing.T) {
 	}
 	srv := setupService(t, simParams)
 
-	slot1Blocks, _, err := srv.generateBlockHeadersForSlot(ctx, 1)
+	slot1Blocks, slashings, err := srv.generateBlocksForSlot(ctx, 1)
 	require.NoError(t, err)
 	require.Equal(t, 2, len(slot1Blocks))
+	require.Equal(t, 1, len(slashings))
 
-	block1Root, err := slot1Blocks[0].HashTreeRoot()
+	block1Root, err := slot1Blocks[0].Block.HashTreeRoot()
 	require.NoError(t, err)
-	block2Root, err := slot1Blocks[1].HashTreeRoot()
+	block2Root, err := slot1Blocks[1].Block.HashTreeRoot()
 	require.NoError(t, err)
-	if slot1Blocks[0].Header.ProposerIndex == slot1Blocks[1].Header.ProposerIndex && bytes.Equal(block1Root[:], block2Root[:]) {
+	if slot1Blocks[0].Block.ProposerIndex == slot1Blocks[1].Block.ProposerIndex && bytes.Equal(block1Root[:], block2Root[:]) {
 		t.Error("Blocks received were not slashable")
 	}
+
+	// The headers of the slashing share the roots and signatures of the blocks.
+	header1Root, err := slashings[0].Header_1.Header.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, block1Root, header1Root)
+	require.DeepEqual(t, slot1Blocks[0].Signature, slashings[0].Header_1.Signature)
+	header2Root, err := slashings[0].Header_2.Header.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, block2Root, header2Root)
+	require.DeepEqual(t, slot1Blocks[1].Signature, slashings[0].Header_2.Signature)
 }
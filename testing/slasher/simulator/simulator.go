@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/slasher/beaconclient"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
@@ -20,6 +21,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 )
 
 var log = logrus.WithField("prefix", "simulator")
@@ -35,6 +37,9 @@ type ServiceConfig struct {
 	SlashingsPool               slashings.PoolManager
 	PrivateKeysByValidatorIndex map[types.ValidatorIndex]bls.SecretKey
 	SyncChecker                 sync.Checker
+	// Standalone runs slasher as a separate process would, receiving the simulated
+	// attestations and blocks over the gRPC API of a simulated beacon node.
+	Standalone bool
 }
 
 // Parameters for a slasher simulator.
@@ -53,6 +58,9 @@ type Parameters struct {
 type Simulator struct {
 	ctx                   context.Context
 	slasher               *slasher.Service
+	beaconNode            *beaconNode
+	beaconNodeConn        *grpc.ClientConn
+	beaconClient          *beaconclient.Service
 	srvConfig             *ServiceConfig
 	indexedAttsFeed       *event.Feed
 	beaconBlocksFeed      *event.Feed
@@ -84,7 +92,7 @@ func New(ctx context.Context, srvConfig *ServiceConfig) (*Simulator, error) {
 	sentBlockSlashingFeed := new(event.Feed)
 	sentAttSlashingFeed := new(event.Feed)
 
-	slasherSrv, err := slasher.New(ctx, &slasher.ServiceConfig{
+	slasherCfg := &slasher.ServiceConfig{
 		IndexedAttestationsFeed: indexedAttsFeed,
 		BeaconBlockHeadersFeed:  beaconBlocksFeed,
 		Database:                srvConfig.Database,
@@ -94,13 +102,38 @@ func New(ctx context.Context, srvConfig *ServiceConfig) (*Simulator, error) {
 		StateGen:                srvConfig.StateGen,
 		SlashingPoolInserter:    srvConfig.SlashingsPool,
 		SyncChecker:             srvConfig.SyncChecker,
-	})
+	}
+	var node *beaconNode
+	var conn *grpc.ClientConn
+	var client *beaconclient.Service
+	if srvConfig.Standalone {
+		node = newBeaconNode(srvConfig)
+		var err error
+		conn, err = node.dial(ctx)
+		if err != nil {
+			return nil, err
+		}
+		client = beaconclient.New(ctx, &beaconclient.Config{
+			BeaconChainClient:         ethpb.NewBeaconChainClient(conn),
+			NodeClient:                ethpb.NewNodeClient(conn),
+			BeaconNodeValidatorClient: ethpb.NewBeaconNodeValidatorClient(conn),
+			IndexedAttestationsFeed:   indexedAttsFeed,
+			BeaconBlockHeadersFeed:    beaconBlocksFeed,
+		})
+		slasherCfg.StateNotifier = client
+		slasherCfg.SyncChecker = client
+		slasherCfg.BeaconNode = client
+	}
+	slasherSrv, err := slasher.New(ctx, slasherCfg)
 	if err != nil {
 		return nil, err
 	}
 	return &Simulator{
 		ctx:                   ctx,
 		slasher:               slasherSrv,
+		beaconNode:            node,
+		beaconNodeConn:        conn,
+		beaconClient:          client,
 		srvConfig:             srvConfig,
 		indexedAttsFeed:       indexedAttsFeed,
 		beaconBlocksFeed:      beaconBlocksFeed,
@@ -119,6 +152,7 @@ func (s *Simulator) Start() {
 		"secondsPerSlot":         s.srvConfig.Params.SecondsPerSlot,
 		"proposerSlashingProbab": s.srvConfig.Params.ProposerSlashingProbab,
 		"attesterSlashingProbab": s.srvConfig.Params.AttesterSlashingProbab,
+		"standalone":             s.srvConfig.Standalone,
 	}).Info("Starting slasher simulator")
 
 	// Override global configuration for simulation purposes.
@@ -142,10 +176,17 @@ func (s *Simulator) Start() {
 	// for slasher to pick up a genesis time.
 	time.Sleep(time.Second)
 	s.genesisTime = time.Now()
-	s.srvConfig.StateNotifier.StateFeed().Send(&feed.Event{
-		Type: statefeed.Initialized,
-		Data: &statefeed.InitializedData{StartTime: s.genesisTime},
-	})
+	if s.srvConfig.Standalone {
+		// The beacon node client notifies slasher once it gets the genesis time.
+		s.beaconNode.setGenesisTime(s.genesisTime)
+		s.beaconNode.start()
+		s.beaconClient.Start()
+	} else {
+		s.srvConfig.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.Initialized,
+			Data: &statefeed.InitializedData{StartTime: s.genesisTime},
+		})
+	}
 
 	// We simulate blocks and attestations for N epochs.
 	s.simulateBlocksAndAttestations(s.ctx)
@@ -157,6 +198,15 @@ func (s *Simulator) Start() {
 
 // Stop the simulator.
 func (s *Simulator) Stop() error {
+	if s.srvConfig.Standalone {
+		if err := s.beaconClient.Stop(); err != nil {
+			return err
+		}
+		if err := s.beaconNodeConn.Close(); err != nil {
+			return err
+		}
+		s.beaconNode.stop()
+	}
 	return s.slasher.Stop()
 }
 
@@ -183,12 +233,12 @@ func (s *Simulator) simulateBlocksAndAttestations(ctx context.Context) {
 				continue
 			}
 
-			blockHeaders, propSlashings, err := s.generateBlockHeadersForSlot(ctx, slot)
+			blks, propSlashings, err := s.generateBlocksForSlot(ctx, slot)
 			if err != nil {
-				log.WithError(err).Fatal("Could not generate block headers for slot")
+				log.WithError(err).Fatal("Could not generate blocks for slot")
 			}
 			log.WithFields(logrus.Fields{
-				"numBlocks":    len(blockHeaders),
+				"numBlocks":    len(blks),
 				"numSlashable": len(propSlashings),
 			}).Infof("Producing blocks for slot %d", slot)
 			for _, sl := range propSlashings {
@@ -198,8 +248,8 @@ func (s *Simulator) simulateBlocksAndAttestations(ctx context.Context) {
 				}
 				s.sentProposerSlashings[slashingRoot] = sl
 			}
-			for _, bb := range blockHeaders {
-				s.beaconBlocksFeed.Send(bb)
+			for _, bb := range blks {
+				s.sendBlock(bb)
 			}
 
 			atts, attSlashings, err := s.generateAttestationsForSlot(ctx, slot)
@@ -218,7 +268,7 @@ func (s *Simulator) simulateBlocksAndAttestations(ctx context.Context) {
 				s.sentAttesterSlashings[slashingRoot] = sl
 			}
 			for _, aa := range atts {
-				s.indexedAttsFeed.Send(aa)
+				s.sendAttestation(aa)
 			}
 		case <-ctx.Done():
 			return
@@ -226,6 +276,28 @@ func (s *Simulator) simulateBlocksAndAttestations(ctx context.Context) {
 	}
 }
 
+// sendBlock to slasher, through the simulated beacon node in standalone mode.
+func (s *Simulator) sendBlock(block *ethpb.SignedBeaconBlock) {
+	if s.srvConfig.Standalone {
+		s.beaconNode.blocksFeed.Send(block)
+		return
+	}
+	header, err := blockHeader(block)
+	if err != nil {
+		log.WithError(err).Fatal("Could not get block header")
+	}
+	s.beaconBlocksFeed.Send(header)
+}
+
+// sendAttestation to slasher, through the simulated beacon node in standalone mode.
+func (s *Simulator) sendAttestation(att *ethpb.IndexedAttestation) {
+	if s.srvConfig.Standalone {
+		s.beaconNode.attsFeed.Send(att)
+		return
+	}
+	s.indexedAttsFeed.Send(att)
+}
+
 func (s *Simulator) verifySlashingsWereDetected(ctx context.Context) {
 	poolProposerSlashings := s.srvConfig.SlashingsPool.PendingProposerSlashings(
 		ctx, nil, true, /* no limit */
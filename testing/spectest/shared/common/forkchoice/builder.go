@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/rpc/prysm/v1alpha1/validator"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
@@ -155,5 +156,14 @@ func (bb *Builder) Check(t testing.TB, c *Check) {
 		got := fmt.Sprintf("%#x", bb.service.ForkChoiceStore().ProposerBoost())
 		require.DeepEqual(t, want, got)
 	}
+	if c.GetProposerHead != nil {
+		want := fmt.Sprintf("%#x", common.FromHex(*c.GetProposerHead))
+		headRoot, err := bb.service.HeadRoot(ctx)
+		require.NoError(t, err)
+		secondsIntoSlot := uint64(bb.lastTick) % params.BeaconConfig().SecondsPerSlot
+		proposerHead := validator.ProposerHead(bb.service.ForkChoicer(), bytesutil.ToBytes32(headRoot), bb.service.CurrentSlot(), secondsIntoSlot)
+		got := fmt.Sprintf("%#x", proposerHead)
+		require.DeepEqual(t, want, got)
+	}
 
 }
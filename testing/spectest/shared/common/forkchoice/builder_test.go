@@ -1,9 +1,20 @@
 package forkchoice
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/prysmaticlabs/go-bitfield"
+	coreBlocks "github.com/prysmaticlabs/prysm/v3/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/transition"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
 	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 	"github.com/prysmaticlabs/prysm/v3/testing/util"
@@ -39,3 +50,72 @@ func TestPoWBlock(t *testing.T) {
 
 	require.Equal(t, 1, len(builder.execMock.powBlocks))
 }
+
+func TestBuilderCheck_GetProposerHead(t *testing.T) {
+	ctx := context.Background()
+	st, keys := util.DeterministicGenesisState(t, 64)
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis, err := blocks.NewSignedBeaconBlock(coreBlocks.NewGenesisBlock(stateRoot[:]))
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	builder := NewBuilder(t, st.Copy(), genesis)
+
+	conf := util.DefaultBlockGenConfig()
+	conf.NumAttestations = 0
+	blk, err := util.GenerateFullBlock(st, keys, conf, 1)
+	require.NoError(t, err)
+	parent, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	parentRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err = transition.ExecuteStateTransition(ctx, st, parent)
+	require.NoError(t, err)
+	blk, err = util.GenerateFullBlock(st, keys, conf, 2)
+	require.NoError(t, err)
+	head, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	headRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// The parent is timely while the head arrives 5 seconds into its slot.
+	builder.Tick(t, 12)
+	builder.ValidBlock(t, parent)
+	builder.Tick(t, 29)
+	builder.ValidBlock(t, head)
+
+	// The committees of both slots vote for the parent.
+	builder.Tick(t, 36)
+	domain, err := signing.Domain(st.Fork(), 0, params.BeaconConfig().DomainBeaconAttester, st.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	for _, slot := range []types.Slot{1, 2} {
+		committee, err := helpers.BeaconCommitteeFromState(ctx, st, slot, 0)
+		require.NoError(t, err)
+		att := &ethpb.Attestation{
+			AggregationBits: bitfield.NewBitlist(uint64(len(committee))),
+			Data: &ethpb.AttestationData{
+				Slot:            slot,
+				BeaconBlockRoot: parentRoot[:],
+				Source:          &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+				Target:          &ethpb.Checkpoint{Root: genesisRoot[:]},
+			},
+		}
+		root, err := signing.ComputeSigningRoot(att.Data, domain)
+		require.NoError(t, err)
+		sigs := make([]bls.Signature, len(committee))
+		for i, idx := range committee {
+			att.AggregationBits.SetBitAt(uint64(i), true)
+			sigs[i] = keys[idx].Sign(root[:])
+		}
+		att.Signature = bls.AggregateSignatures(sigs).Marshal()
+		builder.Attestation(t, att)
+	}
+
+	// The late and weak head is reorged out in favor of its strong parent.
+	want := fmt.Sprintf("%#x", parentRoot)
+	builder.Check(t, &Check{
+		Head:            &SlotRoot{Slot: 2, Root: fmt.Sprintf("%#x", headRoot)},
+		GetProposerHead: &want,
+	})
+}
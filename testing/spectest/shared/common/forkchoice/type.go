@@ -19,6 +19,7 @@ type Check struct {
 	JustifiedCheckPoint     *EpochRoot `json:"justified_checkpoint"`
 	BestJustifiedCheckPoint *EpochRoot `json:"best_justified_checkpoint"`
 	FinalizedCheckPoint     *EpochRoot `json:"finalized_checkpoint"`
+	GetProposerHead         *string    `json:"get_proposer_head"`
 }
 
 type SlotRoot struct {
@@ -14,7 +14,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/validator/client"
+	"github.com/prysmaticlabs/prysm/v3/validator/exits"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -72,6 +74,60 @@ func (acm *AccountsCLIManager) Exit(ctx context.Context) error {
 	return nil
 }
 
+// PresignExits signs voluntary exits of one or more accounts for a future epoch and saves them to files,
+// from which they can be broadcast later. The exits are signed without a beacon node, with the fork version
+// and the genesis validators root given to the manager. A beacon node is only used to look up the indices
+// of validators which were not given to the manager.
+func (acm *AccountsCLIManager) PresignExits(ctx context.Context) error {
+	// User decided to cancel the voluntary exit.
+	if acm.rawPubKeys == nil && acm.formattedPubKeys == nil {
+		return nil
+	}
+	if len(acm.genesisValidatorsRoot) != fieldparams.RootLength {
+		return errors.New("a genesis validators root of 32 bytes is required to sign voluntary exits")
+	}
+	if len(acm.exitForkVersion) != fieldparams.VersionLength {
+		return errors.New("a fork version of 4 bytes is required to sign voluntary exits")
+	}
+
+	var validatorClient *ethpb.BeaconNodeValidatorClient
+	signedExits := make([]*ethpb.SignedVoluntaryExit, 0, len(acm.rawPubKeys))
+	for i, key := range acm.rawPubKeys {
+		index, ok := acm.validatorIndices[bytesutil.ToBytes48(key)]
+		if !ok {
+			if validatorClient == nil {
+				var err error
+				validatorClient, _, err = acm.prepareBeaconClients(ctx)
+				if err != nil {
+					return errors.Wrap(err, "could not prepare beacon node client to look up validator indices")
+				}
+			}
+			resp, err := (*validatorClient).ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: key})
+			if err != nil {
+				return errors.Wrapf(err, "could not get validator index of account %s", acm.formattedPubKeys[i])
+			}
+			index = resp.Index
+		}
+		exit := &ethpb.VoluntaryExit{Epoch: acm.exitEpoch, ValidatorIndex: index}
+		signedExit, err := client.SignVoluntaryExitOffline(
+			ctx, acm.keymanager.Sign, key, exit, acm.exitForkVersion, acm.genesisValidatorsRoot,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "could not sign voluntary exit of account %s", acm.formattedPubKeys[i])
+		}
+		signedExits = append(signedExits, signedExit)
+	}
+	if err := exits.Save(acm.exitsDir, signedExits); err != nil {
+		return errors.Wrap(err, "could not save voluntary exits")
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":      acm.exitEpoch,
+		"exitsDir":   acm.exitsDir,
+		"publicKeys": strings.Join(acm.formattedPubKeys, ", "),
+	}).Infof("Signed %d voluntary exits, which can be broadcast with prysmctl or the validator keymanager API", len(signedExits))
+	return nil
+}
+
 // PerformVoluntaryExit uses gRPC clients to submit a voluntary exit message to a beacon node.
 func PerformVoluntaryExit(
 	ctx context.Context, cfg PerformExitCfg,
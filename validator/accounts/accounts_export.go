@@ -0,0 +1,56 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+)
+
+// Export allows users to select validator accounts from their wallet and export them
+// as EIP-2335 keystore files, each encrypted with its own password and the selected
+// key derivation function, which can be imported in other Ethereum consensus clients.
+func (acm *AccountsCLIManager) Export(ctx context.Context) error {
+	exporter, ok := acm.keymanager.(keymanager.KeystoreExporter)
+	if !ok {
+		return errors.New("keymanager does not support exporting keystores")
+	}
+	if len(acm.filteredPubKeys) == 0 {
+		return errors.New("nothing to export")
+	}
+	passwords := acm.exportPasswords
+	if len(passwords) == 1 {
+		passwords = make([]string, len(acm.filteredPubKeys))
+		for i := range passwords {
+			passwords[i] = acm.exportPasswords[0]
+		}
+	}
+	keystores, err := exporter.ExportKeystores(ctx, acm.filteredPubKeys, passwords, acm.kdf)
+	if err != nil {
+		return errors.Wrap(err, "could not export keys from keymanager")
+	}
+	if err := file.MkdirAll(acm.exportDir); err != nil {
+		return errors.Wrapf(err, "could not create directory at path: %s", acm.exportDir)
+	}
+	for _, k := range keystores {
+		keystorePath := filepath.Join(acm.exportDir, fmt.Sprintf("keystore-%s.json", k.Pubkey))
+		if file.FileExists(keystorePath) {
+			return errors.Errorf("keystore file already exists: %s", keystorePath)
+		}
+		encodedFile, err := json.MarshalIndent(k, "", "\t")
+		if err != nil {
+			return errors.Wrap(err, "could not marshal keystore to JSON file")
+		}
+		if err := file.WriteFile(keystorePath, encodedFile); err != nil {
+			return errors.Wrapf(err, "could not write keystore file %s", keystorePath)
+		}
+	}
+	log.WithField(
+		"export-dir", acm.exportDir,
+	).Infof("Successfully exported %d accounts", len(keystores))
+	return nil
+}
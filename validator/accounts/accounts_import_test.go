@@ -20,7 +20,6 @@ import (
 )
 
 func TestImportAccounts_NoPassword(t *testing.T) {
-	local.ResetCaches()
 	walletDir, passwordsDir, passwordFilePath := setupWalletAndPasswordsDir(t)
 	keysDir := filepath.Join(t.TempDir(), "keysDir")
 	require.NoError(t, os.MkdirAll(keysDir, os.ModePerm))
@@ -57,7 +56,6 @@ func TestImportAccounts_NoPassword(t *testing.T) {
 }
 
 func TestImport_SortByDerivationPath(t *testing.T) {
-	local.ResetCaches()
 	type test struct {
 		name  string
 		input []string
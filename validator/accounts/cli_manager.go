@@ -5,10 +5,14 @@ import (
 
 	"github.com/pkg/errors"
 	grpcutil "github.com/prysmaticlabs/prysm/v3/api/grpc"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/pkcs11"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
 	"google.golang.org/grpc"
 )
@@ -27,34 +31,44 @@ func NewCLIManager(opts ...Option) (*AccountsCLIManager, error) {
 // AccountsCLIManager defines a struct capable of performing various validator
 // wallet & account operations via the command line.
 type AccountsCLIManager struct {
-	wallet               *wallet.Wallet
-	keymanager           keymanager.IKeymanager
-	keymanagerKind       keymanager.Kind
-	keymanagerOpts       *remote.KeymanagerOpts
-	showDepositData      bool
-	showPrivateKeys      bool
-	listValidatorIndices bool
-	deletePublicKeys     bool
-	importPrivateKeys    bool
-	readPasswordFile     bool
-	skipMnemonicConfirm  bool
-	dialOpts             []grpc.DialOption
-	grpcHeaders          []string
-	beaconRPCProvider    string
-	walletKeyCount       int
-	privateKeyFile       string
-	passwordFilePath     string
-	keysDir              string
-	backupsDir           string
-	backupsPassword      string
-	filteredPubKeys      []bls.PublicKey
-	rawPubKeys           [][]byte
-	formattedPubKeys     []string
-	walletDir            string
-	walletPassword       string
-	mnemonic             string
-	numAccounts          int
-	mnemonic25thWord     string
+	wallet                *wallet.Wallet
+	keymanager            keymanager.IKeymanager
+	keymanagerKind        keymanager.Kind
+	keymanagerOpts        *remote.KeymanagerOpts
+	pkcs11Opts            *pkcs11.KeymanagerOpts
+	showDepositData       bool
+	showPrivateKeys       bool
+	listValidatorIndices  bool
+	deletePublicKeys      bool
+	importPrivateKeys     bool
+	readPasswordFile      bool
+	skipMnemonicConfirm   bool
+	dialOpts              []grpc.DialOption
+	grpcHeaders           []string
+	beaconRPCProvider     string
+	walletKeyCount        int
+	privateKeyFile        string
+	passwordFilePath      string
+	keysDir               string
+	backupsDir            string
+	backupsPassword       string
+	exportDir             string
+	exportPasswords       []string
+	newWalletPassword     string
+	kdf                   *keystore.KDFParams
+	filteredPubKeys       []bls.PublicKey
+	rawPubKeys            [][]byte
+	formattedPubKeys      []string
+	walletDir             string
+	walletPassword        string
+	mnemonic              string
+	numAccounts           int
+	mnemonic25thWord      string
+	exitEpoch             types.Epoch
+	exitForkVersion       []byte
+	genesisValidatorsRoot []byte
+	validatorIndices      map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex
+	exitsDir              string
 }
 
 func (acm *AccountsCLIManager) prepareBeaconClients(ctx context.Context) (*ethpb.BeaconNodeValidatorClient, *ethpb.NodeClient, error) {
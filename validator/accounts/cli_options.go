@@ -1,9 +1,13 @@
 package accounts
 
 import (
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/pkcs11"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
 	"google.golang.org/grpc"
 )
@@ -43,6 +47,14 @@ func WithKeymanagerOpts(kmo *remote.KeymanagerOpts) Option {
 	}
 }
 
+// WithPKCS11KeymanagerOpts provides a PKCS#11 keymanager configuration to the accounts cli manager.
+func WithPKCS11KeymanagerOpts(kmo *pkcs11.KeymanagerOpts) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.pkcs11Opts = kmo
+		return nil
+	}
+}
+
 // WithShowDepositData enables displaying deposit data in the accounts cli manager.
 func WithShowDepositData() Option {
 	return func(acc *AccountsCLIManager) error {
@@ -171,6 +183,38 @@ func WithBackupsPassword(backupsPassword string) Option {
 	}
 }
 
+// WithExportDir specifies the directory exported keystores are written to.
+func WithExportDir(exportDir string) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.exportDir = exportDir
+		return nil
+	}
+}
+
+// WithExportPasswords specifies the passwords of exported keystores, one per public key or a single one for all.
+func WithExportPasswords(exportPasswords []string) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.exportPasswords = exportPasswords
+		return nil
+	}
+}
+
+// WithNewWalletPassword specifies the password a wallet is re-encrypted with.
+func WithNewWalletPassword(newWalletPassword string) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.newWalletPassword = newWalletPassword
+		return nil
+	}
+}
+
+// WithKDFParams specifies the key derivation function of encrypted keystores.
+func WithKDFParams(kdf *keystore.KDFParams) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.kdf = kdf
+		return nil
+	}
+}
+
 // WithFilteredPubKeys adds public key strings parsed from CLI.
 func WithFilteredPubKeys(filteredPubKeys []bls.PublicKey) Option {
 	return func(acc *AccountsCLIManager) error {
@@ -234,3 +278,44 @@ func WithNumAccounts(numAccounts int) Option {
 		return nil
 	}
 }
+
+// WithExitEpoch specifies the epoch of pre-signed voluntary exits.
+func WithExitEpoch(exitEpoch types.Epoch) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.exitEpoch = exitEpoch
+		return nil
+	}
+}
+
+// WithExitForkVersion specifies the fork version used to sign voluntary exits without a beacon node.
+func WithExitForkVersion(forkVersion []byte) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.exitForkVersion = forkVersion
+		return nil
+	}
+}
+
+// WithGenesisValidatorsRoot specifies the genesis validators root used to sign voluntary exits without a beacon node.
+func WithGenesisValidatorsRoot(genesisValidatorsRoot []byte) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.genesisValidatorsRoot = genesisValidatorsRoot
+		return nil
+	}
+}
+
+// WithValidatorIndices specifies the indices of validators, so that their voluntary exits can be signed
+// without a beacon node.
+func WithValidatorIndices(indices map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.validatorIndices = indices
+		return nil
+	}
+}
+
+// WithExitsDir specifies the directory where pre-signed voluntary exits are saved.
+func WithExitsDir(exitsDir string) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.exitsDir = exitsDir
+		return nil
+	}
+}
@@ -20,6 +20,8 @@ type Wallet interface {
 	// Methods to retrieve wallet and accounts metadata.
 	AccountsDir() string
 	Password() string
+	// SetPassword after the keystores of the wallet were re-encrypted with a new password.
+	SetPassword(password string)
 	// Read methods for important wallet and accounts-related files.
 	ReadFileAtPath(ctx context.Context, filePath string, fileName string) ([]byte, error)
 	// Write methods to persist important wallet and accounts-related files to disk.
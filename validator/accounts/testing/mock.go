@@ -53,6 +53,11 @@ func (w *Wallet) Password() string {
 	return w.WalletPassword
 }
 
+// SetPassword --
+func (w *Wallet) SetPassword(password string) {
+	w.WalletPassword = password
+}
+
 // WriteFileAtPath --
 func (w *Wallet) WriteFileAtPath(_ context.Context, pathName, fileName string, data []byte) error {
 	w.lock.Lock()
@@ -128,23 +133,27 @@ func (_ MockValidator) RolesAt(_ context.Context, _ types.Slot) (map[[48]byte][]
 	panic("implement me")
 }
 
-func (_ MockValidator) SubmitAttestation(_ context.Context, _ types.Slot, _ [48]byte) {
+func (_ MockValidator) PrepareDuty(_ context.Context, _ types.Slot, _ [48]byte, _ iface2.ValidatorRole) error {
+	panic("implement me")
+}
+
+func (_ MockValidator) SubmitAttestation(_ context.Context, _ types.Slot, _ [48]byte) error {
 	panic("implement me")
 }
 
-func (_ MockValidator) ProposeBlock(_ context.Context, _ types.Slot, _ [48]byte) {
+func (_ MockValidator) ProposeBlock(_ context.Context, _ types.Slot, _ [48]byte) error {
 	panic("implement me")
 }
 
-func (_ MockValidator) SubmitAggregateAndProof(_ context.Context, _ types.Slot, _ [48]byte) {
+func (_ MockValidator) SubmitAggregateAndProof(_ context.Context, _ types.Slot, _ [48]byte) error {
 	panic("implement me")
 }
 
-func (_ MockValidator) SubmitSyncCommitteeMessage(_ context.Context, _ types.Slot, _ [48]byte) {
+func (_ MockValidator) SubmitSyncCommitteeMessage(_ context.Context, _ types.Slot, _ [48]byte) error {
 	panic("implement me")
 }
 
-func (_ MockValidator) SubmitSignedContributionAndProof(_ context.Context, _ types.Slot, _ [48]byte) {
+func (_ MockValidator) SubmitSignedContributionAndProof(_ context.Context, _ types.Slot, _ [48]byte) error {
 	panic("implement me")
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v3/io/file"
 	"github.com/prysmaticlabs/prysm/v3/io/prompt"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/pkcs11"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
 	"github.com/urfave/cli/v2"
 )
@@ -28,6 +29,8 @@ const (
 	SelectAccountsDeletePromptText = "Select the account(s) you would like to delete"
 	// SelectAccountsBackupPromptText --
 	SelectAccountsBackupPromptText = "Select the account(s) you wish to backup"
+	// SelectAccountsExportPromptText --
+	SelectAccountsExportPromptText = "Select the account(s) you wish to export"
 	// SelectAccountsVoluntaryExitPromptText --
 	SelectAccountsVoluntaryExitPromptText = "Select the account(s) on which you wish to perform a voluntary exit"
 )
@@ -141,6 +144,52 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+// InputPKCS11KeymanagerConfig via the cli.
+func InputPKCS11KeymanagerConfig(cliCtx *cli.Context) (*pkcs11.KeymanagerOpts, error) {
+	library := cliCtx.String(flags.PKCS11LibraryFlag.Name)
+	label := cliCtx.String(flags.PKCS11TokenLabelFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if library == "" {
+		library, err = prompt.ValidatePrompt(
+			os.Stdin,
+			"Path to the PKCS#11 module of the token (such as /usr/lib/softhsm/libsofthsm2.so)",
+			validateLibraryPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if label == "" {
+		label, err = prompt.ValidatePrompt(
+			os.Stdin,
+			"Label of the token holding validating keys",
+			prompt.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	libraryPath, err := file.ExpandPath(strings.TrimRight(library, "\r\n"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not determine absolute path for %s", library)
+	}
+	newCfg := &pkcs11.KeymanagerOpts{
+		Library:    libraryPath,
+		TokenLabel: strings.TrimRight(label, "\r\n"),
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
+func validateLibraryPath(input string) error {
+	if input == "" {
+		return errors.New("module path cannot be empty")
+	}
+	if !file.FileExists(input) {
+		return fmt.Errorf("no module found at path: %s", input)
+	}
+	return nil
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/cmd/validator/flags"
@@ -18,8 +19,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/pkcs11"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/threshold"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -57,6 +60,8 @@ var (
 		keymanager.Derived:    "HD Wallet",
 		keymanager.Remote:     "Remote Signing Wallet (Advanced)",
 		keymanager.Web3Signer: "Consensys Web3Signer (Advanced)",
+		keymanager.Threshold:  "Threshold Signing Wallet (Advanced)",
+		keymanager.PKCS11:     "PKCS#11 Token Wallet (Advanced)",
 	}
 	// ValidateExistingPass checks that an input cannot be empty.
 	ValidateExistingPass = func(input string) error {
@@ -84,6 +89,7 @@ type Wallet struct {
 	configFilePath string
 	walletPassword string
 	keymanagerKind keymanager.Kind
+	passwordLock   sync.RWMutex
 }
 
 // New creates a struct from config values.
@@ -262,9 +268,18 @@ func (w *Wallet) AccountsDir() string {
 
 // Password for the wallet.
 func (w *Wallet) Password() string {
+	w.passwordLock.RLock()
+	defer w.passwordLock.RUnlock()
 	return w.walletPassword
 }
 
+// SetPassword of the wallet, once its keystores have been re-encrypted with it.
+func (w *Wallet) SetPassword(password string) {
+	w.passwordLock.Lock()
+	defer w.passwordLock.Unlock()
+	w.walletPassword = password
+}
+
 // InitializeKeymanager reads a keymanager config from disk at the wallet path,
 // unmarshals it based on the wallet's keymanager kind, and returns its value.
 func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymanagerConfig) (keymanager.IKeymanager, error) {
@@ -313,10 +328,44 @@ func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymana
 		if !bytesutil.IsValidRoot(config.GenesisValidatorsRoot) {
 			return nil, errors.New("web3signer requires a genesis validators root value")
 		}
-		km, err = remoteweb3signer.NewKeymanager(ctx, config)
+		web3signerConfig := *config
+		web3signerConfig.ListenForChanges = cfg.ListenForChanges
+		km, err = remoteweb3signer.NewKeymanager(ctx, &web3signerConfig)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize web3signer keymanager")
 		}
+	case keymanager.Threshold:
+		configFile, err := w.ReadKeymanagerConfigFromDisk(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read keymanager config")
+		}
+		opts, err := threshold.UnmarshalOptionsFile(configFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal keymanager config file")
+		}
+		km, err = threshold.NewKeymanager(ctx, &threshold.SetupConfig{
+			Opts:     opts,
+			Password: w.Password(),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize threshold keymanager")
+		}
+	case keymanager.PKCS11:
+		configFile, err := w.ReadKeymanagerConfigFromDisk(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read keymanager config")
+		}
+		opts, err := pkcs11.UnmarshalOptionsFile(configFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal keymanager config file")
+		}
+		km, err = pkcs11.NewKeymanager(ctx, &pkcs11.SetupConfig{
+			Opts: opts,
+			Pin:  w.Password(),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize pkcs11 keymanager")
+		}
 	default:
 		return nil, fmt.Errorf("keymanager kind not supported: %s", w.keymanagerKind)
 	}
@@ -336,7 +385,8 @@ func (w *Wallet) WriteFileAtPath(_ context.Context, filePath, fileName string, d
 		}
 	}
 	fullPath := filepath.Join(accountPath, fileName)
-	if err := file.WriteFile(fullPath, data); err != nil {
+	// Files such as the accounts keystore are rewritten in place, and must never be left half written.
+	if err := file.WriteFileAtomic(fullPath, data); err != nil {
 		return errors.Wrapf(err, "could not write %s", filePath)
 	}
 	log.WithFields(logrus.Fields{
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/pkcs11"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
 )
 
@@ -70,8 +71,17 @@ func (acm *AccountsCLIManager) WalletCreate(ctx context.Context) (*wallet.Wallet
 		log.WithField("--wallet-dir", acm.walletDir).Info(
 			"Successfully created wallet with remote keymanager configuration",
 		)
+	case keymanager.PKCS11:
+		if err = createPKCS11KeymanagerWallet(ctx, w, acm.pkcs11Opts); err != nil {
+			return nil, errors.Wrap(err, "could not initialize wallet")
+		}
+		log.WithField("--wallet-dir", acm.walletDir).Info(
+			"Successfully created wallet with pkcs11 keymanager configuration",
+		)
 	case keymanager.Web3Signer:
 		return nil, errors.New("web3signer keymanager does not require persistent wallets.")
+	case keymanager.Threshold:
+		return nil, errors.New("threshold wallets are created by splitting a keystore with prysmctl threshold split")
 	default:
 		return nil, errors.Wrapf(err, errKeymanagerNotSupported, w.KeymanagerKind())
 	}
@@ -131,3 +141,20 @@ func createRemoteKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, op
 	}
 	return nil
 }
+
+func createPKCS11KeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, opts *pkcs11.KeymanagerOpts) error {
+	if opts == nil {
+		return errors.New("no pkcs11 keymanager configuration provided")
+	}
+	keymanagerConfig, err := pkcs11.MarshalOptionsFile(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal config file")
+	}
+	if err := wallet.SaveWallet(); err != nil {
+		return errors.Wrap(err, "could not save wallet to disk")
+	}
+	if err := wallet.WriteKeymanagerConfigToDisk(ctx, keymanagerConfig); err != nil {
+		return errors.Wrap(err, "could not write keymanager config to disk")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package accounts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+)
+
+// WalletReencrypt re-encrypts the keystores of a wallet with a new password and
+// key derivation function. The password files are rewritten once the re-encrypted
+// keystores are written, and before they replace the current ones.
+func (acm *AccountsCLIManager) WalletReencrypt(ctx context.Context) error {
+	reencrypter, ok := acm.keymanager.(keymanager.Reencrypter)
+	if !ok {
+		return errors.Errorf("keymanager type %s does not support re-encryption", acm.wallet.KeymanagerKind())
+	}
+	// The validator client reads the wallet password from the default password file of the
+	// wallet directory if there is one, or from the file given with --wallet-password-file,
+	// so they must hold the new password. They are checked before re-encrypting the wallet,
+	// which would otherwise no longer be unlocked by them.
+	var passwordFiles []string
+	defaultPasswordFile := filepath.Join(filepath.Dir(acm.wallet.AccountsDir()), wallet.DefaultWalletPasswordFile)
+	if file.FileExists(defaultPasswordFile) {
+		passwordFiles = append(passwordFiles, defaultPasswordFile)
+	}
+	if acm.passwordFilePath != "" {
+		passwordFile, err := file.ExpandPath(acm.passwordFilePath)
+		if err != nil {
+			return err
+		}
+		if passwordFile != defaultPasswordFile {
+			passwordFiles = append(passwordFiles, passwordFile)
+		}
+	}
+	for _, passwordFile := range passwordFiles {
+		if err := checkPasswordFileWritable(passwordFile); err != nil {
+			return errors.Wrapf(err, "wallet password file %s cannot be updated with the new password", passwordFile)
+		}
+	}
+	savePassword := func() error {
+		for _, passwordFile := range passwordFiles {
+			if err := file.WriteFileAtomic(passwordFile, []byte(acm.newWalletPassword)); err != nil {
+				return errors.Wrapf(err, "could not update wallet password file %s", passwordFile)
+			}
+		}
+		return nil
+	}
+	if err := reencrypter.Reencrypt(ctx, acm.newWalletPassword, acm.kdf, savePassword); err != nil {
+		return errors.Wrap(err, "could not re-encrypt wallet")
+	}
+	log.WithField("kdf", acm.kdf.String()).Info("Successfully re-encrypted wallet")
+	return nil
+}
+
+// checkPasswordFileWritable checks a password file can be atomically rewritten, which requires
+// the file to have 0600 permissions and its directory to be writable.
+func checkPasswordFileWritable(passwordFile string) error {
+	info, err := os.Stat(passwordFile)
+	if err != nil {
+		return err
+	}
+	if info.Mode() != params.BeaconIoConfig().ReadWritePermissions {
+		return errors.New("file does not have 0600 permissions")
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(passwordFile), filepath.Base(passwordFile)+".tmp*")
+	if err != nil {
+		return errors.Wrap(err, "directory is not writable")
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Remove(tmp.Name())
+}
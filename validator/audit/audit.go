@@ -0,0 +1,150 @@
+// Package audit keeps an append-only audit log of the signing operations of the validator client. Each entry
+// records a sign request along with the decision of slashing protection, and is chained to the previous entry
+// by its hash so that any change to the log can be detected. The last entry is also kept in the validator
+// database, so that the removal of the last entries or the replacement of the whole log can be detected too.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "audit")
+
+// Decision of slashing protection on a signed object.
+type Decision string
+
+const (
+	// NotProtected is the decision for the objects slashing protection does not apply to, such as randao reveals.
+	NotProtected Decision = "not_protected"
+	// Signed is the decision for the blocks and attestations signed by the keymanager. The decision of slashing
+	// protection on them is recorded in a later entry.
+	Signed Decision = "signed"
+	// Allowed is the decision for the blocks and attestations which passed slashing protection.
+	Allowed Decision = "allowed"
+	// Rejected is the decision for the blocks and attestations which were rejected by slashing protection, and
+	// were therefore not broadcast.
+	Rejected Decision = "rejected"
+	// SignFailed is the decision for the objects the keymanager could not sign.
+	SignFailed Decision = "sign_failed"
+)
+
+// TruncatedObjectType is the object type of the entries recording the removal of an incomplete entry from the
+// end of the log, such as one left by a validator client which stopped while writing it.
+const TruncatedObjectType = "truncated_entry"
+
+// genesisHash is the previous hash of the first entry of the log.
+var genesisHash = hexutil.Encode(make([]byte, sha256.Size))
+
+// Entry of the audit log, written as a line of JSON.
+type Entry struct {
+	Sequence    uint64      `json:"sequence"`
+	Time        string      `json:"time"`
+	ObjectType  string      `json:"object_type"`
+	PublicKey   string      `json:"public_key"`
+	SigningRoot string      `json:"signing_root"`
+	Slot        types.Slot  `json:"slot"`
+	Epoch       types.Epoch `json:"epoch"`
+	Decision    Decision    `json:"decision"`
+	// Error is the reason of a rejection or of a signing failure.
+	Error    string `json:"error,omitempty"`
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
+// NewEntry returns the entry of a sign request. err is the reason of a rejection or of a signing failure, if any.
+func NewEntry(req *validatorpb.SignRequest, decision Decision, err error) *Entry {
+	e := &Entry{
+		Time:        prysmTime.Now().UTC().Format(time.RFC3339Nano),
+		ObjectType:  ObjectType(req),
+		PublicKey:   hexutil.Encode(req.PublicKey),
+		SigningRoot: hexutil.Encode(req.SigningRoot),
+		Slot:        req.SigningSlot,
+		Epoch:       slots.ToEpoch(req.SigningSlot),
+		Decision:    decision,
+	}
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_AttestationData:
+		if o.AttestationData != nil && o.AttestationData.Target != nil {
+			e.Epoch = o.AttestationData.Target.Epoch
+		}
+	case *validatorpb.SignRequest_Epoch:
+		e.Epoch = o.Epoch
+	case *validatorpb.SignRequest_Exit:
+		if o.Exit != nil {
+			e.Epoch = o.Exit.Epoch
+		}
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	return e
+}
+
+// ObjectType returns the type of the object of a sign request, as named in the SignRequest protobuf.
+func ObjectType(req *validatorpb.SignRequest) string {
+	switch req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		return "block"
+	case *validatorpb.SignRequest_AttestationData:
+		return "attestation_data"
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		return "aggregate_attestation_and_proof"
+	case *validatorpb.SignRequest_Exit:
+		return "exit"
+	case *validatorpb.SignRequest_Slot:
+		return "slot"
+	case *validatorpb.SignRequest_Epoch:
+		return "epoch"
+	case *validatorpb.SignRequest_BlockAltair:
+		return "block_altair"
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		return "sync_aggregator_selection_data"
+	case *validatorpb.SignRequest_ContributionAndProof:
+		return "contribution_and_proof"
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		return "sync_message_block_root"
+	case *validatorpb.SignRequest_BlockBellatrix:
+		return "block_bellatrix"
+	case *validatorpb.SignRequest_BlindedBlockBellatrix:
+		return "blinded_block_bellatrix"
+	case *validatorpb.SignRequest_Registration:
+		return "registration"
+	default:
+		return "unknown"
+	}
+}
+
+// Protected returns true if slashing protection applies to the object of a sign request.
+func Protected(req *validatorpb.SignRequest) bool {
+	switch req.Object.(type) {
+	case *validatorpb.SignRequest_Block,
+		*validatorpb.SignRequest_BlockAltair,
+		*validatorpb.SignRequest_BlockBellatrix,
+		*validatorpb.SignRequest_BlindedBlockBellatrix,
+		*validatorpb.SignRequest_AttestationData:
+		return true
+	default:
+		return false
+	}
+}
+
+// computeHash of the entry, which covers every field of the entry but the hash itself.
+func (e *Entry) computeHash() (string, error) {
+	unhashed := *e
+	unhashed.Hash = ""
+	enc, err := json.Marshal(&unhashed)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(enc)
+	return hexutil.Encode(h[:]), nil
+}
@@ -0,0 +1,46 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+)
+
+// Keymanager records every signature of a keymanager in an audit log. A signature which cannot be recorded
+// is not returned.
+type Keymanager struct {
+	keymanager.IKeymanager
+	log *Log
+}
+
+// NewKeymanager records the signatures of a keymanager in an audit log. The keymanager is returned as is if
+// the log is nil.
+func NewKeymanager(km keymanager.IKeymanager, l *Log) keymanager.IKeymanager {
+	if l == nil {
+		return km
+	}
+	return &Keymanager{IKeymanager: km, log: l}
+}
+
+// Sign signs a request with the keymanager and records it in the audit log. Blocks and attestations are
+// recorded as signed, and recorded again once checked by slashing protection along with its decision.
+func (k *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	sig, err := k.IKeymanager.Sign(ctx, req)
+	decision := Signed
+	switch {
+	case err != nil:
+		decision = SignFailed
+	case !Protected(req):
+		decision = NotProtected
+	}
+	if auditErr := k.log.Append(ctx, NewEntry(req, decision, err)); auditErr != nil {
+		log.WithError(auditErr).Error("Could not write to signing audit log")
+		if err == nil {
+			return nil, errors.Wrap(auditErr, "could not record signature in signing audit log")
+		}
+	}
+	return sig, err
+}
@@ -0,0 +1,76 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
+)
+
+func TestKeymanager_Sign(t *testing.T) {
+	ctx := context.Background()
+	km, err := local.NewInteropKeymanager(ctx, 0, 1)
+	require.NoError(t, err)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, km, NewKeymanager(km, nil))
+
+	dir := filepath.Join(t.TempDir(), "audit")
+	l, err := Open(ctx, dir, 0, nil)
+	require.NoError(t, err)
+	audited := NewKeymanager(km, l)
+
+	requests := []*validatorpb.SignRequest{
+		{
+			PublicKey:   pubKeys[0][:],
+			SigningRoot: make([]byte, 32),
+			Object:      &validatorpb.SignRequest_Slot{Slot: 1},
+			SigningSlot: 1,
+		},
+		{
+			PublicKey:   pubKeys[0][:],
+			SigningRoot: make([]byte, 32),
+			Object:      &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{Slot: 2}},
+			SigningSlot: 2,
+		},
+		{
+			PublicKey:   make([]byte, 48),
+			SigningRoot: make([]byte, 32),
+			Object:      &validatorpb.SignRequest_Slot{Slot: 3},
+			SigningSlot: 3,
+		},
+	}
+	for i, req := range requests {
+		sig, err := audited.Sign(ctx, req)
+		if i == 2 {
+			require.ErrorContains(t, "no signing key found", err)
+			continue
+		}
+		require.NoError(t, err)
+		require.NotNil(t, sig)
+	}
+
+	// A signature which cannot be recorded is not returned.
+	require.NoError(t, l.Close())
+	sig, err := audited.Sign(ctx, requests[0])
+	require.ErrorContains(t, "could not record signature in signing audit log", err)
+	assert.Equal(t, nil, sig)
+
+	var exported bytes.Buffer
+	require.NoError(t, Export(dir, &exported))
+	lines := strings.Split(strings.TrimSpace(exported.String()), "\n")
+	require.Equal(t, 3, len(lines))
+	for i, decision := range []Decision{NotProtected, Signed, SignFailed} {
+		e := &Entry{}
+		require.NoError(t, json.Unmarshal([]byte(lines[i]), e))
+		assert.Equal(t, decision, e.Decision)
+	}
+}
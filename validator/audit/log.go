@@ -0,0 +1,369 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+)
+
+const (
+	logFilePrefix = "signing-audit-"
+	logFileSuffix = ".jsonl"
+)
+
+// Log is an audit log written to a directory. A new file is started once the current file exceeds the
+// maximum file size, and the files are never rewritten nor removed.
+type Log struct {
+	dir         string
+	maxFileSize int64
+	heads       HeadStore
+	lock        sync.Mutex
+	f           *os.File
+	fileIndex   uint64
+	fileSize    int64
+	sequence    uint64
+	lastHash    string
+}
+
+// HeadStore keeps the last entry of the log outside of the log, such as in the validator database, so that
+// the removal of the last entries of the log, or the replacement of the whole log, can be detected.
+type HeadStore interface {
+	SigningAuditLogHead(ctx context.Context) (*kv.SigningAuditLogHead, error)
+	SaveSigningAuditLogHead(ctx context.Context, head *kv.SigningAuditLogHead) error
+}
+
+// Open the audit log of a directory, creating the directory if needed. New entries are chained to the last
+// entry of the log. An incomplete entry at the end of the log, left by a validator client which stopped
+// while writing it, is removed and the removal is recorded in the log. If heads is not nil, the last entry
+// of the log is checked against the head it stores, and each new entry becomes the head.
+func Open(ctx context.Context, dir string, maxFileSize int64, heads HeadStore) (*Log, error) {
+	dir, err := file.ExpandPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.MkdirAll(dir); err != nil {
+		return nil, errors.Wrapf(err, "could not create audit log directory %s", dir)
+	}
+	files, err := logFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	l := &Log{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		heads:       heads,
+		fileIndex:   1,
+		lastHash:    genesisHash,
+	}
+	if len(files) > 0 {
+		l.fileIndex = files[len(files)-1].index
+	}
+	truncation, err := truncateIncompleteEntry(files)
+	if err != nil {
+		return nil, err
+	}
+	// The last files may be empty if the validator client stopped right after starting a new file.
+	for i := len(files) - 1; i >= 0; i-- {
+		last, err := lastEntry(files[i].path)
+		if err != nil {
+			return nil, err
+		}
+		if last != nil {
+			l.sequence, l.lastHash = last.Sequence, last.Hash
+			break
+		}
+	}
+	if heads != nil {
+		head, err := heads.SigningAuditLogHead(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get head of audit log")
+		}
+		if err := checkHead(files, l.sequence, l.lastHash, head); err != nil {
+			return nil, err
+		}
+	}
+	if err := l.openFile(); err != nil {
+		return nil, err
+	}
+	if truncation != nil {
+		log.WithField("file", truncation.file).Warn("Removed an incomplete entry from the end of the audit log")
+		if err := l.Append(ctx, truncation.entry()); err != nil {
+			return nil, errors.Wrap(err, "could not record removal of incomplete audit log entry")
+		}
+	}
+	return l, nil
+}
+
+// Append an entry to the log, setting its sequence number and hashes.
+func (l *Log) Append(ctx context.Context, e *Entry) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.f == nil {
+		return errors.New("audit log is closed")
+	}
+	if l.maxFileSize > 0 && l.fileSize >= l.maxFileSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+	e.Sequence = l.sequence + 1
+	e.PrevHash = l.lastHash
+	hash, err := e.computeHash()
+	if err != nil {
+		return errors.Wrap(err, "could not hash audit log entry")
+	}
+	e.Hash = hash
+	enc, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "could not encode audit log entry")
+	}
+	if _, err := l.f.Write(append(enc, '\n')); err != nil {
+		l.removeIncompleteEntry()
+		return errors.Wrap(err, "could not write audit log entry")
+	}
+	// The entry must be on disk before the head, or a crash could leave a head the log never reaches.
+	if err := l.f.Sync(); err != nil {
+		l.removeIncompleteEntry()
+		return errors.Wrap(err, "could not sync audit log entry")
+	}
+	l.fileSize += int64(len(enc) + 1)
+	l.sequence, l.lastHash = e.Sequence, e.Hash
+	if l.heads != nil {
+		if err := l.heads.SaveSigningAuditLogHead(ctx, &kv.SigningAuditLogHead{Sequence: e.Sequence, Hash: e.Hash}); err != nil {
+			return errors.Wrap(err, "could not save head of audit log")
+		}
+	}
+	return nil
+}
+
+// removeIncompleteEntry removes what was written of an entry which could not be appended,
+// so that the next entry starts on a line of its own. It must be called with the lock held.
+func (l *Log) removeIncompleteEntry() {
+	if err := l.f.Truncate(l.fileSize); err != nil {
+		log.WithError(err).Error("Could not remove incomplete audit log entry")
+	}
+}
+
+// Close the log.
+func (l *Log) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.f == nil {
+		return nil
+	}
+	err := l.f.Close()
+	l.f = nil
+	return err
+}
+
+func (l *Log) rotate() error {
+	if err := l.f.Close(); err != nil {
+		return errors.Wrap(err, "could not close audit log file")
+	}
+	l.f = nil
+	l.fileIndex++
+	return l.openFile()
+}
+
+func (l *Log) openFile() error {
+	path := filepath.Join(l.dir, logFileName(l.fileIndex))
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return errors.Wrapf(err, "could not open audit log file %s", path)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close audit log file")
+		}
+		return errors.Wrapf(err, "could not stat audit log file %s", path)
+	}
+	l.f, l.fileSize = f, info.Size()
+	return nil
+}
+
+type logFile struct {
+	index uint64
+	path  string
+}
+
+func logFileName(index uint64) string {
+	return fmt.Sprintf("%s%06d%s", logFilePrefix, index, logFileSuffix)
+}
+
+// logFiles returns the files of the audit log of a directory, in order.
+func logFiles(dir string) ([]*logFile, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read audit log directory %s", dir)
+	}
+	var files []*logFile
+	for _, de := range dirEntries {
+		name := de.Name()
+		if de.IsDir() || !strings.HasPrefix(name, logFilePrefix) || !strings.HasSuffix(name, logFileSuffix) {
+			continue
+		}
+		index, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, logFilePrefix), logFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		files = append(files, &logFile{index: index, path: filepath.Join(dir, name)})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].index < files[j].index
+	})
+	return files, nil
+}
+
+// truncation of an incomplete entry at the end of a log file.
+type truncation struct {
+	file    string
+	removed []byte
+}
+
+// entry returns the entry recording the truncation in the log.
+func (t *truncation) entry() *Entry {
+	return &Entry{
+		Time:       prysmTime.Now().UTC().Format(time.RFC3339Nano),
+		ObjectType: TruncatedObjectType,
+		Error:      fmt.Sprintf("removed incomplete entry from the end of %s: %q", t.file, t.removed),
+	}
+}
+
+// truncateIncompleteEntry removes the incomplete entry at the end of the last log file with content, if any.
+// Entries are written with a single write ending with a newline, so only the last entry can be incomplete.
+func truncateIncompleteEntry(files []*logFile) (*truncation, error) {
+	for i := len(files) - 1; i >= 0; i-- {
+		path := files[i].path
+		enc, err := os.ReadFile(path) // #nosec G304
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read audit log file %s", path)
+		}
+		if len(enc) == 0 {
+			continue
+		}
+		if enc[len(enc)-1] == '\n' {
+			return nil, nil
+		}
+		size := bytes.LastIndexByte(enc, '\n') + 1
+		if err := os.Truncate(path, int64(size)); err != nil {
+			return nil, errors.Wrapf(err, "could not remove incomplete entry from audit log file %s", path)
+		}
+		return &truncation{file: filepath.Base(path), removed: enc[size:]}, nil
+	}
+	return nil, nil
+}
+
+// checkHead checks that the log ends with the head stored outside of it, or with entries following the head.
+// The log may have one more entry than the head if the validator client stopped before saving the head.
+func checkHead(files []*logFile, sequence uint64, lastHash string, head *kv.SigningAuditLogHead) error {
+	if head == nil {
+		return nil
+	}
+	if head.Sequence > sequence {
+		return fmt.Errorf(
+			"audit log ends with entry %d while the validator database records entry %d: entries were removed",
+			sequence, head.Sequence,
+		)
+	}
+	hash := lastHash
+	if head.Sequence < sequence {
+		var err error
+		hash, err = entryHash(files, head.Sequence)
+		if err != nil {
+			return err
+		}
+	}
+	if hash != head.Hash {
+		return fmt.Errorf(
+			"entry %d of the audit log does not match the one recorded in the validator database: the log was altered",
+			head.Sequence,
+		)
+	}
+	return nil
+}
+
+// entryHash returns the hash of the entry of the log with a sequence number, looking for it from the end.
+func entryHash(files []*logFile, sequence uint64) (string, error) {
+	for i := len(files) - 1; i >= 0; i-- {
+		var hash string
+		var first uint64
+		if err := readEntries(files[i].path, func(line int, _ []byte, e *Entry) error {
+			if line == 1 {
+				first = e.Sequence
+			}
+			if e.Sequence == sequence {
+				hash = e.Hash
+			}
+			return nil
+		}); err != nil {
+			return "", err
+		}
+		if hash != "" {
+			return hash, nil
+		}
+		if first != 0 && first < sequence {
+			break
+		}
+	}
+	return "", fmt.Errorf("audit log has no entry %d", sequence)
+}
+
+// lastEntry returns the last entry of a log file, or nil if the file is empty.
+func lastEntry(path string) (*Entry, error) {
+	var last *Entry
+	err := readEntries(path, func(_ int, _ []byte, e *Entry) error {
+		last = e
+		return nil
+	})
+	return last, err
+}
+
+// readEntries calls fn with the line number, the raw line and the decoded entry of each line of a log file.
+func readEntries(path string, fn func(line int, raw []byte, e *Entry) error) error {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return errors.Wrapf(err, "could not open audit log file %s", path)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close audit log file")
+		}
+	}()
+	r := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		raw, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			if len(raw) == 0 {
+				return nil
+			}
+			return fmt.Errorf("%s:%d: incomplete audit log entry", filepath.Base(path), line)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not read audit log file %s", path)
+		}
+		e := &Entry{}
+		if err := json.Unmarshal(raw, e); err != nil {
+			return errors.Wrapf(err, "%s:%d: could not decode audit log entry", filepath.Base(path), line)
+		}
+		if err := fn(line, raw, e); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+)
+
+func appendEntries(t *testing.T, l *Log, slots ...types.Slot) {
+	for _, slot := range slots {
+		req := &validatorpb.SignRequest{
+			PublicKey:   make([]byte, 48),
+			SigningRoot: make([]byte, 32),
+			Object:      &validatorpb.SignRequest_Slot{Slot: slot},
+			SigningSlot: slot,
+		}
+		require.NoError(t, l.Append(context.Background(), NewEntry(req, NotProtected, nil)))
+	}
+}
+
+func TestLog_AppendAndVerify(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "audit")
+	l, err := Open(context.Background(), dir, 1024, nil)
+	require.NoError(t, err)
+	appendEntries(t, l, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	require.NoError(t, l.Close())
+	require.ErrorContains(t, "audit log is closed", l.Append(context.Background(), &Entry{}))
+
+	// The log is rotated once a file exceeds the maximum size.
+	files, err := logFiles(dir)
+	require.NoError(t, err)
+	assert.Equal(t, true, len(files) > 1)
+
+	// Entries appended once the log is opened again are chained to the previous entries.
+	l, err = Open(context.Background(), dir, 1024, nil)
+	require.NoError(t, err)
+	appendEntries(t, l, 11, 12)
+	require.NoError(t, l.Close())
+
+	count, err := Verify(dir, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), count)
+
+	var exported bytes.Buffer
+	require.NoError(t, Export(dir, &exported))
+	lines := strings.Split(strings.TrimSpace(exported.String()), "\n")
+	require.Equal(t, 12, len(lines))
+	for i, line := range lines {
+		e := &Entry{}
+		require.NoError(t, json.Unmarshal([]byte(line), e))
+		assert.Equal(t, uint64(i+1), e.Sequence)
+		assert.Equal(t, types.Slot(i+1), e.Slot)
+	}
+}
+
+func TestVerify_Tampered(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(lines []string) []string
+		err    string
+	}{
+		{
+			name: "changed entry",
+			tamper: func(lines []string) []string {
+				lines[1] = strings.Replace(lines[1], `"slot":2`, `"slot":3`, 1)
+				return lines
+			},
+			err: "hash of entry 2 does not match its content",
+		},
+		{
+			name: "removed entry",
+			tamper: func(lines []string) []string {
+				return append(lines[:1], lines[2:]...)
+			},
+			err: "entry has sequence number 3, expected 2",
+		},
+		{
+			name: "rewritten chain",
+			tamper: func(lines []string) []string {
+				return lines[1:]
+			},
+			err: "entry has sequence number 2, expected 1",
+		},
+		{
+			name: "truncated entry",
+			tamper: func(lines []string) []string {
+				lines[2] = lines[2][:10]
+				return lines
+			},
+			err: "could not decode audit log entry",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "audit")
+			l, err := Open(context.Background(), dir, 0, nil)
+			require.NoError(t, err)
+			appendEntries(t, l, 1, 2, 3)
+			require.NoError(t, l.Close())
+
+			path := filepath.Join(dir, logFileName(1))
+			enc, err := os.ReadFile(path)
+			require.NoError(t, err)
+			lines := tt.tamper(strings.Split(strings.TrimSpace(string(enc)), "\n"))
+			require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0600))
+
+			_, err = Verify(dir, nil)
+			require.ErrorContains(t, tt.err, err)
+		})
+	}
+}
+
+func TestVerify_NoLog(t *testing.T) {
+	_, err := Verify(t.TempDir(), nil)
+	require.ErrorContains(t, "no audit log in directory", err)
+}
+
+func TestNewEntry(t *testing.T) {
+	pubKey := bytes.Repeat([]byte{1}, 48)
+	root := bytes.Repeat([]byte{2}, 32)
+
+	e := NewEntry(&validatorpb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: root,
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 4},
+		SigningSlot: 100,
+	}, NotProtected, nil)
+	assert.Equal(t, "epoch", e.ObjectType)
+	assert.Equal(t, "0x"+strings.Repeat("01", 48), e.PublicKey)
+	assert.Equal(t, "0x"+strings.Repeat("02", 32), e.SigningRoot)
+	assert.Equal(t, types.Slot(100), e.Slot)
+	assert.Equal(t, types.Epoch(4), e.Epoch)
+	assert.Equal(t, NotProtected, e.Decision)
+	assert.Equal(t, "", e.Error)
+
+	req := &validatorpb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: root,
+		Object:      &validatorpb.SignRequest_SyncMessageBlockRoot{SyncMessageBlockRoot: root},
+		SigningSlot: 100,
+	}
+	e = NewEntry(req, SignFailed, errors.New("remote signer unavailable"))
+	assert.Equal(t, "sync_message_block_root", e.ObjectType)
+	assert.Equal(t, types.Epoch(3), e.Epoch)
+	assert.Equal(t, SignFailed, e.Decision)
+	assert.Equal(t, "remote signer unavailable", e.Error)
+	assert.Equal(t, false, Protected(req))
+	assert.Equal(t, true, Protected(&validatorpb.SignRequest{Object: &validatorpb.SignRequest_BlockBellatrix{}}))
+}
+
+type memoryHeads struct {
+	head *kv.SigningAuditLogHead
+}
+
+func (m *memoryHeads) SigningAuditLogHead(_ context.Context) (*kv.SigningAuditLogHead, error) {
+	return m.head, nil
+}
+
+func (m *memoryHeads) SaveSigningAuditLogHead(_ context.Context, head *kv.SigningAuditLogHead) error {
+	m.head = head
+	return nil
+}
+
+func TestOpen_IncompleteEntry(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "audit")
+	l, err := Open(context.Background(), dir, 0, nil)
+	require.NoError(t, err)
+	appendEntries(t, l, 1, 2, 3)
+	require.NoError(t, l.Close())
+
+	// A validator client stopped while writing the fourth entry.
+	path := filepath.Join(dir, logFileName(1))
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"sequence":4,"ti`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	l, err = Open(context.Background(), dir, 0, nil)
+	require.NoError(t, err)
+	appendEntries(t, l, 4)
+	require.NoError(t, l.Close())
+
+	count, err := Verify(dir, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), count)
+
+	var exported bytes.Buffer
+	require.NoError(t, Export(dir, &exported))
+	lines := strings.Split(strings.TrimSpace(exported.String()), "\n")
+	require.Equal(t, 5, len(lines))
+	e := &Entry{}
+	require.NoError(t, json.Unmarshal([]byte(lines[3]), e))
+	assert.Equal(t, TruncatedObjectType, e.ObjectType)
+	assert.Equal(t, true, strings.Contains(e.Error, `{\"sequence\":4,\"ti`))
+	e = &Entry{}
+	require.NoError(t, json.Unmarshal([]byte(lines[4]), e))
+	assert.Equal(t, types.Slot(4), e.Slot)
+}
+
+func TestOpen_Head(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(t *testing.T, dir string)
+		err    string
+	}{
+		{
+			name:   "unchanged log",
+			tamper: func(t *testing.T, dir string) {},
+		},
+		{
+			name: "removed last entry",
+			tamper: func(t *testing.T, dir string) {
+				path := filepath.Join(dir, logFileName(1))
+				enc, err := os.ReadFile(path)
+				require.NoError(t, err)
+				lines := strings.Split(strings.TrimSpace(string(enc)), "\n")
+				require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines[:2], "\n")+"\n"), 0600))
+			},
+			err: "entries were removed",
+		},
+		{
+			name: "replaced log",
+			tamper: func(t *testing.T, dir string) {
+				require.NoError(t, os.RemoveAll(dir))
+				l, err := Open(context.Background(), dir, 0, nil)
+				require.NoError(t, err)
+				appendEntries(t, l, 4, 5, 6)
+				require.NoError(t, l.Close())
+			},
+			err: "does not match the one recorded in the validator database",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "audit")
+			heads := &memoryHeads{}
+			l, err := Open(context.Background(), dir, 0, heads)
+			require.NoError(t, err)
+			appendEntries(t, l, 1, 2, 3)
+			require.NoError(t, l.Close())
+			require.NotNil(t, heads.head)
+			assert.Equal(t, uint64(3), heads.head.Sequence)
+
+			tt.tamper(t, dir)
+
+			_, err = Verify(dir, heads.head)
+			l, openErr := Open(context.Background(), dir, 0, heads)
+			if tt.err == "" {
+				require.NoError(t, err)
+				require.NoError(t, openErr)
+				require.NoError(t, l.Close())
+				return
+			}
+			require.ErrorContains(t, tt.err, err)
+			require.ErrorContains(t, tt.err, openErr)
+		})
+	}
+}
+
+func TestOpen_HeadBehindLog(t *testing.T) {
+	// The head may miss the last entry if the validator client stopped before saving it.
+	dir := filepath.Join(t.TempDir(), "audit")
+	heads := &memoryHeads{}
+	l, err := Open(context.Background(), dir, 1024, heads)
+	require.NoError(t, err)
+	appendEntries(t, l, 1, 2, 3, 4, 5, 6, 7)
+	head := heads.head
+	appendEntries(t, l, 8)
+	require.NoError(t, l.Close())
+	heads.head = head
+
+	count, err := Verify(dir, head)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), count)
+	l, err = Open(context.Background(), dir, 1024, heads)
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+}
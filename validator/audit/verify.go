@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+)
+
+// Verify the audit log of a directory, checking that the entries are numbered in sequence, that the hash of
+// each entry matches its content and that each entry is chained to the previous one. If head is not nil, the
+// log is also checked to contain the head recorded in the validator database, so that the removal of the last
+// entries or the replacement of the whole log is detected. It returns the number of verified entries.
+func Verify(dir string, head *kv.SigningAuditLogHead) (uint64, error) {
+	var sequence uint64
+	prevHash := genesisHash
+	err := forEachEntry(dir, func(path string, line int, _ []byte, e *Entry) error {
+		where := fmt.Sprintf("%s:%d", filepath.Base(path), line)
+		if e.Sequence != sequence+1 {
+			return fmt.Errorf("%s: entry has sequence number %d, expected %d", where, e.Sequence, sequence+1)
+		}
+		if e.PrevHash != prevHash {
+			return fmt.Errorf("%s: entry %d is not chained to the previous entry", where, e.Sequence)
+		}
+		hash, err := e.computeHash()
+		if err != nil {
+			return errors.Wrapf(err, "%s: could not hash entry %d", where, e.Sequence)
+		}
+		if e.Hash != hash {
+			return fmt.Errorf("%s: hash of entry %d does not match its content", where, e.Sequence)
+		}
+		if head != nil && e.Sequence == head.Sequence && e.Hash != head.Hash {
+			return fmt.Errorf("%s: entry %d does not match the one recorded in the validator database", where, e.Sequence)
+		}
+		sequence, prevHash = e.Sequence, e.Hash
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if head != nil && head.Sequence > sequence {
+		return 0, fmt.Errorf(
+			"audit log ends with entry %d while the validator database records entry %d: entries were removed",
+			sequence, head.Sequence,
+		)
+	}
+	return sequence, nil
+}
+
+// Export writes the entries of the audit log of a directory to w, as JSON lines, in order.
+func Export(dir string, w io.Writer) error {
+	return forEachEntry(dir, func(_ string, _ int, raw []byte, _ *Entry) error {
+		_, err := w.Write(raw)
+		return err
+	})
+}
+
+func forEachEntry(dir string, fn func(path string, line int, raw []byte, e *Entry) error) error {
+	dir, err := file.ExpandPath(dir)
+	if err != nil {
+		return err
+	}
+	files, err := logFiles(dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no audit log in directory %s", dir)
+	}
+	for _, f := range files {
+		path := f.path
+		if err := readEntries(path, func(line int, raw []byte, e *Entry) error {
+			return fn(path, line, raw, e)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
@@ -24,7 +25,7 @@ import (
 // via gRPC. Beacon node will verify the slot signature and determine if the validator is also
 // an aggregator. If yes, then beacon node will broadcast aggregated signature and
 // proof on the validator's behalf.
-func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) {
+func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) error {
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitAggregateAndProof")
 	defer span.End()
 
@@ -37,7 +38,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Wrap(err, "could not fetch validator assignment")
 	}
 
 	// Avoid sending beacon node duplicated aggregation requests.
@@ -45,7 +46,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 	v.aggregatedSlotCommitteeIDCacheLock.Lock()
 	if v.aggregatedSlotCommitteeIDCache.Contains(k) {
 		v.aggregatedSlotCommitteeIDCacheLock.Unlock()
-		return
+		return nil
 	}
 	v.aggregatedSlotCommitteeIDCache.Add(k, true)
 	v.aggregatedSlotCommitteeIDCacheLock.Unlock()
@@ -56,7 +57,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Wrap(err, "could not sign slot")
 	}
 
 	// As specified in spec, an aggregator should wait until two thirds of the way through slot
@@ -74,20 +75,19 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 		s, ok := status.FromError(err)
 		if ok && s.Code() == codes.NotFound {
 			log.WithField("slot", slot).WithError(err).Warn("No attestations to aggregate")
-		} else {
-			log.WithField("slot", slot).WithError(err).Error("Could not submit slot signature to beacon node")
-			if v.emitAccountMetrics {
-				ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
-			}
+			return errors.Wrap(err, "no attestations to aggregate")
 		}
-
-		return
+		log.WithField("slot", slot).WithError(err).Error("Could not submit slot signature to beacon node")
+		if v.emitAccountMetrics {
+			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		return errors.Wrap(err, "could not submit slot signature to beacon node")
 	}
 
 	sig, err := v.aggregateAndProofSig(ctx, pubKey, res.AggregateAndProof, slot)
 	if err != nil {
 		log.WithError(err).Error("Could not sign aggregate and proof")
-		return
+		return errors.Wrap(err, "could not sign aggregate and proof")
 	}
 	_, err = v.validatorClient.SubmitSignedAggregateSelectionProof(ctx, &ethpb.SignedAggregateSubmitRequest{
 		SignedAggregateAndProof: &ethpb.SignedAggregateAttestationAndProof{
@@ -100,7 +100,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Wrap(err, "could not submit signed aggregate and proof to beacon node")
 	}
 
 	if err := v.addIndicesToLog(duty); err != nil {
@@ -108,16 +108,20 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Wrap(err, "could not add aggregator indices to logs")
 	}
 	if v.emitAccountMetrics {
 		ValidatorAggSuccessVec.WithLabelValues(fmtKey).Inc()
 	}
-
+	return nil
 }
 
 // Signs input slot with domain selection proof. This is used to create the signature for aggregator selection.
 func (v *validator) signSlotWithSelectionProof(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) (signature []byte, err error) {
+	key := precomputedSignatureKey{domain: params.BeaconConfig().DomainSelectionProof, pubKey: pubKey, slot: slot}
+	if sig, ok := v.precomputedSignature(key); ok {
+		return sig, nil
+	}
 	domain, err := v.domainData(ctx, slots.ToEpoch(slot), params.BeaconConfig().DomainSelectionProof[:])
 	if err != nil {
 		return nil, err
@@ -129,7 +133,7 @@ func (v *validator) signSlotWithSelectionProof(ctx context.Context, pubKey [fiel
 	if err != nil {
 		return nil, err
 	}
-	sig, err = v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err = v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
@@ -139,7 +143,7 @@ func (v *validator) signSlotWithSelectionProof(ctx context.Context, pubKey [fiel
 	if err != nil {
 		return nil, err
 	}
-
+	v.savePrecomputedSignature(key, sig.Marshal())
 	return sig.Marshal(), nil
 }
 
@@ -183,7 +187,7 @@ func (v *validator) aggregateAndProofSig(ctx context.Context, pubKey [fieldparam
 	if err != nil {
 		return nil, err
 	}
-	sig, err = v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err = v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: d.SignatureDomain,
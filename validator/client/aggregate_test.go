@@ -28,7 +28,8 @@ func TestSubmitAggregateAndProof_GetDutiesRequestFailure(t *testing.T) {
 
 	pubKey := [fieldparams.BLSPubkeyLength]byte{}
 	copy(pubKey[:], validatorKey.PublicKey().Marshal())
-	validator.SubmitAggregateAndProof(context.Background(), 0, pubKey)
+	err := validator.SubmitAggregateAndProof(context.Background(), 0, pubKey)
+	require.ErrorContains(t, "could not fetch validator assignment", err)
 
 	require.LogsContain(t, hook, "Could not fetch validator assignment")
 }
@@ -3,11 +3,11 @@ package client
 import (
 	"bytes"
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v3/async"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
@@ -32,7 +32,7 @@ import (
 // It fetches the latest beacon block head along with the latest canonical beacon state
 // information in order to sign the block and include information about the validator's
 // participation in voting on the block.
-func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) {
+func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) error {
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitAttestation")
 	defer span.End()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
@@ -43,13 +43,13 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 	if err := b.WriteByte(byte(iface.RoleAttester)); err != nil {
 		log.WithError(err).Error("Could not write role byte for lock key")
 		tracing.AnnotateError(span, err)
-		return
+		return errors.Wrap(err, "could not write role byte for lock key")
 	}
 	_, err := b.Write(pubKey[:])
 	if err != nil {
 		log.WithError(err).Error("Could not write pubkey bytes for lock key")
 		tracing.AnnotateError(span, err)
-		return
+		return errors.Wrap(err, "could not write pubkey bytes for lock key")
 	}
 	lock := async.NewMultilock(b.String())
 	lock.Lock()
@@ -64,11 +64,11 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		tracing.AnnotateError(span, err)
-		return
+		return errors.Wrap(err, "could not fetch validator assignment")
 	}
 	if len(duty.Committee) == 0 {
 		log.Debug("Empty committee for validator duty, not attesting")
-		return
+		return nil
 	}
 
 	req := &ethpb.AttestationDataRequest{
@@ -82,7 +82,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		tracing.AnnotateError(span, err)
-		return
+		return errors.Wrap(err, "could not request attestation to sign at slot")
 	}
 
 	indexedAtt := &ethpb.IndexedAttestation{
@@ -97,7 +97,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		tracing.AnnotateError(span, err)
-		return
+		return errors.Wrap(err, "could not get domain and signing root from attestation")
 	}
 
 	sig, _, err := v.signAtt(ctx, pubKey, data, slot)
@@ -107,7 +107,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		tracing.AnnotateError(span, err)
-		return
+		return errors.Wrap(err, "could not sign attestation")
 	}
 
 	var indexInCommittee uint64
@@ -124,7 +124,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Errorf("validator ID %d not found in committee", duty.ValidatorIndex)
 	}
 
 	aggregationBitfield := bitfield.NewBitlist(uint64(len(duty.Committee)))
@@ -143,7 +143,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 			attestationLogFields(pubKey, indexedAtt),
 		).Debug("Attempted slashable attestation details")
 		tracing.AnnotateError(span, err)
-		return
+		return errors.Wrap(err, "failed attestation slashing protection check")
 	}
 	attResp, err := v.validatorClient.ProposeAttestation(ctx, attestation)
 	if err != nil {
@@ -152,7 +152,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		tracing.AnnotateError(span, err)
-		return
+		return errors.Wrap(err, "could not submit attestation to beacon node")
 	}
 
 	if err := v.saveAttesterIndexToData(data, duty.ValidatorIndex); err != nil {
@@ -161,7 +161,13 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		tracing.AnnotateError(span, err)
-		return
+		return nil // The attestation was submitted.
+	}
+
+	if dataRoot, err := data.HashTreeRoot(); err != nil {
+		log.WithError(err).Error("Could not hash attestation data to track its inclusion")
+	} else {
+		v.performance.attested(slot, pubKey, dataRoot, indexInCommittee)
 	}
 
 	span.AddAttributes(
@@ -178,15 +184,19 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		ValidatorAttestSuccessVec.WithLabelValues(fmtKey).Inc()
 		ValidatorAttestedSlotsGaugeVec.WithLabelValues(fmtKey).Set(float64(slot))
 	}
+	return nil
 }
 
 // Given the validator public key, this gets the validator assignment.
 func (v *validator) duty(pubKey [fieldparams.BLSPubkeyLength]byte) (*ethpb.DutiesResponse_Duty, error) {
-	if v.duties == nil {
+	v.dutiesLock.RLock()
+	duties := v.duties
+	v.dutiesLock.RUnlock()
+	if duties == nil {
 		return nil, errors.New("no duties for validators")
 	}
 
-	for _, duty := range v.duties.Duties {
+	for _, duty := range duties.Duties {
 		if bytes.Equal(pubKey[:], duty.PublicKey) {
 			return duty, nil
 		}
@@ -201,7 +211,7 @@ func (v *validator) signAtt(ctx context.Context, pubKey [fieldparams.BLSPubkeyLe
 	if err != nil {
 		return nil, [32]byte{}, err
 	}
-	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err := v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
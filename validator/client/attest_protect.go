@@ -25,9 +25,12 @@ func (v *validator) slashableAttestationCheck(
 	indexedAtt *ethpb.IndexedAttestation,
 	pubKey [fieldparams.BLSPubkeyLength]byte,
 	signingRoot [32]byte,
-) error {
+) (err error) {
 	ctx, span := trace.StartSpan(ctx, "validator.postAttSignUpdate")
 	defer span.End()
+	defer func() {
+		v.auditAttestationProtection(ctx, pubKey, signingRoot, indexedAtt.Data, err)
+	}()
 
 	// Based on EIP3076, validator should refuse to sign any attestation with source epoch less
 	// than the minimum source epoch present in that signer’s attestations.
@@ -57,7 +57,7 @@ func TestAttestToBlockHead_SubmitAttestation_EmptyCommittee(t *testing.T) {
 			Committee:      make([]types.ValidatorIndex, 0),
 			ValidatorIndex: 0,
 		}}}
-	validator.SubmitAttestation(context.Background(), 0, pubKey)
+	require.NoError(t, validator.SubmitAttestation(context.Background(), 0, pubKey))
 	require.LogsContain(t, hook, "Empty committee")
 }
 
@@ -92,7 +92,8 @@ func TestAttestToBlockHead_SubmitAttestation_RequestFailure(t *testing.T) {
 
 	pubKey := [fieldparams.BLSPubkeyLength]byte{}
 	copy(pubKey[:], validatorKey.PublicKey().Marshal())
-	validator.SubmitAttestation(context.Background(), 30, pubKey)
+	err := validator.SubmitAttestation(context.Background(), 30, pubKey)
+	require.ErrorContains(t, "could not submit attestation to beacon node", err)
 	require.LogsContain(t, hook, "Could not submit attestation to beacon node")
 }
 
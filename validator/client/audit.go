@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/validator/audit"
+)
+
+// sign signs a request with the keymanager, which records it in the signing audit log.
+func (v *validator) sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	return audit.NewKeymanager(v.keyManager, v.signingAuditLog).Sign(ctx, req)
+}
+
+// auditAttestationProtection records a signed attestation in the signing audit log, along with the decision
+// of slashing protection.
+func (v *validator) auditAttestationProtection(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, data *ethpb.AttestationData, protectionErr error,
+) {
+	if v.signingAuditLog == nil {
+		return
+	}
+	v.auditProtection(ctx, &validatorpb.SignRequest{
+		PublicKey:   pubKey[:],
+		SigningRoot: signingRoot[:],
+		Object:      &validatorpb.SignRequest_AttestationData{AttestationData: data},
+		SigningSlot: data.Slot,
+	}, protectionErr)
+}
+
+// auditProposalProtection records a signed block in the signing audit log, along with the decision of
+// slashing protection.
+func (v *validator) auditProposalProtection(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, blk interfaces.BeaconBlock, protectionErr error,
+) {
+	if v.signingAuditLog == nil {
+		return
+	}
+	sro, err := blk.AsSignRequestObject()
+	if err != nil {
+		log.WithError(err).Error("Could not record block in signing audit log")
+		return
+	}
+	v.auditProtection(ctx, &validatorpb.SignRequest{
+		PublicKey:   pubKey[:],
+		SigningRoot: signingRoot[:],
+		Object:      sro,
+		SigningSlot: blk.Slot(),
+	}, protectionErr)
+}
+
+func (v *validator) auditProtection(ctx context.Context, req *validatorpb.SignRequest, protectionErr error) {
+	decision := audit.Allowed
+	if protectionErr != nil {
+		decision = audit.Rejected
+	}
+	if err := v.signingAuditLog.Append(ctx, audit.NewEntry(req, decision, protectionErr)); err != nil {
+		log.WithError(err).Error("Could not write to signing audit log")
+	}
+}
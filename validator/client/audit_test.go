@@ -0,0 +1,95 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/audit"
+)
+
+func TestValidator_SigningAuditLog(t *testing.T) {
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "audit")
+	auditLog, err := audit.Open(ctx, dir, 0, validator.db)
+	require.NoError(t, err)
+	validator.signingAuditLog = auditLog
+
+	_, err = validator.sign(ctx, &validatorpb.SignRequest{
+		PublicKey:   pubKey[:],
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Slot{Slot: 5},
+		SigningSlot: 5,
+	})
+	require.NoError(t, err)
+	_, err = validator.sign(ctx, &validatorpb.SignRequest{
+		PublicKey:   make([]byte, fieldparams.BLSPubkeyLength),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Slot{Slot: 5},
+		SigningSlot: 5,
+	})
+	require.NotNil(t, err)
+
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1},
+		Data: &ethpb.AttestationData{
+			Slot:            5,
+			BeaconBlockRoot: bytesutil.PadTo([]byte("great block"), 32),
+			Source:          &ethpb.Checkpoint{Epoch: 4, Root: bytesutil.PadTo([]byte("good source"), 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 10, Root: bytesutil.PadTo([]byte("good target"), 32)},
+		},
+	}
+	_, err = validator.sign(ctx, &validatorpb.SignRequest{
+		PublicKey:   pubKey[:],
+		SigningRoot: bytesutil.PadTo([]byte{1}, 32),
+		Object:      &validatorpb.SignRequest_AttestationData{AttestationData: att.Data},
+		SigningSlot: 5,
+	})
+	require.NoError(t, err)
+	require.NoError(t, validator.slashableAttestationCheck(ctx, att, pubKey, [32]byte{1}))
+	// A different attestation for the same target is rejected.
+	rejection := validator.slashableAttestationCheck(ctx, att, pubKey, [32]byte{2})
+	require.ErrorContains(t, "could not sign attestation lower than or equal to lowest target epoch", rejection)
+	require.NoError(t, auditLog.Close())
+
+	// The last entry is recorded in the validator database.
+	head, err := validator.db.SigningAuditLogHead(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), head.Sequence)
+	count, err := audit.Verify(dir, head)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), count)
+	var exported bytes.Buffer
+	require.NoError(t, audit.Export(dir, &exported))
+	var entries []*audit.Entry
+	for _, line := range strings.Split(strings.TrimSpace(exported.String()), "\n") {
+		e := &audit.Entry{}
+		require.NoError(t, json.Unmarshal([]byte(line), e))
+		entries = append(entries, e)
+	}
+	assert.Equal(t, "slot", entries[0].ObjectType)
+	assert.Equal(t, audit.NotProtected, entries[0].Decision)
+	assert.Equal(t, audit.SignFailed, entries[1].Decision)
+	// Attestations are recorded once signed, and once checked by slashing protection.
+	assert.Equal(t, "attestation_data", entries[2].ObjectType)
+	assert.Equal(t, audit.Signed, entries[2].Decision)
+	assert.Equal(t, "attestation_data", entries[3].ObjectType)
+	assert.Equal(t, audit.Allowed, entries[3].Decision)
+	assert.Equal(t, "0x01"+strings.Repeat("00", 31), entries[3].SigningRoot)
+	assert.Equal(t, audit.Rejected, entries[4].Decision)
+	assert.Equal(t, rejection.Error(), entries[4].Error)
+}
@@ -0,0 +1,374 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+	"github.com/sirupsen/logrus"
+)
+
+// dutyHistoryEpochs is the number of epochs for which planned duties are kept, including the current epoch.
+const dutyHistoryEpochs = 2
+
+// Reasons for which a duty is missed.
+const (
+	missedAfterDeadline    = "started after deadline"
+	missedDeadlineExceeded = "deadline exceeded"
+	missedContextCanceled  = "context canceled"
+	missedDutyFailed       = "failed"
+)
+
+// dutyDueThirds is the number of thirds of a slot into the slot at which each duty is due: proposals at the
+// start of the slot, attestations and sync committee messages once a third of the slot has passed, and
+// aggregations once two thirds of the slot have passed. Every duty has to be done by the end of its slot.
+var dutyDueThirds = map[iface.ValidatorRole]int64{
+	iface.RoleProposer:                0,
+	iface.RoleAttester:                1,
+	iface.RoleSyncCommittee:           1,
+	iface.RoleAggregator:              2,
+	iface.RoleSyncCommitteeAggregator: 2,
+}
+
+// DutyStatus is the status of a scheduled duty.
+type DutyStatus int8
+
+const (
+	// DutyScheduled means that the duty is planned but has not been started yet.
+	DutyScheduled DutyStatus = iota
+	// DutyRunning means that the duty is being performed.
+	DutyRunning
+	// DutyCompleted means that the duty was performed before its deadline.
+	DutyCompleted
+	// DutyMissed means that the duty failed or could not be performed before its deadline.
+	DutyMissed
+)
+
+// String returns the name of a duty status.
+func (s DutyStatus) String() string {
+	switch s {
+	case DutyScheduled:
+		return "scheduled"
+	case DutyRunning:
+		return "running"
+	case DutyCompleted:
+		return "completed"
+	case DutyMissed:
+		return "missed"
+	default:
+		return fmt.Sprintf("%d", int(s))
+	}
+}
+
+// ScheduledDuty is a duty of a validator planned by the duty scheduler, along with how it went.
+type ScheduledDuty struct {
+	Slot        types.Slot
+	PubKey      [fieldparams.BLSPubkeyLength]byte
+	Role        iface.ValidatorRole
+	DueTime     time.Time
+	Deadline    time.Time
+	Status      DutyStatus
+	StartedAt   time.Time
+	CompletedAt time.Time
+	// Delay is how long after its due time the duty was completed.
+	Delay      time.Duration
+	MissReason string
+}
+
+// dutyScheduler plans the duties of the validator slot by slot: the duties of the current slot are
+// planned right before they are performed, so that they follow the latest duties and keys of the
+// validator, while the rest of the epoch is planned in the background to pre-compute the signatures
+// these duties need. Each duty of a slot is performed under its own deadline, recording how late it was
+// and why it was missed.
+type dutyScheduler struct {
+	v             iface.Validator
+	lock          sync.RWMutex
+	planned       bool
+	plannedEpoch  types.Epoch
+	planningAhead bool
+	// generation is bumped whenever the plan is invalidated, so that plans made ahead with stale keys
+	// or duties are not taken as complete.
+	generation uint64
+	duties     map[types.Slot][]*ScheduledDuty
+}
+
+func newDutyScheduler(v iface.Validator) *dutyScheduler {
+	return &dutyScheduler{
+		v:      v,
+		duties: make(map[types.Slot][]*ScheduledDuty),
+	}
+}
+
+// plan the duties of a slot from the current roles of the validator. Duties already planned at that
+// slot keep their status, while scheduled duties the validator no longer has are dropped.
+func (s *dutyScheduler) plan(ctx context.Context, slot types.Slot) error {
+	roles, err := s.v.RolesAt(ctx, slot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get validator roles at slot %d", slot)
+	}
+	duties := s.dutiesFromRoles(slot, roles)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.merge(slot, duties)
+	epoch := slots.ToEpoch(slot)
+	for sl := range s.duties {
+		if slots.ToEpoch(sl)+dutyHistoryEpochs <= epoch {
+			delete(s.duties, sl)
+		}
+	}
+	return nil
+}
+
+// merge newly planned duties into the duties of a slot. The lock must be held by the caller.
+func (s *dutyScheduler) merge(slot types.Slot, duties []*ScheduledDuty) {
+	type dutyKey struct {
+		pubKey [fieldparams.BLSPubkeyLength]byte
+		role   iface.ValidatorRole
+	}
+	existing := make(map[dutyKey]*ScheduledDuty, len(s.duties[slot]))
+	for _, d := range s.duties[slot] {
+		existing[dutyKey{d.PubKey, d.Role}] = d
+	}
+	merged := make([]*ScheduledDuty, 0, len(duties))
+	for _, d := range duties {
+		k := dutyKey{d.PubKey, d.Role}
+		if e, ok := existing[k]; ok {
+			merged = append(merged, e)
+			delete(existing, k)
+			continue
+		}
+		merged = append(merged, d)
+	}
+	// Duties which were started are kept as history even if the validator no longer has them.
+	for _, d := range existing {
+		if d.Status != DutyScheduled {
+			merged = append(merged, d)
+		}
+	}
+	sortDuties(merged)
+	s.duties[slot] = merged
+}
+
+// planAhead plans the duties of the slots after a slot until the end of its epoch, unless they are
+// already planned, and then pre-computes the signatures these duties need. A slot which cannot be
+// planned is skipped, and planned again once it comes.
+func (s *dutyScheduler) planAhead(ctx context.Context, slot types.Slot) {
+	epoch := slots.ToEpoch(slot)
+	s.lock.Lock()
+	if s.planningAhead || (s.planned && s.plannedEpoch == epoch) {
+		s.lock.Unlock()
+		return
+	}
+	s.planningAhead = true
+	generation := s.generation
+	s.lock.Unlock()
+	defer func() {
+		s.lock.Lock()
+		s.planningAhead = false
+		s.lock.Unlock()
+	}()
+
+	epochEnd, err := slots.EpochEnd(epoch)
+	if err != nil {
+		log.WithError(err).Debug("Could not plan duties ahead of time")
+		return
+	}
+	complete := true
+	for sl := slot + 1; sl <= epochEnd; sl++ {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := s.plan(ctx, sl); err != nil {
+			log.WithError(err).WithField("slot", sl).Debug("Could not plan duties ahead of time")
+			complete = false
+		}
+	}
+
+	s.lock.Lock()
+	if complete && s.generation == generation {
+		s.planned = true
+		s.plannedEpoch = epoch
+	}
+	scheduled := make(map[types.Slot][]*ScheduledDuty)
+	for sl := slot + 1; sl <= epochEnd; sl++ {
+		for _, d := range s.duties[sl] {
+			if d.Status == DutyScheduled {
+				scheduled[sl] = append(scheduled[sl], d)
+			}
+		}
+	}
+	s.lock.Unlock()
+
+	s.prepare(ctx, scheduled)
+}
+
+// invalidate the duties planned ahead of time, for instance when the keys of the validator change, so
+// that they are planned again.
+func (s *dutyScheduler) invalidate() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.planned = false
+	s.generation++
+}
+
+// dutiesFromRoles turns the roles of validators at a slot into duties, ordered by public key and role.
+func (s *dutyScheduler) dutiesFromRoles(slot types.Slot, roles map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole) []*ScheduledDuty {
+	deadline := s.v.SlotDeadline(slot)
+	slotStart := deadline.Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	oneThird := slots.DivideSlotBy(3 /* a third of the slot duration */)
+	duties := make([]*ScheduledDuty, 0, len(roles))
+	for pubKey, rs := range roles {
+		for _, role := range rs {
+			thirds, ok := dutyDueThirds[role]
+			if !ok {
+				continue
+			}
+			duties = append(duties, &ScheduledDuty{
+				Slot:     slot,
+				PubKey:   pubKey,
+				Role:     role,
+				DueTime:  slotStart.Add(time.Duration(thirds) * oneThird),
+				Deadline: deadline,
+				Status:   DutyScheduled,
+			})
+		}
+	}
+	sortDuties(duties)
+	return duties
+}
+
+// sortDuties orders duties by public key and role.
+func sortDuties(duties []*ScheduledDuty) {
+	sort.Slice(duties, func(i, j int) bool {
+		if c := bytes.Compare(duties[i].PubKey[:], duties[j].PubKey[:]); c != 0 {
+			return c < 0
+		}
+		return duties[i].Role < duties[j].Role
+	})
+}
+
+// prepare pre-computes the signatures needed by planned duties, in slot order.
+func (s *dutyScheduler) prepare(ctx context.Context, duties map[types.Slot][]*ScheduledDuty) {
+	planned := make([]types.Slot, 0, len(duties))
+	for sl := range duties {
+		planned = append(planned, sl)
+	}
+	sort.Slice(planned, func(i, j int) bool {
+		return planned[i] < planned[j]
+	})
+	for _, sl := range planned {
+		for _, d := range duties[sl] {
+			if ctx.Err() != nil {
+				return
+			}
+			if err := s.v.PrepareDuty(ctx, d.Slot, d.PubKey, d.Role); err != nil {
+				log.WithError(err).WithFields(logrus.Fields{
+					"slot":   d.Slot,
+					"pubKey": fmt.Sprintf("%#x", bytesutil.Trunc(d.PubKey[:])),
+					"role":   d.Role,
+				}).Debug("Could not prepare duty ahead of time")
+			}
+		}
+	}
+}
+
+// dutiesAt returns the duties planned at a slot.
+func (s *dutyScheduler) dutiesAt(slot types.Slot) []*ScheduledDuty {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.duties[slot]
+}
+
+// perform a duty with the deadline of the duty, recording when it was done or why it was missed.
+func (s *dutyScheduler) perform(ctx context.Context, d *ScheduledDuty, do func(ctx context.Context) error) {
+	s.lock.Lock()
+	now := prysmTime.Now()
+	if !now.Before(d.Deadline) {
+		s.miss(d, now, missedAfterDeadline, nil)
+		s.lock.Unlock()
+		return
+	}
+	d.Status = DutyRunning
+	d.StartedAt = now
+	s.lock.Unlock()
+
+	dutyCtx, cancel := context.WithDeadline(ctx, d.Deadline)
+	defer cancel()
+	err := do(dutyCtx)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now = prysmTime.Now()
+	switch {
+	case !now.Before(d.Deadline):
+		s.miss(d, now, missedDeadlineExceeded, err)
+	case ctx.Err() != nil:
+		s.miss(d, now, missedContextCanceled, err)
+	case err != nil:
+		s.miss(d, now, missedDutyFailed, err)
+	default:
+		d.Status = DutyCompleted
+		d.CompletedAt = now
+		if now.After(d.DueTime) {
+			d.Delay = now.Sub(d.DueTime)
+		}
+		ValidatorDutyDelayHistogram.WithLabelValues(d.Role.String()).Observe(d.Delay.Seconds())
+	}
+}
+
+// miss records a missed duty, along with the error the duty failed with if any. The lock must be held
+// by the caller.
+func (s *dutyScheduler) miss(d *ScheduledDuty, now time.Time, reason string, err error) {
+	d.Status = DutyMissed
+	d.MissReason = reason
+	if err != nil {
+		d.MissReason = reason + ": " + err.Error()
+	}
+	if now.After(d.DueTime) {
+		d.Delay = now.Sub(d.DueTime)
+	}
+	ValidatorDutiesMissedVec.WithLabelValues(d.Role.String(), reason).Inc()
+	entry := log.WithFields(logrus.Fields{
+		"slot":   d.Slot,
+		"pubKey": fmt.Sprintf("%#x", bytesutil.Trunc(d.PubKey[:])),
+		"role":   d.Role,
+		"delay":  d.Delay,
+		"reason": reason,
+	})
+	if err != nil {
+		entry = entry.WithError(err)
+	}
+	entry.Warn("Missed validator duty")
+}
+
+// schedule returns a copy of the planned duties, ordered by slot.
+func (s *dutyScheduler) schedule() []ScheduledDuty {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	planned := make([]types.Slot, 0, len(s.duties))
+	for sl := range s.duties {
+		planned = append(planned, sl)
+	}
+	sort.Slice(planned, func(i, j int) bool {
+		return planned[i] < planned[j]
+	})
+	var duties []ScheduledDuty
+	for _, sl := range planned {
+		for _, d := range s.duties[sl] {
+			duties = append(duties, *d)
+		}
+	}
+	return duties
+}
@@ -0,0 +1,225 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// rolesAtValidator is a fake validator whose roles cannot be fetched at some slots.
+type rolesAtValidator struct {
+	*testutil.FakeValidator
+	failAt map[types.Slot]bool
+}
+
+func (v *rolesAtValidator) RolesAt(ctx context.Context, slot types.Slot) (map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole, error) {
+	if v.failAt[slot] {
+		return nil, errors.New("no duties for validators")
+	}
+	return v.FakeValidator.RolesAt(ctx, slot)
+}
+
+func TestDutyScheduler_Plan(t *testing.T) {
+	ctx := context.Background()
+	v := &testutil.FakeValidator{Km: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
+	v.RolesAtRet = []iface.ValidatorRole{iface.RoleAttester, iface.RoleAggregator, iface.RoleUnknown}
+	s := newDutyScheduler(v)
+
+	slot := params.BeaconConfig().SlotsPerEpoch + 3
+	require.NoError(t, s.plan(ctx, slot))
+	assert.Equal(t, uint64(slot), v.RoleAtArg1)
+	assert.Equal(t, 0, len(s.dutiesAt(slot+1)))
+	duties := s.dutiesAt(slot)
+	require.Equal(t, 2, len(duties))
+	assert.Equal(t, iface.RoleAttester, duties[0].Role)
+	assert.Equal(t, iface.RoleAggregator, duties[1].Role)
+	assert.Equal(t, DutyScheduled, duties[0].Status)
+	oneThird := slots.DivideSlotBy(3)
+	assert.Equal(t, oneThird, duties[1].DueTime.Sub(duties[0].DueTime))
+
+	// Planning a slot again keeps the duties already planned, and drops the scheduled duties the
+	// validator no longer has while keeping those already started.
+	duties[0].Status = DutyCompleted
+	v.RolesAtRet = []iface.ValidatorRole{iface.RoleProposer}
+	require.NoError(t, s.plan(ctx, slot))
+	duties = s.dutiesAt(slot)
+	require.Equal(t, 2, len(duties))
+	assert.Equal(t, iface.RoleAttester, duties[0].Role)
+	assert.Equal(t, DutyCompleted, duties[0].Status)
+	assert.Equal(t, iface.RoleProposer, duties[1].Role)
+	assert.Equal(t, DutyScheduled, duties[1].Status)
+
+	// Duties of the previous epoch are kept, older duties are pruned.
+	nextEpoch := slot + params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, s.plan(ctx, nextEpoch))
+	assert.Equal(t, 2, len(s.dutiesAt(slot)))
+	require.NoError(t, s.plan(ctx, nextEpoch+params.BeaconConfig().SlotsPerEpoch))
+	assert.Equal(t, 0, len(s.dutiesAt(slot)))
+	assert.Equal(t, 1, len(s.dutiesAt(nextEpoch)))
+}
+
+func TestDutyScheduler_PlanAhead(t *testing.T) {
+	ctx := context.Background()
+	slot := params.BeaconConfig().SlotsPerEpoch + 3
+	epochEnd, err := slots.EpochEnd(slots.ToEpoch(slot))
+	require.NoError(t, err)
+	v := &rolesAtValidator{
+		FakeValidator: &testutil.FakeValidator{Km: &mockKeymanager{accountsChangedFeed: &event.Feed{}}},
+		failAt:        map[types.Slot]bool{slot + 2: true},
+	}
+	v.RolesAtRet = []iface.ValidatorRole{iface.RoleAttester}
+	s := newDutyScheduler(v)
+
+	// A slot which cannot be planned does not prevent the other slots from being planned.
+	s.planAhead(ctx, slot)
+	assert.Equal(t, 0, len(s.dutiesAt(slot)))
+	assert.Equal(t, 0, len(s.dutiesAt(slot+2)))
+	for sl := slot + 1; sl <= epochEnd; sl++ {
+		if sl != slot+2 {
+			assert.Equal(t, 1, len(s.dutiesAt(sl)), "slot %d", sl)
+		}
+	}
+	assert.Equal(t, false, s.planned)
+
+	// The epoch is planned again as long as some slot could not be planned.
+	v.failAt = nil
+	s.planAhead(ctx, slot+1)
+	assert.Equal(t, 1, len(s.dutiesAt(slot+2)))
+	assert.Equal(t, true, s.planned)
+
+	// Once planned, the epoch is not planned again until the plan is invalidated.
+	v.RoleAtCalled = false
+	s.planAhead(ctx, slot+2)
+	assert.Equal(t, false, v.RoleAtCalled)
+	s.invalidate()
+	v.RolesAtRet = []iface.ValidatorRole{iface.RoleProposer}
+	s.planAhead(ctx, slot+2)
+	assert.Equal(t, true, v.RoleAtCalled)
+	duties := s.dutiesAt(epochEnd)
+	require.Equal(t, 1, len(duties))
+	assert.Equal(t, iface.RoleProposer, duties[0].Role)
+}
+
+func TestDutyScheduler_Perform(t *testing.T) {
+	ctx := context.Background()
+	s := newDutyScheduler(&testutil.FakeValidator{})
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+
+	t.Run("completed", func(t *testing.T) {
+		d := &ScheduledDuty{
+			Slot:     1,
+			PubKey:   pubKey,
+			Role:     iface.RoleAttester,
+			DueTime:  prysmTime.Now().Add(-time.Second),
+			Deadline: prysmTime.Now().Add(time.Minute),
+		}
+		var deadline time.Time
+		s.perform(ctx, d, func(ctx context.Context) error {
+			deadline, _ = ctx.Deadline()
+			return nil
+		})
+		assert.Equal(t, d.Deadline, deadline)
+		assert.Equal(t, DutyCompleted, d.Status)
+		assert.Equal(t, true, d.Delay >= time.Second)
+		assert.Equal(t, "", d.MissReason)
+	})
+	t.Run("started after deadline", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		d := &ScheduledDuty{
+			Slot:     1,
+			PubKey:   pubKey,
+			Role:     iface.RoleProposer,
+			DueTime:  prysmTime.Now().Add(-time.Minute),
+			Deadline: prysmTime.Now().Add(-time.Second),
+		}
+		called := false
+		s.perform(ctx, d, func(ctx context.Context) error {
+			called = true
+			return nil
+		})
+		assert.Equal(t, false, called)
+		assert.Equal(t, DutyMissed, d.Status)
+		assert.Equal(t, missedAfterDeadline, d.MissReason)
+		assert.Equal(t, true, d.Delay >= time.Minute)
+		require.LogsContain(t, hook, "Missed validator duty")
+	})
+	t.Run("deadline exceeded", func(t *testing.T) {
+		d := &ScheduledDuty{
+			Slot:     1,
+			PubKey:   pubKey,
+			Role:     iface.RoleAggregator,
+			DueTime:  prysmTime.Now(),
+			Deadline: prysmTime.Now().Add(50 * time.Millisecond),
+		}
+		s.perform(ctx, d, func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		assert.Equal(t, DutyMissed, d.Status)
+		assert.Equal(t, missedDeadlineExceeded+": "+context.DeadlineExceeded.Error(), d.MissReason)
+	})
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		d := &ScheduledDuty{
+			Slot:     1,
+			PubKey:   pubKey,
+			Role:     iface.RoleSyncCommittee,
+			DueTime:  prysmTime.Now(),
+			Deadline: prysmTime.Now().Add(time.Minute),
+		}
+		s.perform(ctx, d, func(ctx context.Context) error {
+			cancel()
+			return nil
+		})
+		assert.Equal(t, DutyMissed, d.Status)
+		assert.Equal(t, missedContextCanceled, d.MissReason)
+	})
+	t.Run("failed", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		d := &ScheduledDuty{
+			Slot:     1,
+			PubKey:   pubKey,
+			Role:     iface.RoleAttester,
+			DueTime:  prysmTime.Now(),
+			Deadline: prysmTime.Now().Add(time.Minute),
+		}
+		s.perform(ctx, d, func(ctx context.Context) error {
+			return errors.New("could not sign attestation")
+		})
+		assert.Equal(t, DutyMissed, d.Status)
+		assert.Equal(t, missedDutyFailed+": could not sign attestation", d.MissReason)
+		require.LogsContain(t, hook, "could not sign attestation")
+	})
+}
+
+func TestDutyScheduler_Schedule(t *testing.T) {
+	v := &testutil.FakeValidator{Km: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
+	v.RolesAtRet = []iface.ValidatorRole{iface.RoleProposer}
+	s := newDutyScheduler(v)
+	epochEnd, err := slots.EpochEnd(0)
+	require.NoError(t, err)
+	require.NoError(t, s.plan(context.Background(), epochEnd-1))
+	s.planAhead(context.Background(), epochEnd-1)
+
+	duties := s.schedule()
+	require.Equal(t, 2, len(duties))
+	assert.Equal(t, epochEnd-1, duties[0].Slot)
+	assert.Equal(t, epochEnd, duties[1].Slot)
+
+	// The schedule is a copy of the planned duties.
+	duties[0].Status = DutyMissed
+	assert.Equal(t, DutyScheduled, s.dutiesAt(epochEnd - 1)[0].Status)
+}
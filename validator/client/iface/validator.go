@@ -35,6 +35,24 @@ const (
 	RoleSyncCommitteeAggregator
 )
 
+// String returns the name of a validator role.
+func (r ValidatorRole) String() string {
+	switch r {
+	case RoleAttester:
+		return "attester"
+	case RoleProposer:
+		return "proposer"
+	case RoleAggregator:
+		return "aggregator"
+	case RoleSyncCommittee:
+		return "sync_committee"
+	case RoleSyncCommitteeAggregator:
+		return "sync_committee_aggregator"
+	default:
+		return "unknown"
+	}
+}
+
 // Validator interface defines the primary methods of a validator client.
 type Validator interface {
 	Done()
@@ -47,11 +65,12 @@ type Validator interface {
 	LogValidatorGainsAndLosses(ctx context.Context, slot types.Slot) error
 	UpdateDuties(ctx context.Context, slot types.Slot) error
 	RolesAt(ctx context.Context, slot types.Slot) (map[[fieldparams.BLSPubkeyLength]byte][]ValidatorRole, error) // validator pubKey -> roles
-	SubmitAttestation(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
-	ProposeBlock(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
-	SubmitAggregateAndProof(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
-	SubmitSyncCommitteeMessage(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
-	SubmitSignedContributionAndProof(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
+	PrepareDuty(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte, role ValidatorRole) error
+	SubmitAttestation(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) error
+	ProposeBlock(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) error
+	SubmitAggregateAndProof(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) error
+	SubmitSyncCommitteeMessage(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) error
+	SubmitSignedContributionAndProof(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) error
 	LogAttestationsSubmitted()
 	LogSyncCommitteeMessagesSubmitted()
 	UpdateDomainDataCaches(ctx context.Context, slot types.Slot)
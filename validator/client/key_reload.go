@@ -41,6 +41,10 @@ func (v *validator) HandleKeyReload(ctx context.Context, newKeys [][fieldparams.
 	if anyActive {
 		logActiveValidatorStatus(statuses)
 	}
+	// Clear assignments so that the duties of the new keys are fetched at the next slot.
+	v.dutiesLock.Lock()
+	v.duties = nil
+	v.dutiesLock.Unlock()
 
 	return anyActive, nil
 }
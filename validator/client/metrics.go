@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
@@ -67,6 +68,30 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorDutyDelayHistogram used to track how long after their due time duties are completed.
+	ValidatorDutyDelayHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "duty_delay_seconds",
+			Help:      "Time between the due time of a duty and its completion.",
+			Buckets:   []float64{0.25, 0.5, 1, 2, 4, 8, 12},
+		},
+		[]string{
+			"role",
+		},
+	)
+	// ValidatorDutiesMissedVec used to count duties missed by the duty scheduler.
+	ValidatorDutiesMissedVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "missed_duties_total",
+			Help:      "Duties which could not be performed before their deadline, by role and reason.",
+		},
+		[]string{
+			"role",
+			"reason",
+		},
+	)
 	// ValidatorProposeFailVecSlasher used to count failed proposals by slashing protection.
 	ValidatorProposeFailVecSlasher = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -206,15 +231,13 @@ var (
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
 // responsibilities throughout the beacon chain's lifecycle. It logs absolute accrued rewards
 // and penalties over time, percentage gain/loss, and gives the end user a better idea
-// of how the validator performs with respect to the rest.
+// of how the validator performs with respect to the rest. The performance of the previous epoch
+// is persisted to the performance history whether or not balances are logged.
 func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot types.Slot) error {
 	if !slots.IsEpochEnd(slot) || slot <= params.BeaconConfig().SlotsPerEpoch {
 		// Do nothing unless we are at the end of the epoch, and not in the first epoch.
 		return nil
 	}
-	if !v.logValidatorBalances {
-		return nil
-	}
 
 	var pks [][fieldparams.BLSPubkeyLength]byte
 	var err error
@@ -232,27 +255,35 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot types.S
 		return err
 	}
 
-	if v.emitAccountMetrics {
-		for _, missingPubKey := range resp.MissingValidators {
-			fmtKey := fmt.Sprintf("%#x", missingPubKey)
-			ValidatorBalancesGaugeVec.WithLabelValues(fmtKey).Set(0)
-		}
-	}
-
 	prevEpoch := types.Epoch(0)
 	if slot >= params.BeaconConfig().SlotsPerEpoch {
 		prevEpoch = types.Epoch(slot/params.BeaconConfig().SlotsPerEpoch) - 1
+	}
+	if v.logValidatorBalances {
+		if v.emitAccountMetrics {
+			for _, missingPubKey := range resp.MissingValidators {
+				fmtKey := fmt.Sprintf("%#x", missingPubKey)
+				ValidatorBalancesGaugeVec.WithLabelValues(fmtKey).Set(0)
+			}
+		}
 		if uint64(v.voteStats.startEpoch) == ^uint64(0) { // Handles unknown first epoch.
 			v.voteStats.startEpoch = prevEpoch
 		}
+		v.prevBalanceLock.Lock()
+		for i, pubKey := range resp.PublicKeys {
+			v.logForEachValidator(i, pubKey, resp, slot, prevEpoch)
+		}
+		v.prevBalanceLock.Unlock()
+
+		v.UpdateLogAggregateStats(resp, slot)
 	}
-	v.prevBalanceLock.Lock()
-	for i, pubKey := range resp.PublicKeys {
-		v.logForEachValidator(i, pubKey, resp, slot, prevEpoch)
-	}
-	v.prevBalanceLock.Unlock()
 
-	v.UpdateLogAggregateStats(resp, slot)
+	if err := v.savePerformance(ctx, resp, prevEpoch); err != nil {
+		return errors.Wrap(err, "could not save validator performance")
+	}
+	if err := v.db.PrunePerformanceRecords(ctx, prevEpoch); err != nil {
+		return errors.Wrap(err, "could not prune validator performance history")
+	}
 	return nil
 }
 
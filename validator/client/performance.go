@@ -0,0 +1,232 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+)
+
+// performanceTracker keeps track of the duty outcomes which are not reported by the beacon node,
+// namely the proposals and sync committee messages of validators and the inclusion delay of their
+// attestations, until the performance of their epoch is persisted.
+type performanceTracker struct {
+	lock         sync.Mutex
+	epochs       map[types.Epoch]map[[fieldparams.BLSPubkeyLength]byte]*epochPerformance
+	attestations map[[32]byte]*trackedAttestation
+}
+
+// epochPerformance of a validator. The slot maps hold whether the duty of each slot was performed.
+type epochPerformance struct {
+	proposals       map[types.Slot]bool
+	syncMessages    map[types.Slot]bool
+	attestationSeen bool
+	inclusionDelay  types.Slot
+}
+
+// trackedAttestation is an attestation submitted by the validator client, along with the
+// validators which signed it by position in the committee.
+type trackedAttestation struct {
+	slot      types.Slot
+	positions map[uint64][fieldparams.BLSPubkeyLength]byte
+}
+
+func newPerformanceTracker() *performanceTracker {
+	return &performanceTracker{
+		epochs:       make(map[types.Epoch]map[[fieldparams.BLSPubkeyLength]byte]*epochPerformance),
+		attestations: make(map[[32]byte]*trackedAttestation),
+	}
+}
+
+// validator returns the performance of a validator in the epoch of a slot. The caller must hold the lock.
+func (p *performanceTracker) validator(slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) *epochPerformance {
+	epoch := slots.ToEpoch(slot)
+	if p.epochs[epoch] == nil {
+		p.epochs[epoch] = make(map[[fieldparams.BLSPubkeyLength]byte]*epochPerformance)
+	}
+	perf, ok := p.epochs[epoch][pubKey]
+	if !ok {
+		perf = &epochPerformance{
+			proposals:    make(map[types.Slot]bool),
+			syncMessages: make(map[types.Slot]bool),
+		}
+		p.epochs[epoch][pubKey] = perf
+	}
+	return perf
+}
+
+// expect records the proposals and sync committee messages expected from a validator at a slot.
+// It may be called several times for the same slot.
+func (p *performanceTracker) expect(slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte, roles []iface.ValidatorRole) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, role := range roles {
+		var expected map[types.Slot]bool
+		switch role {
+		case iface.RoleProposer:
+			expected = p.validator(slot, pubKey).proposals
+		case iface.RoleSyncCommittee:
+			expected = p.validator(slot, pubKey).syncMessages
+		default:
+			continue
+		}
+		if _, ok := expected[slot]; !ok {
+			expected[slot] = false
+		}
+	}
+}
+
+// proposed records a block published by a validator.
+func (p *performanceTracker) proposed(slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.validator(slot, pubKey).proposals[slot] = true
+}
+
+// syncMessageSubmitted records a sync committee message published by a validator.
+func (p *performanceTracker) syncMessageSubmitted(slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.validator(slot, pubKey).syncMessages[slot] = true
+}
+
+// attested records an attestation published by a validator, so that its inclusion in a block can
+// be looked for.
+func (p *performanceTracker) attested(slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte, dataRoot [32]byte, indexInCommittee uint64) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	att, ok := p.attestations[dataRoot]
+	if !ok {
+		att = &trackedAttestation{slot: slot, positions: make(map[uint64][fieldparams.BLSPubkeyLength]byte)}
+		p.attestations[dataRoot] = att
+	}
+	att.positions[indexInCommittee] = pubKey
+	p.validator(slot, pubKey)
+}
+
+// processBlock looks for the attestations of validators in a block, and records the inclusion
+// delay of those seen for the first time.
+func (p *performanceTracker) processBlock(blk interfaces.SignedBeaconBlock) {
+	if p == nil || blk == nil || blk.IsNil() {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if len(p.attestations) == 0 {
+		return
+	}
+	blkSlot := blk.Block().Slot()
+	for _, att := range blk.Block().Body().Attestations() {
+		if att == nil || att.Data == nil {
+			continue
+		}
+		root, err := att.Data.HashTreeRoot()
+		if err != nil {
+			log.WithError(err).Debug("Could not hash attestation data")
+			continue
+		}
+		tracked, ok := p.attestations[root]
+		if !ok {
+			continue
+		}
+		for position, pubKey := range tracked.positions {
+			if position >= att.AggregationBits.Len() || !att.AggregationBits.BitAt(position) {
+				continue
+			}
+			perf := p.validator(tracked.slot, pubKey)
+			perf.attestationSeen = true
+			if blkSlot > tracked.slot {
+				perf.inclusionDelay = blkSlot - tracked.slot
+			}
+			delete(tracked.positions, position)
+		}
+		if len(tracked.positions) == 0 {
+			delete(p.attestations, root)
+		}
+	}
+}
+
+// take returns the performance of validators in an epoch and forgets everything tracked up to it.
+func (p *performanceTracker) take(epoch types.Epoch) map[[fieldparams.BLSPubkeyLength]byte]*epochPerformance {
+	if p == nil {
+		return nil
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	perfs := p.epochs[epoch]
+	for e := range p.epochs {
+		if e <= epoch {
+			delete(p.epochs, e)
+		}
+	}
+	for root, att := range p.attestations {
+		if slots.ToEpoch(att.slot) <= epoch {
+			delete(p.attestations, root)
+		}
+	}
+	return perfs
+}
+
+// savePerformance persists the performance of validators in an epoch, combining the outcome of their
+// attestations reported by the beacon node with the duties tracked by the validator client.
+func (v *validator) savePerformance(ctx context.Context, resp *ethpb.ValidatorPerformanceResponse, epoch types.Epoch) error {
+	perfs := v.performance.take(epoch)
+	records := make(map[[fieldparams.BLSPubkeyLength]byte]*kv.PerformanceRecord, len(resp.PublicKeys))
+	for i, pk := range resp.PublicKeys {
+		pubKey := bytesutil.ToBytes48(pk)
+		record := &kv.PerformanceRecord{Epoch: epoch}
+		if i < len(resp.CorrectlyVotedSource) {
+			record.CorrectSource = resp.CorrectlyVotedSource[i]
+		}
+		if i < len(resp.CorrectlyVotedTarget) {
+			record.CorrectTarget = resp.CorrectlyVotedTarget[i]
+		}
+		if i < len(resp.CorrectlyVotedHead) {
+			record.CorrectHead = resp.CorrectlyVotedHead[i]
+		}
+		if i < len(resp.BalancesBeforeEpochTransition) {
+			record.BalanceBefore = resp.BalancesBeforeEpochTransition[i]
+		}
+		if i < len(resp.BalancesAfterEpochTransition) {
+			record.BalanceAfter = resp.BalancesAfterEpochTransition[i]
+		}
+		record.AttestationIncluded = record.CorrectSource || record.CorrectTarget
+		if perf, ok := perfs[pubKey]; ok {
+			record.AttestationIncluded = record.AttestationIncluded || perf.attestationSeen
+			record.InclusionDelay = perf.inclusionDelay
+			record.ProposalsAssigned = uint64(len(perf.proposals))
+			for _, made := range perf.proposals {
+				if made {
+					record.ProposalsMade++
+				}
+			}
+			record.SyncMessagesExpected = uint64(len(perf.syncMessages))
+			for _, submitted := range perf.syncMessages {
+				if submitted {
+					record.SyncMessagesSubmitted++
+				}
+			}
+		}
+		records[pubKey] = record
+	}
+	return v.db.SavePerformanceRecords(ctx, records)
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/go-bitfield"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/consensus-types/blocks"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/mock"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+)
+
+func TestPerformanceTracker_InclusionDelay(t *testing.T) {
+	p := newPerformanceTracker()
+	pubKey1 := [fieldparams.BLSPubkeyLength]byte{1}
+	pubKey2 := [fieldparams.BLSPubkeyLength]byte{2}
+	data := util.HydrateAttestationData(&ethpb.AttestationData{Slot: 3})
+	dataRoot, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	p.attested(3, pubKey1, dataRoot, 0)
+	p.attested(3, pubKey2, dataRoot, 2)
+
+	bits := bitfield.NewBitlist(4)
+	bits.SetBitAt(0, true)
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 5
+	blk.Block.Body.Attestations = []*ethpb.Attestation{{Data: data, AggregationBits: bits, Signature: make([]byte, 96)}}
+	wsb, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	p.processBlock(wsb)
+
+	// The attestation of the second validator is included in a later block.
+	bits = bitfield.NewBitlist(4)
+	bits.SetBitAt(0, true)
+	bits.SetBitAt(2, true)
+	blk = util.NewBeaconBlock()
+	blk.Block.Slot = 6
+	blk.Block.Body.Attestations = []*ethpb.Attestation{{Data: data, AggregationBits: bits, Signature: make([]byte, 96)}}
+	wsb, err = blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	p.processBlock(wsb)
+
+	perfs := p.take(0)
+	require.Equal(t, 2, len(perfs))
+	assert.Equal(t, true, perfs[pubKey1].attestationSeen)
+	assert.Equal(t, types.Slot(2), perfs[pubKey1].inclusionDelay)
+	assert.Equal(t, true, perfs[pubKey2].attestationSeen)
+	assert.Equal(t, types.Slot(3), perfs[pubKey2].inclusionDelay)
+	assert.Equal(t, 0, len(p.attestations))
+	assert.Equal(t, 0, len(p.take(0)))
+}
+
+func TestValidator_SavePerformance(t *testing.T) {
+	ctx := context.Background()
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+	v.performance = newPerformanceTracker()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	epochStart := params.BeaconConfig().SlotsPerEpoch
+
+	// Planning the same duties twice does not change the expectations.
+	for i := 0; i < 2; i++ {
+		v.performance.expect(epochStart+1, pubKey, []iface.ValidatorRole{iface.RoleProposer, iface.RoleSyncCommittee})
+		v.performance.expect(epochStart+2, pubKey, []iface.ValidatorRole{iface.RoleAttester, iface.RoleSyncCommittee})
+	}
+	v.performance.proposed(epochStart+1, pubKey)
+	v.performance.syncMessageSubmitted(epochStart+2, pubKey)
+
+	resp := &ethpb.ValidatorPerformanceResponse{
+		PublicKeys:                    [][]byte{pubKey[:]},
+		CorrectlyVotedSource:          []bool{true},
+		CorrectlyVotedTarget:          []bool{true},
+		CorrectlyVotedHead:            []bool{false},
+		BalancesBeforeEpochTransition: []uint64{32000000000},
+		BalancesAfterEpochTransition:  []uint64{32000010000},
+	}
+	require.NoError(t, v.savePerformance(ctx, resp, 1))
+
+	records, err := v.db.PerformanceRecords(ctx, nil, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records[pubKey]))
+	record := records[pubKey][0]
+	assert.Equal(t, types.Epoch(1), record.Epoch)
+	assert.Equal(t, true, record.AttestationIncluded)
+	assert.Equal(t, true, record.CorrectTarget)
+	assert.Equal(t, false, record.CorrectHead)
+	assert.Equal(t, uint64(1), record.ProposalsAssigned)
+	assert.Equal(t, uint64(1), record.ProposalsMade)
+	assert.Equal(t, uint64(2), record.SyncMessagesExpected)
+	assert.Equal(t, uint64(1), record.SyncMessagesSubmitted)
+	assert.Equal(t, uint64(32000010000), record.BalanceAfter)
+}
+
+func TestValidator_LogValidatorGainsAndLosses_PersistsWithoutLogging(t *testing.T) {
+	ctx := context.Background()
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	v.beaconClient = beaconClient
+	v.logValidatorBalances = false
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	// The record of a validator no longer managed is pruned along with the others.
+	removedKey := [fieldparams.BLSPubkeyLength]byte{1}
+	require.NoError(t, v.db.SavePerformanceRecords(ctx, map[[fieldparams.BLSPubkeyLength]byte]*kv.PerformanceRecord{
+		removedKey: {Epoch: 1},
+	}))
+
+	beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:           [][]byte{pubKey[:]},
+		CorrectlyVotedSource: []bool{true},
+		CorrectlyVotedTarget: []bool{true},
+		CorrectlyVotedHead:   []bool{true},
+	}, nil)
+	prevEpoch := types.Epoch(kv.PerformanceHistoryEpochs + 2)
+	epochEnd, err := slots.EpochEnd(prevEpoch + 1)
+	require.NoError(t, err)
+	require.NoError(t, v.LogValidatorGainsAndLosses(ctx, epochEnd))
+
+	records, err := v.db.PerformanceRecords(ctx, nil, 0, prevEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	require.Equal(t, 1, len(records[pubKey]))
+	assert.Equal(t, prevEpoch, records[pubKey][0].Epoch)
+	assert.Equal(t, true, records[pubKey][0].CorrectHead)
+}
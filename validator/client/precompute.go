@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+)
+
+// precomputedSignaturesCacheSize is the number of signatures computed ahead of their duties which are kept around.
+const precomputedSignaturesCacheSize = 1 << 14
+
+// precomputedSignatureKey identifies a signature which does not depend on the chain, such as a RANDAO
+// reveal or a selection proof, and can therefore be computed before the duty needing it.
+type precomputedSignatureKey struct {
+	domain [4]byte
+	pubKey [fieldparams.BLSPubkeyLength]byte
+	epoch  types.Epoch
+	slot   types.Slot
+	index  uint64
+}
+
+// PrepareDuty pre-computes the signatures a duty needs ahead of time, namely the RANDAO reveal of a proposal
+// and the selection proof of an aggregation, so that the keymanager is not waited on when the duty is performed.
+// The selection proofs of sync committee aggregations are already computed when determining the roles of a slot.
+func (v *validator) PrepareDuty(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte, role iface.ValidatorRole) error {
+	switch role {
+	case iface.RoleProposer:
+		_, err := v.signRandaoReveal(ctx, pubKey, slots.ToEpoch(slot), slot)
+		return err
+	case iface.RoleAggregator:
+		_, err := v.signSlotWithSelectionProof(ctx, pubKey, slot)
+		return err
+	default:
+		return nil
+	}
+}
+
+func (v *validator) precomputedSignature(key precomputedSignatureKey) ([]byte, bool) {
+	if v.precomputedSignatures == nil {
+		return nil, false
+	}
+	sig, ok := v.precomputedSignatures.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return bytesutil.SafeCopyBytes(sig.([]byte)), true
+}
+
+func (v *validator) savePrecomputedSignature(key precomputedSignatureKey, sig []byte) {
+	if v.precomputedSignatures == nil {
+		return
+	}
+	v.precomputedSignatures.Add(key, bytesutil.SafeCopyBytes(sig))
+}
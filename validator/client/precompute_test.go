@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	lruwrpr "github.com/prysmaticlabs/prysm/v3/cache/lru"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+)
+
+func TestPrepareDuty_PrecomputesSignatures(t *testing.T) {
+	ctx := context.Background()
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	validator.precomputedSignatures = lruwrpr.New(precomputedSignaturesCacheSize)
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/).Times(3)
+
+	require.NoError(t, validator.PrepareDuty(ctx, 40, pubKey, iface.RoleProposer))
+	require.NoError(t, validator.PrepareDuty(ctx, 41, pubKey, iface.RoleAggregator))
+	require.NoError(t, validator.PrepareDuty(ctx, 41, pubKey, iface.RoleAttester))
+
+	// The keymanager is no longer needed once the signatures are precomputed.
+	validator.keyManager = &mockKeymanager{keysMap: map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey{}}
+	randaoReveal, err := validator.signRandaoReveal(ctx, pubKey, 1, 42)
+	require.NoError(t, err)
+	assert.Equal(t, fieldparams.BLSSignatureLength, len(randaoReveal))
+	_, err = validator.signSlotWithSelectionProof(ctx, pubKey, 41)
+	require.NoError(t, err)
+
+	// Signatures which were not precomputed still need the keymanager.
+	_, err = validator.signSlotWithSelectionProof(ctx, pubKey, 42)
+	require.ErrorContains(t, "not found", err)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/v3/time"
 	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/graffiti"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -36,10 +37,10 @@ const signExitErr = "could not sign voluntary exit proposal"
 // chain node to construct the new block. The new block is then processed with
 // the state root computation, and finally signed by the validator before being
 // sent back to the beacon node for broadcasting.
-func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) {
+func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) error {
 	if slot == 0 {
 		log.Debug("Assigned to genesis slot, skipping proposal")
-		return
+		return nil
 	}
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeBlock")
 	defer span.End()
@@ -60,10 +61,10 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Wrap(err, "failed to sign randao reveal")
 	}
 
-	g, err := v.getGraffiti(ctx, pubKey)
+	g, err := v.getGraffiti(ctx, pubKey, slot)
 	if err != nil {
 		// Graffiti is not a critical enough to fail block production and cause
 		// validator to miss block reward. When failed, validator should continue
@@ -82,7 +83,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Wrap(err, "failed to request block from beacon node")
 	}
 
 	// Sign returned block from beacon node
@@ -92,7 +93,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Wrap(err, "failed to wrap block")
 	}
 
 	sig, signingRoot, err := v.signBlock(ctx, pubKey, epoch, slot, wb)
@@ -101,13 +102,13 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Wrap(err, "failed to sign block")
 	}
 
 	blk, err := blocks.BuildSignedBeaconBlock(wb, sig)
 	if err != nil {
 		log.WithError(err).Error("Failed to build signed beacon block")
-		return
+		return errors.Wrap(err, "failed to build signed beacon block")
 	}
 
 	if err := v.slashableProposalCheck(ctx, pubKey, blk, signingRoot); err != nil {
@@ -117,7 +118,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Wrap(err, "failed block slashing protection check")
 	}
 
 	// Propose and broadcast block via beacon node
@@ -127,7 +128,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Wrap(err, "failed to create proposal request")
 	}
 	blkResp, err := v.validatorClient.ProposeBeaconBlock(ctx, proposal)
 	if err != nil {
@@ -135,8 +136,9 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
-		return
+		return errors.Wrap(err, "failed to propose block")
 	}
+	v.performance.proposed(slot, pubKey)
 
 	span.AddAttributes(
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRoot)),
@@ -148,7 +150,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		p, err := blk.Block().Body().Execution()
 		if err != nil {
 			log.WithError(err).Error("Failed to get execution payload")
-			return
+			return nil // The block was proposed.
 		}
 		log = log.WithFields(logrus.Fields{
 			"payloadHash": fmt.Sprintf("%#x", bytesutil.Trunc(p.BlockHash())),
@@ -159,7 +161,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 			txs, err := p.Transactions()
 			if err != nil {
 				log.WithError(err).Error("Failed to get execution payload transactions")
-				return
+				return nil // The block was proposed.
 			}
 			log = log.WithField("txCount", len(txs))
 		}
@@ -182,6 +184,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 	if v.emitAccountMetrics {
 		ValidatorProposeSuccessVec.WithLabelValues(fmtKey).Inc()
 	}
+	return nil
 }
 
 // ProposeExit performs a voluntary exit on a validator.
@@ -228,6 +231,10 @@ func ProposeExit(
 
 // Sign randao reveal with randao domain and private key.
 func (v *validator) signRandaoReveal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, epoch types.Epoch, slot types.Slot) ([]byte, error) {
+	key := precomputedSignatureKey{domain: params.BeaconConfig().DomainRandao, pubKey: pubKey, epoch: epoch}
+	if sig, ok := v.precomputedSignature(key); ok {
+		return sig, nil
+	}
 	domain, err := v.domainData(ctx, epoch, params.BeaconConfig().DomainRandao[:])
 	if err != nil {
 		return nil, errors.Wrap(err, domainDataErr)
@@ -242,7 +249,7 @@ func (v *validator) signRandaoReveal(ctx context.Context, pubKey [fieldparams.BL
 	if err != nil {
 		return nil, err
 	}
-	randaoReveal, err = v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	randaoReveal, err = v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
@@ -252,6 +259,7 @@ func (v *validator) signRandaoReveal(ctx context.Context, pubKey [fieldparams.BL
 	if err != nil {
 		return nil, err
 	}
+	v.savePrecomputedSignature(key, randaoReveal.Marshal())
 	return randaoReveal.Marshal(), nil
 }
 
@@ -274,7 +282,7 @@ func (v *validator) signBlock(ctx context.Context, pubKey [fieldparams.BLSPubkey
 	if err != nil {
 		return nil, [32]byte{}, err
 	}
-	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err := v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     blockRoot[:],
 		SignatureDomain: domain.SignatureDomain,
@@ -307,8 +315,38 @@ func signVoluntaryExit(
 	if domain == nil {
 		return nil, errors.New(domainDataErr)
 	}
+	return signVoluntaryExitWithDomain(ctx, signer, pubKey, exit, domain.SignatureDomain)
+}
+
+// SignVoluntaryExitOffline signs a voluntary exit without a beacon node, computing the signature domain
+// from the fork version of the exit epoch and the genesis validators root of the network.
+func SignVoluntaryExitOffline(
+	ctx context.Context,
+	signer iface.SigningFunc,
+	pubKey []byte,
+	exit *ethpb.VoluntaryExit,
+	forkVersion []byte,
+	genesisValidatorsRoot []byte,
+) (*ethpb.SignedVoluntaryExit, error) {
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainVoluntaryExit, forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, domainDataErr)
+	}
+	sig, err := signVoluntaryExitWithDomain(ctx, signer, pubKey, exit, domain)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}, nil
+}
 
-	exitRoot, err := signing.ComputeSigningRoot(exit, domain.SignatureDomain)
+func signVoluntaryExitWithDomain(
+	ctx context.Context,
+	signer iface.SigningFunc,
+	pubKey []byte,
+	exit *ethpb.VoluntaryExit,
+	domain []byte,
+) ([]byte, error) {
+	exitRoot, err := signing.ComputeSigningRoot(exit, domain)
 	if err != nil {
 		return nil, errors.Wrap(err, signingRootErr)
 	}
@@ -316,7 +354,7 @@ func signVoluntaryExit(
 	sig, err := signer(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey,
 		SigningRoot:     exitRoot[:],
-		SignatureDomain: domain.SignatureDomain,
+		SignatureDomain: domain,
 		Object:          &validatorpb.SignRequest_Exit{Exit: exit},
 	})
 	if err != nil {
@@ -325,50 +363,97 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
-// Gets the graffiti from cli or file for the validator public key.
-func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
-	// When specified, default graffiti from the command line takes the first priority.
+// Gets the graffiti of the validator public key for a block proposed at the given slot,
+// expanding the variables of its template.
+func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) ([]byte, error) {
+	template, err := v.graffitiTemplate(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(template) == 0 {
+		return []byte{}, nil
+	}
+	data := &graffiti.TemplateData{
+		ClientVersion: version.SemanticVersion(),
+		Slot:          slot,
+	}
+	if graffiti.UsesVariable(template, graffiti.ValidatorIndexVar) {
+		idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get validator index for graffiti")
+		}
+		data.ValidatorIndex = idx.Index
+	}
+	if graffiti.UsesVariable(template, graffiti.ExecutionClientVar) {
+		data.ExecutionClient = v.executionClientName(ctx)
+	}
+	return graffiti.ExpandTemplate(template, data), nil
+}
+
+// executionClientName returns the name of the execution client of the beacon node, or
+// an empty string if the beacon node does not report it.
+func (v *validator) executionClientName(ctx context.Context) string {
+	res, err := v.node.GetVersion(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.WithError(err).Debug("Could not get beacon node version")
+		return ""
+	}
+	elVersion, ok := version.ExecutionClientFromMetadata(res.Metadata)
+	if !ok {
+		return ""
+	}
+	return version.ExecutionClientName(elVersion)
+}
+
+// Gets the graffiti template set through the keymanager API, the cli or file for the validator public key.
+func (v *validator) graffitiTemplate(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (string, error) {
+	// When set through the keymanager API, the graffiti of the validator takes the first priority.
+	g, ok, err := v.db.GraffitiForPubKey(ctx, pubKey)
+	if err != nil {
+		return "", errors.Wrap(err, "could not get graffiti of validator")
+	}
+	if ok {
+		return g, nil
+	}
+
+	// When specified, default graffiti from the command line takes the second priority.
 	if len(v.graffiti) != 0 {
-		return v.graffiti, nil
+		return string(v.graffiti), nil
 	}
 
 	if v.graffitiStruct == nil {
-		return nil, errors.New("graffitiStruct can't be nil")
+		return "", errors.New("graffitiStruct can't be nil")
 	}
 
-	// When specified, individual validator specified graffiti takes the second priority.
+	// When specified, individual validator specified graffiti takes the third priority.
 	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
 	if err != nil {
-		return []byte{}, err
+		return "", err
 	}
-	g, ok := v.graffitiStruct.Specific[idx.Index]
+	g, ok = v.graffitiStruct.Specific[idx.Index]
 	if ok {
-		return []byte(g), nil
+		return g, nil
 	}
 
-	// When specified, a graffiti from the ordered list in the file take third priority.
+	// When specified, a graffiti from the ordered list in the file take fourth priority.
 	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
-		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
+		ordered := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
 		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
 		err := v.db.SaveGraffitiOrderedIndex(ctx, v.graffitiOrderedIndex)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to update graffiti ordered index")
+			return "", errors.Wrap(err, "failed to update graffiti ordered index")
 		}
-		return []byte(graffiti), nil
+		return ordered, nil
 	}
 
-	// When specified, a graffiti from the random list in the file take fourth priority.
+	// When specified, a graffiti from the random list in the file take fifth priority.
 	if len(v.graffitiStruct.Random) != 0 {
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
 		i := r.Uint64() % uint64(len(v.graffitiStruct.Random))
-		return []byte(v.graffitiStruct.Random[i]), nil
+		return v.graffitiStruct.Random[i], nil
 	}
 
 	// Finally, default graffiti if specified in the file will be used.
-	if v.graffitiStruct.Default != "" {
-		return []byte(v.graffitiStruct.Default), nil
-	}
-
-	return []byte{}, nil
+	return v.graffitiStruct.Default, nil
 }
@@ -17,10 +17,13 @@ var failedBlockSignExternalErr = "attempted a double proposal, block rejected by
 
 func (v *validator) slashableProposalCheck(
 	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signedBlock interfaces.SignedBeaconBlock, signingRoot [32]byte,
-) error {
+) (err error) {
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 
 	blk := signedBlock.Block()
+	defer func() {
+		v.auditProposalProtection(ctx, pubKey, signingRoot, blk, err)
+	}()
 	prevSigningRoot, proposalAtSlotExists, err := v.db.ProposalHistoryForSlot(ctx, pubKey, blk.Slot())
 	if err != nil {
 		if v.emitAccountMetrics {
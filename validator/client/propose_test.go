@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/runtime/version"
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/mock"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
@@ -251,7 +252,8 @@ func TestProposeBlock_ProposeBlockFailed(t *testing.T) {
 				gomock.AssignableToTypeOf(&ethpb.GenericSignedBeaconBlock{}),
 			).Return(nil /*response*/, errors.New("uh oh"))
 
-			validator.ProposeBlock(context.Background(), 1, pubKey)
+			err := validator.ProposeBlock(context.Background(), 1, pubKey)
+			require.ErrorContains(t, "failed to propose block", err)
 			require.LogsContain(t, hook, "Failed to propose block")
 		})
 	}
@@ -983,7 +985,8 @@ func TestGetGraffiti_Ok(t *testing.T) {
 					ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
 					Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
 			}
-			got, err := tt.v.getGraffiti(context.Background(), pubKey)
+			tt.v.db = testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+			got, err := tt.v.getGraffiti(context.Background(), pubKey, 1)
 			require.NoError(t, err)
 			require.DeepEqual(t, tt.want, got)
 		})
@@ -1011,8 +1014,66 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 		},
 	}
 	for _, want := range [][]byte{{'a'}, {'b'}, {'c'}, {'d'}, {'d'}} {
-		got, err := v.getGraffiti(context.Background(), pubKey)
+		got, err := v.getGraffiti(context.Background(), pubKey, 1)
 		require.NoError(t, err)
 		require.DeepEqual(t, want, got)
 	}
 }
+
+func TestGetGraffiti_KeymanagerAPI(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	valDB := testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	v := &validator{
+		db:       valDB,
+		graffiti: []byte("cli"),
+	}
+	got, err := v.getGraffiti(ctx, pubKey, 1)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("cli"), got)
+
+	// Graffiti set through the keymanager API takes priority over the cli graffiti.
+	require.NoError(t, valDB.SaveGraffitiForPubKey(ctx, pubKey, "api"))
+	got, err = v.getGraffiti(ctx, pubKey, 1)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("api"), got)
+
+	require.NoError(t, valDB.DeleteGraffitiForPubKey(ctx, pubKey))
+	got, err = v.getGraffiti(ctx, pubKey, 1)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("cli"), got)
+}
+
+func TestGetGraffiti_Template(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+		nodeClient:      mock.NewMockNodeClient(ctrl),
+	}
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	v := &validator{
+		db:              testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}),
+		validatorClient: m.validatorClient,
+		node:            m.nodeClient,
+		graffiti:        []byte("{execution_client} {validator_index} {slot}"),
+	}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+	m.nodeClient.EXPECT().
+		GetVersion(gomock.Any(), gomock.Any()).
+		Return(&ethpb.Version{Metadata: version.ExecutionClientMetadata("Geth/v1.10.26-stable/linux-amd64/go1.19.3")}, nil)
+	got, err := v.getGraffiti(ctx, pubKey, 100)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("Geth 2 100"), got)
+
+	// The execution client is left out if the beacon node does not report it.
+	v.graffiti = []byte("Prysm/{execution_client}")
+	m.nodeClient.EXPECT().
+		GetVersion(gomock.Any(), gomock.Any()).
+		Return(&ethpb.Version{}, nil)
+	got, err = v.getGraffiti(ctx, pubKey, 100)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("Prysm/"), got)
+}
@@ -2,7 +2,6 @@ package client
 
 import (
 	"context"
-	"fmt"
 	"sync"
 	"time"
 
@@ -11,7 +10,6 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/config/params"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
-	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
@@ -32,9 +30,9 @@ var backOffPeriod = 10 * time.Second
 // 2 - Wait for validator activation
 // 3 - Wait for the next slot start
 // 4 - Update assignments
-// 5 - Determine role at current slot
-// 6 - Perform assigned role, if any
-func run(ctx context.Context, v iface.Validator) {
+// 5 - Plan and perform the duties of the current slot, if any
+// 6 - Plan the duties of the rest of the epoch in the background, if not already planned
+func run(ctx context.Context, v iface.Validator, scheduler *dutyScheduler) {
 	cleanup := v.Done
 	defer cleanup()
 
@@ -90,6 +88,7 @@ func run(ctx context.Context, v iface.Validator) {
 				continue
 			}
 		case newKeys := <-accountsChangedChan:
+			scheduler.invalidate()
 			anyActive, err := v.HandleKeyReload(ctx, newKeys)
 			if err != nil {
 				log.WithError(err).Error("Could not properly handle reloaded keys")
@@ -122,6 +121,7 @@ func run(ctx context.Context, v iface.Validator) {
 			// epoch transition in the beacon node's state.
 			if err := v.UpdateDuties(ctx, slot); err != nil {
 				handleAssignmentError(err, slot)
+				scheduler.invalidate()
 				cancel()
 				span.End()
 				continue
@@ -143,14 +143,14 @@ func run(ctx context.Context, v iface.Validator) {
 
 			var wg sync.WaitGroup
 
-			allRoles, err := v.RolesAt(ctx, slot)
-			if err != nil {
-				log.WithError(err).Error("Could not get validator roles")
+			if err := scheduler.plan(ctx, slot); err != nil {
+				log.WithError(err).Error("Could not plan validator duties")
 				cancel()
 				span.End()
 				continue
 			}
-			performRoles(slotCtx, allRoles, v, slot, &wg, span)
+			performDuties(slotCtx, scheduler, v, slot, &wg, span)
+			go scheduler.planAhead(ctx, slot)
 		}
 	}
 }
@@ -235,30 +235,33 @@ func waitForActivation(ctx context.Context, v iface.Validator) (types.Slot, erro
 	return headSlot, nil
 }
 
-func performRoles(slotCtx context.Context, allRoles map[[48]byte][]iface.ValidatorRole, v iface.Validator, slot types.Slot, wg *sync.WaitGroup, span *trace.Span) {
-	for pubKey, roles := range allRoles {
-		wg.Add(len(roles))
-		for _, role := range roles {
-			go func(role iface.ValidatorRole, pubKey [fieldparams.BLSPubkeyLength]byte) {
-				defer wg.Done()
-				switch role {
+func performDuties(slotCtx context.Context, scheduler *dutyScheduler, v iface.Validator, slot types.Slot, wg *sync.WaitGroup, span *trace.Span) {
+	duties := scheduler.dutiesAt(slot)
+	wg.Add(len(duties))
+	for _, duty := range duties {
+		go func(duty *ScheduledDuty) {
+			defer wg.Done()
+			scheduler.perform(slotCtx, duty, func(ctx context.Context) error {
+				switch duty.Role {
 				case iface.RoleAttester:
-					v.SubmitAttestation(slotCtx, slot, pubKey)
+					return v.SubmitAttestation(ctx, slot, duty.PubKey)
 				case iface.RoleProposer:
-					v.ProposeBlock(slotCtx, slot, pubKey)
+					return v.ProposeBlock(ctx, slot, duty.PubKey)
 				case iface.RoleAggregator:
-					v.SubmitAggregateAndProof(slotCtx, slot, pubKey)
+					return v.SubmitAggregateAndProof(ctx, slot, duty.PubKey)
 				case iface.RoleSyncCommittee:
-					v.SubmitSyncCommitteeMessage(slotCtx, slot, pubKey)
+					return v.SubmitSyncCommitteeMessage(ctx, slot, duty.PubKey)
 				case iface.RoleSyncCommitteeAggregator:
-					v.SubmitSignedContributionAndProof(slotCtx, slot, pubKey)
-				case iface.RoleUnknown:
-					log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Trace("No active roles, doing nothing")
+					return v.SubmitSignedContributionAndProof(ctx, slot, duty.PubKey)
 				default:
-					log.Warnf("Unhandled role %v", role)
+					log.Warnf("Unhandled role %v", duty.Role)
+					return nil
 				}
-			}(role, pubKey)
-		}
+			})
+		}(duty)
+	}
+	if len(duties) == 0 {
+		log.WithField("slot", slot).Trace("No duties, doing nothing")
 	}
 
 	// Wait for all processes to complete, then report span complete.
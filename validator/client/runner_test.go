@@ -28,13 +28,13 @@ func cancelledContext() context.Context {
 
 func TestCancelledContext_CleansUpValidator(t *testing.T) {
 	v := &testutil.FakeValidator{Km: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
-	run(cancelledContext(), v)
+	run(cancelledContext(), v, newDutyScheduler(v))
 	assert.Equal(t, true, v.DoneCalled, "Expected Done() to be called")
 }
 
 func TestCancelledContext_WaitsForChainStart(t *testing.T) {
 	v := &testutil.FakeValidator{Km: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
-	run(cancelledContext(), v)
+	run(cancelledContext(), v, newDutyScheduler(v))
 	assert.Equal(t, 1, v.WaitForChainStartCalled, "Expected WaitForChainStart() to be called")
 }
 
@@ -46,7 +46,7 @@ func TestRetry_On_ConnectionError(t *testing.T) {
 	}
 	backOffPeriod = 10 * time.Millisecond
 	ctx, cancel := context.WithCancel(context.Background())
-	go run(ctx, v)
+	go run(ctx, v, newDutyScheduler(v))
 	// each step will fail (retry times)=10 this sleep times will wait more then
 	// the time it takes for all steps to succeed before main loop.
 	time.Sleep(time.Duration(retry*6) * backOffPeriod)
@@ -61,7 +61,7 @@ func TestRetry_On_ConnectionError(t *testing.T) {
 
 func TestCancelledContext_WaitsForActivation(t *testing.T) {
 	v := &testutil.FakeValidator{Km: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
-	run(cancelledContext(), v)
+	run(cancelledContext(), v, newDutyScheduler(v))
 	assert.Equal(t, 1, v.WaitForActivationCalled, "Expected WaitForActivation() to be called")
 }
 
@@ -78,7 +78,7 @@ func TestUpdateDuties_NextSlot(t *testing.T) {
 		cancel()
 	}()
 
-	run(ctx, v)
+	run(ctx, v, newDutyScheduler(v))
 
 	require.Equal(t, true, v.UpdateDutiesCalled, "Expected UpdateAssignments(%d) to be called", slot)
 	assert.Equal(t, uint64(slot), v.UpdateDutiesArg1, "UpdateAssignments was called with wrong argument")
@@ -99,7 +99,7 @@ func TestUpdateDuties_HandlesError(t *testing.T) {
 	}()
 	v.UpdateDutiesRet = errors.New("bad")
 
-	run(ctx, v)
+	run(ctx, v, newDutyScheduler(v))
 
 	require.LogsContain(t, hook, "Failed to update assignments")
 }
@@ -111,16 +111,19 @@ func TestRoleAt_NextSlot(t *testing.T) {
 	slot := types.Slot(55)
 	ticker := make(chan types.Slot)
 	v.NextSlotRet = ticker
+	v.RolesAtRet = []iface.ValidatorRole{iface.RoleAttester}
 	go func() {
 		ticker <- slot
 
 		cancel()
 	}()
 
-	run(ctx, v)
+	scheduler := newDutyScheduler(v)
+	run(ctx, v, scheduler)
 
 	require.Equal(t, true, v.RoleAtCalled, "Expected RoleAt(%d) to be called", slot)
-	assert.Equal(t, uint64(slot), v.RoleAtArg1, "RoleAt called with the wrong arg")
+	// The duties of the current slot are planned before they are performed.
+	assert.Equal(t, 1, len(scheduler.dutiesAt(slot)))
 }
 
 func TestAttests_NextSlot(t *testing.T) {
@@ -137,7 +140,7 @@ func TestAttests_NextSlot(t *testing.T) {
 		cancel()
 	}()
 	timer := time.NewTimer(200 * time.Millisecond)
-	run(ctx, v)
+	run(ctx, v, newDutyScheduler(v))
 	<-timer.C
 	require.Equal(t, true, v.AttestToBlockHeadCalled, "SubmitAttestation(%d) was not called", slot)
 	assert.Equal(t, uint64(slot), v.AttestToBlockHeadArg1, "SubmitAttestation was called with wrong arg")
@@ -157,7 +160,7 @@ func TestProposes_NextSlot(t *testing.T) {
 		cancel()
 	}()
 	timer := time.NewTimer(200 * time.Millisecond)
-	run(ctx, v)
+	run(ctx, v, newDutyScheduler(v))
 	<-timer.C
 	require.Equal(t, true, v.ProposeBlockCalled, "ProposeBlock(%d) was not called", slot)
 	assert.Equal(t, uint64(slot), v.ProposeBlockArg1, "ProposeBlock was called with wrong arg")
@@ -177,7 +180,7 @@ func TestBothProposesAndAttests_NextSlot(t *testing.T) {
 		cancel()
 	}()
 	timer := time.NewTimer(200 * time.Millisecond)
-	run(ctx, v)
+	run(ctx, v, newDutyScheduler(v))
 	<-timer.C
 	require.Equal(t, true, v.AttestToBlockHeadCalled, "SubmitAttestation(%d) was not called", slot)
 	assert.Equal(t, uint64(slot), v.AttestToBlockHeadArg1, "SubmitAttestation was called with wrong arg")
@@ -198,7 +201,7 @@ func TestAllValidatorsAreExited_NextSlot(t *testing.T) {
 
 		cancel()
 	}()
-	run(ctx, v)
+	run(ctx, v, newDutyScheduler(v))
 	assert.LogsContain(t, hook, "All validators are exited")
 }
 
@@ -211,7 +214,7 @@ func TestKeyReload_ActiveKey(t *testing.T) {
 
 		cancel()
 	}()
-	run(ctx, v)
+	run(ctx, v, newDutyScheduler(v))
 	assert.Equal(t, true, v.HandleKeyReloadCalled)
 	// We expect that WaitForActivation will only be called once,
 	// at the very beginning, and not after account changes.
@@ -227,7 +230,7 @@ func TestKeyReload_NoActiveKey(t *testing.T) {
 
 		cancel()
 	}()
-	run(ctx, v)
+	run(ctx, v, newDutyScheduler(v))
 	assert.Equal(t, true, v.HandleKeyReloadCalled)
 	assert.Equal(t, 2, v.WaitForActivationCalled)
 }
@@ -245,7 +248,7 @@ func TestKeyReload_RemoteKeymanager(t *testing.T) {
 
 		cancel()
 	}()
-	run(ctx, v)
+	run(ctx, v, newDutyScheduler(v))
 	assert.Equal(t, true, km.ReloadPublicKeysCalled)
 }
 
@@ -267,7 +270,7 @@ func TestUpdateProposerSettingsAt_EpochStart(t *testing.T) {
 		cancel()
 	}()
 
-	run(ctx, v)
+	run(ctx, v, newDutyScheduler(v))
 	assert.LogsContain(t, hook, "updated proposer settings")
 }
 
@@ -292,6 +295,6 @@ func TestUpdateProposerSettings_ContinuesAfterValidatorRegistrationFails(t *test
 
 		cancel()
 	}()
-	run(ctx, v)
+	run(ctx, v, newDutyScheduler(v))
 	assert.LogsContain(t, hook, ErrBuilderValidatorRegistration.Error())
 }
@@ -21,6 +21,7 @@ import (
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/audit"
 	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/v3/validator/db"
 	"github.com/prysmaticlabs/prysm/v3/validator/graffiti"
@@ -52,6 +53,7 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	interopKeysConfig     *local.InteropKeymanagerConfig
+	keymanager            keymanager.IKeymanager
 	conn                  *grpc.ClientConn
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
@@ -65,11 +67,13 @@ type ValidatorService struct {
 	endpoint              string
 	ctx                   context.Context
 	validator             iface.Validator
+	scheduler             *dutyScheduler
 	db                    db.Database
 	grpcHeaders           []string
 	graffiti              []byte
 	Web3SignerConfig      *remoteweb3signer.SetupConfig
 	proposerSettings      *validatorserviceconfig.ProposerSettings
+	signingAuditLog       *audit.Log
 }
 
 // Config for the validator service.
@@ -78,6 +82,7 @@ type Config struct {
 	LogValidatorBalances       bool
 	EmitAccountMetrics         bool
 	InteropKeysConfig          *local.InteropKeymanagerConfig
+	Keymanager                 keymanager.IKeymanager
 	Wallet                     *wallet.Wallet
 	WalletInitializedFeed      *event.Feed
 	GrpcRetriesFlag            uint
@@ -93,6 +98,7 @@ type Config struct {
 	Endpoint                   string
 	Web3SignerConfig           *remoteweb3signer.SetupConfig
 	ProposerSettings           *validatorserviceconfig.ProposerSettings
+	SigningAuditLog            *audit.Log
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -118,9 +124,11 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		walletInitializedFeed: cfg.WalletInitializedFeed,
 		useWeb:                cfg.UseWeb,
 		interopKeysConfig:     cfg.InteropKeysConfig,
+		keymanager:            cfg.Keymanager,
 		graffitiStruct:        cfg.GraffitiStruct,
 		Web3SignerConfig:      cfg.Web3SignerConfig,
 		proposerSettings:      cfg.ProposerSettings,
+		signingAuditLog:       cfg.SigningAuditLog,
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -193,10 +201,13 @@ func (v *ValidatorService) Start() {
 		attLogs:                        make(map[[32]byte]*attSubmitted),
 		domainDataCache:                cache,
 		aggregatedSlotCommitteeIDCache: aggregatedSlotCommitteeIDCache,
+		precomputedSignatures:          lruwrpr.New(precomputedSignaturesCacheSize),
+		performance:                    newPerformanceTracker(),
 		voteStats:                      voteStats{startEpoch: types.Epoch(^uint64(0))},
 		syncCommitteeStats:             syncCommitteeStats{},
 		useWeb:                         v.useWeb,
 		interopKeysConfig:              v.interopKeysConfig,
+		initializedKeymanager:          v.keymanager,
 		wallet:                         v.wallet,
 		walletInitializedFeed:          v.walletInitializedFeed,
 		blockFeed:                      new(event.Feed),
@@ -206,6 +217,7 @@ func (v *ValidatorService) Start() {
 		Web3SignerConfig:               v.Web3SignerConfig,
 		proposerSettings:               v.proposerSettings,
 		walletInitializedChannel:       make(chan *wallet.Wallet, 1),
+		signingAuditLog:                v.signingAuditLog,
 	}
 	// To resolve a race condition at startup due to the interface
 	// nature of the abstracted block type. We initialize
@@ -218,13 +230,19 @@ func (v *ValidatorService) Start() {
 	close(tempChan)
 
 	v.validator = valStruct
-	go run(v.ctx, v.validator)
+	v.scheduler = newDutyScheduler(valStruct)
+	go run(v.ctx, v.validator, v.scheduler)
 }
 
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	if v.signingAuditLog != nil {
+		if err := v.signingAuditLog.Close(); err != nil {
+			log.WithError(err).Error("Could not close signing audit log")
+		}
+	}
 	if v.conn != nil {
 		return v.conn.Close()
 	}
@@ -236,6 +254,17 @@ func (v *ValidatorService) Status() error {
 	if v.conn == nil {
 		return errors.New("no connection to beacon RPC")
 	}
+	if v.validator == nil {
+		return nil
+	}
+	// The keymanager is not initialized until the validator has started.
+	if km, err := v.validator.Keymanager(); err == nil {
+		if web3signerKm, ok := km.(*remoteweb3signer.Keymanager); ok {
+			if err := web3signerKm.Status(); err != nil {
+				return errors.Wrap(err, "web3signer is not healthy")
+			}
+		}
+	}
 	return nil
 }
 
@@ -248,6 +277,17 @@ func (v *ValidatorService) Keymanager() (keymanager.IKeymanager, error) {
 	return v.validator.Keymanager()
 }
 
+// SigningKeymanager returns the keymanager of the validator client, recording every signature in the signing
+// audit log, if any. Signatures made outside of the duties of the validator client, such as voluntary exits,
+// must use it so that the audit log stays complete.
+func (v *ValidatorService) SigningKeymanager() (keymanager.IKeymanager, error) {
+	km, err := v.validator.Keymanager()
+	if err != nil {
+		return nil, err
+	}
+	return audit.NewKeymanager(km, v.signingAuditLog), nil
+}
+
 func (v *ValidatorService) ProposerSettings() *validatorserviceconfig.ProposerSettings {
 	return v.validator.ProposerSettings()
 }
@@ -257,6 +297,15 @@ func (v *ValidatorService) SetProposerSettings(settings *validatorserviceconfig.
 	v.validator.SetProposerSettings(settings)
 }
 
+// DutySchedule returns the duties planned by the validator client for the current and previous
+// epochs, along with how late each duty was and why it was missed.
+func (v *ValidatorService) DutySchedule() []ScheduledDuty {
+	if v.scheduler == nil {
+		return nil
+	}
+	return v.scheduler.schedule()
+}
+
 // ConstructDialOptions constructs a list of grpc dial options
 func ConstructDialOptions(
 	maxCallRecvMsgSize int,
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	emptypb "github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
@@ -22,7 +23,7 @@ import (
 )
 
 // SubmitSyncCommitteeMessage submits the sync committee message to the beacon chain.
-func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) {
+func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) error {
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitSyncCommitteeMessage")
 	defer span.End()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
@@ -33,28 +34,28 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 	if err != nil {
 		log.WithError(err).Error("Could not request sync message block root to sign")
 		tracing.AnnotateError(span, err)
-		return
+		return errors.Wrap(err, "could not request sync message block root to sign")
 	}
 
 	duty, err := v.duty(pubKey)
 	if err != nil {
 		log.WithError(err).Error("Could not fetch validator assignment")
-		return
+		return errors.Wrap(err, "could not fetch validator assignment")
 	}
 
 	d, err := v.domainData(ctx, slots.ToEpoch(slot), params.BeaconConfig().DomainSyncCommittee[:])
 	if err != nil {
 		log.WithError(err).Error("Could not get sync committee domain data")
-		return
+		return errors.Wrap(err, "could not get sync committee domain data")
 	}
 	sszRoot := types.SSZBytes(res.Root)
 	r, err := signing.ComputeSigningRoot(&sszRoot, d.SignatureDomain)
 	if err != nil {
 		log.WithError(err).Error("Could not get sync committee message signing root")
-		return
+		return errors.Wrap(err, "could not get sync committee message signing root")
 	}
 
-	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err := v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     r[:],
 		SignatureDomain: d.SignatureDomain,
@@ -65,7 +66,7 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 	})
 	if err != nil {
 		log.WithError(err).Error("Could not sign sync committee message")
-		return
+		return errors.Wrap(err, "could not sign sync committee message")
 	}
 
 	msg := &ethpb.SyncCommitteeMessage{
@@ -76,8 +77,9 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 	}
 	if _, err := v.validatorClient.SubmitSyncMessage(ctx, msg); err != nil {
 		log.WithError(err).Error("Could not submit sync committee message")
-		return
+		return errors.Wrap(err, "could not submit sync committee message")
 	}
+	v.performance.syncMessageSubmitted(slot, pubKey)
 
 	msgSlot := msg.Slot
 	slotTime := time.Unix(int64(v.genesisTime+uint64(msgSlot)*params.BeaconConfig().SecondsPerSlot), 0)
@@ -89,10 +91,11 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 		"validatorIndex":     msg.ValidatorIndex,
 	}).Info("Submitted new sync message")
 	atomic.AddUint64(&v.syncCommitteeStats.totalMessagesSubmitted, 1)
+	return nil
 }
 
 // SubmitSignedContributionAndProof submits the signed sync committee contribution and proof to the beacon chain.
-func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) {
+func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot types.Slot, pubKey [fieldparams.BLSPubkeyLength]byte) error {
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitSignedContributionAndProof")
 	defer span.End()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
@@ -100,7 +103,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 	duty, err := v.duty(pubKey)
 	if err != nil {
 		log.WithError(err).Error("Could not fetch validator assignment")
-		return
+		return errors.Wrap(err, "could not fetch validator assignment")
 	}
 
 	indexRes, err := v.validatorClient.GetSyncSubcommitteeIndex(ctx, &ethpb.SyncSubcommitteeIndexRequest{
@@ -109,17 +112,17 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 	})
 	if err != nil {
 		log.WithError(err).Error("Could not get sync subcommittee index")
-		return
+		return errors.Wrap(err, "could not get sync subcommittee index")
 	}
 	if len(indexRes.Indices) == 0 {
 		log.Debug("Empty subcommittee index list, do nothing")
-		return
+		return nil
 	}
 
 	selectionProofs, err := v.selectionProofs(ctx, slot, pubKey, indexRes)
 	if err != nil {
 		log.WithError(err).Error("Could not get selection proofs")
-		return
+		return errors.Wrap(err, "could not get selection proofs")
 	}
 
 	v.waitToSlotTwoThirds(ctx, slot)
@@ -128,7 +131,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 		isAggregator, err := altair.IsSyncCommitteeAggregator(selectionProofs[i])
 		if err != nil {
 			log.WithError(err).Error("Could check in aggregator")
-			return
+			return errors.Wrap(err, "could not check if validator is a sync committee aggregator")
 		}
 		if !isAggregator {
 			continue
@@ -142,7 +145,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 		})
 		if err != nil {
 			log.WithError(err).Error("Could not get sync committee contribution")
-			return
+			return errors.Wrap(err, "could not get sync committee contribution")
 		}
 		if contribution.AggregationBits.Count() == 0 {
 			log.WithFields(logrus.Fields{
@@ -161,7 +164,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 		sig, err := v.signContributionAndProof(ctx, pubKey, contributionAndProof, slot)
 		if err != nil {
 			log.WithError(err).Error("Could not sign contribution and proof")
-			return
+			return errors.Wrap(err, "could not sign contribution and proof")
 		}
 
 		if _, err := v.validatorClient.SubmitSignedContributionAndProof(ctx, &ethpb.SignedContributionAndProof{
@@ -169,7 +172,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 			Signature: sig,
 		}); err != nil {
 			log.WithError(err).Error("Could not submit signed contribution and proof")
-			return
+			return errors.Wrap(err, "could not submit signed contribution and proof")
 		}
 
 		contributionSlot := contributionAndProof.Contribution.Slot
@@ -184,6 +187,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 			"bitsCount":          contributionAndProof.Contribution.AggregationBits.Count(),
 		}).Info("Submitted new sync contribution and proof")
 	}
+	return nil
 }
 
 // Signs and returns selection proofs per validator for slot and pub key.
@@ -206,6 +210,10 @@ func (v *validator) selectionProofs(ctx context.Context, slot types.Slot, pubKey
 
 // Signs input slot with domain sync committee selection proof. This is used to create the signature for sync committee selection.
 func (v *validator) signSyncSelectionData(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, index uint64, slot types.Slot) (signature []byte, err error) {
+	key := precomputedSignatureKey{domain: params.BeaconConfig().DomainSyncCommitteeSelectionProof, pubKey: pubKey, slot: slot, index: index}
+	if sig, ok := v.precomputedSignature(key); ok {
+		return sig, nil
+	}
 	domain, err := v.domainData(ctx, slots.ToEpoch(slot), params.BeaconConfig().DomainSyncCommitteeSelectionProof[:])
 	if err != nil {
 		return nil, err
@@ -218,7 +226,7 @@ func (v *validator) signSyncSelectionData(ctx context.Context, pubKey [fieldpara
 	if err != nil {
 		return nil, err
 	}
-	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err := v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
@@ -228,6 +236,7 @@ func (v *validator) signSyncSelectionData(ctx context.Context, pubKey [fieldpara
 	if err != nil {
 		return nil, err
 	}
+	v.savePrecomputedSignature(key, sig.Marshal())
 	return sig.Marshal(), nil
 }
 
@@ -241,7 +250,7 @@ func (v *validator) signContributionAndProof(ctx context.Context, pubKey [fieldp
 	if err != nil {
 		return nil, err
 	}
-	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err := v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: d.SignatureDomain,
@@ -107,7 +107,8 @@ func TestSubmitSyncCommitteeMessage_CouldNotSubmit(t *testing.T) {
 
 	pubKey := [fieldparams.BLSPubkeyLength]byte{}
 	copy(pubKey[:], validatorKey.PublicKey().Marshal())
-	validator.SubmitSyncCommitteeMessage(context.Background(), 1, pubKey)
+	err := validator.SubmitSyncCommitteeMessage(context.Background(), 1, pubKey)
+	require.ErrorContains(t, "could not submit sync committee message", err)
 
 	require.LogsContain(t, hook, "Could not submit sync committee message")
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
 	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
 	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
@@ -47,6 +48,8 @@ type FakeValidator struct {
 	PublicKey                         string
 	UpdateDutiesRet                   error
 	ProposerSettingsErr               error
+	ProposeBlockErr                   error
+	SubmitAttestationErr              error
 	RolesAtRet                        []iface.ValidatorRole
 	Balances                          map[[fieldparams.BLSPubkeyLength]byte]uint64
 	IndexToPubkeyMap                  map[uint64][fieldparams.BLSPubkeyLength]byte
@@ -120,7 +123,7 @@ func (fv *FakeValidator) CanonicalHeadSlot(_ context.Context) (types.Slot, error
 // SlotDeadline for mocking.
 func (fv *FakeValidator) SlotDeadline(_ types.Slot) time.Time {
 	fv.SlotDeadlineCalled = true
-	return prysmTime.Now()
+	return prysmTime.Now().Add(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
 }
 
 // NextSlot for mocking.
@@ -162,24 +165,33 @@ func (fv *FakeValidator) RolesAt(_ context.Context, slot types.Slot) (map[[field
 	return vr, nil
 }
 
+// PrepareDuty for mocking.
+func (_ *FakeValidator) PrepareDuty(_ context.Context, _ types.Slot, _ [fieldparams.BLSPubkeyLength]byte, _ iface.ValidatorRole) error {
+	return nil
+}
+
 // SubmitAttestation for mocking.
-func (fv *FakeValidator) SubmitAttestation(_ context.Context, slot types.Slot, _ [fieldparams.BLSPubkeyLength]byte) {
+func (fv *FakeValidator) SubmitAttestation(_ context.Context, slot types.Slot, _ [fieldparams.BLSPubkeyLength]byte) error {
 	fv.AttestToBlockHeadCalled = true
 	fv.AttestToBlockHeadArg1 = uint64(slot)
+	return fv.SubmitAttestationErr
 }
 
 // ProposeBlock for mocking.
-func (fv *FakeValidator) ProposeBlock(_ context.Context, slot types.Slot, _ [fieldparams.BLSPubkeyLength]byte) {
+func (fv *FakeValidator) ProposeBlock(_ context.Context, slot types.Slot, _ [fieldparams.BLSPubkeyLength]byte) error {
 	fv.ProposeBlockCalled = true
 	fv.ProposeBlockArg1 = uint64(slot)
+	return fv.ProposeBlockErr
 }
 
 // SubmitAggregateAndProof for mocking.
-func (_ *FakeValidator) SubmitAggregateAndProof(_ context.Context, _ types.Slot, _ [fieldparams.BLSPubkeyLength]byte) {
+func (_ *FakeValidator) SubmitAggregateAndProof(_ context.Context, _ types.Slot, _ [fieldparams.BLSPubkeyLength]byte) error {
+	return nil
 }
 
 // SubmitSyncCommitteeMessage for mocking.
-func (_ *FakeValidator) SubmitSyncCommitteeMessage(_ context.Context, _ types.Slot, _ [fieldparams.BLSPubkeyLength]byte) {
+func (_ *FakeValidator) SubmitSyncCommitteeMessage(_ context.Context, _ types.Slot, _ [fieldparams.BLSPubkeyLength]byte) error {
+	return nil
 }
 
 // LogAttestationsSubmitted for mocking.
@@ -246,7 +258,8 @@ func (fv *FakeValidator) HandleKeyReload(_ context.Context, newKeys [][fieldpara
 }
 
 // SubmitSignedContributionAndProof for mocking
-func (_ *FakeValidator) SubmitSignedContributionAndProof(_ context.Context, _ types.Slot, _ [fieldparams.BLSPubkeyLength]byte) {
+func (_ *FakeValidator) SubmitSignedContributionAndProof(_ context.Context, _ types.Slot, _ [fieldparams.BLSPubkeyLength]byte) error {
+	return nil
 }
 
 // HasProposerSettings for mocking
@@ -35,6 +35,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/time/slots"
 	accountsiface "github.com/prysmaticlabs/prysm/v3/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/audit"
 	"github.com/prysmaticlabs/prysm/v3/validator/client/iface"
 	vdb "github.com/prysmaticlabs/prysm/v3/validator/db"
 	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
@@ -72,6 +73,7 @@ type validator struct {
 	highestValidSlotLock               sync.Mutex
 	prevBalanceLock                    sync.RWMutex
 	slashableKeysLock                  sync.RWMutex
+	dutiesLock                         sync.RWMutex
 	eipImportBlacklistedPublicKeys     map[[fieldparams.BLSPubkeyLength]byte]bool
 	walletInitializedFeed              *event.Feed
 	attLogs                            map[[32]byte]*attSubmitted
@@ -82,11 +84,14 @@ type validator struct {
 	signedValidatorRegistrations       map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1
 	graffitiOrderedIndex               uint64
 	aggregatedSlotCommitteeIDCache     *lru.Cache
+	precomputedSignatures              *lru.Cache
+	performance                        *performanceTracker
 	domainDataCache                    *ristretto.Cache
 	highestValidSlot                   types.Slot
 	genesisTime                        uint64
 	blockFeed                          *event.Feed
 	interopKeysConfig                  *local.InteropKeymanagerConfig
+	initializedKeymanager              keymanager.IKeymanager
 	wallet                             *wallet.Wallet
 	graffitiStruct                     *graffiti.Graffiti
 	node                               ethpb.NodeClient
@@ -102,6 +107,7 @@ type validator struct {
 	Web3SignerConfig                   *remoteweb3signer.SetupConfig
 	proposerSettings                   *validatorserviceconfig.ProposerSettings
 	walletInitializedChannel           chan *wallet.Wallet
+	signingAuditLog                    *audit.Log
 }
 
 type validatorStatus struct {
@@ -131,7 +137,10 @@ func (v *validator) WaitForKeymanagerInitialization(ctx context.Context) error {
 		}
 		v.keyManager = km
 	} else {
-		if v.interopKeysConfig != nil {
+		if v.initializedKeymanager != nil {
+			// The keymanager was initialized beforehand, such as the keymanager combining the keys of tenants.
+			v.keyManager = v.initializedKeymanager
+		} else if v.interopKeysConfig != nil {
 			keyManager, err := local.NewInteropKeymanager(ctx, v.interopKeysConfig.Offset, v.interopKeysConfig.NumValidatorKeys)
 			if err != nil {
 				return errors.Wrap(err, "could not generate interop keys for key manager")
@@ -367,6 +376,7 @@ func (v *validator) ReceiveBlocks(ctx context.Context, connectionErrorChannel ch
 			v.highestValidSlot = blk.Block().Slot()
 		}
 		v.highestValidSlotLock.Unlock()
+		v.performance.processBlock(blk)
 		v.blockFeed.Send(blk)
 	}
 }
@@ -603,13 +613,17 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	// If duties is nil it means we have had no prior duties and just started up.
 	resp, err := v.validatorClient.GetDuties(ctx, req)
 	if err != nil {
+		v.dutiesLock.Lock()
 		v.duties = nil // Clear assignments so we know to retry the request.
+		v.dutiesLock.Unlock()
 		log.Error(err)
 		return err
 	}
 
+	v.dutiesLock.Lock()
 	v.duties = resp
-	v.logDuties(slot, v.duties.CurrentEpochDuties)
+	v.dutiesLock.Unlock()
+	v.logDuties(slot, resp.CurrentEpochDuties)
 
 	// Non-blocking call for beacon node to start subscriptions for aggregators.
 	go func() {
@@ -691,8 +705,15 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 // validator is known to not have a roles at the slot. Returns UNKNOWN if the
 // validator assignments are unknown. Otherwise returns a valid ValidatorRole map.
 func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole, error) {
+	v.dutiesLock.RLock()
+	duties := v.duties
+	v.dutiesLock.RUnlock()
+	if duties == nil {
+		return nil, errors.New("no duties for validators")
+	}
+
 	rolesAt := make(map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole)
-	for validator, duty := range v.duties.Duties {
+	for validator, duty := range duties.Duties {
 		var roles []iface.ValidatorRole
 
 		if duty == nil {
@@ -724,7 +745,7 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[fieldpar
 		// the validator checks whether it's in the sync committee of following epoch.
 		inSyncCommittee := false
 		if slots.IsEpochEnd(slot) {
-			if v.duties.NextEpochDuties[validator].IsSyncCommittee {
+			if duties.NextEpochDuties[validator].IsSyncCommittee {
 				roles = append(roles, iface.RoleSyncCommittee)
 				inSyncCommittee = true
 			}
@@ -751,6 +772,7 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[fieldpar
 		var pubKey [fieldparams.BLSPubkeyLength]byte
 		copy(pubKey[:], duty.PublicKey)
 		rolesAt[pubKey] = roles
+		v.performance.expect(slot, pubKey, roles)
 	}
 	return rolesAt, nil
 }
@@ -1010,7 +1032,7 @@ func (v *validator) PushProposerSettings(ctx context.Context, km keymanager.IKey
 		return err
 	}
 
-	signedRegReqs, err := v.buildSignedRegReqs(ctx, pubkeys, km.Sign)
+	signedRegReqs, err := v.buildSignedRegReqs(ctx, pubkeys, audit.NewKeymanager(km, v.signingAuditLog).Sign)
 	if err != nil {
 		return err
 	}
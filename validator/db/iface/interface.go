@@ -62,4 +62,20 @@ type ValidatorDB interface {
 	// Graffiti ordered index related methods
 	SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error
 	GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error)
+
+	// Per validator graffiti related methods.
+	GraffitiForPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (string, bool, error)
+	SaveGraffitiForPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, graffiti string) error
+	DeleteGraffitiForPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error
+
+	// Performance history related methods.
+	SavePerformanceRecords(ctx context.Context, records map[[fieldparams.BLSPubkeyLength]byte]*kv.PerformanceRecord) error
+	PrunePerformanceRecords(ctx context.Context, epoch types.Epoch) error
+	PerformanceRecords(
+		ctx context.Context, pubKeys [][fieldparams.BLSPubkeyLength]byte, startEpoch, endEpoch types.Epoch,
+	) (map[[fieldparams.BLSPubkeyLength]byte][]*kv.PerformanceRecord, error)
+
+	// Signing audit log related methods.
+	SigningAuditLogHead(ctx context.Context) (*kv.SigningAuditLogHead, error)
+	SaveSigningAuditLogHead(ctx context.Context, head *kv.SigningAuditLogHead) error
 }
@@ -0,0 +1,51 @@
+package kv
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SigningAuditLogHead is the last entry of the signing audit log. It is kept in the db so that the removal of
+// the last entries of the log, or the replacement of the whole log, can be detected.
+type SigningAuditLogHead struct {
+	Sequence uint64 `json:"sequence"`
+	Hash     string `json:"hash"`
+}
+
+// SaveSigningAuditLogHead writes the last entry of the signing audit log to the db.
+func (s *Store) SaveSigningAuditLogHead(ctx context.Context, head *SigningAuditLogHead) error {
+	_, span := trace.StartSpan(ctx, "Validator.SaveSigningAuditLogHead")
+	defer span.End()
+	enc, err := json.Marshal(head)
+	if err != nil {
+		return errors.Wrap(err, "could not encode signing audit log head")
+	}
+	return s.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(signingAuditLogBucket).Put(signingAuditLogHeadKey, enc)
+	})
+}
+
+// SigningAuditLogHead returns the last entry of the signing audit log, or nil if none was saved.
+func (s *Store) SigningAuditLogHead(ctx context.Context) (*SigningAuditLogHead, error) {
+	_, span := trace.StartSpan(ctx, "Validator.SigningAuditLogHead")
+	defer span.End()
+	var head *SigningAuditLogHead
+	err := s.view(func(tx *bolt.Tx) error {
+		// The bucket is missing from databases opened read-only before it was introduced.
+		bkt := tx.Bucket(signingAuditLogBucket)
+		if bkt == nil {
+			return nil
+		}
+		enc := bkt.Get(signingAuditLogHeadKey)
+		if enc == nil {
+			return nil
+		}
+		head = &SigningAuditLogHead{}
+		return json.Unmarshal(enc, head)
+	})
+	return head, err
+}
@@ -0,0 +1,24 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestStore_SigningAuditLogHead(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, nil)
+
+	head, err := db.SigningAuditLogHead(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, head == nil)
+
+	require.NoError(t, db.SaveSigningAuditLogHead(ctx, &SigningAuditLogHead{Sequence: 1, Hash: "0x01"}))
+	require.NoError(t, db.SaveSigningAuditLogHead(ctx, &SigningAuditLogHead{Sequence: 2, Hash: "0x02"}))
+	head, err = db.SigningAuditLogHead(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &SigningAuditLogHead{Sequence: 2, Hash: "0x02"}, head)
+}
@@ -56,6 +56,10 @@ var blockedBuckets = [][]byte{
 // Config represents store's config object.
 type Config struct {
 	PubKeys [][fieldparams.BLSPubkeyLength]byte
+	// ReadOnly opens an existing database without writing to it, for tools which only read it.
+	ReadOnly bool
+	// DisableMetrics does not export the metrics of the database, so that a process can open several databases.
+	DisableMetrics bool
 }
 
 // Store defines an implementation of the Prysm Database interface
@@ -67,14 +71,24 @@ type Store struct {
 	batchedAttestationsChan            chan *AttestationRecord
 	batchAttestationsFlushedFeed       *event.Feed
 	batchedAttestationsFlushInProgress abool.AtomicBool
+	metricsDisabled                    bool
 }
 
 // Close closes the underlying boltdb database.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	s.unregisterMetrics()
 	return s.db.Close()
 }
 
+// unregisterMetrics stops exporting the metrics of the database. The collector is looked up by the
+// description of its metrics, which is the same for every database, so a database whose metrics are not
+// exported must not unregister the metrics of another database.
+func (s *Store) unregisterMetrics() {
+	if !s.metricsDisabled {
+		prometheus.Unregister(createBoltCollector(s.db))
+	}
+}
+
 func (s *Store) update(fn func(*bolt.Tx) error) error {
 	return s.db.Update(fn)
 }
@@ -87,7 +101,7 @@ func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
+	s.unregisterMetrics()
 	return os.Remove(filepath.Join(s.databasePath, ProtectionDbFileName))
 }
 
@@ -109,6 +123,9 @@ func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
 func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, error) {
+	if config != nil && config.ReadOnly {
+		return newReadOnlyKVStore(dirPath)
+	}
 	hasDir, err := file.HasDir(dirPath)
 	if err != nil {
 		return nil, err
@@ -136,6 +153,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		batchedAttestations:          NewQueuedAttestationRecords(),
 		batchedAttestationsChan:      make(chan *AttestationRecord, attestationBatchCapacity),
 		batchAttestationsFlushedFeed: new(event.Feed),
+		metricsDisabled:              config != nil && config.DisableMetrics,
 	}
 
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
@@ -152,6 +170,9 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			pubKeysBucket,
 			migrationsBucket,
 			graffitiBucket,
+			graffitiByPubKeyBucket,
+			performanceBucket,
+			signingAuditLogBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -175,6 +196,33 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	// intervals to our database.
 	go kv.batchAttestationWrites(ctx)
 
+	if kv.metricsDisabled {
+		return kv, nil
+	}
+	return kv, prometheus.Register(createBoltCollector(kv.db))
+}
+
+// newReadOnlyKVStore opens an existing database at the directory path specified in read-only mode.
+// No bucket is created, nothing is pruned and no attestation is batched.
+func newReadOnlyKVStore(dirPath string) (*Store, error) {
+	datafile := filepath.Join(dirPath, ProtectionDbFileName)
+	if !file.FileExists(datafile) {
+		return nil, errors.Errorf("no database found at path %s", datafile)
+	}
+	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout:  params.BeaconIoConfig().BoltTimeout,
+		ReadOnly: true,
+	})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	kv := &Store{
+		db:           boltDB,
+		databasePath: dirPath,
+	}
 	return kv, prometheus.Register(createBoltCollector(kv.db))
 }
 
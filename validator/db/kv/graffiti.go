@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	bolt "go.etcd.io/bbolt"
 )
@@ -37,3 +38,35 @@ func (s *Store) GraffitiOrderedIndex(_ context.Context, fileHash [32]byte) (uint
 	})
 	return orderedIndex, err
 }
+
+// GraffitiForPubKey fetches the graffiti set for a validator public key, if any.
+func (s *Store) GraffitiForPubKey(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (string, bool, error) {
+	var graffiti string
+	var exists bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(graffitiByPubKeyBucket)
+		g := bkt.Get(pubKey[:])
+		if g == nil {
+			return nil
+		}
+		graffiti, exists = string(g), true
+		return nil
+	})
+	return graffiti, exists, err
+}
+
+// SaveGraffitiForPubKey writes the graffiti of a validator public key to the db.
+func (s *Store) SaveGraffitiForPubKey(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, graffiti string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(graffitiByPubKeyBucket)
+		return bkt.Put(pubKey[:], []byte(graffiti))
+	})
+}
+
+// DeleteGraffitiForPubKey removes the graffiti of a validator public key from the db.
+func (s *Store) DeleteGraffitiForPubKey(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(graffitiByPubKeyBucket)
+		return bkt.Delete(pubKey[:])
+	})
+}
@@ -58,3 +58,31 @@ func TestStore_GraffitiOrderedIndex_ReadAndWrite(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_GraffitiForPubKey(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	_, exists, err := db.GraffitiForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	require.NoError(t, db.SaveGraffitiForPubKey(ctx, pubKey, "prysm {slot}"))
+	graffiti, exists, err := db.GraffitiForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, "prysm {slot}", graffiti)
+
+	// An empty graffiti is a valid value, distinct from no graffiti.
+	require.NoError(t, db.SaveGraffitiForPubKey(ctx, pubKey, ""))
+	graffiti, exists, err = db.GraffitiForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, "", graffiti)
+
+	require.NoError(t, db.DeleteGraffitiForPubKey(ctx, pubKey))
+	_, exists, err = db.GraffitiForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+}
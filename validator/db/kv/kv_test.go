@@ -31,3 +31,15 @@ func setupDB(t testing.TB, pubkeys [][fieldparams.BLSPubkeyLength]byte) *Store {
 	})
 	return db
 }
+
+func TestNewKVStore_DisableMetrics(t *testing.T) {
+	// The metrics of the database opened first are exported.
+	setupDB(t, nil)
+	db, err := NewKVStore(context.Background(), t.TempDir(), &Config{DisableMetrics: true})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// Closing the other database keeps the metrics of the first one.
+	_, err = NewKVStore(context.Background(), t.TempDir(), &Config{})
+	require.ErrorContains(t, "duplicate metrics collector registration", err)
+}
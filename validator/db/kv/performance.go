@@ -0,0 +1,205 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PerformanceHistoryEpochs is the number of epochs of performance history kept for each validator,
+// which is about 30 days.
+const PerformanceHistoryEpochs = 6750
+
+// PerformanceRecord is the outcome of the duties of a validator in an epoch.
+type PerformanceRecord struct {
+	Epoch types.Epoch `json:"epoch"`
+	// Attestation outcome, as seen by the beacon node at the end of the following epoch.
+	AttestationIncluded bool `json:"attestation_included"`
+	CorrectSource       bool `json:"correct_source"`
+	CorrectTarget       bool `json:"correct_target"`
+	CorrectHead         bool `json:"correct_head"`
+	// InclusionDelay is the number of slots between the attestation and the first block including it,
+	// zero if the attestation was not seen in a block.
+	InclusionDelay types.Slot `json:"inclusion_delay"`
+	// Proposals assigned to the validator in the epoch, and those which were published.
+	ProposalsAssigned uint64 `json:"proposals_assigned"`
+	ProposalsMade     uint64 `json:"proposals_made"`
+	// Sync committee messages expected from the validator in the epoch, and those which were published.
+	SyncMessagesExpected  uint64 `json:"sync_messages_expected"`
+	SyncMessagesSubmitted uint64 `json:"sync_messages_submitted"`
+	// Balances in gwei before and after the epoch transition.
+	BalanceBefore uint64 `json:"balance_before"`
+	BalanceAfter  uint64 `json:"balance_after"`
+}
+
+// SavePerformanceRecords writes the performance of validators in an epoch to the db.
+func (s *Store) SavePerformanceRecords(ctx context.Context, records map[[fieldparams.BLSPubkeyLength]byte]*PerformanceRecord) error {
+	_, span := trace.StartSpan(ctx, "Validator.SavePerformanceRecords")
+	defer span.End()
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(performanceBucket)
+		for pubKey, record := range records {
+			if record == nil {
+				continue
+			}
+			enc, err := json.Marshal(record)
+			if err != nil {
+				return errors.Wrap(err, "could not encode performance record")
+			}
+			pkBucket, err := bkt.CreateBucketIfNotExists(pubKey[:])
+			if err != nil {
+				return err
+			}
+			if err := pkBucket.Put(bytesutil.EpochToBytesBigEndian(record.Epoch), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PrunePerformanceRecords deletes the performance records of every validator, including those no
+// longer managed by the validator client, which are more than PerformanceHistoryEpochs older than
+// the given epoch.
+func (s *Store) PrunePerformanceRecords(ctx context.Context, epoch types.Epoch) error {
+	_, span := trace.StartSpan(ctx, "Validator.PrunePerformanceRecords")
+	defer span.End()
+	if epoch < PerformanceHistoryEpochs {
+		return nil
+	}
+	oldest := bytesutil.EpochToBytesBigEndian(epoch - PerformanceHistoryEpochs)
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(performanceBucket)
+		var emptied [][]byte
+		if err := bkt.ForEach(func(pubKey, _ []byte) error {
+			pkBucket := bkt.Bucket(pubKey)
+			if pkBucket == nil {
+				return nil
+			}
+			c := pkBucket.Cursor()
+			for k, _ := c.First(); k != nil && bytes.Compare(k, oldest) < 0; k, _ = c.First() {
+				if err := pkBucket.Delete(k); err != nil {
+					return err
+				}
+			}
+			if k, _ := c.First(); k == nil {
+				emptied = append(emptied, pubKey)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, pubKey := range emptied {
+			if err := bkt.DeleteBucket(pubKey); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PerformanceRecords returns the performance records of validators between two epochs, inclusive,
+// ordered by epoch. The records of all validators are returned if no public keys are given.
+func (s *Store) PerformanceRecords(
+	ctx context.Context, pubKeys [][fieldparams.BLSPubkeyLength]byte, startEpoch, endEpoch types.Epoch,
+) (map[[fieldparams.BLSPubkeyLength]byte][]*PerformanceRecord, error) {
+	_, span := trace.StartSpan(ctx, "Validator.PerformanceRecords")
+	defer span.End()
+	if startEpoch > endEpoch {
+		return nil, errors.Errorf("start epoch %d is after end epoch %d", startEpoch, endEpoch)
+	}
+	records := make(map[[fieldparams.BLSPubkeyLength]byte][]*PerformanceRecord)
+	err := s.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(performanceBucket)
+		if bkt == nil {
+			// A database opened read-only may predate the performance history.
+			return nil
+		}
+		if len(pubKeys) == 0 {
+			if err := bkt.ForEach(func(k, _ []byte) error {
+				pubKeys = append(pubKeys, bytesutil.ToBytes48(k))
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		start := bytesutil.EpochToBytesBigEndian(startEpoch)
+		end := bytesutil.EpochToBytesBigEndian(endEpoch)
+		for _, pubKey := range pubKeys {
+			pkBucket := bkt.Bucket(pubKey[:])
+			if pkBucket == nil {
+				continue
+			}
+			c := pkBucket.Cursor()
+			for k, v := c.Seek(start); k != nil && bytes.Compare(k, end) <= 0; k, v = c.Next() {
+				record := &PerformanceRecord{}
+				if err := json.Unmarshal(v, record); err != nil {
+					return errors.Wrap(err, "could not decode performance record")
+				}
+				records[pubKey] = append(records[pubKey], record)
+			}
+		}
+		return nil
+	})
+	return records, err
+}
+
+// PerformanceSummary aggregates the performance records of a validator over a range of epochs.
+type PerformanceSummary struct {
+	Epochs                uint64 `json:"epochs"`
+	AttestationsIncluded  uint64 `json:"attestations_included"`
+	CorrectSource         uint64 `json:"correct_source"`
+	CorrectTarget         uint64 `json:"correct_target"`
+	CorrectHead           uint64 `json:"correct_head"`
+	ProposalsAssigned     uint64 `json:"proposals_assigned"`
+	ProposalsMade         uint64 `json:"proposals_made"`
+	SyncMessagesExpected  uint64 `json:"sync_messages_expected"`
+	SyncMessagesSubmitted uint64 `json:"sync_messages_submitted"`
+	// AverageInclusionDelay is computed over the attestations seen in a block by the validator client.
+	AverageInclusionDelay float64 `json:"average_inclusion_delay"`
+	// BalanceChange in gwei between the first and the last epochs of the range.
+	BalanceChange int64 `json:"balance_change"`
+}
+
+// SummarizePerformance aggregates performance records ordered by epoch.
+func SummarizePerformance(records []*PerformanceRecord) *PerformanceSummary {
+	summary := &PerformanceSummary{}
+	var delays, delayed uint64
+	for _, r := range records {
+		summary.Epochs++
+		if r.AttestationIncluded {
+			summary.AttestationsIncluded++
+		}
+		if r.CorrectSource {
+			summary.CorrectSource++
+		}
+		if r.CorrectTarget {
+			summary.CorrectTarget++
+		}
+		if r.CorrectHead {
+			summary.CorrectHead++
+		}
+		if r.InclusionDelay > 0 {
+			delays += uint64(r.InclusionDelay)
+			delayed++
+		}
+		summary.ProposalsAssigned += r.ProposalsAssigned
+		summary.ProposalsMade += r.ProposalsMade
+		summary.SyncMessagesExpected += r.SyncMessagesExpected
+		summary.SyncMessagesSubmitted += r.SyncMessagesSubmitted
+	}
+	if delayed > 0 {
+		summary.AverageInclusionDelay = float64(delays) / float64(delayed)
+	}
+	if len(records) > 0 {
+		summary.BalanceChange = int64(records[len(records)-1].BalanceAfter) - int64(records[0].BalanceBefore)
+	}
+	return summary
+}
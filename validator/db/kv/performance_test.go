@@ -0,0 +1,143 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func TestStore_PerformanceRecords(t *testing.T) {
+	ctx := context.Background()
+	pubKey1 := [fieldparams.BLSPubkeyLength]byte{1}
+	pubKey2 := [fieldparams.BLSPubkeyLength]byte{2}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey1, pubKey2})
+
+	for epoch := types.Epoch(1); epoch <= 5; epoch++ {
+		require.NoError(t, db.SavePerformanceRecords(ctx, map[[fieldparams.BLSPubkeyLength]byte]*PerformanceRecord{
+			pubKey1: {Epoch: epoch, AttestationIncluded: true, CorrectHead: epoch%2 == 0, InclusionDelay: 1},
+			pubKey2: {Epoch: epoch, ProposalsAssigned: 1, ProposalsMade: 1},
+		}))
+	}
+
+	records, err := db.PerformanceRecords(ctx, [][fieldparams.BLSPubkeyLength]byte{pubKey1}, 2, 4)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	require.Equal(t, 3, len(records[pubKey1]))
+	for i, r := range records[pubKey1] {
+		assert.Equal(t, types.Epoch(i+2), r.Epoch)
+		assert.Equal(t, r.Epoch%2 == 0, r.CorrectHead)
+		assert.Equal(t, types.Slot(1), r.InclusionDelay)
+	}
+
+	// All validators are returned without public keys.
+	records, err = db.PerformanceRecords(ctx, nil, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, 5, len(records[pubKey2]))
+	assert.Equal(t, uint64(1), records[pubKey2][4].ProposalsMade)
+
+	// Saving the record of an epoch again overwrites it.
+	require.NoError(t, db.SavePerformanceRecords(ctx, map[[fieldparams.BLSPubkeyLength]byte]*PerformanceRecord{
+		pubKey2: {Epoch: 5, ProposalsAssigned: 1},
+	}))
+	records, err = db.PerformanceRecords(ctx, [][fieldparams.BLSPubkeyLength]byte{pubKey2}, 5, 5)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records[pubKey2]))
+	assert.Equal(t, uint64(0), records[pubKey2][0].ProposalsMade)
+
+	_, err = db.PerformanceRecords(ctx, nil, 5, 4)
+	require.ErrorContains(t, "start epoch 5 is after end epoch 4", err)
+}
+
+func TestStore_PrunePerformanceRecords(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	removedKey := [fieldparams.BLSPubkeyLength]byte{2}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	for _, epoch := range []types.Epoch{1, 2, 3} {
+		require.NoError(t, db.SavePerformanceRecords(ctx, map[[fieldparams.BLSPubkeyLength]byte]*PerformanceRecord{
+			pubKey:     {Epoch: epoch},
+			removedKey: {Epoch: epoch},
+		}))
+	}
+	require.NoError(t, db.SavePerformanceRecords(ctx, map[[fieldparams.BLSPubkeyLength]byte]*PerformanceRecord{
+		pubKey: {Epoch: PerformanceHistoryEpochs + 3},
+	}))
+	// Nothing is pruned before the history is full.
+	require.NoError(t, db.PrunePerformanceRecords(ctx, PerformanceHistoryEpochs-1))
+	records, err := db.PerformanceRecords(ctx, nil, 0, PerformanceHistoryEpochs+3)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(records[pubKey]))
+	assert.Equal(t, 3, len(records[removedKey]))
+
+	// The records of validators which are no longer saved are pruned too.
+	require.NoError(t, db.PrunePerformanceRecords(ctx, PerformanceHistoryEpochs+3))
+	records, err = db.PerformanceRecords(ctx, nil, 0, PerformanceHistoryEpochs+3)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records[pubKey]))
+	assert.Equal(t, types.Epoch(3), records[pubKey][0].Epoch)
+	assert.Equal(t, types.Epoch(PerformanceHistoryEpochs+3), records[pubKey][1].Epoch)
+	require.Equal(t, 1, len(records[removedKey]))
+
+	require.NoError(t, db.PrunePerformanceRecords(ctx, PerformanceHistoryEpochs+4))
+	records, err = db.PerformanceRecords(ctx, nil, 0, PerformanceHistoryEpochs+4)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, 1, len(records[pubKey]))
+}
+
+func TestStore_PerformanceRecords_ReadOnly(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.SavePerformanceRecords(ctx, map[[fieldparams.BLSPubkeyLength]byte]*PerformanceRecord{
+		pubKey: {Epoch: 1, CorrectHead: true},
+	}))
+	require.NoError(t, db.Close())
+
+	db, err = NewKVStore(ctx, dir, &Config{ReadOnly: true})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	records, err := db.PerformanceRecords(ctx, nil, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records[pubKey]))
+	assert.Equal(t, true, records[pubKey][0].CorrectHead)
+	err = db.SavePerformanceRecords(ctx, map[[fieldparams.BLSPubkeyLength]byte]*PerformanceRecord{
+		pubKey: {Epoch: 2},
+	})
+	require.ErrorContains(t, "read-only", err)
+
+	_, err = NewKVStore(ctx, t.TempDir(), &Config{ReadOnly: true})
+	require.ErrorContains(t, "no database found", err)
+}
+
+func TestSummarizePerformance(t *testing.T) {
+	summary := SummarizePerformance([]*PerformanceRecord{
+		{Epoch: 1, AttestationIncluded: true, CorrectSource: true, CorrectTarget: true, CorrectHead: true, InclusionDelay: 1, BalanceBefore: 100, BalanceAfter: 110},
+		{Epoch: 2, ProposalsAssigned: 1, SyncMessagesExpected: 32, SyncMessagesSubmitted: 30, BalanceBefore: 110, BalanceAfter: 105},
+		{Epoch: 3, AttestationIncluded: true, CorrectSource: true, InclusionDelay: 4, ProposalsAssigned: 1, ProposalsMade: 1, BalanceBefore: 105, BalanceAfter: 90},
+	})
+	assert.DeepEqual(t, &PerformanceSummary{
+		Epochs:                3,
+		AttestationsIncluded:  2,
+		CorrectSource:         2,
+		CorrectTarget:         1,
+		CorrectHead:           1,
+		ProposalsAssigned:     2,
+		ProposalsMade:         1,
+		SyncMessagesExpected:  32,
+		SyncMessagesSubmitted: 30,
+		AverageInclusionDelay: 2.5,
+		BalanceChange:         -10,
+	}, summary)
+	assert.DeepEqual(t, &PerformanceSummary{}, SummarizePerformance(nil))
+}
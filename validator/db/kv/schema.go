@@ -34,6 +34,16 @@ var (
 	// Graffiti
 	graffitiBucket = []byte("graffiti")
 
+	// Graffiti set for individual validators through the keymanager API.
+	graffitiByPubKeyBucket = []byte("graffiti-by-pubkey")
+
+	// Performance history of individual validators, by epoch.
+	performanceBucket = []byte("performance-history")
+
+	// Head of the signing audit log.
+	signingAuditLogBucket  = []byte("signing-audit-log")
+	signingAuditLogHeadKey = []byte("head")
+
 	// Graffiti ordered index and hash keys
 	graffitiOrderedIndexKey = []byte("graffiti-ordered-index")
 	graffitiFileHashKey     = []byte("graffiti-file-hash")
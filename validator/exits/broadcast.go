@@ -0,0 +1,344 @@
+package exits
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "exits")
+
+// ProgressFileName is the name of the file recording the progress of a broadcast in the directory of the exits.
+const ProgressFileName = "broadcast_progress.json"
+
+// progressLogInterval is the number of exits broadcast between two progress logs.
+const progressLogInterval = 100
+
+// SubmitFunc submits a signed voluntary exit to a beacon node.
+type SubmitFunc func(ctx context.Context, signedExit *ethpb.SignedVoluntaryExit) error
+
+// Progress of the broadcast of voluntary exits. It is safe for concurrent use, so that the progress may
+// be reported while the exits are broadcast.
+type Progress struct {
+	lock      sync.RWMutex
+	dir       string
+	exits     map[types.ValidatorIndex]bool
+	running   bool
+	submitted map[types.ValidatorIndex]bool
+	failed    map[types.ValidatorIndex]string
+	// held are the exits of future epochs, which beacon nodes reject until their epoch, by validator index.
+	held map[types.ValidatorIndex]types.Epoch
+}
+
+// Report is a snapshot of the progress of a broadcast.
+type Report struct {
+	Total     int  `json:"total"`
+	Submitted int  `json:"submitted"`
+	Failed    int  `json:"failed"`
+	Pending   int  `json:"pending"`
+	Running   bool `json:"running"`
+	// Held is the number of pending exits of epochs which have not started yet.
+	Held int `json:"held,omitempty"`
+	// Errors of the failed exits by validator index.
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// progressFile is the content of the progress file, from which an interrupted broadcast is resumed.
+type progressFile struct {
+	Submitted []types.ValidatorIndex          `json:"submitted"`
+	Failed    map[types.ValidatorIndex]string `json:"failed"`
+}
+
+// NewProgress returns the progress of a broadcast which has not started.
+func NewProgress() *Progress {
+	return &Progress{
+		submitted: make(map[types.ValidatorIndex]bool),
+		failed:    make(map[types.ValidatorIndex]string),
+		held:      make(map[types.ValidatorIndex]types.Epoch),
+	}
+}
+
+// LoadProgress reads the progress of a previous broadcast of the exits of a directory, if any. The progress
+// is saved back to the directory while the exits are broadcast.
+func LoadProgress(dir string) (*Progress, error) {
+	p := NewProgress()
+	p.dir = dir
+	path := filepath.Join(dir, ProgressFileName)
+	if !file.FileExists(path) {
+		return p, nil
+	}
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, err
+	}
+	f := &progressFile{}
+	if err := json.Unmarshal(enc, f); err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s", path)
+	}
+	for _, index := range f.Submitted {
+		p.submitted[index] = true
+	}
+	for index, msg := range f.Failed {
+		p.failed[index] = msg
+	}
+	return p, nil
+}
+
+// save writes the progress to the directory it was loaded from, if any.
+func (p *Progress) save() error {
+	if p.dir == "" {
+		return nil
+	}
+	p.lock.RLock()
+	f := &progressFile{
+		Submitted: make([]types.ValidatorIndex, 0, len(p.submitted)),
+		Failed:    make(map[types.ValidatorIndex]string, len(p.failed)),
+	}
+	for index := range p.submitted {
+		f.Submitted = append(f.Submitted, index)
+	}
+	for index, msg := range p.failed {
+		f.Failed[index] = msg
+	}
+	p.lock.RUnlock()
+	sort.Slice(f.Submitted, func(i, j int) bool {
+		return f.Submitted[i] < f.Submitted[j]
+	})
+	enc, err := json.MarshalIndent(f, "", "\t")
+	if err != nil {
+		return err
+	}
+	return file.WriteFileAtomic(filepath.Join(p.dir, ProgressFileName), enc)
+}
+
+// Running returns true while exits are being broadcast.
+func (p *Progress) Running() bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.running
+}
+
+// Report returns a snapshot of the progress.
+func (p *Progress) Report() *Report {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	r := &Report{
+		Total:   len(p.exits),
+		Failed:  len(p.failed),
+		Running: p.running,
+	}
+	for index := range p.exits {
+		if p.submitted[index] {
+			r.Submitted++
+		} else if _, ok := p.held[index]; ok {
+			r.Held++
+		}
+	}
+	r.Pending = r.Total - r.Submitted - r.Failed
+	if len(p.failed) > 0 {
+		r.Errors = make(map[string]string, len(p.failed))
+		for index, msg := range p.failed {
+			r.Errors[strconv.FormatUint(uint64(index), 10)] = msg
+		}
+	}
+	return r
+}
+
+func (p *Progress) start(signedExits []*ethpb.SignedVoluntaryExit) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.running {
+		return errors.New("voluntary exits are already being broadcast")
+	}
+	p.running = true
+	p.exits = make(map[types.ValidatorIndex]bool, len(signedExits))
+	for _, signedExit := range signedExits {
+		p.exits[signedExit.Exit.ValidatorIndex] = true
+	}
+	// Failed exits are retried.
+	p.failed = make(map[types.ValidatorIndex]string)
+	p.held = make(map[types.ValidatorIndex]types.Epoch)
+	return nil
+}
+
+func (p *Progress) isSubmitted(index types.ValidatorIndex) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.submitted[index]
+}
+
+// hold an exit until its epoch.
+func (p *Progress) hold(index types.ValidatorIndex, epoch types.Epoch) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.held[index] = epoch
+}
+
+// nextHeldEpoch returns the earliest epoch of the held exits, if any.
+func (p *Progress) nextHeldEpoch() (types.Epoch, bool) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	var next types.Epoch
+	found := false
+	for _, epoch := range p.held {
+		if !found || epoch < next {
+			next = epoch
+			found = true
+		}
+	}
+	return next, found
+}
+
+func (p *Progress) record(index types.ValidatorIndex, err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.held, index)
+	if err != nil {
+		p.failed[index] = err.Error()
+		return
+	}
+	p.submitted[index] = true
+}
+
+func (p *Progress) stop() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.running = false
+}
+
+// Broadcast submits signed voluntary exits one after another and records the outcome of each in the
+// progress. The exits already submitted according to the progress are skipped, and those which failed
+// are retried, so that an interrupted broadcast may be resumed. The exits of epochs which have not started
+// yet according to the genesis time are held and left pending, for a later broadcast to submit them. It
+// stops early if the context is canceled. The progress is saved regularly if it was loaded from the
+// directory of the exits.
+func Broadcast(
+	ctx context.Context, signedExits []*ethpb.SignedVoluntaryExit, submit SubmitFunc, genesisTime time.Time, progress *Progress,
+) error {
+	if err := progress.start(signedExits); err != nil {
+		return err
+	}
+	defer progress.stop()
+	return broadcast(ctx, signedExits, submit, genesisTime, progress, false /* wait for held exits */)
+}
+
+// BroadcastInBackground is like Broadcast, but returns once the broadcast has started, and keeps running
+// until the held exits are submitted at the start of their epoch. An error is returned if exits are already
+// being broadcast with the same progress.
+func BroadcastInBackground(
+	ctx context.Context, signedExits []*ethpb.SignedVoluntaryExit, submit SubmitFunc, genesisTime time.Time, progress *Progress,
+) error {
+	if err := progress.start(signedExits); err != nil {
+		return err
+	}
+	go func() {
+		defer progress.stop()
+		if err := broadcast(ctx, signedExits, submit, genesisTime, progress, true /* wait for held exits */); err != nil {
+			log.WithError(err).Error("Could not broadcast voluntary exits")
+		}
+	}()
+	return nil
+}
+
+func broadcast(
+	ctx context.Context,
+	signedExits []*ethpb.SignedVoluntaryExit,
+	submit SubmitFunc,
+	genesisTime time.Time,
+	progress *Progress,
+	waitForHeld bool,
+) error {
+	for {
+		if err := submitReady(ctx, signedExits, submit, genesisTime, progress); err != nil {
+			return err
+		}
+		epoch, ok := progress.nextHeldEpoch()
+		if !ok || !waitForHeld {
+			break
+		}
+		start, err := epochStartTime(genesisTime, epoch)
+		if err != nil {
+			return err
+		}
+		log.WithFields(logrus.Fields{
+			"held":  progress.Report().Held,
+			"epoch": epoch,
+		}).Info("Holding voluntary exits until their epoch")
+		timer := time.NewTimer(time.Until(start))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if err := progress.save(); err != nil {
+				log.WithError(err).Error("Could not save broadcast progress")
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	r := progress.Report()
+	log.WithFields(logrus.Fields{
+		"submitted": r.Submitted,
+		"failed":    r.Failed,
+		"held":      r.Held,
+	}).Info("Broadcast voluntary exits")
+	return errors.Wrap(progress.save(), "could not save broadcast progress")
+}
+
+// submitReady submits the exits which are neither submitted nor held, holding those of future epochs.
+func submitReady(
+	ctx context.Context, signedExits []*ethpb.SignedVoluntaryExit, submit SubmitFunc, genesisTime time.Time, progress *Progress,
+) error {
+	for i, signedExit := range signedExits {
+		if ctx.Err() != nil {
+			if err := progress.save(); err != nil {
+				log.WithError(err).Error("Could not save broadcast progress")
+			}
+			return ctx.Err()
+		}
+		index := signedExit.Exit.ValidatorIndex
+		if progress.isSubmitted(index) {
+			continue
+		}
+		if signedExit.Exit.Epoch > slots.EpochsSinceGenesis(genesisTime) {
+			progress.hold(index, signedExit.Exit.Epoch)
+			continue
+		}
+		err := submit(ctx, signedExit)
+		if err != nil {
+			log.WithError(err).WithField("validatorIndex", index).Warn("Could not submit voluntary exit")
+		}
+		progress.record(index, err)
+		if (i+1)%progressLogInterval == 0 {
+			if err := progress.save(); err != nil {
+				log.WithError(err).Error("Could not save broadcast progress")
+			}
+			r := progress.Report()
+			log.WithFields(logrus.Fields{
+				"submitted": r.Submitted,
+				"failed":    r.Failed,
+				"pending":   r.Pending,
+			}).Info("Broadcasting voluntary exits")
+		}
+	}
+	return nil
+}
+
+// epochStartTime returns the time at which an epoch starts.
+func epochStartTime(genesisTime time.Time, epoch types.Epoch) (time.Time, error) {
+	slot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return slots.StartTime(uint64(genesisTime.Unix()), slot), nil
+}
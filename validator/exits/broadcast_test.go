@@ -0,0 +1,127 @@
+package exits
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+)
+
+// genesisTime is a genesis time far enough in the past for the exits of the tests to be valid.
+var genesisTime = time.Unix(0, 0)
+
+func TestBroadcast_Resumes(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	signedExits := []*ethpb.SignedVoluntaryExit{signedExit(1), signedExit(2), signedExit(3)}
+	require.NoError(t, Save(dir, signedExits))
+
+	var submitted []types.ValidatorIndex
+	submit := func(_ context.Context, signedExit *ethpb.SignedVoluntaryExit) error {
+		if signedExit.Exit.ValidatorIndex == 2 && len(submitted) < 2 {
+			return errors.New("beacon node unavailable")
+		}
+		submitted = append(submitted, signedExit.Exit.ValidatorIndex)
+		return nil
+	}
+	progress, err := LoadProgress(dir)
+	require.NoError(t, err)
+	require.NoError(t, Broadcast(ctx, signedExits, submit, genesisTime, progress))
+	assert.DeepEqual(t, &Report{
+		Total:     3,
+		Submitted: 2,
+		Failed:    1,
+		Errors:    map[string]string{"2": "beacon node unavailable"},
+	}, progress.Report())
+
+	// Resuming from the saved progress only retries the failed exit.
+	progress, err = LoadProgress(dir)
+	require.NoError(t, err)
+	require.NoError(t, Broadcast(ctx, signedExits, submit, genesisTime, progress))
+	assert.DeepEqual(t, []types.ValidatorIndex{1, 3, 2}, submitted)
+	assert.DeepEqual(t, &Report{Total: 3, Submitted: 3}, progress.Report())
+}
+
+func TestBroadcast_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	submit := func(_ context.Context, _ *ethpb.SignedVoluntaryExit) error {
+		cancel()
+		return nil
+	}
+	progress := NewProgress()
+	err := Broadcast(ctx, []*ethpb.SignedVoluntaryExit{signedExit(1), signedExit(2)}, submit, genesisTime, progress)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.DeepEqual(t, &Report{Total: 2, Submitted: 1, Pending: 1}, progress.Report())
+}
+
+func TestBroadcastInBackground_AlreadyRunning(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	submit := func(_ context.Context, _ *ethpb.SignedVoluntaryExit) error {
+		<-release
+		return nil
+	}
+	progress := NewProgress()
+	require.NoError(t, BroadcastInBackground(ctx, []*ethpb.SignedVoluntaryExit{signedExit(1)}, submit, genesisTime, progress))
+	assert.Equal(t, true, progress.Running())
+	err := BroadcastInBackground(ctx, []*ethpb.SignedVoluntaryExit{signedExit(2)}, submit, genesisTime, progress)
+	require.ErrorContains(t, "already being broadcast", err)
+
+	close(release)
+	for progress.Running() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.DeepEqual(t, &Report{Total: 1, Submitted: 1}, progress.Report())
+}
+
+func TestBroadcast_HoldsFutureExits(t *testing.T) {
+	ctx := context.Background()
+	currentEpoch := slots.EpochsSinceGenesis(genesisTime)
+	future := signedExit(2)
+	future.Exit.Epoch = currentEpoch + 1
+	var submitted []types.ValidatorIndex
+	submit := func(_ context.Context, signedExit *ethpb.SignedVoluntaryExit) error {
+		submitted = append(submitted, signedExit.Exit.ValidatorIndex)
+		return nil
+	}
+	progress := NewProgress()
+	require.NoError(t, Broadcast(ctx, []*ethpb.SignedVoluntaryExit{signedExit(1), future}, submit, genesisTime, progress))
+	assert.DeepEqual(t, []types.ValidatorIndex{1}, submitted)
+	assert.DeepEqual(t, &Report{Total: 2, Submitted: 1, Pending: 1, Held: 1}, progress.Report())
+}
+
+func TestBroadcastInBackground_SubmitsHeldExitsAtTheirEpoch(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SecondsPerSlot = 1
+	cfg.SlotsPerEpoch = 1
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	// The next epoch starts at the next second.
+	genesis := time.Unix(time.Now().Unix(), 0)
+	ready := signedExit(1)
+	ready.Exit.Epoch = 0
+	future := signedExit(2)
+	future.Exit.Epoch = slots.EpochsSinceGenesis(genesis) + 1
+	submitted := make(chan types.ValidatorIndex, 2)
+	submit := func(_ context.Context, signedExit *ethpb.SignedVoluntaryExit) error {
+		submitted <- signedExit.Exit.ValidatorIndex
+		return nil
+	}
+	progress := NewProgress()
+	require.NoError(t, BroadcastInBackground(ctx, []*ethpb.SignedVoluntaryExit{ready, future}, submit, genesis, progress))
+	assert.Equal(t, types.ValidatorIndex(1), <-submitted)
+	assert.Equal(t, types.ValidatorIndex(2), <-submitted)
+	for progress.Running() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.DeepEqual(t, &Report{Total: 2, Submitted: 2}, progress.Report())
+}
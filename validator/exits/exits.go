@@ -0,0 +1,138 @@
+// Package exits stores pre-signed voluntary exits in files, in the JSON format of the beacon node API,
+// and broadcasts them to a beacon node while tracking the progress of the broadcast.
+package exits
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+)
+
+const (
+	exitFilePrefix = "exit_"
+	exitFileSuffix = ".json"
+)
+
+// VoluntaryExitJSON is a voluntary exit in the JSON format of the beacon node API.
+type VoluntaryExitJSON struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// SignedVoluntaryExitJSON is a signed voluntary exit in the JSON format of the beacon node API, which
+// is the body of a request to submit it to the pool of a beacon node.
+type SignedVoluntaryExitJSON struct {
+	Message   *VoluntaryExitJSON `json:"message"`
+	Signature string             `json:"signature"`
+}
+
+// ToJSON converts a signed voluntary exit to the JSON format of the beacon node API.
+func ToJSON(signedExit *ethpb.SignedVoluntaryExit) *SignedVoluntaryExitJSON {
+	return &SignedVoluntaryExitJSON{
+		Message: &VoluntaryExitJSON{
+			Epoch:          strconv.FormatUint(uint64(signedExit.Exit.Epoch), 10),
+			ValidatorIndex: strconv.FormatUint(uint64(signedExit.Exit.ValidatorIndex), 10),
+		},
+		Signature: hexutil.Encode(signedExit.Signature),
+	}
+}
+
+// FromJSON converts a signed voluntary exit in the JSON format of the beacon node API.
+func FromJSON(signedExit *SignedVoluntaryExitJSON) (*ethpb.SignedVoluntaryExit, error) {
+	if signedExit == nil || signedExit.Message == nil {
+		return nil, errors.New("missing voluntary exit message")
+	}
+	epoch, err := strconv.ParseUint(signedExit.Message.Epoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid exit epoch")
+	}
+	index, err := strconv.ParseUint(signedExit.Message.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid validator index")
+	}
+	sig, err := hexutil.Decode(signedExit.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature")
+	}
+	if len(sig) != fieldparams.BLSSignatureLength {
+		return nil, fmt.Errorf("signature is %d bytes long, expected %d", len(sig), fieldparams.BLSSignatureLength)
+	}
+	return &ethpb.SignedVoluntaryExit{
+		Exit: &ethpb.VoluntaryExit{
+			Epoch:          types.Epoch(epoch),
+			ValidatorIndex: types.ValidatorIndex(index),
+		},
+		Signature: sig,
+	}, nil
+}
+
+// FileName of the file storing the voluntary exit of a validator.
+func FileName(index types.ValidatorIndex) string {
+	return fmt.Sprintf("%s%d%s", exitFilePrefix, index, exitFileSuffix)
+}
+
+// Save writes signed voluntary exits to a directory, one file per validator.
+func Save(dir string, signedExits []*ethpb.SignedVoluntaryExit) error {
+	hasDir, err := file.HasDir(dir)
+	if err != nil {
+		return err
+	}
+	if !hasDir {
+		if err := file.MkdirAll(dir); err != nil {
+			return errors.Wrapf(err, "could not create directory %s", dir)
+		}
+	}
+	for _, signedExit := range signedExits {
+		enc, err := json.MarshalIndent(ToJSON(signedExit), "", "\t")
+		if err != nil {
+			return errors.Wrap(err, "could not encode voluntary exit")
+		}
+		if err := file.WriteFile(filepath.Join(dir, FileName(signedExit.Exit.ValidatorIndex)), enc); err != nil {
+			return errors.Wrapf(err, "could not write voluntary exit of validator %d", signedExit.Exit.ValidatorIndex)
+		}
+	}
+	return nil
+}
+
+// Load reads the signed voluntary exits saved in a directory, ordered by validator index.
+func Load(dir string) ([]*ethpb.SignedVoluntaryExit, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read directory %s", dir)
+	}
+	signedExits := make([]*ethpb.SignedVoluntaryExit, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, exitFilePrefix) || !strings.HasSuffix(name, exitFileSuffix) {
+			continue
+		}
+		enc, err := file.ReadFileAsBytes(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		signedExitJSON := &SignedVoluntaryExitJSON{}
+		if err := json.Unmarshal(enc, signedExitJSON); err != nil {
+			return nil, errors.Wrapf(err, "could not decode %s", name)
+		}
+		signedExit, err := FromJSON(signedExitJSON)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid voluntary exit in %s", name)
+		}
+		signedExits = append(signedExits, signedExit)
+	}
+	sort.Slice(signedExits, func(i, j int) bool {
+		return signedExits[i].Exit.ValidatorIndex < signedExits[j].Exit.ValidatorIndex
+	})
+	return signedExits, nil
+}
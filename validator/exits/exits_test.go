@@ -0,0 +1,56 @@
+package exits
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+)
+
+func signedExit(index types.ValidatorIndex) *ethpb.SignedVoluntaryExit {
+	sig := make([]byte, fieldparams.BLSSignatureLength)
+	sig[0] = byte(index)
+	return &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 100, ValidatorIndex: index},
+		Signature: sig,
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "exits")
+	signedExits := []*ethpb.SignedVoluntaryExit{signedExit(12), signedExit(3), signedExit(7)}
+	require.NoError(t, Save(dir, signedExits))
+	assert.Equal(t, true, fileExists(filepath.Join(dir, "exit_12.json")))
+	// Other files of the directory are ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ProgressFileName), []byte("{}"), 0600))
+
+	loaded, err := Load(dir)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(loaded))
+	for i, index := range []types.ValidatorIndex{3, 7, 12} {
+		assert.DeepEqual(t, signedExit(index), loaded[i])
+	}
+}
+
+func TestFromJSON(t *testing.T) {
+	_, err := FromJSON(&SignedVoluntaryExitJSON{})
+	require.ErrorContains(t, "missing voluntary exit message", err)
+	_, err = FromJSON(&SignedVoluntaryExitJSON{Message: &VoluntaryExitJSON{Epoch: "a", ValidatorIndex: "1"}})
+	require.ErrorContains(t, "invalid exit epoch", err)
+	_, err = FromJSON(&SignedVoluntaryExitJSON{Message: &VoluntaryExitJSON{Epoch: "1", ValidatorIndex: "1"}, Signature: "0x1234"})
+	require.ErrorContains(t, "signature is 2 bytes long", err)
+
+	converted, err := FromJSON(ToJSON(signedExit(5)))
+	require.NoError(t, err)
+	assert.DeepEqual(t, signedExit(5), converted)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
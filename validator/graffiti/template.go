@@ -0,0 +1,96 @@
+package graffiti
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+)
+
+// Variables of graffiti templates, written between braces such as {slot}.
+const (
+	// ClientVersionVar is the version of the validator client.
+	ClientVersionVar = "client_version"
+	// ExecutionClientVar is the name of the execution client of the beacon node, such as Geth.
+	ExecutionClientVar = "execution_client"
+	// SlotVar is the slot of the proposed block.
+	SlotVar = "slot"
+	// EpochVar is the epoch of the proposed block.
+	EpochVar = "epoch"
+	// ValidatorIndexVar is the index of the proposer.
+	ValidatorIndexVar = "validator_index"
+)
+
+// graffitiLength is the length in bytes of the graffiti of a block.
+const graffitiLength = 32
+
+var templateVarRegex = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+// TemplateData holds the values of the variables of graffiti templates when proposing a block.
+type TemplateData struct {
+	ClientVersion   string
+	ExecutionClient string
+	Slot            types.Slot
+	ValidatorIndex  types.ValidatorIndex
+}
+
+func (d *TemplateData) value(name string) (string, bool) {
+	switch name {
+	case ClientVersionVar:
+		return d.ClientVersion, true
+	case ExecutionClientVar:
+		return d.ExecutionClient, true
+	case SlotVar:
+		return strconv.FormatUint(uint64(d.Slot), 10), true
+	case EpochVar:
+		return strconv.FormatUint(uint64(slots.ToEpoch(d.Slot)), 10), true
+	case ValidatorIndexVar:
+		return strconv.FormatUint(uint64(d.ValidatorIndex), 10), true
+	default:
+		return "", false
+	}
+}
+
+// ValidateTemplate checks the variables of a graffiti template are all known,
+// and that its text without the variables fits in the graffiti of a block.
+func ValidateTemplate(template string) error {
+	for _, m := range templateVarRegex.FindAllStringSubmatch(template, -1) {
+		if _, ok := (&TemplateData{}).value(m[1]); !ok {
+			return fmt.Errorf("unknown graffiti template variable %s", m[0])
+		}
+	}
+	if n := len(templateVarRegex.ReplaceAllString(template, "")); n > graffitiLength {
+		return fmt.Errorf("graffiti is %d bytes long, more than the maximum of %d bytes", n, graffitiLength)
+	}
+	return nil
+}
+
+// UsesVariable returns true if a graffiti template refers to the given variable.
+func UsesVariable(template, name string) bool {
+	return strings.Contains(template, "{"+name+"}")
+}
+
+// ExpandTemplate replaces the variables of a graffiti template by their values, truncating
+// the result to the length of the graffiti of a block. Unknown variables are left as is, so
+// that graffiti written before templates existed are unchanged.
+func ExpandTemplate(template string, data *TemplateData) []byte {
+	expanded := templateVarRegex.ReplaceAllStringFunc(template, func(v string) string {
+		if value, ok := data.value(v[1 : len(v)-1]); ok {
+			return value
+		}
+		return v
+	})
+	if len(expanded) <= graffitiLength {
+		return []byte(expanded)
+	}
+	// Do not cut a multi-byte character in half.
+	end := graffitiLength
+	for end > 0 && !utf8.RuneStart(expanded[end]) {
+		end--
+	}
+	return []byte(expanded[:end])
+}
@@ -0,0 +1,47 @@
+package graffiti
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+)
+
+func TestExpandTemplate(t *testing.T) {
+	data := &TemplateData{
+		ClientVersion:   "v3.1.2",
+		ExecutionClient: "Geth",
+		Slot:            params.BeaconConfig().SlotsPerEpoch*2 + 1,
+		ValidatorIndex:  42,
+	}
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{template: "", want: ""},
+		{template: "no variables", want: "no variables"},
+		{template: "Prysm {client_version}/{execution_client}", want: "Prysm v3.1.2/Geth"},
+		{template: "{slot} {epoch} {validator_index}", want: "65 2 42"},
+		{template: "{unknown} {slot}", want: "{unknown} 65"},
+		{template: strings.Repeat("{slot}", 17), want: strings.Repeat("65", 16)},
+		{template: "0123456789012345678901234567890é", want: "0123456789012345678901234567890"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(ExpandTemplate(tt.template, data)))
+		})
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	assert.NoError(t, ValidateTemplate("Prysm {client_version} {execution_client} {slot} {epoch} {validator_index}"))
+	assert.NoError(t, ValidateTemplate("{slot}01234567890123456789012345678901"))
+	assert.ErrorContains(t, "unknown graffiti template variable {version}", ValidateTemplate("{version}"))
+	assert.ErrorContains(t, "more than the maximum of 32 bytes", ValidateTemplate("012345678901234567890123456789012"))
+}
+
+func TestUsesVariable(t *testing.T) {
+	assert.Equal(t, true, UsesVariable("Prysm {execution_client}", ExecutionClientVar))
+	assert.Equal(t, false, UsesVariable("Prysm {slot}", ExecutionClientVar))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/async/event"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
 	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
 	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/iface"
@@ -89,6 +90,19 @@ func (km *Keymanager) ExtractKeystores(
 	return km.localKM.ExtractKeystores(ctx, publicKeys, password)
 }
 
+// ExportKeystores retrieves the secret keys for specified public keys and encrypts
+// each of them into an EIP-2335 keystore with its own password.
+func (km *Keymanager) ExportKeystores(
+	ctx context.Context, publicKeys []bls.PublicKey, passwords []string, kdf *keystore.KDFParams,
+) ([]*keymanager.Keystore, error) {
+	return km.localKM.ExportKeystores(ctx, publicKeys, passwords, kdf)
+}
+
+// Reencrypt the keystore of the derived keymanager with a new password.
+func (km *Keymanager) Reencrypt(ctx context.Context, newPassword string, kdf *keystore.KDFParams, savePassword func() error) error {
+	return km.localKM.Reencrypt(ctx, newPassword, kdf, savePassword)
+}
+
 // ValidatingAccountNames for the derived keymanager.
 func (km *Keymanager) ValidatingAccountNames(_ context.Context) ([]string, error) {
 	return km.localKM.ValidatingAccountNames()
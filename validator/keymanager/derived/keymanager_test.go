@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/testing/assert"
 	"github.com/prysmaticlabs/prysm/v3/testing/require"
 	mock "github.com/prysmaticlabs/prysm/v3/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
 	constant "github.com/prysmaticlabs/prysm/v3/validator/testing"
 	"github.com/tyler-smith/go-bip39"
 	util "github.com/wealdtech/go-eth2-util"
@@ -204,7 +205,7 @@ func TestDerivedKeymanager_Sign_NoPublicKeyInCache(t *testing.T) {
 	req := &validatorpb.SignRequest{
 		PublicKey: []byte("hello world"),
 	}
-	dr := &Keymanager{}
+	dr := &Keymanager{localKM: &local.Keymanager{}}
 	_, err := dr.Sign(context.Background(), req)
 	assert.ErrorContains(t, "no signing key found", err)
 }
@@ -13,14 +13,13 @@ import (
 )
 
 func TestLocalKeymanager_ExtractKeystores(t *testing.T) {
-	secretKeysCache = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)
-	dr := &Keymanager{}
+	dr := &Keymanager{secretKeysCache: make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)}
 	validatingKeys := make([]bls.SecretKey, 10)
 	for i := 0; i < len(validatingKeys); i++ {
 		secretKey, err := bls.RandKey()
 		require.NoError(t, err)
 		validatingKeys[i] = secretKey
-		secretKeysCache[bytesutil.ToBytes48(secretKey.PublicKey().Marshal())] = secretKey
+		dr.secretKeysCache[bytesutil.ToBytes48(secretKey.PublicKey().Marshal())] = secretKey
 	}
 	ctx := context.Background()
 	password := "password"
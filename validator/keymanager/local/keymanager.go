@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/async/event"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v3/runtime/interop"
@@ -23,12 +24,6 @@ import (
 	"go.opencensus.io/trace"
 )
 
-var (
-	lock              sync.RWMutex
-	orderedPublicKeys = make([][fieldparams.BLSPubkeyLength]byte, 0)
-	secretKeysCache   = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)
-)
-
 const (
 	// KeystoreFileNameFormat exposes the filename the keystore should be formatted in.
 	KeystoreFileNameFormat = "keystore-%d.json"
@@ -43,6 +38,12 @@ type Keymanager struct {
 	wallet              iface.Wallet
 	accountsStore       *accountStore
 	accountsChangedFeed *event.Feed
+	// kdf of the accounts keystore, kept when it is rewritten.
+	kdf *keystore.KDFParams
+	// lock guards the keys caches, used to speed up FetchValidatingPublicKeys and Sign.
+	lock              sync.RWMutex
+	orderedPublicKeys [][fieldparams.BLSPubkeyLength]byte
+	secretKeysCache   map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey
 }
 
 // SetupConfig includes configuration values for initializing
@@ -68,20 +69,13 @@ type AccountsKeystoreRepresentation struct {
 	Name    string                 `json:"name"`
 }
 
-// ResetCaches for the keymanager.
-func ResetCaches() {
-	lock.Lock()
-	orderedPublicKeys = make([][fieldparams.BLSPubkeyLength]byte, 0)
-	secretKeysCache = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)
-	lock.Unlock()
-}
-
 // NewKeymanager instantiates a new local keymanager from configuration options.
 func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	k := &Keymanager{
 		wallet:              cfg.Wallet,
 		accountsStore:       &accountStore{},
 		accountsChangedFeed: new(event.Feed),
+		secretKeysCache:     make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey),
 	}
 
 	if err := k.initializeAccountKeystore(ctx); err != nil {
@@ -108,6 +102,7 @@ type InteropKeymanagerConfig struct {
 func NewInteropKeymanager(_ context.Context, offset, numValidatorKeys uint64) (*Keymanager, error) {
 	k := &Keymanager{
 		accountsChangedFeed: new(event.Feed),
+		secretKeysCache:     make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey),
 	}
 	if numValidatorKeys == 0 {
 		return k, nil
@@ -116,15 +111,15 @@ func NewInteropKeymanager(_ context.Context, offset, numValidatorKeys uint64) (*
 	if err != nil {
 		return nil, errors.Wrap(err, "could not generate interop keys")
 	}
-	lock.Lock()
+	k.lock.Lock()
 	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, numValidatorKeys)
 	for i := uint64(0); i < numValidatorKeys; i++ {
 		publicKey := bytesutil.ToBytes48(publicKeys[i].Marshal())
 		pubKeys[i] = publicKey
-		secretKeysCache[publicKey] = secretKeys[i]
+		k.secretKeysCache[publicKey] = secretKeys[i]
 	}
-	orderedPublicKeys = pubKeys
-	lock.Unlock()
+	k.orderedPublicKeys = pubKeys
+	k.lock.Unlock()
 	return k, nil
 }
 
@@ -136,60 +131,60 @@ func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BL
 }
 
 // ValidatingAccountNames for a local keymanager.
-func (_ *Keymanager) ValidatingAccountNames() ([]string, error) {
-	lock.RLock()
-	names := make([]string, len(orderedPublicKeys))
-	for i, pubKey := range orderedPublicKeys {
+func (km *Keymanager) ValidatingAccountNames() ([]string, error) {
+	km.lock.RLock()
+	names := make([]string, len(km.orderedPublicKeys))
+	for i, pubKey := range km.orderedPublicKeys {
 		names[i] = petnames.DeterministicName(bytesutil.FromBytes48(pubKey), "-")
 	}
-	lock.RUnlock()
+	km.lock.RUnlock()
 	return names, nil
 }
 
 // Initialize public and secret key caches that are used to speed up the functions
 // FetchValidatingPublicKeys and Sign
 func (km *Keymanager) initializeKeysCachesFromKeystore() error {
-	lock.Lock()
-	defer lock.Unlock()
+	km.lock.Lock()
+	defer km.lock.Unlock()
 	count := len(km.accountsStore.PrivateKeys)
-	orderedPublicKeys = make([][fieldparams.BLSPubkeyLength]byte, count)
-	secretKeysCache = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey, count)
+	km.orderedPublicKeys = make([][fieldparams.BLSPubkeyLength]byte, count)
+	km.secretKeysCache = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey, count)
 	for i, publicKey := range km.accountsStore.PublicKeys {
 		publicKey48 := bytesutil.ToBytes48(publicKey)
-		orderedPublicKeys[i] = publicKey48
+		km.orderedPublicKeys[i] = publicKey48
 		secretKey, err := bls.SecretKeyFromBytes(km.accountsStore.PrivateKeys[i])
 		if err != nil {
 			return errors.Wrap(err, "failed to initialize keys caches from account keystore")
 		}
-		secretKeysCache[publicKey48] = secretKey
+		km.secretKeysCache[publicKey48] = secretKey
 	}
 	return nil
 }
 
 // FetchValidatingPublicKeys fetches the list of active public keys from the local account keystores.
-func (_ *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "keymanager.FetchValidatingPublicKeys")
 	defer span.End()
 
-	lock.RLock()
-	keys := orderedPublicKeys
+	km.lock.RLock()
+	keys := km.orderedPublicKeys
 	result := make([][fieldparams.BLSPubkeyLength]byte, len(keys))
 	copy(result, keys)
-	lock.RUnlock()
+	km.lock.RUnlock()
 	return result, nil
 }
 
 // FetchValidatingPrivateKeys fetches the list of private keys from the secret keys cache
 func (km *Keymanager) FetchValidatingPrivateKeys(ctx context.Context) ([][32]byte, error) {
-	lock.RLock()
-	defer lock.RUnlock()
-	privKeys := make([][32]byte, len(secretKeysCache))
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	privKeys := make([][32]byte, len(km.secretKeysCache))
 	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not retrieve public keys")
 	}
 	for i, pk := range pubKeys {
-		seckey, ok := secretKeysCache[pk]
+		seckey, ok := km.secretKeysCache[pk]
 		if !ok {
 			return nil, errors.New("Could not fetch private key")
 		}
@@ -199,7 +194,7 @@ func (km *Keymanager) FetchValidatingPrivateKeys(ctx context.Context) ([][32]byt
 }
 
 // Sign signs a message using a validator key.
-func (_ *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
 	ctx, span := trace.StartSpan(ctx, "keymanager.Sign")
 	defer span.End()
 
@@ -207,9 +202,9 @@ func (_ *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bl
 	if publicKey == nil {
 		return nil, errors.New("nil public key in request")
 	}
-	lock.RLock()
-	secretKey, ok := secretKeysCache[bytesutil.ToBytes48(publicKey)]
-	lock.RUnlock()
+	km.lock.RLock()
+	secretKey, ok := km.secretKeysCache[bytesutil.ToBytes48(publicKey)]
+	km.lock.RUnlock()
 	if !ok {
 		return nil, errors.New("no signing key found in keys cache")
 	}
@@ -231,12 +226,21 @@ func (km *Keymanager) initializeAccountKeystore(ctx context.Context) error {
 	// We extract the validator signing private key from the keystore
 	// by utilizing the password and initialize a new BLS secret key from
 	// its raw bytes.
+	if kdf, err := keystore.EIP2335KDFParams(keystoreFile.Crypto); err == nil && *kdf != *keystore.DefaultKDFParams() {
+		km.kdf = kdf
+	}
 	password := km.wallet.Password()
 	decryptor := keystorev4.New()
 	enc, err := decryptor.Decrypt(keystoreFile.Crypto, password)
 	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
-		return errors.Wrap(err, "wrong password for wallet entered")
-	} else if err != nil {
+		recovered, ok := km.recoverReencryptedKeystore(ctx, password)
+		if !ok {
+			return errors.Wrap(err, "wrong password for wallet entered")
+		}
+		keystoreFile = recovered
+		enc, err = decryptor.Decrypt(keystoreFile.Crypto, password)
+	}
+	if err != nil {
 		return errors.Wrap(err, "could not decrypt keystore")
 	}
 
@@ -307,7 +311,7 @@ func (km *Keymanager) CreateAccountsKeystore(
 	if err != nil {
 		return nil, err
 	}
-	cryptoFields, err := encryptor.Encrypt(encodedStore, km.wallet.Password())
+	cryptoFields, err := km.encryptAccounts(encodedStore, km.wallet.Password())
 	if err != nil {
 		return nil, errors.Wrap(err, "could not encrypt accounts")
 	}
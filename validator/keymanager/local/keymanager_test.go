@@ -163,8 +163,7 @@ func TestLocalKeymanager_Sign_NoPublicKeyInCache(t *testing.T) {
 	req := &validatorpb.SignRequest{
 		PublicKey: []byte("hello world"),
 	}
-	secretKeysCache = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)
-	dr := &Keymanager{}
+	dr := &Keymanager{secretKeysCache: make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)}
 	_, err := dr.Sign(context.Background(), req)
 	assert.ErrorContains(t, "no signing key found in keys cache", err)
 }
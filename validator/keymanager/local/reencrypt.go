@@ -0,0 +1,164 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// ReencryptedKeystoreFileName is the name of the re-encrypted accounts keystore while the new
+// wallet password is being saved, before it replaces the accounts keystore.
+const ReencryptedKeystoreFileName = "reencrypted-accounts.keystore.json"
+
+// Reencrypt encrypts the accounts keystore of the wallet with a new password, deriving its key
+// with the given function and cost. The new keystore is first written next to the current one,
+// then savePassword, if any, persists the new password and the new keystore atomically replaces
+// the current one. A crash at any point leaves a keystore which the wallet opens with either the
+// previous or the new password, as the re-encrypted keystore is recovered when the wallet is
+// opened with the new password. Keys imported afterwards are encrypted the same way.
+func (km *Keymanager) Reencrypt(ctx context.Context, newPassword string, kdf *keystore.KDFParams, savePassword func() error) error {
+	if err := kdf.Validate(); err != nil {
+		return err
+	}
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	encodedStore, err := json.MarshalIndent(km.accountsStore, "", "\t")
+	if err != nil {
+		return err
+	}
+	cryptoFields, err := keystore.EncryptEIP2335(encodedStore, newPassword, kdf)
+	if err != nil {
+		return errors.Wrap(err, "could not encrypt accounts")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	encryptor := keystorev4.New()
+	encoded, err := json.MarshalIndent(&AccountsKeystoreRepresentation{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Name:    encryptor.Name(),
+	}, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := km.wallet.WriteFileAtPath(ctx, AccountsPath, ReencryptedKeystoreFileName, encoded); err != nil {
+		return errors.Wrap(err, "could not write re-encrypted accounts keystore")
+	}
+	if savePassword != nil {
+		// The re-encrypted keystore is kept, as some of the password files may already hold the new password.
+		if err := savePassword(); err != nil {
+			return errors.Wrap(err, "could not save new wallet password")
+		}
+	}
+	// The password is changed before the keystore, which is reloaded with it once replaced.
+	km.wallet.SetPassword(newPassword)
+	if err := km.wallet.WriteFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName, encoded); err != nil {
+		return errors.Wrap(err, "could not write accounts keystore")
+	}
+	km.removeReencryptedKeystore()
+	km.kdf = kdf
+	log.WithField("kdf", kdf).Info("Re-encrypted accounts keystore")
+	return nil
+}
+
+// recoverReencryptedKeystore replaces the accounts keystore with the re-encrypted one if a
+// re-encryption was interrupted after the new wallet password was saved, returning the
+// recovered keystore or false if there is none the password decrypts.
+func (km *Keymanager) recoverReencryptedKeystore(ctx context.Context, password string) (*AccountsKeystoreRepresentation, bool) {
+	encoded, err := km.wallet.ReadFileAtPath(ctx, AccountsPath, ReencryptedKeystoreFileName)
+	if err != nil || len(encoded) == 0 {
+		return nil, false
+	}
+	keystoreFile := &AccountsKeystoreRepresentation{}
+	if err := json.Unmarshal(encoded, keystoreFile); err != nil {
+		return nil, false
+	}
+	if _, err := keystorev4.New().Decrypt(keystoreFile.Crypto, password); err != nil {
+		return nil, false
+	}
+	if err := km.wallet.WriteFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName, encoded); err != nil {
+		log.WithError(err).Error("Could not recover re-encrypted accounts keystore")
+		return nil, false
+	}
+	km.removeReencryptedKeystore()
+	log.Warn("Recovered accounts keystore of an interrupted wallet re-encryption")
+	return keystoreFile, true
+}
+
+// removeReencryptedKeystore deletes the re-encrypted accounts keystore once it replaced the current one.
+func (km *Keymanager) removeReencryptedKeystore() {
+	reencryptedPath := filepath.Join(km.wallet.AccountsDir(), AccountsPath, ReencryptedKeystoreFileName)
+	if err := os.Remove(reencryptedPath); err != nil && !os.IsNotExist(err) {
+		log.WithError(err).Errorf("Could not remove re-encrypted accounts keystore %s", reencryptedPath)
+	}
+}
+
+// ExportKeystores retrieves the secret keys of the specified public keys and encrypts
+// each of them into an EIP-2335 keystore with its own password, deriving the key with
+// the given function and cost.
+func (km *Keymanager) ExportKeystores(
+	_ context.Context, publicKeys []bls.PublicKey, passwords []string, kdf *keystore.KDFParams,
+) ([]*keymanager.Keystore, error) {
+	if len(passwords) != len(publicKeys) {
+		return nil, ErrMismatchedNumPasswords
+	}
+	if err := kdf.Validate(); err != nil {
+		return nil, err
+	}
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	encryptor := keystorev4.New()
+	keystores := make([]*keymanager.Keystore, len(publicKeys))
+	for i, pk := range publicKeys {
+		pubKeyBytes := pk.Marshal()
+		secretKey, ok := km.secretKeysCache[bytesutil.ToBytes48(pubKeyBytes)]
+		if !ok {
+			return nil, fmt.Errorf(
+				"secret key for public key %#x not found in cache",
+				pubKeyBytes,
+			)
+		}
+		cryptoFields, err := keystore.EncryptEIP2335(secretKey.Marshal(), passwords[i], kdf)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"could not encrypt secret key for public key %#x",
+				pubKeyBytes,
+			)
+		}
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return nil, err
+		}
+		keystores[i] = &keymanager.Keystore{
+			Crypto:  cryptoFields,
+			ID:      id.String(),
+			Pubkey:  fmt.Sprintf("%x", pubKeyBytes),
+			Version: encryptor.Version(),
+			Name:    encryptor.Name(),
+		}
+	}
+	return keystores, nil
+}
+
+// encryptAccounts encrypts the encoded accounts store with the key derivation function
+// of the wallet keystore, or the default one if the keystore was never re-encrypted.
+func (km *Keymanager) encryptAccounts(encodedStore []byte, password string) (map[string]interface{}, error) {
+	if km.kdf == nil {
+		return keystorev4.New().Encrypt(encodedStore, password)
+	}
+	return keystore.EncryptEIP2335(encodedStore, password, km.kdf)
+}
@@ -0,0 +1,148 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	mock "github.com/prysmaticlabs/prysm/v3/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestLocalKeymanager_Reencrypt(t *testing.T) {
+	ctx := context.Background()
+	wallet := &mock.Wallet{
+		Files:          make(map[string]map[string][]byte),
+		WalletPassword: password,
+	}
+	dr := &Keymanager{
+		wallet:        wallet,
+		accountsStore: &accountStore{},
+	}
+	_, err := dr.ImportKeystores(ctx, []*keymanager.Keystore{createRandomKeystore(t, password)}, []string{password})
+	require.NoError(t, err)
+
+	const newPassword = "newPassw0rd$2022"
+	kdf := &keystore.KDFParams{Function: keystore.ScryptKDF, Cost: 1024}
+	require.NoError(t, dr.Reencrypt(ctx, newPassword, kdf, nil))
+	assert.Equal(t, newPassword, wallet.Password())
+
+	// The keystore on disk is encrypted with the new password and key derivation function.
+	encoded, err := wallet.ReadFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName)
+	require.NoError(t, err)
+	accountsKeystore := &AccountsKeystoreRepresentation{}
+	require.NoError(t, json.Unmarshal(encoded, accountsKeystore))
+	params, err := keystore.EIP2335KDFParams(accountsKeystore.Crypto)
+	require.NoError(t, err)
+	assert.DeepEqual(t, kdf, params)
+	_, err = keystorev4.New().Decrypt(accountsKeystore.Crypto, password)
+	assert.ErrorContains(t, keymanager.IncorrectPasswordErrMsg, err)
+
+	// A keymanager opened with the new password loads the keys, and keeps the key derivation function on import.
+	reopened := &Keymanager{wallet: wallet, accountsStore: &accountStore{}}
+	require.NoError(t, reopened.initializeAccountKeystore(ctx))
+	assert.Equal(t, 1, len(reopened.accountsStore.PublicKeys))
+	_, err = reopened.ImportKeystores(ctx, []*keymanager.Keystore{createRandomKeystore(t, password)}, []string{password})
+	require.NoError(t, err)
+	encoded, err = wallet.ReadFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(encoded, accountsKeystore))
+	params, err = keystore.EIP2335KDFParams(accountsKeystore.Crypto)
+	require.NoError(t, err)
+	assert.DeepEqual(t, kdf, params)
+
+	err = dr.Reencrypt(ctx, newPassword, &keystore.KDFParams{Function: keystore.ScryptKDF, Cost: 1000}, nil)
+	assert.ErrorContains(t, "scrypt cost must be a power of 2", err)
+}
+
+func TestLocalKeymanager_Reencrypt_Interrupted(t *testing.T) {
+	ctx := context.Background()
+	wallet := &mock.Wallet{
+		Files:          make(map[string]map[string][]byte),
+		WalletPassword: password,
+	}
+	dr := &Keymanager{
+		wallet:        wallet,
+		accountsStore: &accountStore{},
+	}
+	_, err := dr.ImportKeystores(ctx, []*keymanager.Keystore{createRandomKeystore(t, password)}, []string{password})
+	require.NoError(t, err)
+
+	// The re-encryption is interrupted once the new password is saved, before the keystore is replaced.
+	const newPassword = "newPassw0rd$2022"
+	kdf := &keystore.KDFParams{Function: keystore.PBKDF2KDF, Cost: 1000}
+	err = dr.Reencrypt(ctx, newPassword, kdf, func() error {
+		return errors.New("interrupted")
+	})
+	require.ErrorContains(t, "interrupted", err)
+	assert.Equal(t, password, wallet.Password())
+
+	// The wallet still opens with the previous password.
+	reopened := &Keymanager{wallet: wallet, accountsStore: &accountStore{}}
+	require.NoError(t, reopened.initializeAccountKeystore(ctx))
+	assert.Equal(t, 1, len(reopened.accountsStore.PublicKeys))
+
+	// And with the new password, recovering the re-encrypted keystore.
+	wallet.SetPassword(newPassword)
+	reopened = &Keymanager{wallet: wallet, accountsStore: &accountStore{}}
+	require.NoError(t, reopened.initializeAccountKeystore(ctx))
+	assert.Equal(t, 1, len(reopened.accountsStore.PublicKeys))
+	encoded, err := wallet.ReadFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName)
+	require.NoError(t, err)
+	accountsKeystore := &AccountsKeystoreRepresentation{}
+	require.NoError(t, json.Unmarshal(encoded, accountsKeystore))
+	_, err = keystorev4.New().Decrypt(accountsKeystore.Crypto, newPassword)
+	require.NoError(t, err)
+
+	// A wrong password is still rejected.
+	wallet.SetPassword("wrong")
+	reopened = &Keymanager{wallet: wallet, accountsStore: &accountStore{}}
+	assert.ErrorContains(t, "wrong password for wallet entered", reopened.initializeAccountKeystore(ctx))
+}
+
+func TestLocalKeymanager_ExportKeystores(t *testing.T) {
+	ctx := context.Background()
+	wallet := &mock.Wallet{
+		Files:          make(map[string]map[string][]byte),
+		WalletPassword: password,
+	}
+	dr := &Keymanager{
+		wallet:        wallet,
+		accountsStore: &accountStore{},
+	}
+	imported := []*keymanager.Keystore{createRandomKeystore(t, password), createRandomKeystore(t, password)}
+	_, err := dr.ImportKeystores(ctx, imported, []string{password, password})
+	require.NoError(t, err)
+	pubKeys, err := dr.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	publicKeys := make([]bls.PublicKey, len(pubKeys))
+	for i, pk := range pubKeys {
+		publicKeys[i], err = bls.PublicKeyFromBytes(pk[:])
+		require.NoError(t, err)
+	}
+
+	passwords := []string{"first", "second"}
+	kdf := &keystore.KDFParams{Function: keystore.PBKDF2KDF, Cost: 1000}
+	keystores, err := dr.ExportKeystores(ctx, publicKeys, passwords, kdf)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keystores))
+	for i, ks := range keystores {
+		secretKey, err := keystorev4.New().Decrypt(ks.Crypto, passwords[i])
+		require.NoError(t, err)
+		sk, err := bls.SecretKeyFromBytes(secretKey)
+		require.NoError(t, err)
+		assert.DeepEqual(t, pubKeys[i][:], sk.PublicKey().Marshal())
+		params, err := keystore.EIP2335KDFParams(ks.Crypto)
+		require.NoError(t, err)
+		assert.DeepEqual(t, kdf, params)
+	}
+
+	_, err = dr.ExportKeystores(ctx, publicKeys, passwords[:1], kdf)
+	require.ErrorIs(t, err, ErrMismatchedNumPasswords)
+}
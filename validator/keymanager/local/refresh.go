@@ -38,8 +38,11 @@ func (km *Keymanager) listenForAccountChanges(ctx context.Context) {
 			log.WithError(err).Error("Could not close file watcher")
 		}
 	}()
-	if err := watcher.Add(accountsFilePath); err != nil {
-		log.WithError(err).Errorf("Could not add file %s to file watcher", accountsFilePath)
+	// The accounts file is replaced atomically by renaming a new file over it, which ends a watch
+	// of the file itself, so its directory is watched instead.
+	accountsDir := filepath.Dir(accountsFilePath)
+	if err := watcher.Add(accountsDir); err != nil {
+		log.WithError(err).Errorf("Could not add directory %s to file watcher", accountsDir)
 		return
 	}
 	ctx, cancel := context.WithCancel(ctx)
@@ -81,8 +84,11 @@ func (km *Keymanager) listenForAccountChanges(ctx context.Context) {
 	for {
 		select {
 		case event := <-watcher.Events:
-			// If a file was modified, we attempt to read that file
+			// If the accounts file was modified, we attempt to read that file
 			// and parse it into our accounts store.
+			if filepath.Clean(event.Name) != accountsFilePath || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
 			fileChangesChan <- event
 		case err := <-watcher.Errors:
 			log.WithError(err).Errorf("Could not watch for file changes for: %s", accountsFilePath)
@@ -0,0 +1,35 @@
+/*
+Package pkcs11 defines a keymanager implementation which keeps validating keys in a
+PKCS#11 token, such as a hardware security module, rather than in EIP-2335 keystores
+on disk.
+
+PKCS#11 has no mechanism for BLS12-381 signatures, so validating private keys are
+encrypted by the token with AES-GCM under a wrapping key, which is generated in the token
+on first use as a sensitive and non-extractable AES key labeled prysm-validator-wrapping-key:
+it never leaves the token. Each encrypted private key, bound to its public key as additional
+authenticated data, is stored as a generic secret key object of the token:
+
+	CKA_CLASS       CKO_SECRET_KEY
+	CKA_KEY_TYPE    CKK_GENERIC_SECRET
+	CKA_ID          BLS public key
+	CKA_LABEL       0x prefixed hex encoded BLS public key
+	CKA_VALUE       12 byte IV followed by the encrypted 32 byte BLS private key
+
+Private keys are only decrypted by the token to sign. For every signature, the key is
+decrypted within a guarded section serialized over the token session, used to sign and
+then wiped from memory: private keys are never cached by the keymanager. Keys are added
+to the token by importing EIP-2335 keystores through the keymanager API, and the token is
+accessed by logging in as its user with the wallet password as PIN.
+
+The keymanager is configured by a keymanageropts.json file with the following schema:
+
+	{
+	  "library": "/usr/lib/softhsm/libsofthsm2.so", // Path to the PKCS#11 module of the token.
+	  "token_label": "validator"                      // Label of the token holding the keys.
+	}
+
+It can be tested locally against SoftHSM, initializing a token with:
+
+	softhsm2-util --init-token --free --label validator --so-pin <so-pin> --pin <wallet-password>
+*/
+package pkcs11
@@ -0,0 +1,9 @@
+package pkcs11
+
+import "errors"
+
+var (
+	ErrNoPasswords            = errors.New("no passwords provided for keystores")
+	ErrMismatchedNumPasswords = errors.New("number of passwords does not match number of keystores")
+	errKeyNotFound            = errors.New("key not found in token")
+)
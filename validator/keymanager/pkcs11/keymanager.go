@@ -0,0 +1,331 @@
+package pkcs11
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	remoteutils "github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote-utils"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// KeymanagerOpts for a PKCS#11 keymanager.
+type KeymanagerOpts struct {
+	Library    string `json:"library"`
+	TokenLabel string `json:"token_label"`
+}
+
+// SetupConfig includes configuration values for initializing
+// a keymanager, such as the options and the PIN of the token.
+type SetupConfig struct {
+	Opts *KeymanagerOpts
+	Pin  string
+}
+
+// Keymanager implementation signing with validating keys held in a PKCS#11 token.
+type Keymanager struct {
+	opts                *KeymanagerOpts
+	token               token
+	lock                sync.RWMutex
+	pubKeys             [][fieldparams.BLSPubkeyLength]byte
+	accountsChangedFeed *event.Feed
+}
+
+// NewKeymanager instantiates a new PKCS#11 keymanager from configuration options,
+// logging in to the token with the given PIN.
+func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil || cfg.Opts.Library == "" || cfg.Opts.TokenLabel == "" {
+		return nil, errors.New("a PKCS#11 module and token label are required")
+	}
+	t, err := openToken(cfg.Opts.Library, cfg.Opts.TokenLabel, cfg.Pin)
+	if err != nil {
+		return nil, err
+	}
+	km, err := newKeymanager(cfg.Opts, t)
+	if err != nil {
+		if err := t.close(); err != nil {
+			log.WithError(err).Debug("Could not close token")
+		}
+		return nil, err
+	}
+	return km, nil
+}
+
+func newKeymanager(opts *KeymanagerOpts, t token) (*Keymanager, error) {
+	km := &Keymanager{
+		opts:                opts,
+		token:               t,
+		accountsChangedFeed: new(event.Feed),
+	}
+	if _, err := km.reloadPublicKeys(); err != nil {
+		return nil, err
+	}
+	return km, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.WithError(err).Error("Could not close keymanager config file")
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of a PKCS#11 keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("%s: %s\n", au.BrightMagenta("PKCS#11 module"), opts.Library))
+	b.WriteString(fmt.Sprintf("%s: %s\n", au.BrightMagenta("Token label"), opts.TokenLabel))
+	return b.String()
+}
+
+// Close logs out of the token and unloads the PKCS#11 module.
+func (km *Keymanager) Close() error {
+	return km.token.close()
+}
+
+// reloadPublicKeys lists the keys of the token, notifying subscribers if they changed.
+func (km *Keymanager) reloadPublicKeys() ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubKeys, err := km.token.publicKeys()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list keys of token")
+	}
+	sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) == -1 })
+	km.lock.Lock()
+	changed := len(km.pubKeys) != len(pubKeys)
+	for i := 0; !changed && i < len(pubKeys); i++ {
+		changed = km.pubKeys[i] != pubKeys[i]
+	}
+	km.pubKeys = pubKeys
+	km.lock.Unlock()
+	if changed {
+		log.Info(keymanager.KeysReloaded)
+		km.accountsChangedFeed.Send(copyPublicKeys(pubKeys))
+	}
+	return copyPublicKeys(pubKeys), nil
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys of the token.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	return copyPublicKeys(km.pubKeys), nil
+}
+
+// Sign signs a message with a validating key read from the token for the duration of the signature.
+func (km *Keymanager) Sign(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	var sig bls.Signature
+	err := km.token.withSecretKey(bytesutil.ToBytes48(req.PublicKey), func(secretKey []byte) error {
+		sk, err := bls.SecretKeyFromBytes(secretKey)
+		if err != nil {
+			return errors.Wrap(err, "invalid key in token")
+		}
+		defer zeroizeSecretKey(sk)
+		sig = sk.Sign(req.SigningRoot)
+		return nil
+	})
+	if errors.Is(err, errKeyNotFound) {
+		return nil, fmt.Errorf("no signing key found in token for public key %#x", req.PublicKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// ImportKeystores decrypts EIP-2335 keystores and stores their private keys in the token.
+func (km *Keymanager) ImportKeystores(
+	_ context.Context,
+	keystores []*keymanager.Keystore,
+	passwords []string,
+) ([]*ethpbservice.ImportedKeystoreStatus, error) {
+	if len(passwords) == 0 {
+		return nil, ErrNoPasswords
+	}
+	if len(passwords) != len(keystores) {
+		return nil, ErrMismatchedNumPasswords
+	}
+	existing := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	for _, pubKey := range pubKeys {
+		existing[pubKey] = true
+	}
+	decryptor := keystorev4.New()
+	statuses := make([]*ethpbservice.ImportedKeystoreStatus, len(keystores))
+	for i, ks := range keystores {
+		pubKey, err := km.importKeystore(decryptor, ks, passwords[i], existing)
+		switch {
+		case err != nil:
+			statuses[i] = &ethpbservice.ImportedKeystoreStatus{
+				Status:  ethpbservice.ImportedKeystoreStatus_ERROR,
+				Message: err.Error(),
+			}
+		case existing[pubKey]:
+			log.Warnf("Duplicate key in import will be ignored: %#x", pubKey)
+			statuses[i] = &ethpbservice.ImportedKeystoreStatus{
+				Status: ethpbservice.ImportedKeystoreStatus_DUPLICATE,
+			}
+		default:
+			existing[pubKey] = true
+			statuses[i] = &ethpbservice.ImportedKeystoreStatus{
+				Status: ethpbservice.ImportedKeystoreStatus_IMPORTED,
+			}
+		}
+	}
+	if _, err := km.reloadPublicKeys(); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// importKeystore stores the private key of a keystore in the token, unless it is already there.
+func (km *Keymanager) importKeystore(
+	decryptor *keystorev4.Encryptor, ks *keymanager.Keystore, password string, existing map[[fieldparams.BLSPubkeyLength]byte]bool,
+) ([fieldparams.BLSPubkeyLength]byte, error) {
+	secretKeyBytes, err := decryptor.Decrypt(ks.Crypto, password)
+	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+		return [fieldparams.BLSPubkeyLength]byte{}, fmt.Errorf("incorrect password for key 0x%s", ks.Pubkey)
+	} else if err != nil {
+		return [fieldparams.BLSPubkeyLength]byte{}, errors.Wrap(err, "could not decrypt keystore")
+	}
+	defer zeroize(secretKeyBytes)
+	sk, err := bls.SecretKeyFromBytes(secretKeyBytes)
+	if err != nil {
+		return [fieldparams.BLSPubkeyLength]byte{}, errors.Wrap(err, "invalid private key in keystore")
+	}
+	defer zeroizeSecretKey(sk)
+	pubKey := bytesutil.ToBytes48(sk.PublicKey().Marshal())
+	if ks.Pubkey != "" && ks.Pubkey != hex.EncodeToString(pubKey[:]) {
+		return [fieldparams.BLSPubkeyLength]byte{}, fmt.Errorf("public key 0x%s of keystore does not match its private key", ks.Pubkey)
+	}
+	if existing[pubKey] {
+		return pubKey, nil
+	}
+	if err := km.token.storeSecretKey(pubKey, secretKeyBytes); err != nil {
+		return [fieldparams.BLSPubkeyLength]byte{}, err
+	}
+	return pubKey, nil
+}
+
+// DeleteKeystores removes validating keys from the token, while their slashing
+// protection history is maintained in the database.
+func (km *Keymanager) DeleteKeystores(
+	_ context.Context, publicKeys [][]byte,
+) ([]*ethpbservice.DeletedKeystoreStatus, error) {
+	trackedPublicKeys := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	statuses := make([]*ethpbservice.DeletedKeystoreStatus, len(publicKeys))
+	for i, publicKey := range publicKeys {
+		pubKey := bytesutil.ToBytes48(publicKey)
+		if trackedPublicKeys[pubKey] {
+			statuses[i] = &ethpbservice.DeletedKeystoreStatus{
+				Status: ethpbservice.DeletedKeystoreStatus_NOT_ACTIVE,
+			}
+			continue
+		}
+		trackedPublicKeys[pubKey] = true
+		deleted, err := km.token.deleteSecretKey(pubKey)
+		switch {
+		case err != nil:
+			statuses[i] = &ethpbservice.DeletedKeystoreStatus{
+				Status:  ethpbservice.DeletedKeystoreStatus_ERROR,
+				Message: err.Error(),
+			}
+		case !deleted:
+			statuses[i] = &ethpbservice.DeletedKeystoreStatus{
+				Status: ethpbservice.DeletedKeystoreStatus_NOT_FOUND,
+			}
+		default:
+			log.WithField("publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(publicKey))).Info("Deleted validator key from token")
+			statuses[i] = &ethpbservice.DeletedKeystoreStatus{
+				Status: ethpbservice.DeletedKeystoreStatus_DELETED,
+			}
+		}
+	}
+	if _, err := km.reloadPublicKeys(); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime, such as when new validator accounts
+// are imported into the keymanager while the validator process is running.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ExtractKeystores is not supported for the PKCS#11 keymanager type.
+func (*Keymanager) ExtractKeystores(
+	_ context.Context, _ []bls.PublicKey, _ string,
+) ([]*keymanager.Keystore, error) {
+	return nil, errors.New("extracting keys not supported for a PKCS#11 keymanager")
+}
+
+// ListKeymanagerAccounts lists the validating public keys held by the token.
+func (km *Keymanager) ListKeymanagerAccounts(ctx context.Context, cfg keymanager.ListKeymanagerAccountConfig) error {
+	au := aurora.NewAurora(true)
+	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("pkcs11 token").Bold())
+	fmt.Printf(
+		"(configuration file path) %s\n",
+		au.BrightGreen(filepath.Join(cfg.WalletAccountsDir, cfg.KeymanagerConfigFileName)).Bold(),
+	)
+	fmt.Println(" ")
+	fmt.Printf("%s\n", au.BrightGreen("Configuration options").Bold())
+	fmt.Println(km.opts)
+	validatingPubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(validatingPubKeys) == 1 {
+		fmt.Print("Showing 1 validator account\n")
+	} else if len(validatingPubKeys) == 0 {
+		fmt.Print("No accounts found\n")
+		return nil
+	} else {
+		fmt.Printf("Showing %d validator accounts\n", len(validatingPubKeys))
+	}
+	remoteutils.DisplayRemotePublicKeys(validatingPubKeys)
+	return nil
+}
+
+func copyPublicKeys(pubKeys [][fieldparams.BLSPubkeyLength]byte) [][fieldparams.BLSPubkeyLength]byte {
+	c := make([][fieldparams.BLSPubkeyLength]byte, len(pubKeys))
+	copy(c, pubKeys)
+	return c
+}
@@ -0,0 +1,203 @@
+package pkcs11
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const testPassword = "passw0rd"
+
+// memToken is an in-memory token.
+type memToken struct {
+	lock   sync.Mutex
+	keys   map[[fieldparams.BLSPubkeyLength]byte][]byte
+	closed bool
+}
+
+func newMemToken() *memToken {
+	return &memToken{keys: make(map[[fieldparams.BLSPubkeyLength]byte][]byte)}
+}
+
+func (t *memToken) publicKeys() ([][fieldparams.BLSPubkeyLength]byte, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(t.keys))
+	for pubKey := range t.keys {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return pubKeys, nil
+}
+
+func (t *memToken) withSecretKey(pubKey [fieldparams.BLSPubkeyLength]byte, fn func(secretKey []byte) error) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	secretKey, ok := t.keys[pubKey]
+	if !ok {
+		return errKeyNotFound
+	}
+	return fn(append([]byte{}, secretKey...))
+}
+
+func (t *memToken) storeSecretKey(pubKey [fieldparams.BLSPubkeyLength]byte, secretKey []byte) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.keys[pubKey] = append([]byte{}, secretKey...)
+	return nil
+}
+
+func (t *memToken) deleteSecretKey(pubKey [fieldparams.BLSPubkeyLength]byte) (bool, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	_, ok := t.keys[pubKey]
+	delete(t.keys, pubKey)
+	return ok, nil
+}
+
+func (t *memToken) close() error {
+	t.closed = true
+	return nil
+}
+
+func testKeystore(t *testing.T, password string) (bls.SecretKey, *keymanager.Keystore) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	cryptoFields, err := keystorev4.New().Encrypt(secretKey.Marshal(), password)
+	require.NoError(t, err)
+	return secretKey, &keymanager.Keystore{
+		Crypto:  cryptoFields,
+		Pubkey:  hex.EncodeToString(secretKey.PublicKey().Marshal()),
+		Version: keystorev4.New().Version(),
+		Name:    keystorev4.New().Name(),
+	}
+}
+
+func TestKeymanager_ImportKeystores(t *testing.T) {
+	ctx := context.Background()
+	km, err := newKeymanager(&KeymanagerOpts{}, newMemToken())
+	require.NoError(t, err)
+	pubKeysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(pubKeysChan)
+	defer sub.Unsubscribe()
+
+	sk1, ks1 := testKeystore(t, testPassword)
+	_, ks2 := testKeystore(t, "other")
+	mismatched := *ks1
+	mismatched.Pubkey = hex.EncodeToString(make([]byte, fieldparams.BLSPubkeyLength))
+	statuses, err := km.ImportKeystores(
+		ctx,
+		[]*keymanager.Keystore{ks1, ks1, ks2, &mismatched},
+		[]string{testPassword, testPassword, testPassword, testPassword},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(statuses))
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_IMPORTED, statuses[0].Status)
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_DUPLICATE, statuses[1].Status)
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_ERROR, statuses[2].Status)
+	assert.Equal(t, true, strings.Contains(statuses[2].Message, "incorrect password"))
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_ERROR, statuses[3].Status)
+	assert.Equal(t, true, strings.Contains(statuses[3].Message, "does not match its private key"))
+
+	pubKeys := <-pubKeysChan
+	require.Equal(t, 1, len(pubKeys))
+	assert.DeepEqual(t, sk1.PublicKey().Marshal(), pubKeys[0][:])
+
+	statuses, err = km.ImportKeystores(ctx, []*keymanager.Keystore{ks1}, []string{testPassword})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_DUPLICATE, statuses[0].Status)
+
+	_, err = km.ImportKeystores(ctx, []*keymanager.Keystore{ks1}, nil)
+	require.ErrorIs(t, err, ErrNoPasswords)
+	_, err = km.ImportKeystores(ctx, []*keymanager.Keystore{ks1}, []string{testPassword, testPassword})
+	require.ErrorIs(t, err, ErrMismatchedNumPasswords)
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	ctx := context.Background()
+	tok := newMemToken()
+	km, err := newKeymanager(&KeymanagerOpts{}, tok)
+	require.NoError(t, err)
+	secretKey, ks := testKeystore(t, testPassword)
+	_, err = km.ImportKeystores(ctx, []*keymanager.Keystore{ks}, []string{testPassword})
+	require.NoError(t, err)
+
+	root := bytes.Repeat([]byte{1}, 32)
+	sig, err := km.Sign(ctx, &validatorpb.SignRequest{PublicKey: secretKey.PublicKey().Marshal(), SigningRoot: root})
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Sign(root).Marshal(), sig.Marshal())
+
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: make([]byte, fieldparams.BLSPubkeyLength), SigningRoot: root})
+	assert.ErrorContains(t, "no signing key found in token", err)
+
+	require.NoError(t, km.Close())
+	assert.Equal(t, true, tok.closed)
+}
+
+func TestKeymanager_DeleteKeystores(t *testing.T) {
+	ctx := context.Background()
+	km, err := newKeymanager(&KeymanagerOpts{}, newMemToken())
+	require.NoError(t, err)
+	sk1, ks1 := testKeystore(t, testPassword)
+	sk2, ks2 := testKeystore(t, testPassword)
+	_, err = km.ImportKeystores(ctx, []*keymanager.Keystore{ks1, ks2}, []string{testPassword, testPassword})
+	require.NoError(t, err)
+
+	statuses, err := km.DeleteKeystores(ctx, [][]byte{
+		sk1.PublicKey().Marshal(),
+		sk1.PublicKey().Marshal(),
+		make([]byte, fieldparams.BLSPubkeyLength),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(statuses))
+	assert.Equal(t, ethpbservice.DeletedKeystoreStatus_DELETED, statuses[0].Status)
+	assert.Equal(t, ethpbservice.DeletedKeystoreStatus_NOT_ACTIVE, statuses[1].Status)
+	assert.Equal(t, ethpbservice.DeletedKeystoreStatus_NOT_FOUND, statuses[2].Status)
+
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pubKeys))
+	assert.DeepEqual(t, sk2.PublicKey().Marshal(), pubKeys[0][:])
+}
+
+func TestKeymanager_ExtractKeystores(t *testing.T) {
+	km, err := newKeymanager(&KeymanagerOpts{}, newMemToken())
+	require.NoError(t, err)
+	_, err = km.ExtractKeystores(context.Background(), nil, testPassword)
+	assert.ErrorContains(t, "not supported", err)
+}
+
+func TestNewKeymanager_MissingOpts(t *testing.T) {
+	_, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{Library: "lib.so"}})
+	assert.ErrorContains(t, "a PKCS#11 module and token label are required", err)
+	_, err = NewKeymanager(context.Background(), &SetupConfig{
+		Opts: &KeymanagerOpts{Library: filepath.Join(t.TempDir(), "missing.so"), TokenLabel: "validator"},
+	})
+	assert.ErrorContains(t, "could not load PKCS#11 module", err)
+}
+
+func TestUnmarshalOptionsFile(t *testing.T) {
+	opts := &KeymanagerOpts{Library: "/usr/lib/softhsm/libsofthsm2.so", TokenLabel: "validator"}
+	enc, err := MarshalOptionsFile(context.Background(), opts)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "keymanageropts.json")
+	require.NoError(t, os.WriteFile(path, enc, 0600))
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	got, err := UnmarshalOptionsFile(f)
+	require.NoError(t, err)
+	assert.DeepEqual(t, opts, got)
+}
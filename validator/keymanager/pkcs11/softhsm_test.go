@@ -0,0 +1,115 @@
+package pkcs11
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	p11 "github.com/miekg/pkcs11"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+)
+
+// softHSMLibrary returns the path of the SoftHSM module, given by SOFTHSM2_LIB or at its usual location.
+func softHSMLibrary(t *testing.T) string {
+	paths := []string{os.Getenv("SOFTHSM2_LIB"), "/usr/lib/softhsm/libsofthsm2.so", "/usr/local/lib/softhsm/libsofthsm2.so"}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	t.Skip("SoftHSM is not installed, set SOFTHSM2_LIB to the path of libsofthsm2.so to run this test")
+	return ""
+}
+
+// setupSoftHSMToken initializes a SoftHSM token with the given label and user PIN in a temporary directory.
+func setupSoftHSMToken(t *testing.T, library, label, pin string) {
+	dir := t.TempDir()
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "tokens"), 0700))
+	require.NoError(t, os.WriteFile(conf, []byte(fmt.Sprintf("directories.tokendir = %s\n", filepath.Join(dir, "tokens"))), 0600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	ctx := p11.New(library)
+	require.NotNil(t, ctx)
+	defer ctx.Destroy()
+	require.NoError(t, ctx.Initialize())
+	defer func() {
+		require.NoError(t, ctx.Finalize())
+	}()
+	slots, err := ctx.GetSlotList(false)
+	require.NoError(t, err)
+	require.NotEqual(t, 0, len(slots))
+	const soPin = "so-pin"
+	require.NoError(t, ctx.InitToken(slots[0], soPin, label))
+	// Initializing the token reassigns the slot.
+	slots, err = ctx.GetSlotList(true)
+	require.NoError(t, err)
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		require.NoError(t, err)
+		if info.Label != label {
+			continue
+		}
+		session, err := ctx.OpenSession(slot, p11.CKF_SERIAL_SESSION|p11.CKF_RW_SESSION)
+		require.NoError(t, err)
+		require.NoError(t, ctx.Login(session, p11.CKU_SO, soPin))
+		require.NoError(t, ctx.InitPIN(session, pin))
+		require.NoError(t, ctx.Logout(session))
+		require.NoError(t, ctx.CloseSession(session))
+		return
+	}
+	t.Fatalf("initialized token %s not found", label)
+}
+
+func TestKeymanager_SoftHSM(t *testing.T) {
+	library := softHSMLibrary(t)
+	setupSoftHSMToken(t, library, "validator", testPassword)
+	ctx := context.Background()
+	opts := &KeymanagerOpts{Library: library, TokenLabel: "validator"}
+
+	_, err := NewKeymanager(ctx, &SetupConfig{Opts: opts, Pin: "wrong"})
+	assert.ErrorContains(t, "could not log in to token validator", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{Library: library, TokenLabel: "other"}, Pin: testPassword})
+	assert.ErrorContains(t, "no token with label other found", err)
+
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: opts, Pin: testPassword})
+	require.NoError(t, err)
+	secretKey, ks := testKeystore(t, testPassword)
+	statuses, err := km.ImportKeystores(ctx, []*keymanager.Keystore{ks}, []string{testPassword})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(statuses))
+	require.Equal(t, "", statuses[0].Message)
+	require.NoError(t, km.Close())
+
+	// Keys are persisted in the token.
+	km, err = NewKeymanager(ctx, &SetupConfig{Opts: opts, Pin: testPassword})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, km.Close())
+	}()
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pubKeys))
+	assert.DeepEqual(t, secretKey.PublicKey().Marshal(), pubKeys[0][:])
+
+	root := bytes.Repeat([]byte{1}, 32)
+	sig, err := km.Sign(ctx, &validatorpb.SignRequest{PublicKey: pubKeys[0][:], SigningRoot: root})
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Sign(root).Marshal(), sig.Marshal())
+
+	deleted, err := km.DeleteKeystores(ctx, [][]byte{pubKeys[0][:]})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(deleted))
+	pubKeys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(pubKeys))
+}
@@ -0,0 +1,327 @@
+package pkcs11
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	p11 "github.com/miekg/pkcs11"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+)
+
+const (
+	// wrappingKeyLabel is the label of the AES key of the token under which validating keys are encrypted.
+	wrappingKeyLabel = "prysm-validator-wrapping-key"
+	gcmIVLength      = 12
+	gcmTagBits       = 128
+)
+
+// token holding validating private keys.
+type token interface {
+	// publicKeys of the validating keys held by the token.
+	publicKeys() ([][fieldparams.BLSPubkeyLength]byte, error)
+	// withSecretKey calls fn with the private key of a public key, which must not be retained by fn.
+	withSecretKey(pubKey [fieldparams.BLSPubkeyLength]byte, fn func(secretKey []byte) error) error
+	// storeSecretKey adds a private key to the token.
+	storeSecretKey(pubKey [fieldparams.BLSPubkeyLength]byte, secretKey []byte) error
+	// deleteSecretKey removes a private key from the token, returning false if it was not found.
+	deleteSecretKey(pubKey [fieldparams.BLSPubkeyLength]byte) (bool, error)
+	close() error
+}
+
+// p11Token is a token accessed through a PKCS#11 module.
+type p11Token struct {
+	ctx *p11.Ctx
+	// lock serializes the use of the session, which PKCS#11 does not allow concurrently.
+	lock    sync.Mutex
+	session p11.SessionHandle
+	// wrappingKey is the sensitive and non-extractable AES key encrypting the validating keys.
+	wrappingKey p11.ObjectHandle
+}
+
+// openToken loads a PKCS#11 module and logs in as the user of the token with the given label.
+func openToken(library, label, pin string) (*p11Token, error) {
+	ctx := p11.New(library)
+	if ctx == nil {
+		return nil, fmt.Errorf("could not load PKCS#11 module %s", library)
+	}
+	if err := ctx.Initialize(); err != nil && !isError(err, p11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		ctx.Destroy()
+		return nil, errors.Wrap(err, "could not initialize PKCS#11 module")
+	}
+	t := &p11Token{ctx: ctx}
+	if err := t.login(label, pin); err != nil {
+		if err := ctx.Finalize(); err != nil {
+			log.WithError(err).Debug("Could not finalize PKCS#11 module")
+		}
+		ctx.Destroy()
+		return nil, err
+	}
+	if err := t.loadWrappingKey(); err != nil {
+		if err := t.close(); err != nil {
+			log.WithError(err).Debug("Could not close token")
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *p11Token) login(label, pin string) error {
+	slots, err := t.ctx.GetSlotList(true)
+	if err != nil {
+		return errors.Wrap(err, "could not list PKCS#11 slots")
+	}
+	for _, slot := range slots {
+		info, err := t.ctx.GetTokenInfo(slot)
+		if err != nil {
+			return errors.Wrapf(err, "could not get info of token in slot %d", slot)
+		}
+		if strings.TrimSpace(info.Label) != label {
+			continue
+		}
+		session, err := t.ctx.OpenSession(slot, p11.CKF_SERIAL_SESSION|p11.CKF_RW_SESSION)
+		if err != nil {
+			return errors.Wrapf(err, "could not open session with token %s", label)
+		}
+		if err := t.ctx.Login(session, p11.CKU_USER, pin); err != nil && !isError(err, p11.CKR_USER_ALREADY_LOGGED_IN) {
+			if err := t.ctx.CloseSession(session); err != nil {
+				log.WithError(err).Debug("Could not close PKCS#11 session")
+			}
+			return errors.Wrapf(err, "could not log in to token %s", label)
+		}
+		t.session = session
+		return nil
+	}
+	return fmt.Errorf("no token with label %s found", label)
+}
+
+// loadWrappingKey finds the wrapping key of the token, generating it on first use. The key never
+// leaves the token, which only uses it to encrypt and decrypt validating keys.
+func (t *p11Token) loadWrappingKey() error {
+	objects, err := t.findObjects([]*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_SECRET_KEY),
+		p11.NewAttribute(p11.CKA_KEY_TYPE, p11.CKK_AES),
+		p11.NewAttribute(p11.CKA_LABEL, wrappingKeyLabel),
+	})
+	if err != nil {
+		return err
+	}
+	if len(objects) > 0 {
+		t.wrappingKey = objects[0]
+		return nil
+	}
+	key, err := t.ctx.GenerateKey(t.session, []*p11.Mechanism{p11.NewMechanism(p11.CKM_AES_KEY_GEN, nil)}, []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_SECRET_KEY),
+		p11.NewAttribute(p11.CKA_KEY_TYPE, p11.CKK_AES),
+		p11.NewAttribute(p11.CKA_VALUE_LEN, 32),
+		p11.NewAttribute(p11.CKA_TOKEN, true),
+		p11.NewAttribute(p11.CKA_PRIVATE, true),
+		p11.NewAttribute(p11.CKA_SENSITIVE, true),
+		p11.NewAttribute(p11.CKA_EXTRACTABLE, false),
+		p11.NewAttribute(p11.CKA_ENCRYPT, true),
+		p11.NewAttribute(p11.CKA_DECRYPT, true),
+		p11.NewAttribute(p11.CKA_LABEL, wrappingKeyLabel),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not generate wrapping key in token")
+	}
+	t.wrappingKey = key
+	return nil
+}
+
+func (t *p11Token) publicKeys() ([][fieldparams.BLSPubkeyLength]byte, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	objects, err := t.findKeys(nil)
+	if err != nil {
+		return nil, err
+	}
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(objects))
+	for _, o := range objects {
+		attrs, err := t.ctx.GetAttributeValue(t.session, o, []*p11.Attribute{
+			p11.NewAttribute(p11.CKA_LABEL, nil),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read key attributes")
+		}
+		pubKey, err := hexutil.Decode(string(attrs[0].Value))
+		// Only keys stored by the keymanager are listed, other generic secrets of the token being ignored.
+		if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+			continue
+		}
+		pubKeys = append(pubKeys, bytesutil.ToBytes48(pubKey))
+	}
+	return pubKeys, nil
+}
+
+func (t *p11Token) withSecretKey(pubKey [fieldparams.BLSPubkeyLength]byte, fn func(secretKey []byte) error) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	objects, err := t.findKeys(pubKey[:])
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		return errKeyNotFound
+	}
+	attrs, err := t.ctx.GetAttributeValue(t.session, objects[0], []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_VALUE, nil),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not read encrypted key")
+	}
+	encrypted := attrs[0].Value
+	if len(encrypted) <= gcmIVLength {
+		return errors.New("encrypted key in token is too short")
+	}
+	params := p11.NewGCMParams(encrypted[:gcmIVLength], pubKey[:], gcmTagBits)
+	defer params.Free()
+	if err := t.ctx.DecryptInit(t.session, []*p11.Mechanism{p11.NewMechanism(p11.CKM_AES_GCM, params)}, t.wrappingKey); err != nil {
+		return errors.Wrap(err, "could not decrypt key")
+	}
+	secretKey, err := t.ctx.Decrypt(t.session, encrypted[gcmIVLength:])
+	if err != nil {
+		return errors.Wrap(err, "could not decrypt key")
+	}
+	defer zeroize(secretKey)
+	return fn(secretKey)
+}
+
+// storeSecretKey encrypts a private key with the wrapping key of the token, binding it to its public
+// key, and stores the IV followed by the ciphertext as a generic secret key object of the token.
+func (t *p11Token) storeSecretKey(pubKey [fieldparams.BLSPubkeyLength]byte, secretKey []byte) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	iv, err := t.ctx.GenerateRandom(t.session, gcmIVLength)
+	if err != nil {
+		return errors.Wrap(err, "could not generate IV")
+	}
+	params := p11.NewGCMParams(iv, pubKey[:], gcmTagBits)
+	defer params.Free()
+	if err := t.ctx.EncryptInit(t.session, []*p11.Mechanism{p11.NewMechanism(p11.CKM_AES_GCM, params)}, t.wrappingKey); err != nil {
+		return errors.Wrap(err, "could not encrypt key")
+	}
+	ciphertext, err := t.ctx.Encrypt(t.session, secretKey)
+	if err != nil {
+		return errors.Wrap(err, "could not encrypt key")
+	}
+	_, err = t.ctx.CreateObject(t.session, []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_SECRET_KEY),
+		p11.NewAttribute(p11.CKA_KEY_TYPE, p11.CKK_GENERIC_SECRET),
+		p11.NewAttribute(p11.CKA_TOKEN, true),
+		p11.NewAttribute(p11.CKA_PRIVATE, true),
+		// The value is already encrypted under the wrapping key, which is what keeps it from leaving
+		// the token in the clear, and must be readable for the keymanager to decrypt it when signing.
+		p11.NewAttribute(p11.CKA_SENSITIVE, false),
+		p11.NewAttribute(p11.CKA_EXTRACTABLE, true),
+		p11.NewAttribute(p11.CKA_ID, pubKey[:]),
+		p11.NewAttribute(p11.CKA_LABEL, keyLabel(pubKey[:])),
+		p11.NewAttribute(p11.CKA_VALUE, append(iv, ciphertext...)),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not store key in token")
+	}
+	return nil
+}
+
+func (t *p11Token) deleteSecretKey(pubKey [fieldparams.BLSPubkeyLength]byte) (bool, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	objects, err := t.findKeys(pubKey[:])
+	if err != nil {
+		return false, err
+	}
+	for _, o := range objects {
+		if err := t.ctx.DestroyObject(t.session, o); err != nil {
+			return false, errors.Wrap(err, "could not delete key from token")
+		}
+	}
+	return len(objects) > 0, nil
+}
+
+func (t *p11Token) close() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if err := t.ctx.Logout(t.session); err != nil {
+		log.WithError(err).Debug("Could not log out of token")
+	}
+	if err := t.ctx.CloseSession(t.session); err != nil {
+		log.WithError(err).Debug("Could not close PKCS#11 session")
+	}
+	err := t.ctx.Finalize()
+	t.ctx.Destroy()
+	return err
+}
+
+// findKeys returns the generic secret key objects holding encrypted validating keys, restricted to
+// the given public key if any. It must be called with the lock held.
+func (t *p11Token) findKeys(pubKey []byte) ([]p11.ObjectHandle, error) {
+	template := []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_SECRET_KEY),
+		p11.NewAttribute(p11.CKA_KEY_TYPE, p11.CKK_GENERIC_SECRET),
+	}
+	if pubKey != nil {
+		template = append(template,
+			p11.NewAttribute(p11.CKA_ID, pubKey),
+			p11.NewAttribute(p11.CKA_LABEL, keyLabel(pubKey)),
+		)
+	}
+	return t.findObjects(template)
+}
+
+// findObjects returns the objects of the token matching a template. It must be called with the lock held.
+func (t *p11Token) findObjects(template []*p11.Attribute) ([]p11.ObjectHandle, error) {
+	if err := t.ctx.FindObjectsInit(t.session, template); err != nil {
+		return nil, errors.Wrap(err, "could not search token")
+	}
+	var objects []p11.ObjectHandle
+	for {
+		found, _, err := t.ctx.FindObjects(t.session, 100)
+		if err != nil {
+			if err := t.ctx.FindObjectsFinal(t.session); err != nil {
+				log.WithError(err).Debug("Could not end token search")
+			}
+			return nil, errors.Wrap(err, "could not search token")
+		}
+		if len(found) == 0 {
+			break
+		}
+		objects = append(objects, found...)
+	}
+	if err := t.ctx.FindObjectsFinal(t.session); err != nil {
+		return nil, errors.Wrap(err, "could not end token search")
+	}
+	return objects, nil
+}
+
+func keyLabel(pubKey []byte) string {
+	return fmt.Sprintf("%#x", pubKey)
+}
+
+// zeroize wipes a private key from memory.
+func zeroize(secretKey []byte) {
+	for i := range secretKey {
+		secretKey[i] = 0
+	}
+}
+
+// zeroizer is implemented by the BLS secret keys which can be wiped from memory.
+type zeroizer interface {
+	Zeroize()
+}
+
+// zeroizeSecretKey wipes a BLS secret key from memory if its implementation supports it.
+func zeroizeSecretKey(sk bls.SecretKey) {
+	if z, ok := sk.(zeroizer); ok {
+		z.Zeroize()
+	}
+}
+
+func isError(err error, code uint) bool {
+	var p11Err p11.Error
+	return errors.As(err, &p11Err) && uint(p11Err) == code
+}
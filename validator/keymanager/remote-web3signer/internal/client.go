@@ -38,6 +38,7 @@ type SignatureResponse struct {
 type HttpSignerClient interface {
 	Sign(ctx context.Context, pubKey string, request SignRequestJson) (bls.Signature, error)
 	GetPublicKeys(ctx context.Context, url string) ([][48]byte, error)
+	GetServerStatus(ctx context.Context) (string, error)
 }
 
 // ApiClient a wrapper object around web3signer APIs. Please refer to the docs from Consensys' web3signer project.
@@ -121,16 +122,25 @@ func (client *ApiClient) ReloadSignerKeys(ctx context.Context) error {
 	return nil
 }
 
-// GetServerStatus is a wrapper method around the web3signer upcheck api
+// GetServerStatus is a wrapper method around the web3signer upcheck api.
+// The status is returned as plain text by web3signer, quoted statuses are unquoted.
 func (client *ApiClient) GetServerStatus(ctx context.Context) (string, error) {
 	const requestPath = "/upcheck"
 	resp, err := client.doRequest(ctx, http.MethodGet, client.BaseURL.String()+requestPath, nil /* no body needed on get request */)
 	if err != nil {
 		return "", err
 	}
-	var status string
-	if err := unmarshalResponse(resp.Body, &status); err != nil {
-		return "", err
+	defer closeBody(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("web3signer upcheck failed with status: %v", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read response body")
+	}
+	status := strings.TrimSpace(string(body))
+	if unquoted, err := strconv.Unquote(status); err == nil {
+		status = unquoted
 	}
 	return status, nil
 }
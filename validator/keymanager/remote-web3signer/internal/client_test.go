@@ -201,3 +201,23 @@ func TestClient_GetServerStatus_HappyPath(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Nil(t, err)
 }
+
+func TestClient_GetServerStatus_PlainText(t *testing.T) {
+	mock := &mockTransport{mockResponse: &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(bytes.NewReader([]byte("OK\n"))),
+	}}
+	u, err := url.Parse("http://example.com")
+	assert.NoError(t, err)
+	cl := internal.ApiClient{BaseURL: u, RestClient: &http.Client{Transport: mock}}
+	resp, err := cl.GetServerStatus(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "OK", resp)
+
+	mock.mockResponse = &http.Response{
+		StatusCode: 503,
+		Body:       io.NopCloser(bytes.NewReader([]byte("DOWN"))),
+	}
+	_, err = cl.GetServerStatus(context.Background())
+	assert.ErrorContains(t, err, "503")
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-playground/validator/v10"
@@ -40,6 +42,13 @@ type SetupConfig struct {
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys [][48]byte
+
+	// ListenForChanges periodically polls the web3signer's upcheck endpoint and, when PublicKeysURL
+	// is set, the public keys URL, notifying subscribers of any keys added or removed.
+	ListenForChanges bool
+	// KeyRefreshInterval is the time between two polls of the web3signer when listening for changes.
+	// It defaults to DefaultKeyRefreshInterval when unset, and a negative value disables polling.
+	KeyRefreshInterval time.Duration
 }
 
 // Keymanager defines the web3signer keymanager.
@@ -48,13 +57,17 @@ type Keymanager struct {
 	genesisValidatorsRoot []byte
 	publicKeysURL         string
 	providedPublicKeys    [][48]byte
+	fetchedPublicKeys     [][48]byte
 	accountsChangedFeed   *event.Feed
 	validator             *validator.Validate
 	publicKeysUrlCalled   bool
+	keysLock              sync.RWMutex
+	statusLock            sync.RWMutex
+	statusErr             error
 }
 
 // NewKeymanager instantiates a new web3signer key manager.
-func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if cfg.BaseEndpoint == "" || !bytesutil.IsValidRoot(cfg.GenesisValidatorsRoot) {
 		return nil, fmt.Errorf("invalid setup config, one or more configs are empty: BaseEndpoint: %v, GenesisValidatorsRoot: %#x", cfg.BaseEndpoint, cfg.GenesisValidatorsRoot)
 	}
@@ -62,7 +75,7 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create apiClient")
 	}
-	return &Keymanager{
+	km := &Keymanager{
 		client:                internal.HttpSignerClient(client),
 		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
 		accountsChangedFeed:   new(event.Feed),
@@ -70,24 +83,41 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		providedPublicKeys:    cfg.ProvidedPublicKeys,
 		validator:             validator.New(),
 		publicKeysUrlCalled:   false,
-	}, nil
+	}
+	if interval, ok := keyRefreshInterval(cfg); ok {
+		go km.listenForKeyChanges(ctx, interval)
+	}
+	return km, nil
 }
 
 // FetchValidatingPublicKeys fetches the validating public keys
 // from the remote server or from the provided keys if there are no existing public keys set
 // or provides the existing keys in the keymanager.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
-	if km.publicKeysURL != "" && !km.publicKeysUrlCalled {
+	km.keysLock.RLock()
+	fetch := km.publicKeysURL != "" && !km.publicKeysUrlCalled
+	km.keysLock.RUnlock()
+	if fetch {
 		providedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
 		if err != nil {
 			erroredResponsesTotal.Inc()
 			return nil, errors.Wrap(err, fmt.Sprintf("could not get public keys from remote server url: %v", km.publicKeysURL))
 		}
-		// makes sure that if the public keys are deleted the validator does not call URL again.
-		km.publicKeysUrlCalled = true
-		km.providedPublicKeys = providedPublicKeys
+		km.keysLock.Lock()
+		// The keys may have been fetched concurrently while the lock was released.
+		if !km.publicKeysUrlCalled {
+			// makes sure that if the public keys are deleted the validator does not call URL again.
+			km.publicKeysUrlCalled = true
+			km.providedPublicKeys = providedPublicKeys
+			km.fetchedPublicKeys = providedPublicKeys
+		}
+		keys := copyPublicKeys(km.providedPublicKeys)
+		km.keysLock.Unlock()
+		return keys, nil
 	}
-	return km.providedPublicKeys, nil
+	km.keysLock.RLock()
+	defer km.keysLock.RUnlock()
+	return copyPublicKeys(km.providedPublicKeys), nil
 }
 
 // Sign signs the message by using a remote web3signer server.
@@ -302,6 +332,7 @@ func (km *Keymanager) AddPublicKeys(ctx context.Context, pubKeys [][fieldparams.
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
+	km.keysLock.Lock()
 	importedRemoteKeysStatuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(pubKeys))
 	for i, pubKey := range pubKeys {
 		found := false
@@ -325,7 +356,9 @@ func (km *Keymanager) AddPublicKeys(ctx context.Context, pubKeys [][fieldparams.
 		}
 		log.Debug("Added pubkey to keymanager for web3signer", "pubkey", hexutil.Encode(pubKey[:]))
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	keys := copyPublicKeys(km.providedPublicKeys)
+	km.keysLock.Unlock()
+	km.accountsChangedFeed.Send(keys)
 	return importedRemoteKeysStatuses, nil
 }
 
@@ -334,8 +367,10 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
+	km.keysLock.Lock()
 	deletedRemoteKeysStatuses := make([]*ethpbservice.DeletedRemoteKeysStatus, len(pubKeys))
 	if len(km.providedPublicKeys) == 0 {
+		km.keysLock.Unlock()
 		for i := range deletedRemoteKeysStatuses {
 			deletedRemoteKeysStatuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
 				Status:  ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND,
@@ -363,6 +398,8 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 			}
 		}
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	keys := copyPublicKeys(km.providedPublicKeys)
+	km.keysLock.Unlock()
+	km.accountsChangedFeed.Send(keys)
 	return deletedRemoteKeysStatuses, nil
 }
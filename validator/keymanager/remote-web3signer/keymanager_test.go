@@ -22,6 +22,7 @@ import (
 type MockClient struct {
 	Signature       string
 	PublicKeys      []string
+	Status          string
 	isThrowingError bool
 }
 
@@ -47,6 +48,13 @@ func (mc *MockClient) GetPublicKeys(_ context.Context, _ string) ([][48]byte, er
 	return keys, nil
 }
 
+func (mc *MockClient) GetServerStatus(_ context.Context) (string, error) {
+	if mc.isThrowingError {
+		return "", fmt.Errorf("mock error")
+	}
+	return mc.Status, nil
+}
+
 func TestKeymanager_Sign(t *testing.T) {
 	client := &MockClient{
 		Signature: "0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9",
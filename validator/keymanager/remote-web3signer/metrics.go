@@ -66,4 +66,12 @@ var (
 		Name: "remote_web3signer_validator_registration_sign_requests_total",
 		Help: "Total number of validator registration sign requests",
 	})
+	web3signerUp = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "remote_web3signer_up",
+		Help: "1 if the last upcheck of the web3signer succeeded, 0 otherwise",
+	})
+	publicKeysCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "remote_web3signer_public_keys",
+		Help: "Number of public keys fetched from the web3signer public keys url",
+	})
 )
@@ -0,0 +1,183 @@
+package remote_web3signer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	log "github.com/sirupsen/logrus"
+)
+
+// upStatus is the status returned by the web3signer's upcheck endpoint when it is healthy.
+const upStatus = "OK"
+
+// DefaultKeyRefreshInterval is the time between two polls of the web3signer when
+// the keymanager listens for changes without an explicit refresh interval, such as
+// when it is initialized from a wallet.
+const DefaultKeyRefreshInterval = time.Minute
+
+// keyRefreshInterval returns the interval at which the web3signer should be polled,
+// and whether it should be polled at all.
+func keyRefreshInterval(cfg *SetupConfig) (time.Duration, bool) {
+	if !cfg.ListenForChanges || cfg.KeyRefreshInterval < 0 {
+		return 0, false
+	}
+	if cfg.KeyRefreshInterval == 0 {
+		return DefaultKeyRefreshInterval, true
+	}
+	return cfg.KeyRefreshInterval, true
+}
+
+// listenForKeyChanges polls the web3signer at every interval until the context is canceled,
+// checking its health and refreshing the public keys from the public keys URL if one is set.
+func (km *Keymanager) listenForKeyChanges(ctx context.Context, interval time.Duration) {
+	if err := km.upcheck(ctx); err != nil {
+		log.WithError(err).Warn("Web3signer is not healthy")
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := km.upcheck(ctx); err != nil {
+				log.WithError(err).Warn("Web3signer is not healthy")
+			}
+			if km.publicKeysURL == "" {
+				continue
+			}
+			if _, err := km.RefreshPublicKeys(ctx); err != nil {
+				log.WithError(err).Error("Could not refresh public keys from web3signer")
+			}
+		}
+	}
+}
+
+// RefreshPublicKeys fetches the public keys from the public keys URL and applies the keys added and
+// removed since the previous fetch to the keys in use, notifying the subscribers to account changes.
+// Keys added or deleted through the keymanager API are kept as they are.
+func (km *Keymanager) RefreshPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	if km.publicKeysURL == "" {
+		return nil, errors.New("no public keys url set for web3signer")
+	}
+	fetched, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
+	if err != nil {
+		erroredResponsesTotal.Inc()
+		return nil, errors.Wrapf(err, "could not get public keys from remote server url: %v", km.publicKeysURL)
+	}
+
+	km.keysLock.Lock()
+	fetchedAdded, fetchedRemoved := diffPublicKeys(km.fetchedPublicKeys, fetched)
+	km.fetchedPublicKeys = fetched
+	km.publicKeysUrlCalled = true
+	prev := km.providedPublicKeys
+	km.providedPublicKeys = applyPublicKeysDiff(prev, fetchedAdded, fetchedRemoved)
+	added, removed := diffPublicKeys(prev, km.providedPublicKeys)
+	keys := copyPublicKeys(km.providedPublicKeys)
+	km.keysLock.Unlock()
+
+	publicKeysCount.Set(float64(len(keys)))
+	if len(added) == 0 && len(removed) == 0 {
+		return keys, nil
+	}
+	log.WithFields(log.Fields{
+		"added":   formatPublicKeys(added),
+		"removed": formatPublicKeys(removed),
+	}).Info(keymanager.KeysReloaded)
+	km.accountsChangedFeed.Send(keys)
+	return keys, nil
+}
+
+// Status returns an error if the last health check of the web3signer failed.
+func (km *Keymanager) Status() error {
+	km.statusLock.RLock()
+	defer km.statusLock.RUnlock()
+	return km.statusErr
+}
+
+// upcheck queries the web3signer's upcheck endpoint, recording its health.
+func (km *Keymanager) upcheck(ctx context.Context) error {
+	status, err := km.client.GetServerStatus(ctx)
+	if err == nil && status != upStatus {
+		err = fmt.Errorf("web3signer upcheck returned status %q", status)
+	}
+	if err != nil {
+		web3signerUp.Set(0)
+	} else {
+		web3signerUp.Set(1)
+	}
+	km.statusLock.Lock()
+	km.statusErr = err
+	km.statusLock.Unlock()
+	return err
+}
+
+// diffPublicKeys returns the keys of next which are not in prev, and the keys of prev which are not in next.
+func diffPublicKeys(prev, next [][fieldparams.BLSPubkeyLength]byte) (added, removed [][fieldparams.BLSPubkeyLength]byte) {
+	prevSet := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(prev))
+	for _, k := range prev {
+		prevSet[k] = true
+	}
+	nextSet := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(next))
+	for _, k := range next {
+		nextSet[k] = true
+		if !prevSet[k] {
+			added = append(added, k)
+		}
+	}
+	for _, k := range prev {
+		if !nextSet[k] {
+			removed = append(removed, k)
+		}
+	}
+	sort.Slice(added, func(i, j int) bool { return bytes.Compare(added[i][:], added[j][:]) < 0 })
+	sort.Slice(removed, func(i, j int) bool { return bytes.Compare(removed[i][:], removed[j][:]) < 0 })
+	return added, removed
+}
+
+// applyPublicKeysDiff returns keys without the removed keys and with the added keys it does not contain yet.
+func applyPublicKeysDiff(keys, added, removed [][fieldparams.BLSPubkeyLength]byte) [][fieldparams.BLSPubkeyLength]byte {
+	removedSet := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(removed))
+	for _, k := range removed {
+		removedSet[k] = true
+	}
+	present := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(keys)+len(added))
+	applied := make([][fieldparams.BLSPubkeyLength]byte, 0, len(keys)+len(added))
+	for _, k := range keys {
+		if !removedSet[k] {
+			applied = append(applied, k)
+			present[k] = true
+		}
+	}
+	for _, k := range added {
+		if !present[k] {
+			applied = append(applied, k)
+			present[k] = true
+		}
+	}
+	return applied
+}
+
+func copyPublicKeys(keys [][fieldparams.BLSPubkeyLength]byte) [][fieldparams.BLSPubkeyLength]byte {
+	if keys == nil {
+		return nil
+	}
+	copied := make([][fieldparams.BLSPubkeyLength]byte, len(keys))
+	copy(copied, keys)
+	return copied
+}
+
+func formatPublicKeys(keys [][fieldparams.BLSPubkeyLength]byte) string {
+	formatted := make([]string, len(keys))
+	for i, k := range keys {
+		formatted[i] = fmt.Sprintf("%#x", k[:4])
+	}
+	return strings.Join(formatted, ",")
+}
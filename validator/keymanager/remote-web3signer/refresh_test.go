@@ -0,0 +1,180 @@
+package remote_web3signer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	refreshKey1 = "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	refreshKey2 = "0x8000091c2ae64ee414a54c1cc1fc67dec663408bc636cb86756e0200e41a75c8f86603f104f02c856983d2783116be13"
+	refreshKey3 = "0xb3a1a3e8a4b8c4e6f1a83ce8b8cb4a8a6e1a3c55ef27a9f7d6f4a4e3b5a2f1e2c3d4b5a6f7e8d9c0b1a2f3e4d5c6b7a8"
+)
+
+func newRefreshKeymanager(t *testing.T, client *MockClient) *Keymanager {
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		PublicKeysURL:         "http://example2.com/api/v1/eth2/publicKeys",
+	})
+	require.NoError(t, err)
+	km.client = client
+	return km
+}
+
+func toKey(t *testing.T, hexKey string) [fieldparams.BLSPubkeyLength]byte {
+	decoded, err := hexutil.Decode(hexKey)
+	require.NoError(t, err)
+	return bytesutil.ToBytes48(decoded)
+}
+
+func TestKeymanager_RefreshPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	client := &MockClient{PublicKeys: []string{refreshKey1}}
+	km := newRefreshKeymanager(t, client)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, [][48]byte{toKey(t, refreshKey1)}, keys)
+
+	keysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+
+	// Nothing is sent to subscribers when the keys did not change.
+	_, err = km.RefreshPublicKeys(ctx)
+	require.NoError(t, err)
+	select {
+	case <-keysChan:
+		t.Fatal("unexpected key change notification")
+	default:
+	}
+
+	// A key is added on the web3signer and another one is removed.
+	client.PublicKeys = []string{refreshKey2}
+	keys, err = km.RefreshPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, [][48]byte{toKey(t, refreshKey2)}, keys)
+	select {
+	case changed := <-keysChan:
+		assert.EqualValues(t, keys, changed)
+	case <-time.After(time.Second):
+		t.Fatal("expected key change notification")
+	}
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, [][48]byte{toKey(t, refreshKey2)}, keys)
+
+	// Errors leave the keys untouched.
+	client.isThrowingError = true
+	_, err = km.RefreshPublicKeys(ctx)
+	require.ErrorContains(t, "could not get public keys", err)
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, [][48]byte{toKey(t, refreshKey2)}, keys)
+}
+
+func TestKeymanager_RefreshPublicKeys_KeepsKeymanagerAPIChanges(t *testing.T) {
+	ctx := context.Background()
+	client := &MockClient{PublicKeys: []string{refreshKey1, refreshKey2}}
+	km := newRefreshKeymanager(t, client)
+	_, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	// A key is added and another one deleted through the keymanager API.
+	_, err = km.AddPublicKeys(ctx, [][48]byte{toKey(t, refreshKey3)})
+	require.NoError(t, err)
+	_, err = km.DeletePublicKeys(ctx, [][48]byte{toKey(t, refreshKey2)})
+	require.NoError(t, err)
+
+	keys, err := km.RefreshPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, [][48]byte{toKey(t, refreshKey1), toKey(t, refreshKey3)}, keys)
+
+	// Keys removed from the web3signer are still removed.
+	client.PublicKeys = []string{refreshKey2}
+	keys, err = km.RefreshPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, [][48]byte{toKey(t, refreshKey3)}, keys)
+}
+
+func TestApplyPublicKeysDiff(t *testing.T) {
+	k1, k2, k3 := [48]byte{1}, [48]byte{2}, [48]byte{3}
+	assert.EqualValues(t, [][48]byte{k2, k3}, applyPublicKeysDiff([][48]byte{k1, k2}, [][48]byte{k2, k3}, [][48]byte{k1}))
+	assert.EqualValues(t, [][48]byte{}, applyPublicKeysDiff(nil, nil, [][48]byte{k1}))
+}
+
+func TestKeymanager_Status(t *testing.T) {
+	ctx := context.Background()
+	client := &MockClient{Status: upStatus}
+	km := newRefreshKeymanager(t, client)
+	require.NoError(t, km.upcheck(ctx))
+	require.NoError(t, km.Status())
+
+	client.Status = "DOWN"
+	require.ErrorContains(t, "DOWN", km.upcheck(ctx))
+	require.ErrorContains(t, "DOWN", km.Status())
+
+	client.Status = upStatus
+	client.isThrowingError = true
+	require.ErrorContains(t, "mock error", km.upcheck(ctx))
+	require.ErrorContains(t, "mock error", km.Status())
+}
+
+func TestKeymanager_ListenForKeyChanges(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &MockClient{PublicKeys: []string{refreshKey1}, Status: upStatus}
+	km := newRefreshKeymanager(t, client)
+	_, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	keysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+	client.PublicKeys = []string{refreshKey1, refreshKey2}
+	go km.listenForKeyChanges(ctx, 10*time.Millisecond)
+
+	select {
+	case changed := <-keysChan:
+		assert.EqualValues(t, [][48]byte{toKey(t, refreshKey1), toKey(t, refreshKey2)}, changed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected key change notification")
+	}
+	require.NoError(t, km.Status())
+}
+
+func TestKeyRefreshInterval(t *testing.T) {
+	_, ok := keyRefreshInterval(&SetupConfig{KeyRefreshInterval: time.Second})
+	assert.Equal(t, false, ok)
+
+	interval, ok := keyRefreshInterval(&SetupConfig{ListenForChanges: true})
+	assert.Equal(t, true, ok)
+	assert.Equal(t, DefaultKeyRefreshInterval, interval)
+
+	interval, ok = keyRefreshInterval(&SetupConfig{ListenForChanges: true, KeyRefreshInterval: time.Second})
+	assert.Equal(t, true, ok)
+	assert.Equal(t, time.Second, interval)
+
+	_, ok = keyRefreshInterval(&SetupConfig{ListenForChanges: true, KeyRefreshInterval: -1})
+	assert.Equal(t, false, ok)
+}
+
+func TestDiffPublicKeys(t *testing.T) {
+	k1, k2, k3 := [48]byte{1}, [48]byte{2}, [48]byte{3}
+	added, removed := diffPublicKeys([][48]byte{k1, k2}, [][48]byte{k3, k2})
+	assert.EqualValues(t, [][48]byte{k3}, added)
+	assert.EqualValues(t, [][48]byte{k1}, removed)
+
+	added, removed = diffPublicKeys([][48]byte{k1}, [][48]byte{k1})
+	assert.Equal(t, 0, len(added))
+	assert.Equal(t, 0, len(removed))
+}
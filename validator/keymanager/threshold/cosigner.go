@@ -0,0 +1,284 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	fssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/network/forks"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"github.com/prysmaticlabs/prysm/v3/validator/db"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// signPath of the co-signer endpoint serving partial signatures.
+const signPath = "/sign"
+
+// maxRequestSize of a sign request accepted by a co-signer.
+const maxRequestSize = 1 << 22
+
+var (
+	errUnauthorized        = errors.New("missing or invalid auth token")
+	errUnknownKey          = errors.New("no key share for public key")
+	errSigningRootMismatch = errors.New("signing root does not match the object to sign")
+	errSlashable           = errors.New("signing request is slashable")
+)
+
+// SignResponse of a co-signer, holding its hex encoded partial signature.
+type SignResponse struct {
+	Signature string `json:"signature"`
+}
+
+// CosignerConfig includes configuration values for serving partial signatures
+// with the key shares of a participant.
+type CosignerConfig struct {
+	Opts                  *KeymanagerOpts
+	Password              string
+	DB                    db.Database
+	GenesisValidatorsRoot []byte
+}
+
+// CosignerServer serves partial signatures with the key shares of a participant over HTTP.
+// It only serves requests bearing the auth token of the participant, and only signs them once
+// it has derived their signature domain and signing root from the object to sign, the fork
+// schedule of the network and its genesis validators root. Slashing protection is applied to
+// blocks and attestations, so that a compromised participant cannot obtain a slashable
+// signature from the others.
+type CosignerServer struct {
+	shares                map[[fieldparams.BLSPubkeyLength]byte]*share
+	db                    db.Database
+	authToken             string
+	genesisValidatorsRoot []byte
+	// lock serializes slashing protection checks and the history updates which follow them.
+	lock sync.Mutex
+}
+
+// NewCosignerServer instantiates a co-signer from configuration options, decrypting the key shares with the password.
+func NewCosignerServer(cfg *CosignerConfig) (*CosignerServer, error) {
+	if cfg.DB == nil {
+		return nil, errors.New("a slashing protection database is required")
+	}
+	if !bytesutil.IsValidRoot(cfg.GenesisValidatorsRoot) {
+		return nil, fmt.Errorf("invalid genesis validators root %#x", cfg.GenesisValidatorsRoot)
+	}
+	shares, err := loadShares(cfg.Opts, cfg.Password)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Opts.AuthToken == "" {
+		return nil, errors.New("an auth token is required to serve partial signatures")
+	}
+	return &CosignerServer{
+		shares:                shares,
+		db:                    cfg.DB,
+		authToken:             cfg.Opts.AuthToken,
+		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
+	}, nil
+}
+
+// ServeHTTP handles partial signature requests, a JSON encoded sign request being posted to /sign
+// with the auth token of the participant as a bearer token.
+func (c *CosignerServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !c.authorized(r) {
+		http.Error(w, errUnauthorized.Error(), http.StatusUnauthorized)
+		return
+	}
+	if r.URL.Path != signPath {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, "could not read request", http.StatusBadRequest)
+		return
+	}
+	req := &validatorpb.SignRequest{}
+	if err := protojson.Unmarshal(body, req); err != nil {
+		http.Error(w, fmt.Sprintf("could not decode sign request: %v", err), http.StatusBadRequest)
+		return
+	}
+	sig, err := c.Sign(r.Context(), req)
+	switch {
+	case errors.Is(err, errUnknownKey):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, errSlashable):
+		log.WithError(err).WithField("publicKey", fmt.Sprintf("%#x", req.PublicKey)).Warn("Refused to sign slashable request")
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	case errors.Is(err, errSigningRootMismatch):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		log.WithError(err).Error("Could not sign request")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&SignResponse{Signature: fmt.Sprintf("%#x", sig)}); err != nil {
+		log.WithError(err).Error("Could not write sign response")
+	}
+}
+
+// authorized returns true if the request bears the auth token of the participant.
+func (c *CosignerServer) authorized(r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), bearerPrefix)
+	return subtle.ConstantTimeCompare([]byte(token), []byte(c.authToken)) == 1
+}
+
+// Sign returns the partial signature of a request by the share of the validating key,
+// once the request has been checked against the object to sign and the slashing protection history.
+func (c *CosignerServer) Sign(ctx context.Context, req *validatorpb.SignRequest) ([]byte, error) {
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	s, ok := c.shares[pubKey]
+	if !ok {
+		return nil, errors.Wrapf(errUnknownKey, "%#x", req.PublicKey)
+	}
+	obj, err := signedObject(req)
+	if err != nil {
+		return nil, errors.Wrap(errSigningRootMismatch, err.Error())
+	}
+	domain, err := signatureDomain(req, c.genesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(errSigningRootMismatch, err.Error())
+	}
+	if !bytes.Equal(domain, req.SignatureDomain) {
+		return nil, errors.Wrapf(errSigningRootMismatch, "signature domain %#x, expected %#x", req.SignatureDomain, domain)
+	}
+	signingRoot, err := signing.ComputeSigningRoot(obj, domain)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute signing root")
+	}
+	if !bytes.Equal(signingRoot[:], req.SigningRoot) {
+		return nil, errSigningRootMismatch
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		err = c.protectProposal(ctx, pubKey, o.Block.Slot, signingRoot)
+	case *validatorpb.SignRequest_BlockAltair:
+		err = c.protectProposal(ctx, pubKey, o.BlockAltair.Slot, signingRoot)
+	case *validatorpb.SignRequest_BlockBellatrix:
+		err = c.protectProposal(ctx, pubKey, o.BlockBellatrix.Slot, signingRoot)
+	case *validatorpb.SignRequest_BlindedBlockBellatrix:
+		err = c.protectProposal(ctx, pubKey, o.BlindedBlockBellatrix.Slot, signingRoot)
+	case *validatorpb.SignRequest_AttestationData:
+		err = c.protectAttestation(ctx, pubKey, o.AttestationData, signingRoot)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.secretKey.Sign(req.SigningRoot).Marshal(), nil
+}
+
+// signedObject returns the object of a sign request, whose hash tree root the signing root is computed from.
+func signedObject(req *validatorpb.SignRequest) (fssz.HashRoot, error) {
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		return o.Block, nil
+	case *validatorpb.SignRequest_BlockAltair:
+		return o.BlockAltair, nil
+	case *validatorpb.SignRequest_BlockBellatrix:
+		return o.BlockBellatrix, nil
+	case *validatorpb.SignRequest_BlindedBlockBellatrix:
+		return o.BlindedBlockBellatrix, nil
+	case *validatorpb.SignRequest_AttestationData:
+		return o.AttestationData, nil
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		return o.AggregateAttestationAndProof, nil
+	case *validatorpb.SignRequest_Exit:
+		return o.Exit, nil
+	case *validatorpb.SignRequest_Slot:
+		slot := types.SSZUint64(o.Slot)
+		return &slot, nil
+	case *validatorpb.SignRequest_Epoch:
+		epoch := types.SSZUint64(o.Epoch)
+		return &epoch, nil
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		return o.SyncAggregatorSelectionData, nil
+	case *validatorpb.SignRequest_ContributionAndProof:
+		return o.ContributionAndProof, nil
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		root := types.SSZBytes(o.SyncMessageBlockRoot)
+		return &root, nil
+	case *validatorpb.SignRequest_Registration:
+		return o.Registration, nil
+	case nil:
+		return nil, errors.New("sign request has no object")
+	default:
+		return nil, fmt.Errorf("unsupported sign request object %T", o)
+	}
+}
+
+// signatureDomain returns the domain a sign request must be signed with, derived from the domain type
+// of its object and the fork of the network at the epoch of the object.
+func signatureDomain(req *validatorpb.SignRequest, genesisValidatorsRoot []byte) ([]byte, error) {
+	cfg := params.BeaconConfig()
+	var domainType [4]byte
+	var epoch types.Epoch
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		domainType, epoch = cfg.DomainBeaconProposer, slots.ToEpoch(o.Block.Slot)
+	case *validatorpb.SignRequest_BlockAltair:
+		domainType, epoch = cfg.DomainBeaconProposer, slots.ToEpoch(o.BlockAltair.Slot)
+	case *validatorpb.SignRequest_BlockBellatrix:
+		domainType, epoch = cfg.DomainBeaconProposer, slots.ToEpoch(o.BlockBellatrix.Slot)
+	case *validatorpb.SignRequest_BlindedBlockBellatrix:
+		domainType, epoch = cfg.DomainBeaconProposer, slots.ToEpoch(o.BlindedBlockBellatrix.Slot)
+	case *validatorpb.SignRequest_AttestationData:
+		if o.AttestationData.Target == nil {
+			return nil, errors.New("attestation data has no target")
+		}
+		domainType, epoch = cfg.DomainBeaconAttester, o.AttestationData.Target.Epoch
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		if o.AggregateAttestationAndProof.Aggregate == nil || o.AggregateAttestationAndProof.Aggregate.Data == nil {
+			return nil, errors.New("aggregate has no attestation data")
+		}
+		domainType, epoch = cfg.DomainAggregateAndProof, slots.ToEpoch(o.AggregateAttestationAndProof.Aggregate.Data.Slot)
+	case *validatorpb.SignRequest_Exit:
+		domainType, epoch = cfg.DomainVoluntaryExit, o.Exit.Epoch
+	case *validatorpb.SignRequest_Slot:
+		domainType, epoch = cfg.DomainSelectionProof, slots.ToEpoch(o.Slot)
+	case *validatorpb.SignRequest_Epoch:
+		domainType, epoch = cfg.DomainRandao, o.Epoch
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		domainType, epoch = cfg.DomainSyncCommitteeSelectionProof, slots.ToEpoch(o.SyncAggregatorSelectionData.Slot)
+	case *validatorpb.SignRequest_ContributionAndProof:
+		if o.ContributionAndProof.Contribution == nil {
+			return nil, errors.New("contribution and proof has no contribution")
+		}
+		domainType, epoch = cfg.DomainContributionAndProof, slots.ToEpoch(o.ContributionAndProof.Contribution.Slot)
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		domainType, epoch = cfg.DomainSyncCommittee, slots.ToEpoch(req.SigningSlot)
+	case *validatorpb.SignRequest_Registration:
+		// Builder registrations are signed with the genesis fork version and a zero genesis validators root.
+		return signing.ComputeDomain(cfg.DomainApplicationBuilder, nil, nil)
+	default:
+		return nil, fmt.Errorf("unsupported sign request object %T", o)
+	}
+	fork, err := forks.Fork(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return signing.Domain(fork, epoch, domainType, genesisValidatorsRoot)
+}
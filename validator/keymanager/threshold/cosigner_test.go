@@ -0,0 +1,227 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/testing/util"
+	dbtest "github.com/prysmaticlabs/prysm/v3/validator/db/testing"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func testCosignerServer(t *testing.T) (*CosignerServer, []byte) {
+	_, opts := testParticipants(t, 2, 2)
+	pubKey := mustDecode(t, opts[1].Validators[0].PublicKey)
+	db := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48(pubKey)})
+	c, err := NewCosignerServer(&CosignerConfig{Opts: opts[1], Password: testPassword, DB: db, GenesisValidatorsRoot: testGenesisValidatorsRoot})
+	require.NoError(t, err)
+	return c, pubKey
+}
+
+func blockRequest(t *testing.T, pubKey []byte, slot types.Slot, graffiti string) *validatorpb.SignRequest {
+	blk := util.NewBeaconBlock().Block
+	blk.Slot = slot
+	blk.Body.Graffiti = bytesutil.PadTo([]byte(graffiti), 32)
+	domain := testDomain(t, params.BeaconConfig().DomainBeaconProposer)
+	root, err := signing.ComputeSigningRoot(blk, domain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_Block{Block: blk},
+		SigningSlot:     slot,
+	}
+}
+
+func attestationRequest(t *testing.T, pubKey []byte, source, target types.Epoch, blockRoot byte) *validatorpb.SignRequest {
+	data := &ethpb.AttestationData{
+		Slot:            1,
+		BeaconBlockRoot: bytes.Repeat([]byte{blockRoot}, 32),
+		Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+	}
+	domain := testDomain(t, params.BeaconConfig().DomainBeaconAttester)
+	root, err := signing.ComputeSigningRoot(data, domain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_AttestationData{AttestationData: data},
+	}
+}
+
+func TestCosignerServer_Sign_SigningRootMismatch(t *testing.T) {
+	ctx := context.Background()
+	c, pubKey := testCosignerServer(t)
+
+	req := randaoRequest(t, pubKey, 1)
+	req.SigningRoot = make([]byte, 32)
+	_, err := c.Sign(ctx, req)
+	require.ErrorIs(t, err, errSigningRootMismatch)
+
+	req = randaoRequest(t, pubKey, 1)
+	req.Object = nil
+	_, err = c.Sign(ctx, req)
+	require.ErrorIs(t, err, errSigningRootMismatch)
+	assert.ErrorContains(t, "sign request has no object", err)
+
+	_, err = c.Sign(ctx, randaoRequest(t, make([]byte, fieldparams.BLSPubkeyLength), 1))
+	require.ErrorIs(t, err, errUnknownKey)
+}
+
+func TestCosignerServer_Sign_SignatureDomainMismatch(t *testing.T) {
+	ctx := context.Background()
+	c, pubKey := testCosignerServer(t)
+
+	// A randao reveal whose signing root is computed with the proposer domain, which would make
+	// the partial signature valid for a block whose hash tree root is the epoch.
+	req := randaoRequest(t, pubKey, 1)
+	epoch := types.SSZUint64(1)
+	req.SignatureDomain = testDomain(t, params.BeaconConfig().DomainBeaconProposer)
+	root, err := signing.ComputeSigningRoot(&epoch, req.SignatureDomain)
+	require.NoError(t, err)
+	req.SigningRoot = root[:]
+	_, err = c.Sign(ctx, req)
+	require.ErrorIs(t, err, errSigningRootMismatch)
+	assert.ErrorContains(t, "signature domain", err)
+
+	// A domain of another network.
+	req = randaoRequest(t, pubKey, 1)
+	req.SignatureDomain, err = signing.ComputeDomain(params.BeaconConfig().DomainRandao, params.BeaconConfig().GenesisForkVersion, make([]byte, 32))
+	require.NoError(t, err)
+	root, err = signing.ComputeSigningRoot(&epoch, req.SignatureDomain)
+	require.NoError(t, err)
+	req.SigningRoot = root[:]
+	_, err = c.Sign(ctx, req)
+	require.ErrorIs(t, err, errSigningRootMismatch)
+}
+
+func TestCosignerServer_Sign_Proposals(t *testing.T) {
+	ctx := context.Background()
+	c, pubKey := testCosignerServer(t)
+
+	_, err := c.Sign(ctx, blockRequest(t, pubKey, 10, "a"))
+	require.NoError(t, err)
+	// Signing the very same block again is allowed.
+	_, err = c.Sign(ctx, blockRequest(t, pubKey, 10, "a"))
+	require.NoError(t, err)
+
+	_, err = c.Sign(ctx, blockRequest(t, pubKey, 10, "b"))
+	require.ErrorIs(t, err, errSlashable)
+	assert.ErrorContains(t, "double proposal at slot 10", err)
+
+	_, err = c.Sign(ctx, blockRequest(t, pubKey, 9, "a"))
+	require.ErrorIs(t, err, errSlashable)
+	assert.ErrorContains(t, "proposal slot 9 <= lowest signed slot 10", err)
+
+	_, err = c.Sign(ctx, blockRequest(t, pubKey, 11, "a"))
+	require.NoError(t, err)
+}
+
+func TestCosignerServer_Sign_Attestations(t *testing.T) {
+	ctx := context.Background()
+	c, pubKey := testCosignerServer(t)
+
+	_, err := c.Sign(ctx, attestationRequest(t, pubKey, 2, 3, 1))
+	require.NoError(t, err)
+	_, err = c.Sign(ctx, attestationRequest(t, pubKey, 2, 3, 1))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		req     *validatorpb.SignRequest
+		wantErr string
+	}{
+		{
+			name:    "double vote",
+			req:     attestationRequest(t, pubKey, 2, 3, 2),
+			wantErr: "target epoch 3 <= lowest signed target epoch 3",
+		},
+		{
+			name:    "source below lowest signed source",
+			req:     attestationRequest(t, pubKey, 1, 4, 1),
+			wantErr: "source epoch 1 < lowest signed source epoch 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.Sign(ctx, tt.req)
+			require.ErrorIs(t, err, errSlashable)
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+
+	_, err = c.Sign(ctx, attestationRequest(t, pubKey, 3, 4, 1))
+	require.NoError(t, err)
+
+	// A surrounding vote is only caught by the slashing protection history.
+	_, err = c.Sign(ctx, attestationRequest(t, pubKey, 2, 5, 1))
+	require.ErrorIs(t, err, errSlashable)
+	assert.ErrorContains(t, "surrounds another", err)
+}
+
+func TestCosignerServer_ServeHTTP(t *testing.T) {
+	c, pubKey := testCosignerServer(t)
+	srv := httptest.NewServer(c)
+	defer srv.Close()
+
+	postWithToken := func(req *validatorpb.SignRequest, token string) int {
+		body, err := protojson.Marshal(req)
+		require.NoError(t, err)
+		httpReq, err := http.NewRequest(http.MethodPost, srv.URL+signPath, bytes.NewReader(body))
+		require.NoError(t, err)
+		httpReq.Header.Set("Authorization", bearerPrefix+token)
+		resp, err := http.DefaultClient.Do(httpReq)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+	post := func(req *validatorpb.SignRequest) int {
+		return postWithToken(req, "token-2")
+	}
+	assert.Equal(t, http.StatusUnauthorized, postWithToken(blockRequest(t, pubKey, 10, "a"), ""))
+	assert.Equal(t, http.StatusUnauthorized, postWithToken(blockRequest(t, pubKey, 10, "a"), "token-1"))
+	assert.Equal(t, http.StatusOK, post(blockRequest(t, pubKey, 10, "a")))
+	assert.Equal(t, http.StatusPreconditionFailed, post(blockRequest(t, pubKey, 10, "b")))
+	assert.Equal(t, http.StatusNotFound, post(randaoRequest(t, make([]byte, fieldparams.BLSPubkeyLength), 1)))
+	mismatch := randaoRequest(t, pubKey, 1)
+	mismatch.SigningRoot = make([]byte, 32)
+	assert.Equal(t, http.StatusBadRequest, post(mismatch))
+
+	httpReq, err := http.NewRequest(http.MethodGet, srv.URL+signPath, nil)
+	require.NoError(t, err)
+	httpReq.Header.Set("Authorization", bearerPrefix+"token-2")
+	resp, err := http.DefaultClient.Do(httpReq)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestKeymanager_Sign_DeniedByCosigner(t *testing.T) {
+	ctx := context.Background()
+	_, opts := testParticipants(t, 2, 2)
+	startCosigners(t, opts)
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: opts[0], Password: testPassword})
+	require.NoError(t, err)
+	pubKey := mustDecode(t, opts[0].Validators[0].PublicKey)
+
+	_, err = km.Sign(ctx, blockRequest(t, pubKey, 10, "a"))
+	require.NoError(t, err)
+	_, err = km.Sign(ctx, blockRequest(t, pubKey, 10, "b"))
+	require.ErrorIs(t, err, ErrThresholdNotReached)
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+}
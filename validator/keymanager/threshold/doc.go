@@ -0,0 +1,39 @@
+/*
+Package threshold defines a keymanager implementation in which no single party holds a
+validating private key. Each validating key is split with Shamir's secret sharing into
+shares held by several participants, and any threshold of them can produce a signature
+of the validating key by combining their partial signatures.
+
+The keymanager holds one share of each validating key, signs a request with it and asks
+the other participants, its co-signers, for their partial signatures over HTTP, presenting
+the auth token of each co-signer as a bearer token. Partial signatures are checked against
+the public key of the share which produced them and combined once enough of them are gathered.
+Co-signers are served by a CosignerServer, which derives the signature domain of every request
+from the type of the object being signed, the fork schedule of the network and its genesis
+validators root, recomputes the signing root and applies slashing protection to blocks and
+attestations before signing them with its share.
+
+The keymanager is configured by a keymanageropts.json file with the following schema,
+usually produced by splitting a keystore with `prysmctl threshold split`:
+
+	{
+	  "threshold": 2,         // Number of partial signatures needed to sign.
+	  "index": 1,             // Index of the share held by this participant.
+	  "auth_token": "0x...",  // Token the co-signer of this participant requires.
+	  "cosigners": [          // Other participants, the URL of their co-signer and the token it requires.
+	    {"index": 2, "url": "https://cosigner-2:7600", "auth_token": "0x..."},
+	    {"index": 3, "url": "https://cosigner-3:7600", "auth_token": "0x..."}
+	  ],
+	  "validators": [
+	    {
+	      "public_key": "0x...",                                // Validating public key.
+	      "share_public_keys": {"1": "0x...", "2": "0x...", "3": "0x..."},
+	      "keystore": {...}                                     // EIP-2335 keystore of this participant's share.
+	    }
+	  ]
+	}
+
+Share keystores are encrypted with the wallet password. Auth tokens are sent in clear over
+plain HTTP, so co-signers reachable from other hosts should be served over TLS.
+*/
+package threshold
@@ -0,0 +1,416 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	remoteutils "github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote-utils"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// defaultTimeout for a partial signature request to a co-signer.
+const defaultTimeout = 2 * time.Second
+
+// bearerPrefix of the authorization header of partial signature requests.
+const bearerPrefix = "Bearer "
+
+var (
+	// ErrThresholdNotReached defines a failure to gather enough valid partial signatures to sign a request.
+	ErrThresholdNotReached = errors.New("not enough partial signatures to reach the signing threshold")
+	// ErrSigningDenied defines a co-signer refusing to sign a request, for instance because it is slashable.
+	ErrSigningDenied = errors.New("signing request was denied by co-signer")
+)
+
+// KeymanagerOpts for a threshold keymanager.
+type KeymanagerOpts struct {
+	Threshold  uint64       `json:"threshold"`
+	Index      uint64       `json:"index"`
+	AuthToken  string       `json:"auth_token"`
+	Cosigners  []*Cosigner  `json:"cosigners"`
+	Validators []*Validator `json:"validators"`
+}
+
+// Cosigner is another participant holding shares of the validating keys, along with the
+// auth token its co-signer requires.
+type Cosigner struct {
+	Index     uint64 `json:"index"`
+	URL       string `json:"url"`
+	AuthToken string `json:"auth_token"`
+}
+
+// Validator is a validating key split into shares, along with the share held by this participant.
+type Validator struct {
+	PublicKey       string               `json:"public_key"`
+	SharePublicKeys map[uint64]string    `json:"share_public_keys"`
+	Keystore        *keymanager.Keystore `json:"keystore"`
+}
+
+// SetupConfig includes configuration values for initializing
+// a keymanager, such as the options and the wallet password.
+type SetupConfig struct {
+	Opts     *KeymanagerOpts
+	Password string
+	Timeout  time.Duration
+}
+
+// share of a validating key held by this participant.
+type share struct {
+	secretKey       bls.SecretKey
+	publicKey       bls.PublicKey
+	sharePublicKeys map[uint64]bls.PublicKey
+}
+
+// Keymanager implementation signing with a share of each validating key and combining
+// the partial signatures of co-signers.
+type Keymanager struct {
+	opts                *KeymanagerOpts
+	client              *http.Client
+	shares              map[[fieldparams.BLSPubkeyLength]byte]*share
+	orderedPubKeys      [][fieldparams.BLSPubkeyLength]byte
+	accountsChangedFeed *event.Feed
+}
+
+// NewKeymanager instantiates a new threshold keymanager from configuration options,
+// decrypting the key shares with the wallet password.
+func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	shares, err := loadShares(cfg.Opts, cfg.Password)
+	if err != nil {
+		return nil, err
+	}
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(shares))
+	for pubKey := range shares {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) == -1 })
+	return &Keymanager{
+		opts:                cfg.Opts,
+		client:              &http.Client{Timeout: timeout},
+		shares:              shares,
+		orderedPubKeys:      pubKeys,
+		accountsChangedFeed: new(event.Feed),
+	}, nil
+}
+
+// loadShares validates the options and decrypts the key shares of this participant.
+func loadShares(opts *KeymanagerOpts, password string) (map[[fieldparams.BLSPubkeyLength]byte]*share, error) {
+	if opts == nil {
+		return nil, errors.New("keymanager options are missing")
+	}
+	if opts.Index == 0 {
+		return nil, errors.New("share index must not be zero")
+	}
+	indices := map[uint64]bool{opts.Index: true}
+	for _, c := range opts.Cosigners {
+		if c.Index == 0 || indices[c.Index] {
+			return nil, fmt.Errorf("invalid or duplicate co-signer index %d", c.Index)
+		}
+		if c.URL == "" {
+			return nil, fmt.Errorf("co-signer %d has no url", c.Index)
+		}
+		if c.AuthToken == "" {
+			return nil, fmt.Errorf("co-signer %d has no auth token", c.Index)
+		}
+		indices[c.Index] = true
+	}
+	if opts.Threshold == 0 || opts.Threshold > uint64(len(indices)) {
+		return nil, fmt.Errorf("threshold must be between 1 and the number of participants %d, got %d", len(indices), opts.Threshold)
+	}
+
+	decryptor := keystorev4.New()
+	shares := make(map[[fieldparams.BLSPubkeyLength]byte]*share, len(opts.Validators))
+	for _, v := range opts.Validators {
+		pubKeyBytes, err := hexutil.Decode(v.PublicKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", v.PublicKey)
+		}
+		pubKey, err := bls.PublicKeyFromBytes(pubKeyBytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid public key %s", v.PublicKey)
+		}
+		s := &share{
+			publicKey:       pubKey,
+			sharePublicKeys: make(map[uint64]bls.PublicKey, len(indices)),
+		}
+		for index := range indices {
+			enc, ok := v.SharePublicKeys[index]
+			if !ok {
+				return nil, fmt.Errorf("missing share %d public key for validator %s", index, v.PublicKey)
+			}
+			b, err := hexutil.Decode(enc)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not decode share %d public key for validator %s", index, v.PublicKey)
+			}
+			if s.sharePublicKeys[index], err = bls.PublicKeyFromBytes(b); err != nil {
+				return nil, errors.Wrapf(err, "invalid share %d public key for validator %s", index, v.PublicKey)
+			}
+		}
+		if err := checkSharePublicKeys(opts.Threshold, s); err != nil {
+			return nil, errors.Wrapf(err, "validator %s", v.PublicKey)
+		}
+
+		if v.Keystore == nil {
+			return nil, fmt.Errorf("missing share keystore for validator %s", v.PublicKey)
+		}
+		secretKeyBytes, err := decryptor.Decrypt(v.Keystore.Crypto, password)
+		if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+			return nil, errors.Wrapf(err, "wrong password for share keystore of validator %s", v.PublicKey)
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "could not decrypt share keystore of validator %s", v.PublicKey)
+		}
+		if s.secretKey, err = bls.SecretKeyFromBytes(secretKeyBytes); err != nil {
+			return nil, errors.Wrapf(err, "invalid share secret key for validator %s", v.PublicKey)
+		}
+		if !s.secretKey.PublicKey().Equals(s.sharePublicKeys[opts.Index]) {
+			return nil, fmt.Errorf("share keystore of validator %s does not match share %d public key", v.PublicKey, opts.Index)
+		}
+		shares[bytesutil.ToBytes48(pubKeyBytes)] = s
+	}
+	return shares, nil
+}
+
+// checkSharePublicKeys ensures the public keys of the first threshold shares combine into the validating public key.
+func checkSharePublicKeys(threshold uint64, s *share) error {
+	indices := make([]uint64, 0, len(s.sharePublicKeys))
+	for index := range s.sharePublicKeys {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	indices = indices[:threshold]
+	pubKeys := make([]bls.PublicKey, len(indices))
+	for i, index := range indices {
+		pubKeys[i] = s.sharePublicKeys[index]
+	}
+	recovered, err := bls.RecoverPublicKey(indices, pubKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not combine share public keys")
+	}
+	if !recovered.Equals(s.publicKey) {
+		return errors.New("share public keys do not combine into the validating public key")
+	}
+	return nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.WithError(err).Error("Could not close keymanager config file")
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of a threshold keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("%s: %d\n", au.BrightMagenta("Signing threshold"), opts.Threshold))
+	b.WriteString(fmt.Sprintf("%s: %d\n", au.BrightMagenta("Share index"), opts.Index))
+	for _, c := range opts.Cosigners {
+		b.WriteString(fmt.Sprintf("%s %d: %s\n", au.BrightMagenta("Co-signer"), c.Index, c.URL))
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the threshold keymanager.
+func (km *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return km.opts
+}
+
+// FetchValidatingPublicKeys fetches the list of validating public keys the keymanager holds a share of.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, len(km.orderedPubKeys))
+	copy(pubKeys, km.orderedPubKeys)
+	return pubKeys, nil
+}
+
+type partialSignature struct {
+	index     uint64
+	signature bls.Signature
+	err       error
+}
+
+// Sign signs a message with the share of the validating key and the partial signatures
+// of the co-signers, combining them into a signature of the validating key.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	s, ok := km.shares[bytesutil.ToBytes48(req.PublicKey)]
+	if !ok {
+		return nil, fmt.Errorf("no key share for public key %#x", req.PublicKey)
+	}
+	indices := []uint64{km.opts.Index}
+	sigs := []bls.Signature{s.secretKey.Sign(req.SigningRoot)}
+
+	if uint64(len(sigs)) < km.opts.Threshold {
+		body, err := protojson.Marshal(req)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not marshal sign request")
+		}
+		// Requests still in flight once the threshold is reached are cancelled.
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		partials := make(chan *partialSignature, len(km.opts.Cosigners))
+		for _, c := range km.opts.Cosigners {
+			go func(c *Cosigner) {
+				sig, err := km.requestPartialSignature(ctx, c, body, req.SigningRoot, s.sharePublicKeys[c.Index])
+				partials <- &partialSignature{index: c.Index, signature: sig, err: err}
+			}(c)
+		}
+		var errs []string
+		for range km.opts.Cosigners {
+			p := <-partials
+			if p.err != nil {
+				log.WithError(p.err).WithField("cosigner", p.index).Debug("Could not get partial signature")
+				errs = append(errs, fmt.Sprintf("co-signer %d: %v", p.index, p.err))
+				continue
+			}
+			indices = append(indices, p.index)
+			sigs = append(sigs, p.signature)
+			if uint64(len(sigs)) == km.opts.Threshold {
+				break
+			}
+		}
+		if uint64(len(sigs)) < km.opts.Threshold {
+			return nil, errors.Wrapf(ErrThresholdNotReached, "got %d of %d: %s", len(sigs), km.opts.Threshold, strings.Join(errs, "; "))
+		}
+	}
+
+	sig, err := bls.RecoverSignature(indices, sigs)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not combine partial signatures")
+	}
+	if !sig.Verify(s.publicKey, req.SigningRoot) {
+		return nil, errors.New("combined signature does not verify against the validating public key")
+	}
+	return sig, nil
+}
+
+// requestPartialSignature asks a co-signer to sign a request with its share, verifying the returned partial signature.
+func (km *Keymanager) requestPartialSignature(
+	ctx context.Context, c *Cosigner, body, signingRoot []byte, sharePublicKey bls.PublicKey,
+) (bls.Signature, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(c.URL, "/")+signPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", bearerPrefix+c.AuthToken)
+	resp, err := km.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read response")
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusPreconditionFailed:
+		return nil, errors.Wrap(ErrSigningDenied, strings.TrimSpace(string(respBody)))
+	default:
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	signResp := &SignResponse{}
+	if err := json.Unmarshal(respBody, signResp); err != nil {
+		return nil, errors.Wrap(err, "could not decode response")
+	}
+	sigBytes, err := hexutil.Decode(signResp.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode partial signature")
+	}
+	sig, err := bls.SignatureFromBytes(sigBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid partial signature")
+	}
+	if !sig.Verify(sharePublicKey, signingRoot) {
+		return nil, errors.New("partial signature does not verify against the share public key")
+	}
+	return sig, nil
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime. Validating keys of a threshold
+// keymanager are fixed by its options, so no event is ever sent.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ExtractKeystores is not supported for the threshold keymanager type.
+func (*Keymanager) ExtractKeystores(
+	_ context.Context, _ []bls.PublicKey, _ string,
+) ([]*keymanager.Keystore, error) {
+	return nil, errors.New("extracting keys not supported for a threshold keymanager")
+}
+
+// DeleteKeystores is not supported for the threshold keymanager type.
+func (*Keymanager) DeleteKeystores(context.Context, [][]byte) ([]*ethpbservice.DeletedKeystoreStatus, error) {
+	return nil, errors.New("Wrong wallet type: threshold. Only Imported or Derived wallets can delete accounts")
+}
+
+// ListKeymanagerAccounts lists the validating public keys the keymanager holds a share of.
+func (km *Keymanager) ListKeymanagerAccounts(ctx context.Context, cfg keymanager.ListKeymanagerAccountConfig) error {
+	au := aurora.NewAurora(true)
+	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("threshold signer").Bold())
+	fmt.Printf(
+		"(configuration file path) %s\n",
+		au.BrightGreen(filepath.Join(cfg.WalletAccountsDir, cfg.KeymanagerConfigFileName)).Bold(),
+	)
+	fmt.Println(" ")
+	fmt.Printf("%s\n", au.BrightGreen("Configuration options").Bold())
+	fmt.Println(km.opts)
+	validatingPubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(validatingPubKeys) == 1 {
+		fmt.Print("Showing 1 validator account\n")
+	} else if len(validatingPubKeys) == 0 {
+		fmt.Print("No accounts found\n")
+		return nil
+	} else {
+		fmt.Printf("Showing %d validator accounts\n", len(validatingPubKeys))
+	}
+	remoteutils.DisplayRemotePublicKeys(validatingPubKeys)
+	return nil
+}
@@ -0,0 +1,231 @@
+package threshold
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/v3/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const testPassword = "passw0rd"
+
+var testGenesisValidatorsRoot = bytesutil.PadTo([]byte("genesis"), 32)
+
+// testDomain returns the signature domain of the given type for the epochs of the genesis fork.
+func testDomain(t *testing.T, domainType [4]byte) []byte {
+	domain, err := signing.ComputeDomain(domainType, params.BeaconConfig().GenesisForkVersion, testGenesisValidatorsRoot)
+	require.NoError(t, err)
+	return domain
+}
+
+// testParticipants splits a new validating key into shares and returns the options of every participant,
+// co-signers being given placeholder URLs.
+func testParticipants(t *testing.T, threshold, n uint64) (bls.SecretKey, []*KeymanagerOpts) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(secretKey, threshold, n)
+	require.NoError(t, err)
+	sharePublicKeys := make(map[uint64]string, n)
+	for i, s := range shares {
+		sharePublicKeys[uint64(i+1)] = fmt.Sprintf("%#x", s.PublicKey().Marshal())
+	}
+	encryptor := keystorev4.New()
+	opts := make([]*KeymanagerOpts, n)
+	for i, s := range shares {
+		index := uint64(i + 1)
+		cryptoFields, err := encryptor.Encrypt(s.Marshal(), testPassword)
+		require.NoError(t, err)
+		var cosigners []*Cosigner
+		for j := uint64(1); j <= n; j++ {
+			if j != index {
+				cosigners = append(cosigners, &Cosigner{Index: j, URL: fmt.Sprintf("http://cosigner-%d", j), AuthToken: fmt.Sprintf("token-%d", j)})
+			}
+		}
+		opts[i] = &KeymanagerOpts{
+			Threshold: threshold,
+			Index:     index,
+			AuthToken: fmt.Sprintf("token-%d", index),
+			Cosigners: cosigners,
+			Validators: []*Validator{{
+				PublicKey:       fmt.Sprintf("%#x", secretKey.PublicKey().Marshal()),
+				SharePublicKeys: sharePublicKeys,
+				Keystore:        &keymanager.Keystore{Crypto: cryptoFields},
+			}},
+		}
+	}
+	return secretKey, opts
+}
+
+// startCosigners serves the co-signers of the first participant in process and points its options at them.
+// A single validator database can be opened at a time, so co-signers share their slashing protection history.
+func startCosigners(t *testing.T, opts []*KeymanagerOpts) []*httptest.Server {
+	pubKey := bytesutil.ToBytes48(mustDecode(t, opts[0].Validators[0].PublicKey))
+	db := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	servers := make([]*httptest.Server, 0, len(opts)-1)
+	for _, o := range opts[1:] {
+		c, err := NewCosignerServer(&CosignerConfig{Opts: o, Password: testPassword, DB: db, GenesisValidatorsRoot: testGenesisValidatorsRoot})
+		require.NoError(t, err)
+		srv := httptest.NewServer(c)
+		t.Cleanup(srv.Close)
+		for _, cosigner := range opts[0].Cosigners {
+			if cosigner.Index == o.Index {
+				cosigner.URL = srv.URL
+			}
+		}
+		servers = append(servers, srv)
+	}
+	return servers
+}
+
+func mustDecode(t *testing.T, s string) []byte {
+	b, err := hexutil.Decode(s)
+	require.NoError(t, err)
+	return b
+}
+
+func randaoRequest(t *testing.T, pubKey []byte, epoch types.Epoch) *validatorpb.SignRequest {
+	sszEpoch := types.SSZUint64(epoch)
+	domain := testDomain(t, params.BeaconConfig().DomainRandao)
+	root, err := signing.ComputeSigningRoot(&sszEpoch, domain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_Epoch{Epoch: epoch},
+	}
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	ctx := context.Background()
+	secretKey, opts := testParticipants(t, 2, 3)
+	servers := startCosigners(t, opts)
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: opts[0], Password: testPassword})
+	require.NoError(t, err)
+
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pubKeys))
+	assert.DeepEqual(t, secretKey.PublicKey().Marshal(), pubKeys[0][:])
+
+	req := randaoRequest(t, pubKeys[0][:], 1)
+	sig, err := km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, secretKey.Sign(req.SigningRoot).Marshal(), sig.Marshal())
+
+	// The threshold is still reached with a single co-signer.
+	servers[0].Close()
+	sig, err = km.Sign(ctx, randaoRequest(t, pubKeys[0][:], 2))
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(secretKey.PublicKey(), randaoRequest(t, pubKeys[0][:], 2).SigningRoot))
+
+	servers[1].Close()
+	_, err = km.Sign(ctx, randaoRequest(t, pubKeys[0][:], 3))
+	require.ErrorIs(t, err, ErrThresholdNotReached)
+}
+
+func TestKeymanager_Sign_InvalidPartialSignature(t *testing.T) {
+	ctx := context.Background()
+	secretKey, opts := testParticipants(t, 2, 2)
+	other, err := bls.RandKey()
+	require.NoError(t, err)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(&SignResponse{
+			Signature: fmt.Sprintf("%#x", other.Sign([]byte("hello")).Marshal()),
+		}))
+	}))
+	defer srv.Close()
+	opts[0].Cosigners[0].URL = srv.URL
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: opts[0], Password: testPassword})
+	require.NoError(t, err)
+
+	_, err = km.Sign(ctx, randaoRequest(t, secretKey.PublicKey().Marshal(), 1))
+	require.ErrorIs(t, err, ErrThresholdNotReached)
+	assert.ErrorContains(t, "partial signature does not verify against the share public key", err)
+}
+
+func TestKeymanager_Sign_UnknownKey(t *testing.T) {
+	ctx := context.Background()
+	_, opts := testParticipants(t, 1, 2)
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: opts[0], Password: testPassword})
+	require.NoError(t, err)
+	_, err = km.Sign(ctx, randaoRequest(t, make([]byte, fieldparams.BLSPubkeyLength), 1))
+	assert.ErrorContains(t, "no key share for public key", err)
+}
+
+func TestNewKeymanager_InvalidOpts(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(o *KeymanagerOpts)
+		password string
+		wantErr  string
+	}{
+		{
+			name:     "wrong password",
+			modify:   func(o *KeymanagerOpts) {},
+			password: "wrong",
+			wantErr:  "wrong password for share keystore",
+		},
+		{
+			name:    "threshold above participants",
+			modify:  func(o *KeymanagerOpts) { o.Threshold = 4 },
+			wantErr: "threshold must be between 1 and the number of participants 3, got 4",
+		},
+		{
+			name:    "duplicate co-signer",
+			modify:  func(o *KeymanagerOpts) { o.Cosigners[1].Index = o.Cosigners[0].Index },
+			wantErr: "invalid or duplicate co-signer index",
+		},
+		{
+			name:    "co-signer without auth token",
+			modify:  func(o *KeymanagerOpts) { o.Cosigners[0].AuthToken = "" },
+			wantErr: "co-signer 2 has no auth token",
+		},
+		{
+			name:    "missing share public key",
+			modify:  func(o *KeymanagerOpts) { delete(o.Validators[0].SharePublicKeys, 3) },
+			wantErr: "missing share 3 public key",
+		},
+		{
+			name: "share public keys of another key",
+			modify: func(o *KeymanagerOpts) {
+				o.Validators[0].SharePublicKeys[1], o.Validators[0].SharePublicKeys[2] =
+					o.Validators[0].SharePublicKeys[2], o.Validators[0].SharePublicKeys[1]
+			},
+			wantErr: "share public keys do not combine into the validating public key",
+		},
+		{
+			name:    "keystore of another share",
+			modify:  func(o *KeymanagerOpts) { o.Index, o.Cosigners[0].Index = o.Cosigners[0].Index, o.Index },
+			wantErr: "does not match share 2 public key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, opts := testParticipants(t, 2, 3)
+			tt.modify(opts[0])
+			password := tt.password
+			if password == "" {
+				password = testPassword
+			}
+			_, err := NewKeymanager(context.Background(), &SetupConfig{Opts: opts[0], Password: password})
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
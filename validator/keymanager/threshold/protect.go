@@ -0,0 +1,91 @@
+package threshold
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/slashings"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+)
+
+// protectProposal checks a block proposal against the proposal history of the validating key,
+// following EIP-3076, and records it if it is not slashable.
+func (c *CosignerServer) protectProposal(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot [32]byte,
+) error {
+	prevSigningRoot, proposalAtSlotExists, err := c.db.ProposalHistoryForSlot(ctx, pubKey, slot)
+	if err != nil {
+		return errors.Wrap(err, "failed to get proposal history")
+	}
+	lowestSignedProposalSlot, lowestProposalExists, err := c.db.LowestSignedProposal(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	// A proposal at the same slot is only allowed if it is the very same block, which
+	// cannot be told apart from a different one when its signing root was not recorded.
+	signingRootIsDifferent := prevSigningRoot == params.BeaconConfig().ZeroHash || prevSigningRoot != signingRoot
+	if proposalAtSlotExists && signingRootIsDifferent {
+		return errors.Wrapf(errSlashable, "double proposal at slot %d", slot)
+	}
+	if lowestProposalExists && signingRootIsDifferent && lowestSignedProposalSlot >= slot {
+		return errors.Wrapf(
+			errSlashable,
+			"proposal slot %d <= lowest signed slot %d",
+			slot,
+			lowestSignedProposalSlot,
+		)
+	}
+	return c.db.SaveProposalHistoryForSlot(ctx, pubKey, slot, signingRoot[:])
+}
+
+// protectAttestation checks an attestation against the attesting history of the validating key,
+// following EIP-3076, and records it if it is not slashable.
+func (c *CosignerServer) protectAttestation(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, data *ethpb.AttestationData, signingRoot [32]byte,
+) error {
+	if data.Source == nil || data.Target == nil {
+		return errors.New("attestation data has no source or target checkpoint")
+	}
+	lowestSourceEpoch, exists, err := c.db.LowestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	if exists && data.Source.Epoch < lowestSourceEpoch {
+		return errors.Wrapf(
+			errSlashable,
+			"source epoch %d < lowest signed source epoch %d",
+			data.Source.Epoch,
+			lowestSourceEpoch,
+		)
+	}
+	existingSigningRoot, err := c.db.SigningRootAtTargetEpoch(ctx, pubKey, data.Target.Epoch)
+	if err != nil {
+		return err
+	}
+	signingRootsDiffer := slashings.SigningRootsDiffer(existingSigningRoot, signingRoot)
+	lowestTargetEpoch, exists, err := c.db.LowestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	if signingRootsDiffer && exists && data.Target.Epoch <= lowestTargetEpoch {
+		return errors.Wrapf(
+			errSlashable,
+			"target epoch %d <= lowest signed target epoch %d",
+			data.Target.Epoch,
+			lowestTargetEpoch,
+		)
+	}
+	indexedAtt := &ethpb.IndexedAttestation{Data: data}
+	slashingKind, err := c.db.CheckSlashableAttestation(ctx, pubKey, signingRoot, indexedAtt)
+	if err != nil {
+		if slashingKind == kv.NotSlashable {
+			return errors.Wrap(err, "could not check attestation against slashing protection history")
+		}
+		return errors.Wrap(errSlashable, err.Error())
+	}
+	return c.db.SaveAttestationForPubKey(ctx, pubKey, signingRoot, indexedAtt)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/async/event"
 	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
 	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
 	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
 )
@@ -60,6 +61,20 @@ type KeyStoreExtractor interface {
 	ExtractKeystores(ctx context.Context, publicKeys []bls.PublicKey, password string) ([]*Keystore, error)
 }
 
+// KeystoreExporter allows keys to be exported as EIP-2335 keystores, each encrypted with its own password.
+type KeystoreExporter interface {
+	ExportKeystores(
+		ctx context.Context, publicKeys []bls.PublicKey, passwords []string, kdf *keystore.KDFParams,
+	) ([]*Keystore, error)
+}
+
+// Reencrypter allows the keystores of the keymanager to be re-encrypted with a new password.
+// savePassword, if not nil, persists the new password once the re-encrypted keystores are
+// written and before they replace the current ones.
+type Reencrypter interface {
+	Reencrypt(ctx context.Context, newPassword string, kdf *keystore.KDFParams, savePassword func() error) error
+}
+
 // PublicKeyAdder allows adding public keys to the keymanager.
 type PublicKeyAdder interface {
 	AddPublicKeys(ctx context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte) ([]*ethpbservice.ImportedRemoteKeysStatus, error)
@@ -104,6 +119,10 @@ const (
 	Remote
 	// Web3Signer keymanager capable of signing data using a remote signer called Web3Signer.
 	Web3Signer
+	// Threshold keymanager holding a share of each validating key and combining partial signatures from co-signers.
+	Threshold
+	// PKCS11 keymanager holding validating keys in a PKCS#11 token, such as a hardware security module.
+	PKCS11
 )
 
 // IncorrectPasswordErrMsg defines a common error string representing an EIP-2335
@@ -125,6 +144,10 @@ func (k Kind) String() string {
 		return "remote"
 	case Web3Signer:
 		return "web3signer"
+	case Threshold:
+		return "threshold"
+	case PKCS11:
+		return "pkcs11"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -141,6 +164,10 @@ func ParseKind(k string) (Kind, error) {
 		return Remote, nil
 	case "web3signer":
 		return Web3Signer, nil
+	case "threshold":
+		return Threshold, nil
+	case "pkcs11":
+		return PKCS11, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
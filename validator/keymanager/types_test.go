@@ -10,22 +10,32 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/pkcs11"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/threshold"
 )
 
 var (
 	_ = keymanager.IKeymanager(&local.Keymanager{})
 	_ = keymanager.IKeymanager(&derived.Keymanager{})
 	_ = keymanager.IKeymanager(&remote.Keymanager{})
+	_ = keymanager.IKeymanager(&threshold.Keymanager{})
+	_ = keymanager.IKeymanager(&pkcs11.Keymanager{})
 
 	// More granular assertions.
 	_ = keymanager.KeysFetcher(&local.Keymanager{})
 	_ = keymanager.KeysFetcher(&derived.Keymanager{})
 	_ = keymanager.Importer(&local.Keymanager{})
 	_ = keymanager.Importer(&derived.Keymanager{})
+	_ = keymanager.Importer(&pkcs11.Keymanager{})
 	_ = keymanager.Deleter(&local.Keymanager{})
 	_ = keymanager.Deleter(&derived.Keymanager{})
+	_ = keymanager.Deleter(&pkcs11.Keymanager{})
+	_ = keymanager.KeystoreExporter(&local.Keymanager{})
+	_ = keymanager.KeystoreExporter(&derived.Keymanager{})
+	_ = keymanager.Reencrypter(&local.Keymanager{})
+	_ = keymanager.Reencrypter(&derived.Keymanager{})
 
 	_ = keymanager.PublicKeyAdder(&remoteweb3signer.Keymanager{})
 	_ = keymanager.PublicKeyDeleter(&remoteweb3signer.Keymanager{})
@@ -46,13 +46,17 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/runtime/prereqs"
 	"github.com/prysmaticlabs/prysm/v3/runtime/version"
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/audit"
 	"github.com/prysmaticlabs/prysm/v3/validator/client"
+	"github.com/prysmaticlabs/prysm/v3/validator/db"
 	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
 	g "github.com/prysmaticlabs/prysm/v3/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v3/validator/keymanager/remote-web3signer"
 	"github.com/prysmaticlabs/prysm/v3/validator/rpc"
 	validatormiddleware "github.com/prysmaticlabs/prysm/v3/validator/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/v3/validator/tenants"
 	"github.com/prysmaticlabs/prysm/v3/validator/web"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -66,11 +70,12 @@ type ValidatorClient struct {
 	cliCtx            *cli.Context
 	ctx               context.Context
 	cancel            context.CancelFunc
-	db                *kv.Store
+	db                db.Database
 	services          *runtime.ServiceRegistry // Lifecycle and service store.
 	lock              sync.RWMutex
 	wallet            *wallet.Wallet
 	walletInitialized *event.Feed
+	tenants           *tenants.Tenants
 	stop              chan struct{} // Channel to wait for termination notifications.
 }
 
@@ -127,6 +132,9 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 	// If the --web flag is enabled to administer the validator
 	// client via a web portal, we start the validator client in a different way.
 	if cliCtx.IsSet(flags.EnableWebFlag.Name) {
+		if cliCtx.IsSet(flags.TenantsFileFlag.Name) {
+			return nil, errors.New("--" + flags.TenantsFileFlag.Name + " cannot be used along with the web UI")
+		}
 		if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) || cliCtx.IsSet(flags.Web3SignerPublicValidatorKeysFlag.Name) {
 			log.Warn("Remote Keymanager API enabled. Prysm web does not properly support web3signer at this time")
 		}
@@ -192,7 +200,11 @@ func (c *ValidatorClient) Close() {
 func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	var err error
 	dataDir := cliCtx.String(flags.WalletDirFlag.Name)
-	if !cliCtx.IsSet(flags.InteropNumValidators.Name) {
+	if cliCtx.IsSet(flags.TenantsFileFlag.Name) {
+		if err := c.initializeTenants(cliCtx); err != nil {
+			return err
+		}
+	} else if !cliCtx.IsSet(flags.InteropNumValidators.Name) {
 		// Custom Check For Web3Signer
 		if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
 			c.wallet = wallet.NewWalletForWeb3Signer()
@@ -251,6 +263,13 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	if err := valDB.RunUpMigrations(cliCtx.Context); err != nil {
 		return errors.Wrap(err, "could not run database migration")
 	}
+	if c.tenants != nil {
+		// The slashing protection history of the keys of each tenant is kept in the database of the tenant.
+		c.db, err = c.tenants.SlashingProtectionDB(cliCtx.Context, valDB)
+		if err != nil {
+			return errors.Wrap(err, "could not open slashing protection databases of tenants")
+		}
+	}
 
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := c.registerPrometheusService(cliCtx); err != nil {
@@ -271,6 +290,33 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	return nil
 }
 
+// initializeTenants opens the wallets of the tenants hosted by the validator client and reads their
+// proposer settings.
+func (c *ValidatorClient) initializeTenants(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(flags.InteropNumValidators.Name) || cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
+		return errors.New("--" + flags.TenantsFileFlag.Name + " cannot be used along with interop keys or web3signer")
+	}
+	ts, err := tenants.Load(c.ctx, cliCtx.String(flags.TenantsFileFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "could not load tenants")
+	}
+	for _, t := range ts.List() {
+		if t.ProposerSettingsFile == "" {
+			continue
+		}
+		var fileConfig *validatorServiceConfig.ProposerSettingsPayload
+		if err := unmarshalFromFile(cliCtx.Context, t.ProposerSettingsFile, &fileConfig); err != nil {
+			return errors.Wrapf(err, "could not read proposer settings of tenant %s", t.Name)
+		}
+		t.ProposerSettings, err = proposerSettingsFromPayload(cliCtx, fileConfig)
+		if err != nil {
+			return errors.Wrapf(err, "invalid proposer settings of tenant %s", t.Name)
+		}
+	}
+	c.tenants = ts
+	return nil
+}
+
 func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	var err error
 	dataDir := cliCtx.String(flags.WalletDirFlag.Name)
@@ -409,6 +455,23 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	var km keymanager.IKeymanager
+	if c.tenants != nil {
+		km = c.tenants
+		bpc, err = c.tenants.ProposerSettings(cliCtx.Context, bpc)
+		if err != nil {
+			return errors.Wrap(err, "could not apply proposer settings of tenants")
+		}
+	}
+	var signingAuditLog *audit.Log
+	if dir := c.cliCtx.String(flags.SigningAuditLogDirFlag.Name); dir != "" {
+		maxFileSize := c.cliCtx.Uint64(flags.SigningAuditLogMaxFileSizeFlag.Name) * 1024 * 1024
+		signingAuditLog, err = audit.Open(c.cliCtx.Context, dir, int64(maxFileSize), c.db) // lint:ignore uintcast -- Sizes in megabytes do not overflow int64.
+		if err != nil {
+			return errors.Wrap(err, "could not open signing audit log")
+		}
+		log.WithField("signingAuditLogDir", dir).Info("Recording signing operations in the signing audit log")
+	}
 
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
@@ -424,11 +487,13 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		ValDB:                      c.db,
 		UseWeb:                     c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		InteropKeysConfig:          interopKeysConfig,
+		Keymanager:                 km,
 		Wallet:                     c.wallet,
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
 		Web3SignerConfig:           wsc,
 		ProposerSettings:           bpc,
+		SigningAuditLog:            signingAuditLog,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -451,6 +516,7 @@ func web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 		web3signerConfig = &remoteweb3signer.SetupConfig{
 			BaseEndpoint:          u.String(),
 			GenesisValidatorsRoot: nil,
+			KeyRefreshInterval:    cliCtx.Duration(flags.Web3SignerKeyRefreshIntervalFlag.Name),
 		}
 		if cliCtx.IsSet(flags.WalletPasswordFileFlag.Name) {
 			log.Warnf("%s was provided while using web3signer and will be ignored", flags.WalletPasswordFileFlag.Name)
@@ -525,6 +591,12 @@ func proposerSettings(cliCtx *cli.Context) (*validatorServiceConfig.ProposerSett
 	if fileConfig == nil {
 		return nil, nil
 	}
+	return proposerSettingsFromPayload(cliCtx, fileConfig)
+}
+
+func proposerSettingsFromPayload(
+	cliCtx *cli.Context, fileConfig *validatorServiceConfig.ProposerSettingsPayload,
+) (*validatorServiceConfig.ProposerSettings, error) {
 	//convert file config to proposer config for internal use
 	vpSettings := &validatorServiceConfig.ProposerSettings{}
 
@@ -665,7 +737,9 @@ func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
 		GenesisFetcher:           vs,
 		NodeGatewayEndpoint:      nodeGatewayEndpoint,
 		WalletDir:                walletDir,
+		WalletPasswordFile:       cliCtx.String(flags.WalletPasswordFileFlag.Name),
 		Wallet:                   c.wallet,
+		Tenants:                  c.tenants,
 		ValidatorGatewayHost:     validatorGatewayHost,
 		ValidatorGatewayPort:     validatorGatewayPort,
 		ValidatorMonitoringHost:  validatorMonitoringHost,
@@ -708,6 +782,7 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		pb.RegisterHealthHandler,
 		validatorpb.RegisterHealthHandler,
 		validatorpb.RegisterAccountsHandler,
+		validatorpb.RegisterDutiesHandler,
 		validatorpb.RegisterBeaconHandler,
 		validatorpb.RegisterSlashingProtectionHandler,
 		ethpbservice.RegisterKeyManagementHandler,
@@ -169,7 +169,7 @@ func (s *Server) VoluntaryExit(
 	if s.wallet == nil {
 		return nil, status.Error(codes.FailedPrecondition, "No wallet found")
 	}
-	km, err := s.validatorService.Keymanager()
+	km, err := s.validatorService.SigningKeymanager()
 	if err != nil {
 		return nil, err
 	}
@@ -17,9 +17,13 @@ func (f *ValidatorEndpointFactory) IsNil() bool {
 func (*ValidatorEndpointFactory) Paths() []string {
 	return []string{
 		"/eth/v1/keystores",
+		"/eth/v1/keystores/reencrypt",
+		"/eth/v1/keystores/export",
 		"/eth/v1/remotekeys",
 		"/eth/v1/validator/{pubkey}/feerecipient",
 		"/eth/v1/validator/{pubkey}/gas_limit",
+		"/eth/v1/validator/{pubkey}/graffiti",
+		"/eth/v1/validator/voluntary_exits/broadcast",
 	}
 }
 
@@ -33,6 +37,12 @@ func (*ValidatorEndpointFactory) Create(path string) (*apimiddleware.Endpoint, e
 		endpoint.PostResponse = &ImportKeystoresResponseJson{}
 		endpoint.DeleteRequest = &DeleteKeystoresRequestJson{}
 		endpoint.DeleteResponse = &DeleteKeystoresResponseJson{}
+	case "/eth/v1/keystores/reencrypt":
+		endpoint.PostRequest = &ReencryptKeystoresRequestJson{}
+		endpoint.PostResponse = &ReencryptKeystoresResponseJson{}
+	case "/eth/v1/keystores/export":
+		endpoint.PostRequest = &ExportKeystoresRequestJson{}
+		endpoint.PostResponse = &ExportKeystoresResponseJson{}
 	case "/eth/v1/remotekeys":
 		endpoint.GetResponse = &ListRemoteKeysResponseJson{}
 		endpoint.PostRequest = &ImportRemoteKeysRequestJson{}
@@ -46,6 +56,13 @@ func (*ValidatorEndpointFactory) Create(path string) (*apimiddleware.Endpoint, e
 		endpoint.GetResponse = &GetGasLimitResponseJson{}
 		endpoint.PostRequest = &SetGasLimitRequestJson{}
 		endpoint.DeleteRequest = &DeleteGasLimitRequestJson{}
+	case "/eth/v1/validator/{pubkey}/graffiti":
+		endpoint.GetResponse = &GetGraffitiResponseJson{}
+		endpoint.PostRequest = &SetGraffitiRequestJson{}
+	case "/eth/v1/validator/voluntary_exits/broadcast":
+		endpoint.GetResponse = &VoluntaryExitsBroadcastResponseJson{}
+		endpoint.PostRequest = &BroadcastVoluntaryExitsRequestJson{}
+		endpoint.PostResponse = &VoluntaryExitsBroadcastResponseJson{}
 	default:
 		return nil, errors.New("invalid path")
 	}
@@ -33,6 +33,31 @@ type DeleteKeystoresResponseJson struct {
 	SlashingProtection string        `json:"slashing_protection"`
 }
 
+type KDFParamsJson struct {
+	Function string `json:"function"`
+	Cost     string `json:"cost"`
+}
+
+type ReencryptKeystoresRequestJson struct {
+	CurrentPassword string         `json:"current_password"`
+	NewPassword     string         `json:"new_password"`
+	Kdf             *KDFParamsJson `json:"kdf"`
+}
+
+type ReencryptKeystoresResponseJson struct {
+	Kdf *KDFParamsJson `json:"kdf"`
+}
+
+type ExportKeystoresRequestJson struct {
+	PublicKeys []string       `json:"pubkeys" hex:"true"`
+	Passwords  []string       `json:"passwords"`
+	Kdf        *KDFParamsJson `json:"kdf"`
+}
+
+type ExportKeystoresResponseJson struct {
+	Keystores []string `json:"keystores"`
+}
+
 //remote keymanager api
 
 type ListRemoteKeysResponseJson struct {
@@ -77,6 +102,11 @@ type GasLimitJson struct {
 	GasLimit string `json:"gas_limit"`
 }
 
+type GraffitiJson struct {
+	Pubkey   string `json:"pubkey" hex:"true"`
+	Graffiti string `json:"graffiti"`
+}
+
 type GetFeeRecipientByPubkeyResponseJson struct {
 	Data *FeeRecipientJson `json:"data"`
 }
@@ -96,3 +126,29 @@ type SetGasLimitRequestJson struct {
 type DeleteGasLimitRequestJson struct {
 	Pubkey string `json:"pubkey" hex:"true"`
 }
+
+type GetGraffitiResponseJson struct {
+	Data *GraffitiJson `json:"data"`
+}
+
+type SetGraffitiRequestJson struct {
+	Graffiti string `json:"graffiti"`
+}
+
+type BroadcastVoluntaryExitsRequestJson struct {
+	Exits []string `json:"exits"`
+}
+
+type VoluntaryExitsBroadcastResponseJson struct {
+	Total     string                    `json:"total"`
+	Submitted string                    `json:"submitted"`
+	Failed    string                    `json:"failed"`
+	Pending   string                    `json:"pending"`
+	Running   bool                      `json:"running"`
+	Errors    []*VoluntaryExitErrorJson `json:"errors"`
+}
+
+type VoluntaryExitErrorJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Error          string `json:"error"`
+}
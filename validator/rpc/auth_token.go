@@ -68,38 +68,43 @@ func CreateAuthToken(walletDirPath, validatorWebAddr string) error {
 // browser. The web interface authenticates by looking for this token in the query parameters
 // of the URL. This token is then used as the bearer token for jwt auth.
 func (s *Server) initializeAuthToken(walletDir string) (string, error) {
-	authTokenFile := filepath.Join(walletDir, authTokenFileName)
+	secret, token, err := readOrCreateAuthToken(walletDir)
+	if err != nil {
+		return "", err
+	}
+	s.jwtSecret = secret
+	return token, nil
+}
+
+// readOrCreateAuthToken reads the jwt secret and token saved in a directory, or creates and saves
+// them if missing.
+func readOrCreateAuthToken(dir string) ([]byte, string, error) {
+	authTokenFile := filepath.Join(dir, authTokenFileName)
 	if file.FileExists(authTokenFile) {
 		// #nosec G304
 		f, err := os.Open(authTokenFile)
 		if err != nil {
-			return "", err
+			return nil, "", err
 		}
 		defer func() {
 			if err := f.Close(); err != nil {
 				log.Error(err)
 			}
 		}()
-		secret, token, err := readAuthTokenFile(f)
-		if err != nil {
-			return "", err
-		}
-		s.jwtSecret = secret
-		return token, nil
+		return readAuthTokenFile(f)
 	}
 	jwtKey, err := createRandomJWTSecret()
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
-	s.jwtSecret = jwtKey
-	token, err := createTokenString(s.jwtSecret)
+	token, err := createTokenString(jwtKey)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
-	if err := saveAuthToken(walletDir, jwtKey, token); err != nil {
-		return "", err
+	if err := saveAuthToken(dir, jwtKey, token); err != nil {
+		return nil, "", err
 	}
-	return token, nil
+	return jwtKey, token, nil
 }
 
 func (s *Server) refreshAuthTokenFromFileChanges(ctx context.Context, authTokenPath string) {
@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/time/slots"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetDutySchedule returns the duties planned by the validator client for the current and previous
+// epochs, along with how late each duty was and why it was missed. The duties may be restricted
+// to the requested epochs.
+func (s *Server) GetDutySchedule(_ context.Context, req *pb.DutyScheduleRequest) (*pb.DutyScheduleResponse, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready. Please try again once validator is ready.")
+	}
+	epochs := make(map[types.Epoch]bool, len(req.Epochs))
+	for _, e := range req.Epochs {
+		epochs[e] = true
+	}
+	resp := &pb.DutyScheduleResponse{Duties: []*pb.ScheduledDuty{}}
+	for _, d := range s.validatorService.DutySchedule() {
+		if len(epochs) > 0 && !epochs[slots.ToEpoch(d.Slot)] {
+			continue
+		}
+		pubKey := d.PubKey
+		resp.Duties = append(resp.Duties, &pb.ScheduledDuty{
+			Slot:        d.Slot,
+			PublicKey:   pubKey[:],
+			Role:        d.Role.String(),
+			DueTime:     dutyTimestamp(d.DueTime),
+			Deadline:    dutyTimestamp(d.Deadline),
+			Status:      d.Status.String(),
+			StartedAt:   dutyTimestamp(d.StartedAt),
+			CompletedAt: dutyTimestamp(d.CompletedAt),
+			DelayMs:     d.Delay.Milliseconds(),
+			MissReason:  d.MissReason,
+		})
+	}
+	return resp, nil
+}
+
+func dutyTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
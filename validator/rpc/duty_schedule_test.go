@@ -0,0 +1,29 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/client"
+)
+
+func TestServer_GetDutySchedule(t *testing.T) {
+	ctx := context.Background()
+	t.Run("validator service not ready", func(t *testing.T) {
+		s := &Server{}
+		_, err := s.GetDutySchedule(ctx, &pb.DutyScheduleRequest{})
+		assert.ErrorContains(t, "Validator service not ready", err)
+	})
+	t.Run("no duties planned before the validator starts", func(t *testing.T) {
+		vs, err := client.NewValidatorService(ctx, &client.Config{})
+		require.NoError(t, err)
+		s := &Server{validatorService: vs}
+		resp, err := s.GetDutySchedule(ctx, &pb.DutyScheduleRequest{Epochs: []types.Epoch{1}})
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(resp.Duties))
+	})
+}
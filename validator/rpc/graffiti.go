@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	"github.com/prysmaticlabs/prysm/v3/validator/graffiti"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// GetGraffiti returns the graffiti of a validator. The graffiti may be a template, such as
+// "{execution_client} {slot}", which is expanded when the validator proposes a block.
+func (s *Server) GetGraffiti(ctx context.Context, req *ethpbservice.PubkeyRequest) (*ethpbservice.GetGraffitiResponse, error) {
+	if err := s.checkGraffitiKey(ctx, req.Pubkey); err != nil {
+		return nil, err
+	}
+	g, ok, err := s.valDB.GraffitiForPubKey(ctx, bytesutil.ToBytes48(req.Pubkey))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get graffiti: %v", err)
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "No graffiti set for validator")
+	}
+	return &ethpbservice.GetGraffitiResponse{
+		Data: &ethpbservice.GetGraffitiResponse_Graffiti{
+			Pubkey:   req.Pubkey,
+			Graffiti: g,
+		},
+	}, nil
+}
+
+// SetGraffiti sets the graffiti of a validator, after checking the placeholders of its template.
+func (s *Server) SetGraffiti(ctx context.Context, req *ethpbservice.SetGraffitiRequest) (*empty.Empty, error) {
+	if err := s.checkGraffitiKey(ctx, req.Pubkey); err != nil {
+		return nil, err
+	}
+	if err := graffiti.ValidateTemplate(req.Graffiti); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid graffiti: %v", err)
+	}
+	if err := s.valDB.SaveGraffitiForPubKey(ctx, bytesutil.ToBytes48(req.Pubkey), req.Graffiti); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save graffiti: %v", err)
+	}
+	// override the 200 success with 202 according to the specs
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "202")); err != nil {
+		return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteGraffiti deletes the graffiti of a validator, which then falls back to the graffiti of the validator client.
+func (s *Server) DeleteGraffiti(ctx context.Context, req *ethpbservice.PubkeyRequest) (*empty.Empty, error) {
+	if err := s.checkGraffitiKey(ctx, req.Pubkey); err != nil {
+		return nil, err
+	}
+	if err := s.valDB.DeleteGraffitiForPubKey(ctx, bytesutil.ToBytes48(req.Pubkey)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete graffiti: %v", err)
+	}
+	// override the 200 success with 204 according to the specs
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")); err != nil {
+		return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// checkGraffitiKey checks the graffiti of a validating key managed by the validator client may be accessed.
+func (s *Server) checkGraffitiKey(ctx context.Context, pubKey []byte) error {
+	if s.validatorService == nil {
+		return status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	if s.valDB == nil {
+		return status.Error(codes.FailedPrecondition, "Validator database not ready")
+	}
+	if err := validatePublicKey(pubKey); err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err := s.checkTenantKey(ctx, pubKey); err != nil {
+		return err
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get keymanager: %v", err)
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not fetch validating public keys: %v", err)
+	}
+	for _, k := range pubKeys {
+		if bytes.Equal(k[:], pubKey) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.NotFound, "Validating public key %#x not found", pubKey)
+}
@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_Graffiti(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	s, km := setupKeystoreEncryptionServer(t)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	pubKey := pubKeys[0]
+	validatorDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{
+		PubKeys: [][fieldparams.BLSPubkeyLength]byte{pubKey},
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+	s.valDB = validatorDB
+
+	t.Run("invalid public key", func(t *testing.T) {
+		_, err := s.GetGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: []byte{0x12, 0x34}})
+		assert.ErrorContains(t, "not a valid bls public key", err)
+	})
+	t.Run("unknown public key", func(t *testing.T) {
+		unknown := [fieldparams.BLSPubkeyLength]byte{1}
+		_, err := s.GetGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: unknown[:]})
+		assert.ErrorContains(t, "not found", err)
+		st, ok := status.FromError(err)
+		require.Equal(t, true, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+		_, err = s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: unknown[:], Graffiti: "graffiti"})
+		assert.ErrorContains(t, "not found", err)
+		_, err = s.DeleteGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: unknown[:]})
+		assert.ErrorContains(t, "not found", err)
+	})
+	t.Run("no graffiti set", func(t *testing.T) {
+		_, err := s.GetGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+		assert.ErrorContains(t, "No graffiti set for validator", err)
+	})
+	t.Run("invalid template", func(t *testing.T) {
+		_, err := s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: pubKey[:], Graffiti: "{unknown}"})
+		assert.ErrorContains(t, "Invalid graffiti", err)
+	})
+	t.Run("set, get and delete graffiti", func(t *testing.T) {
+		_, err := s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: pubKey[:], Graffiti: "{execution_client} {slot}"})
+		require.NoError(t, err)
+
+		resp, err := s.GetGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+		require.NoError(t, err)
+		assert.DeepEqual(t, pubKey[:], resp.Data.Pubkey)
+		assert.Equal(t, "{execution_client} {slot}", resp.Data.Graffiti)
+
+		_, err = s.DeleteGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+		require.NoError(t, err)
+		_, err = s.GetGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+		assert.ErrorContains(t, "No graffiti set for validator", err)
+	})
+}
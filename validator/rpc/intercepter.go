@@ -22,7 +22,12 @@ func (s *Server) JWTInterceptor() grpc.UnaryServerInterceptor {
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
 		if err := s.authorize(ctx); err != nil {
-			return nil, err
+			// Tenants are authorized with their own token for the keymanager API, scoped to their keys.
+			t := s.authorizeTenant(ctx, info.FullMethod)
+			if t == nil {
+				return nil, err
+			}
+			ctx = context.WithValue(ctx, tenantContextKey{}, t)
 		}
 		h, err := handler(ctx, req)
 		log.WithError(err).WithFields(logrus.Fields{
@@ -35,29 +40,41 @@ func (s *Server) JWTInterceptor() grpc.UnaryServerInterceptor {
 
 // Authorize the token received is valid.
 func (s *Server) authorize(ctx context.Context) error {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = jwt.Parse(token, s.validateJWT)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "Could not parse JWT token: %v", err)
+	}
+	return nil
+}
+
+// bearerToken returns the bearer token in the authorization header of a request.
+func bearerToken(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return status.Errorf(codes.InvalidArgument, "Retrieving metadata failed")
+		return "", status.Errorf(codes.InvalidArgument, "Retrieving metadata failed")
 	}
 
 	authHeader, ok := md["authorization"]
 	if !ok {
-		return status.Errorf(codes.Unauthenticated, "Authorization token could not be found")
+		return "", status.Errorf(codes.Unauthenticated, "Authorization token could not be found")
 	}
 	if len(authHeader) < 1 || !strings.Contains(authHeader[0], "Bearer ") {
-		return status.Error(codes.Unauthenticated, "Invalid auth header, needs Bearer {token}")
-	}
-	token := strings.Split(authHeader[0], "Bearer ")[1]
-	_, err := jwt.Parse(token, s.validateJWT)
-	if err != nil {
-		return status.Errorf(codes.Unauthenticated, "Could not parse JWT token: %v", err)
+		return "", status.Error(codes.Unauthenticated, "Invalid auth header, needs Bearer {token}")
 	}
-	return nil
+	return strings.Split(authHeader[0], "Bearer ")[1], nil
 }
 
 func (s *Server) validateJWT(token *jwt.Token) (interface{}, error) {
+	return validateJWTWithSecret(token, s.jwtSecret)
+}
+
+func validateJWTWithSecret(token *jwt.Token, secret []byte) (interface{}, error) {
 	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 		return nil, fmt.Errorf("unexpected JWT signing method: %v", token.Header["alg"])
 	}
-	return s.jwtSecret, nil
+	return secret, nil
 }
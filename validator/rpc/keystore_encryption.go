@@ -0,0 +1,136 @@
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
+	"github.com/prysmaticlabs/prysm/v3/io/prompt"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReencryptKeystores re-encrypts the keystores of the wallet with a new password and
+// key derivation function, given the current wallet password. A zero cost selects the
+// default cost of the function, and the default function is used if none is given.
+// The wallet password file of the validator client, if any, is updated with the new password.
+func (s *Server) ReencryptKeystores(ctx context.Context, req *ethpbservice.ReencryptKeystoresRequest) (*ethpbservice.ReencryptKeystoresResponse, error) {
+	if err := s.keystoreEncryptionPrecondition(); err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(req.CurrentPassword), []byte(s.wallet.Password())) != 1 {
+		return nil, status.Error(codes.PermissionDenied, "Incorrect wallet password")
+	}
+	if err := prompt.ValidatePasswordInput(req.NewPassword); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Password too weak: %v", err)
+	}
+	kdf, err := kdfParamsFromRequest(req.Kdf)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get keymanager: %v", err)
+	}
+	if _, ok := km.(keymanager.Reencrypter); !ok {
+		return nil, status.Error(codes.FailedPrecondition, "Keymanager does not support re-encryption")
+	}
+	acc, err := accounts.NewCLIManager(
+		accounts.WithWallet(s.wallet),
+		accounts.WithKeymanager(km),
+		accounts.WithNewWalletPassword(req.NewPassword),
+		accounts.WithKDFParams(kdf),
+		accounts.WithPasswordFilePath(s.walletPasswordFile),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := acc.WalletReencrypt(ctx); err != nil {
+		log.WithError(err).Error("Could not re-encrypt wallet")
+		return nil, status.Errorf(codes.Internal, "Could not re-encrypt wallet: %v", err)
+	}
+	return &ethpbservice.ReencryptKeystoresResponse{Kdf: kdfParamsToProto(kdf)}, nil
+}
+
+// ExportKeystores exports the keys of the given public keys as EIP-2335 keystores,
+// each encrypted with its own password and the requested key derivation function.
+func (s *Server) ExportKeystores(ctx context.Context, req *ethpbservice.ExportKeystoresRequest) (*ethpbservice.ExportKeystoresResponse, error) {
+	if err := s.keystoreEncryptionPrecondition(); err != nil {
+		return nil, err
+	}
+	if len(req.Pubkeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No public keys specified to export")
+	}
+	if len(req.Passwords) != len(req.Pubkeys) {
+		return nil, status.Error(codes.InvalidArgument, "Number of passwords does not match number of public keys")
+	}
+	publicKeys := make([]bls.PublicKey, len(req.Pubkeys))
+	for i, raw := range req.Pubkeys {
+		pubKey, err := bls.PublicKeyFromBytes(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid public key %#x", raw)
+		}
+		publicKeys[i] = pubKey
+	}
+	kdf, err := kdfParamsFromRequest(req.Kdf)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get keymanager: %v", err)
+	}
+	exporter, ok := km.(keymanager.KeystoreExporter)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "Keymanager does not support exporting keystores")
+	}
+	keystores, err := exporter.ExportKeystores(ctx, publicKeys, req.Passwords, kdf)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not export keystores: %v", err)
+	}
+	resp := &ethpbservice.ExportKeystoresResponse{Keystores: make([]string, len(keystores))}
+	for i, k := range keystores {
+		enc, err := json.Marshal(k)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not marshal keystore: %v", err)
+		}
+		resp.Keystores[i] = string(enc)
+	}
+	return resp, nil
+}
+
+// keystoreEncryptionPrecondition checks the wallet is initialized and the validator service ready.
+func (s *Server) keystoreEncryptionPrecondition() error {
+	if !s.walletInitialized {
+		return status.Error(codes.FailedPrecondition, "Prysm Wallet not initialized. Please create a new wallet.")
+	}
+	if s.validatorService == nil {
+		return status.Error(codes.FailedPrecondition, "Validator service not ready. Please try again once validator is ready.")
+	}
+	return nil
+}
+
+func kdfParamsFromRequest(kdf *ethpbservice.KDFParams) (*keystore.KDFParams, error) {
+	if kdf == nil {
+		return keystore.DefaultKDFParams(), nil
+	}
+	function := kdf.Function
+	if function == "" {
+		function = keystore.DefaultKDFParams().Function
+	}
+	if kdf.Cost > math.MaxInt32 {
+		return nil, fmt.Errorf("key derivation cost %d is too high", kdf.Cost)
+	}
+	return keystore.NewKDFParams(function, int(kdf.Cost))
+}
+
+func kdfParamsToProto(kdf *keystore.KDFParams) *ethpbservice.KDFParams {
+	return &ethpbservice.KDFParams{Function: kdf.Function, Cost: uint64(kdf.Cost)}
+}
@@ -0,0 +1,159 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v3/config/params"
+	"github.com/prysmaticlabs/prysm/v3/crypto/keystore"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/iface"
+	mock "github.com/prysmaticlabs/prysm/v3/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/client"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
+	mocks "github.com/prysmaticlabs/prysm/v3/validator/testing"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func setupKeystoreEncryptionServer(t *testing.T) (*Server, keymanager.IKeymanager) {
+	ctx := context.Background()
+	defaultWalletPath = setupWalletDir(t)
+	acc, err := accounts.NewCLIManager(
+		accounts.WithWalletDir(defaultWalletPath),
+		accounts.WithKeymanagerType(keymanager.Derived),
+		accounts.WithWalletPassword(strongPass),
+		accounts.WithSkipMnemonicConfirm(true),
+	)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(ctx)
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	dr, ok := km.(*derived.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, dr.RecoverAccountsFromMnemonic(ctx, mocks.TestMnemonic, "", 2))
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Wallet:    w,
+		Validator: &mock.MockValidator{Km: km},
+	})
+	require.NoError(t, err)
+	return &Server{
+		walletInitialized: true,
+		wallet:            w,
+		validatorService:  vs,
+	}, km
+}
+
+func TestServer_ReencryptKeystores(t *testing.T) {
+	s, _ := setupKeystoreEncryptionServer(t)
+	ctx := context.Background()
+	const newPassword = "NewPassw0rdz!!"
+	kdf := &ethpbservice.KDFParams{Function: keystore.ScryptKDF, Cost: 1024}
+	// The validator client reads the wallet password from a custom file.
+	s.walletPasswordFile = filepath.Join(t.TempDir(), "password.txt")
+	require.NoError(t, os.WriteFile(s.walletPasswordFile, []byte(strongPass), params.BeaconIoConfig().ReadWritePermissions))
+
+	t.Run("wrong current password", func(t *testing.T) {
+		_, err := s.ReencryptKeystores(ctx, &ethpbservice.ReencryptKeystoresRequest{
+			CurrentPassword: "wrong",
+			NewPassword:     newPassword,
+		})
+		assert.ErrorContains(t, "Incorrect wallet password", err)
+	})
+	t.Run("invalid kdf", func(t *testing.T) {
+		_, err := s.ReencryptKeystores(ctx, &ethpbservice.ReencryptKeystoresRequest{
+			CurrentPassword: strongPass,
+			NewPassword:     newPassword,
+			Kdf:             &ethpbservice.KDFParams{Function: keystore.ScryptKDF, Cost: 1000},
+		})
+		assert.ErrorContains(t, "scrypt cost must be a power of 2", err)
+	})
+	t.Run("kdf cost too high", func(t *testing.T) {
+		_, err := s.ReencryptKeystores(ctx, &ethpbservice.ReencryptKeystoresRequest{
+			CurrentPassword: strongPass,
+			NewPassword:     newPassword,
+			Kdf:             &ethpbservice.KDFParams{Function: keystore.ScryptKDF, Cost: keystore.MaxScryptN * 2},
+		})
+		assert.ErrorContains(t, "scrypt cost must be at most", err)
+		_, err = s.ReencryptKeystores(ctx, &ethpbservice.ReencryptKeystoresRequest{
+			CurrentPassword: strongPass,
+			NewPassword:     newPassword,
+			Kdf:             &ethpbservice.KDFParams{Function: keystore.PBKDF2KDF, Cost: 1 << 40},
+		})
+		assert.ErrorContains(t, "key derivation cost 1099511627776 is too high", err)
+	})
+	t.Run("ok", func(t *testing.T) {
+		resp, err := s.ReencryptKeystores(ctx, &ethpbservice.ReencryptKeystoresRequest{
+			CurrentPassword: strongPass,
+			NewPassword:     newPassword,
+			Kdf:             kdf,
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, kdf, resp.Kdf)
+		assert.Equal(t, newPassword, s.wallet.Password())
+		enc, err := os.ReadFile(s.walletPasswordFile)
+		require.NoError(t, err)
+		assert.Equal(t, newPassword, string(enc))
+
+		// The wallet opens with the new password only.
+		w, err := wallet.OpenWallet(ctx, &wallet.Config{WalletDir: defaultWalletPath, WalletPassword: strongPass})
+		require.NoError(t, err)
+		_, err = w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
+		assert.ErrorContains(t, keymanager.IncorrectPasswordErrMsg, err)
+		w, err = wallet.OpenWallet(ctx, &wallet.Config{WalletDir: defaultWalletPath, WalletPassword: newPassword})
+		require.NoError(t, err)
+		_, err = w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
+		require.NoError(t, err)
+	})
+}
+
+func TestServer_ExportKeystores(t *testing.T) {
+	s, km := setupKeystoreEncryptionServer(t)
+	ctx := context.Background()
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	req := &ethpbservice.ExportKeystoresRequest{
+		Pubkeys:   [][]byte{pubKeys[0][:], pubKeys[1][:]},
+		Passwords: []string{"first", "second"},
+		Kdf:       &ethpbservice.KDFParams{Function: keystore.PBKDF2KDF, Cost: 1000},
+	}
+
+	t.Run("mismatched passwords", func(t *testing.T) {
+		_, err := s.ExportKeystores(ctx, &ethpbservice.ExportKeystoresRequest{
+			Pubkeys:   req.Pubkeys,
+			Passwords: req.Passwords[:1],
+		})
+		assert.ErrorContains(t, "Number of passwords does not match", err)
+	})
+	t.Run("invalid public key", func(t *testing.T) {
+		_, err := s.ExportKeystores(ctx, &ethpbservice.ExportKeystoresRequest{
+			Pubkeys:   [][]byte{{0x01}},
+			Passwords: []string{"first"},
+		})
+		assert.ErrorContains(t, "Invalid public key", err)
+	})
+	t.Run("ok", func(t *testing.T) {
+		resp, err := s.ExportKeystores(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Keystores))
+		for i, enc := range resp.Keystores {
+			k := &keymanager.Keystore{}
+			require.NoError(t, json.Unmarshal([]byte(enc), k))
+			assert.Equal(t, hexutil.Encode(req.Pubkeys[i]), "0x"+k.Pubkey)
+			kdf, err := keystore.EIP2335KDFParams(k.Crypto)
+			require.NoError(t, err)
+			assert.DeepEqual(t, &keystore.KDFParams{Function: keystore.PBKDF2KDF, Cost: 1000}, kdf)
+			_, err = keystorev4.New().Decrypt(k.Crypto, req.Passwords[i])
+			require.NoError(t, err)
+		}
+	})
+}
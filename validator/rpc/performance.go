@@ -0,0 +1,92 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sort"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPerformanceHistory returns the per-epoch performance records of validators, along with a
+// summary of each, between the start and end epochs of the request. A zero end epoch leaves the
+// range unbounded, and the validators may be restricted to the requested public keys.
+func (s *Server) GetPerformanceHistory(ctx context.Context, req *pb.PerformanceHistoryRequest) (*pb.PerformanceHistoryResponse, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not ready. Please try again once validator is ready.")
+	}
+	endEpoch := req.EndEpoch
+	if endEpoch == 0 {
+		endEpoch = types.Epoch(math.MaxUint64)
+	}
+	if req.StartEpoch > endEpoch {
+		return nil, status.Error(codes.InvalidArgument, "Start epoch is after end epoch")
+	}
+	var pubKeys [][fieldparams.BLSPubkeyLength]byte
+	for _, pubKey := range req.PublicKeys {
+		if len(pubKey) != fieldparams.BLSPubkeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "Provided public key %#x is not a valid bls public key", pubKey)
+		}
+		pubKeys = append(pubKeys, bytesutil.ToBytes48(pubKey))
+	}
+
+	records, err := s.valDB.PerformanceRecords(ctx, pubKeys, req.StartEpoch, endEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get performance history: %v", err)
+	}
+	if len(pubKeys) == 0 {
+		for pubKey := range records {
+			pubKeys = append(pubKeys, pubKey)
+		}
+		sort.Slice(pubKeys, func(i, j int) bool {
+			return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) < 0
+		})
+	}
+	resp := &pb.PerformanceHistoryResponse{Validators: make([]*pb.ValidatorPerformanceHistory, 0, len(pubKeys))}
+	for _, pubKey := range pubKeys {
+		history := records[pubKey]
+		summary := kv.SummarizePerformance(history)
+		v := &pb.ValidatorPerformanceHistory{
+			PublicKey: bytesutil.SafeCopyBytes(pubKey[:]),
+			Summary: &pb.PerformanceSummary{
+				Epochs:                summary.Epochs,
+				AttestationsIncluded:  summary.AttestationsIncluded,
+				CorrectSource:         summary.CorrectSource,
+				CorrectTarget:         summary.CorrectTarget,
+				CorrectHead:           summary.CorrectHead,
+				ProposalsAssigned:     summary.ProposalsAssigned,
+				ProposalsMade:         summary.ProposalsMade,
+				SyncMessagesExpected:  summary.SyncMessagesExpected,
+				SyncMessagesSubmitted: summary.SyncMessagesSubmitted,
+				AverageInclusionDelay: summary.AverageInclusionDelay,
+				BalanceChange:         summary.BalanceChange,
+			},
+			Records: make([]*pb.PerformanceRecord, len(history)),
+		}
+		for i, r := range history {
+			v.Records[i] = &pb.PerformanceRecord{
+				Epoch:                 r.Epoch,
+				AttestationIncluded:   r.AttestationIncluded,
+				CorrectSource:         r.CorrectSource,
+				CorrectTarget:         r.CorrectTarget,
+				CorrectHead:           r.CorrectHead,
+				InclusionDelay:        r.InclusionDelay,
+				ProposalsAssigned:     r.ProposalsAssigned,
+				ProposalsMade:         r.ProposalsMade,
+				SyncMessagesExpected:  r.SyncMessagesExpected,
+				SyncMessagesSubmitted: r.SyncMessagesSubmitted,
+				BalanceBefore:         r.BalanceBefore,
+				BalanceAfter:          r.BalanceAfter,
+			}
+		}
+		resp.Validators = append(resp.Validators, v)
+	}
+	return resp, nil
+}
@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	pb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+)
+
+func TestServer_GetPerformanceHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKey1 := [fieldparams.BLSPubkeyLength]byte{1}
+	pubKey2 := [fieldparams.BLSPubkeyLength]byte{2}
+	validatorDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{
+		PubKeys: [][fieldparams.BLSPubkeyLength]byte{pubKey1, pubKey2},
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+	for epoch := types.Epoch(1); epoch <= 4; epoch++ {
+		require.NoError(t, validatorDB.SavePerformanceRecords(ctx, map[[fieldparams.BLSPubkeyLength]byte]*kv.PerformanceRecord{
+			pubKey1: {Epoch: epoch, AttestationIncluded: true, CorrectSource: true},
+			pubKey2: {Epoch: epoch},
+		}))
+	}
+	s := &Server{valDB: validatorDB}
+
+	t.Run("database not ready", func(t *testing.T) {
+		_, err := (&Server{}).GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{})
+		assert.ErrorContains(t, "Validator database not ready", err)
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		_, err := s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{StartEpoch: 3, EndEpoch: 2})
+		assert.ErrorContains(t, "Start epoch is after end epoch", err)
+	})
+	t.Run("invalid public key", func(t *testing.T) {
+		_, err := s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{PublicKeys: [][]byte{{0x12, 0x34}}})
+		assert.ErrorContains(t, "is not a valid bls public key", err)
+	})
+	t.Run("all validators", func(t *testing.T) {
+		resp, err := s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Validators))
+		assert.DeepEqual(t, pubKey1[:], resp.Validators[0].PublicKey)
+		assert.Equal(t, 4, len(resp.Validators[0].Records))
+		assert.Equal(t, uint64(4), resp.Validators[0].Summary.AttestationsIncluded)
+		assert.DeepEqual(t, pubKey2[:], resp.Validators[1].PublicKey)
+	})
+	t.Run("range of a validator", func(t *testing.T) {
+		resp, err := s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{
+			PublicKeys: [][]byte{pubKey1[:]},
+			StartEpoch: 2,
+			EndEpoch:   3,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Validators))
+		require.Equal(t, 2, len(resp.Validators[0].Records))
+		assert.Equal(t, types.Epoch(2), resp.Validators[0].Records[0].Epoch)
+		assert.Equal(t, true, resp.Validators[0].Records[0].CorrectSource)
+		assert.Equal(t, uint64(2), resp.Validators[0].Summary.Epochs)
+	})
+}
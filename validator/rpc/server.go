@@ -20,6 +20,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v3/validator/client"
 	"github.com/prysmaticlabs/prysm/v3/validator/db"
+	"github.com/prysmaticlabs/prysm/v3/validator/exits"
+	"github.com/prysmaticlabs/prysm/v3/validator/tenants"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -45,12 +47,14 @@ type Config struct {
 	KeyFlag                  string
 	ValDB                    db.Database
 	WalletDir                string
+	WalletPasswordFile       string
 	ValidatorService         *client.ValidatorService
 	SyncChecker              client.SyncChecker
 	GenesisFetcher           client.GenesisFetcher
 	WalletInitializedFeed    *event.Feed
 	NodeGatewayEndpoint      string
 	Wallet                   *wallet.Wallet
+	Tenants                  *tenants.Tenants
 }
 
 // Server defining a gRPC server for the remote signer API.
@@ -82,6 +86,7 @@ type Server struct {
 	syncChecker               client.SyncChecker
 	genesisFetcher            client.GenesisFetcher
 	walletDir                 string
+	walletPasswordFile        string
 	wallet                    *wallet.Wallet
 	walletInitializedFeed     *event.Feed
 	walletInitialized         bool
@@ -90,6 +95,9 @@ type Server struct {
 	validatorMonitoringPort   int
 	validatorGatewayHost      string
 	validatorGatewayPort      int
+	exitsProgress             *exits.Progress
+	tenants                   *tenants.Tenants
+	tenantJWTSecrets          map[*tenants.Tenant][]byte
 }
 
 // NewServer instantiates a new gRPC server.
@@ -115,6 +123,7 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		syncChecker:              cfg.SyncChecker,
 		genesisFetcher:           cfg.GenesisFetcher,
 		walletDir:                cfg.WalletDir,
+		walletPasswordFile:       cfg.WalletPasswordFile,
 		walletInitializedFeed:    cfg.WalletInitializedFeed,
 		walletInitialized:        cfg.Wallet != nil,
 		wallet:                   cfg.Wallet,
@@ -123,6 +132,8 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		validatorMonitoringPort:  cfg.ValidatorMonitoringPort,
 		validatorGatewayHost:     cfg.ValidatorGatewayHost,
 		validatorGatewayPort:     cfg.ValidatorGatewayPort,
+		exitsProgress:            exits.NewProgress(),
+		tenants:                  cfg.Tenants,
 	}
 }
 
@@ -176,9 +187,16 @@ func (s *Server) Start() {
 	validatorpb.RegisterHealthServer(s.grpcServer, s)
 	validatorpb.RegisterBeaconServer(s.grpcServer, s)
 	validatorpb.RegisterAccountsServer(s.grpcServer, s)
+	validatorpb.RegisterDutiesServer(s.grpcServer, s)
 	ethpbservice.RegisterKeyManagementServer(s.grpcServer, s)
 	validatorpb.RegisterSlashingProtectionServer(s.grpcServer, s)
 
+	if s.tenants != nil {
+		if err := s.initializeTenantAuthTokens(); err != nil {
+			log.WithError(err).Fatal("Could not initialize auth tokens of tenants")
+		}
+	}
+
 	go func() {
 		if s.listener != nil {
 			if err := s.grpcServer.Serve(s.listener); err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
 	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
 	eth "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/validator/db"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/derived"
 	slashingprotection "github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history"
@@ -31,17 +32,28 @@ const nonExistantPublicKey = "0x0"
 func (s *Server) ListKeystores(
 	ctx context.Context, _ *empty.Empty,
 ) (*ethpbservice.ListKeystoresResponse, error) {
-	if !s.walletInitialized {
-		return nil, status.Error(codes.FailedPrecondition, "Prysm Wallet not initialized. Please create a new wallet.")
-	}
-	if s.validatorService == nil {
-		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready. Please try again once validator is ready.")
-	}
-	km, err := s.validatorService.Keymanager()
+	t, err := s.requestTenant(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get Prysm keymanager (possibly due to beacon node unavailable): %v", err)
+		return nil, err
 	}
-	if s.wallet.KeymanagerKind() != keymanager.Derived && s.wallet.KeymanagerKind() != keymanager.Local {
+	var km keymanager.IKeymanager
+	var kind keymanager.Kind
+	if t != nil {
+		km, kind = t.Keymanager, t.Wallet.KeymanagerKind()
+	} else {
+		if !s.walletInitialized {
+			return nil, status.Error(codes.FailedPrecondition, "Prysm Wallet not initialized. Please create a new wallet.")
+		}
+		if s.validatorService == nil {
+			return nil, status.Error(codes.FailedPrecondition, "Validator service not ready. Please try again once validator is ready.")
+		}
+		km, err = s.validatorService.Keymanager()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get Prysm keymanager (possibly due to beacon node unavailable): %v", err)
+		}
+		kind = s.wallet.KeymanagerKind()
+	}
+	if kind != keymanager.Derived && kind != keymanager.Local && kind != keymanager.PKCS11 {
 		return nil, status.Errorf(codes.FailedPrecondition, "Prysm validator keys are not stored locally with this keymanager type.")
 	}
 	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
@@ -53,7 +65,7 @@ func (s *Server) ListKeystores(
 		keystoreResponse[i] = &ethpbservice.ListKeystoresResponse_Keystore{
 			ValidatingPubkey: pubKeys[i][:],
 		}
-		if s.wallet.KeymanagerKind() == keymanager.Derived {
+		if kind == keymanager.Derived {
 			keystoreResponse[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
 		}
 	}
@@ -66,17 +78,26 @@ func (s *Server) ListKeystores(
 func (s *Server) ImportKeystores(
 	ctx context.Context, req *ethpbservice.ImportKeystoresRequest,
 ) (*ethpbservice.ImportKeystoresResponse, error) {
-	if !s.walletInitialized {
-		statuses := groupImportErrors(req, "Prysm Wallet not initialized. Please create a new wallet.")
-		return &ethpbservice.ImportKeystoresResponse{Data: statuses}, nil
-	}
-	if s.validatorService == nil {
-		statuses := groupImportErrors(req, "Validator service not ready. Please try again once validator is ready.")
-		return &ethpbservice.ImportKeystoresResponse{Data: statuses}, nil
-	}
-	km, err := s.validatorService.Keymanager()
+	t, err := s.requestTenant(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get keymanager (possibly due to beacon node unavailable): %v", err)
+		return nil, err
+	}
+	var km keymanager.IKeymanager
+	if t != nil {
+		km = t.Keymanager
+	} else {
+		if !s.walletInitialized {
+			statuses := groupImportErrors(req, "Prysm Wallet not initialized. Please create a new wallet.")
+			return &ethpbservice.ImportKeystoresResponse{Data: statuses}, nil
+		}
+		if s.validatorService == nil {
+			statuses := groupImportErrors(req, "Validator service not ready. Please try again once validator is ready.")
+			return &ethpbservice.ImportKeystoresResponse{Data: statuses}, nil
+		}
+		km, err = s.validatorService.Keymanager()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get keymanager (possibly due to beacon node unavailable): %v", err)
+		}
 	}
 	importer, ok := km.(keymanager.Importer)
 	if !ok {
@@ -96,20 +117,6 @@ func (s *Server) ImportKeystores(
 		}
 		keystores[i] = k
 	}
-	if req.SlashingProtection != "" {
-		if err := slashingprotection.ImportStandardProtectionJSON(
-			ctx, s.valDB, bytes.NewBuffer([]byte(req.SlashingProtection)),
-		); err != nil {
-			statuses := make([]*ethpbservice.ImportedKeystoreStatus, len(req.Keystores))
-			for i := range statuses {
-				statuses[i] = &ethpbservice.ImportedKeystoreStatus{
-					Status:  ethpbservice.ImportedKeystoreStatus_ERROR,
-					Message: fmt.Sprintf("could not import slashing protection: %v", err),
-				}
-			}
-			return &ethpbservice.ImportKeystoresResponse{Data: statuses}, nil
-		}
-	}
 	if len(req.Passwords) == 0 {
 		req.Passwords = make([]string, len(req.Keystores))
 	}
@@ -124,10 +131,61 @@ func (s *Server) ImportKeystores(
 		req.Passwords = passwordList
 	}
 
-	statuses, err := importer.ImportKeystores(ctx, keystores, req.Passwords)
+	// A tenant cannot import the keys of another tenant, nor their slashing protection history.
+	statuses := make([]*ethpbservice.ImportedKeystoreStatus, len(keystores))
+	importedKeystores, passwords := keystores, req.Passwords
+	if t != nil {
+		importedKeystores, passwords = nil, nil
+		for i, k := range keystores {
+			pubKey, err := hexutil.Decode("0x" + normalizeHexPubKey(k.Pubkey))
+			if err == nil && len(pubKey) == fieldparams.BLSPubkeyLength {
+				if owner := s.tenants.Owner(bytesutil.ToBytes48(pubKey)); owner != nil && owner != t {
+					statuses[i] = &ethpbservice.ImportedKeystoreStatus{
+						Status:  ethpbservice.ImportedKeystoreStatus_ERROR,
+						Message: "Validating key belongs to another tenant",
+					}
+					continue
+				}
+			}
+			importedKeystores = append(importedKeystores, k)
+			passwords = append(passwords, req.Passwords[i])
+		}
+	}
+	if req.SlashingProtection != "" {
+		slashingProtection := req.SlashingProtection
+		if t != nil {
+			slashingProtection, err = slashingProtectionOfKeystores(slashingProtection, importedKeystores)
+		}
+		if err == nil {
+			err = slashingprotection.ImportStandardProtectionJSON(
+				ctx, s.slashingProtectionDB(t), bytes.NewBuffer([]byte(slashingProtection)),
+			)
+		}
+		if err != nil {
+			statuses := make([]*ethpbservice.ImportedKeystoreStatus, len(req.Keystores))
+			for i := range statuses {
+				statuses[i] = &ethpbservice.ImportedKeystoreStatus{
+					Status:  ethpbservice.ImportedKeystoreStatus_ERROR,
+					Message: fmt.Sprintf("could not import slashing protection: %v", err),
+				}
+			}
+			return &ethpbservice.ImportKeystoresResponse{Data: statuses}, nil
+		}
+	}
+	importedStatuses, err := importer.ImportKeystores(ctx, importedKeystores, passwords)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not import keystores: %v", err)
 	}
+	j := 0
+	for i := range statuses {
+		if statuses[i] == nil && j < len(importedStatuses) {
+			statuses[i] = importedStatuses[j]
+			j++
+		}
+	}
+	if t != nil {
+		s.applyTenantProposerSettings(ctx)
+	}
 
 	// If any of the keys imported had a slashing protection history before, we
 	// stop marking them as deleted from our validator database.
@@ -149,17 +207,26 @@ func groupImportErrors(req *ethpbservice.ImportKeystoresRequest, errorMessage st
 func (s *Server) DeleteKeystores(
 	ctx context.Context, req *ethpbservice.DeleteKeystoresRequest,
 ) (*ethpbservice.DeleteKeystoresResponse, error) {
-	if !s.walletInitialized {
-		statuses := groupExportErrors(req, "Prysm Wallet not initialized. Please create a new wallet.")
-		return &ethpbservice.DeleteKeystoresResponse{Data: statuses}, nil
-	}
-	if s.validatorService == nil {
-		statuses := groupExportErrors(req, "Validator service not ready")
-		return &ethpbservice.DeleteKeystoresResponse{Data: statuses}, nil
-	}
-	km, err := s.validatorService.Keymanager()
+	t, err := s.requestTenant(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get keymanager (possibly due to beacon node unavailable): %v", err)
+		return nil, err
+	}
+	var km keymanager.IKeymanager
+	if t != nil {
+		km = t.Keymanager
+	} else {
+		if !s.walletInitialized {
+			statuses := groupExportErrors(req, "Prysm Wallet not initialized. Please create a new wallet.")
+			return &ethpbservice.DeleteKeystoresResponse{Data: statuses}, nil
+		}
+		if s.validatorService == nil {
+			statuses := groupExportErrors(req, "Validator service not ready")
+			return &ethpbservice.DeleteKeystoresResponse{Data: statuses}, nil
+		}
+		km, err = s.validatorService.Keymanager()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get keymanager (possibly due to beacon node unavailable): %v", err)
+		}
 	}
 	if len(req.Pubkeys) == 0 {
 		return &ethpbservice.DeleteKeystoresResponse{Data: make([]*ethpbservice.DeletedKeystoreStatus, 0)}, nil
@@ -169,12 +236,16 @@ func (s *Server) DeleteKeystores(
 		return nil, status.Errorf(codes.Internal, "Could not delete keys: %v", err)
 	}
 
-	statuses, err = s.transformDeletedKeysStatuses(ctx, req.Pubkeys, statuses)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not transform deleted keys statuses: %v", err)
+	// The keys a tenant no longer has may belong to another tenant, so only the slashing protection
+	// history of the keys deleted by the tenant is exported.
+	if t == nil {
+		statuses, err = s.transformDeletedKeysStatuses(ctx, req.Pubkeys, statuses)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not transform deleted keys statuses: %v", err)
+		}
 	}
 
-	exportedHistory, err := s.slashingProtectionHistoryForDeletedKeys(ctx, req.Pubkeys, statuses)
+	exportedHistory, err := s.slashingProtectionHistoryForDeletedKeys(ctx, s.slashingProtectionDB(t), req.Pubkeys, statuses)
 	if err != nil {
 		log.WithError(err).Warn("Could not get slashing protection history for deleted keys")
 		statuses := groupExportErrors(req, "Non duplicate keys that were existing were deleted, but could not export slashing protection history.")
@@ -247,7 +318,7 @@ func (s *Server) publicKeysInDB(ctx context.Context) (map[[fieldparams.BLSPubkey
 // Exports slashing protection data for a list of DELETED or NOT_ACTIVE keys only to be used
 // as part of the DeleteKeystores endpoint.
 func (s *Server) slashingProtectionHistoryForDeletedKeys(
-	ctx context.Context, pubKeys [][]byte, statuses []*ethpbservice.DeletedKeystoreStatus,
+	ctx context.Context, valDB db.Database, pubKeys [][]byte, statuses []*ethpbservice.DeletedKeystoreStatus,
 ) (*format.EIPSlashingProtectionFormat, error) {
 	// We select the keys that were DELETED or NOT_ACTIVE from the previous action
 	// and use that to filter our slashing protection export.
@@ -258,11 +329,19 @@ func (s *Server) slashingProtectionHistoryForDeletedKeys(
 			filteredKeys = append(filteredKeys, pk)
 		}
 	}
-	return slashingprotection.ExportStandardProtectionJSON(ctx, s.valDB, filteredKeys...)
+	return slashingprotection.ExportStandardProtectionJSON(ctx, valDB, filteredKeys...)
 }
 
 // ListRemoteKeys returns a list of all public keys defined for web3signer keymanager type.
 func (s *Server) ListRemoteKeys(ctx context.Context, _ *empty.Empty) (*ethpbservice.ListRemoteKeysResponse, error) {
+	t, err := s.requestTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if t != nil {
+		// The wallets of tenants are stored on disk, so they are never of type Web3Signer.
+		return nil, status.Errorf(codes.FailedPrecondition, "Wallet of tenant is not of type Web3Signer.")
+	}
 	if !s.walletInitialized {
 		return nil, status.Error(codes.FailedPrecondition, "Prysm Wallet not initialized. Please create a new wallet.")
 	}
@@ -295,6 +374,14 @@ func (s *Server) ListRemoteKeys(ctx context.Context, _ *empty.Empty) (*ethpbserv
 
 // ImportRemoteKeys imports a list of public keys defined for web3signer keymanager type.
 func (s *Server) ImportRemoteKeys(ctx context.Context, req *ethpbservice.ImportRemoteKeysRequest) (*ethpbservice.ImportRemoteKeysResponse, error) {
+	t, err := s.requestTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if t != nil {
+		// The wallets of tenants are stored on disk, so they are never of type Web3Signer.
+		return nil, status.Errorf(codes.FailedPrecondition, "Wallet of tenant is not of type Web3Signer.")
+	}
 	if !s.walletInitialized {
 		return nil, status.Error(codes.FailedPrecondition, "Prysm Wallet not initialized. Please create a new wallet.")
 	}
@@ -349,6 +436,14 @@ func groupImportRemoteKeysErrors(req *ethpbservice.ImportRemoteKeysRequest, erro
 
 // DeleteRemoteKeys deletes a list of public keys defined for web3signer keymanager type.
 func (s *Server) DeleteRemoteKeys(ctx context.Context, req *ethpbservice.DeleteRemoteKeysRequest) (*ethpbservice.DeleteRemoteKeysResponse, error) {
+	t, err := s.requestTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if t != nil {
+		// The wallets of tenants are stored on disk, so they are never of type Web3Signer.
+		return nil, status.Errorf(codes.FailedPrecondition, "Wallet of tenant is not of type Web3Signer.")
+	}
 	if !s.walletInitialized {
 		return nil, status.Error(codes.FailedPrecondition, "Prysm Wallet not initialized. Please create a new wallet.")
 	}
@@ -392,7 +487,7 @@ func groupDeleteRemoteKeysErrors(req *ethpbservice.DeleteRemoteKeysRequest, erro
 	return statuses
 }
 
-func (s *Server) GetGasLimit(_ context.Context, req *ethpbservice.PubkeyRequest) (*ethpbservice.GetGasLimitResponse, error) {
+func (s *Server) GetGasLimit(ctx context.Context, req *ethpbservice.PubkeyRequest) (*ethpbservice.GetGasLimitResponse, error) {
 	if s.validatorService == nil {
 		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
 	}
@@ -400,6 +495,9 @@ func (s *Server) GetGasLimit(_ context.Context, req *ethpbservice.PubkeyRequest)
 	if err := validatePublicKey(validatorKey); err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
+	if err := s.checkTenantKey(ctx, validatorKey); err != nil {
+		return nil, err
+	}
 	resp := &ethpbservice.GetGasLimitResponse{
 		Data: &ethpbservice.GetGasLimitResponse_GasLimit{
 			Pubkey: validatorKey,
@@ -430,6 +528,9 @@ func (s *Server) SetGasLimit(ctx context.Context, req *ethpbservice.SetGasLimitR
 	if err := validatePublicKey(validatorKey); err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
+	if err := s.checkTenantKey(ctx, validatorKey); err != nil {
+		return nil, err
+	}
 
 	defaultOption := validatorServiceConfig.DefaultProposerOption()
 	var pBuilderConfig *validatorServiceConfig.BuilderConfig
@@ -504,6 +605,9 @@ func (s *Server) DeleteGasLimit(ctx context.Context, req *ethpbservice.DeleteGas
 	if err := validatePublicKey(validatorKey); err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
+	if err := s.checkTenantKey(ctx, validatorKey); err != nil {
+		return nil, err
+	}
 
 	proposerSettings := s.validatorService.ProposerSettings()
 	if proposerSettings != nil && proposerSettings.ProposeConfig != nil {
@@ -538,6 +642,9 @@ func (s *Server) ListFeeRecipientByPubkey(ctx context.Context, req *ethpbservice
 	if err := validatePublicKey(validatorKey); err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
+	if err := s.checkTenantKey(ctx, validatorKey); err != nil {
+		return nil, err
+	}
 	defaultFeeRecipient := params.BeaconConfig().DefaultFeeRecipient.Bytes()
 	finalResp := &ethpbservice.GetFeeRecipientByPubkeyResponse{
 		Data: &ethpbservice.GetFeeRecipientByPubkeyResponse_FeeRecipient{
@@ -578,6 +685,9 @@ func (s *Server) SetFeeRecipientByPubkey(ctx context.Context, req *ethpbservice.
 	if err := validatePublicKey(validatorKey); err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
+	if err := s.checkTenantKey(ctx, validatorKey); err != nil {
+		return nil, err
+	}
 	defaultOption := validatorServiceConfig.DefaultProposerOption()
 	encoded := hexutil.Encode(req.Ethaddress)
 	if !common.IsHexAddress(encoded) {
@@ -640,6 +750,9 @@ func (s *Server) DeleteFeeRecipientByPubkey(ctx context.Context, req *ethpbservi
 	if err := validatePublicKey(validatorKey); err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
+	if err := s.checkTenantKey(ctx, validatorKey); err != nil {
+		return nil, err
+	}
 	defaultFeeRecipient := params.BeaconConfig().DefaultFeeRecipient
 	if s.validatorService.ProposerSettings() != nil && s.validatorService.ProposerSettings().DefaultConfig != nil {
 		defaultFeeRecipient = s.validatorService.ProposerSettings().DefaultConfig.FeeRecipient
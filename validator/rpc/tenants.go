@@ -0,0 +1,159 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/validator/db"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history/format"
+	"github.com/prysmaticlabs/prysm/v3/validator/tenants"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// keyManagementMethodPrefix is the prefix of the gRPC methods of the standard keymanager API, which are
+// the only methods tenants are authorized to call.
+const keyManagementMethodPrefix = "/ethereum.eth.service.KeyManagement/"
+
+type tenantContextKey struct{}
+
+// tenantFromContext returns the tenant whose auth token authorized a request, if any.
+func tenantFromContext(ctx context.Context) *tenants.Tenant {
+	t, ok := ctx.Value(tenantContextKey{}).(*tenants.Tenant)
+	if !ok {
+		return nil
+	}
+	return t
+}
+
+// initializeTenantAuthTokens reads the auth token of each tenant from its wallet directory, creating
+// it if missing.
+func (s *Server) initializeTenantAuthTokens() error {
+	s.tenantJWTSecrets = make(map[*tenants.Tenant][]byte, len(s.tenants.List()))
+	for _, t := range s.tenants.List() {
+		secret, _, err := readOrCreateAuthToken(t.WalletDir)
+		if err != nil {
+			return errors.Wrapf(err, "could not initialize auth token of tenant %s", t.Name)
+		}
+		s.tenantJWTSecrets[t] = secret
+		log.WithFields(logrus.Fields{
+			"tenant":        t.Name,
+			"authTokenPath": filepath.Join(t.WalletDir, authTokenFileName),
+		}).Info("Initialized keymanager API auth token of tenant")
+	}
+	return nil
+}
+
+// authorizeTenant returns the tenant whose auth token authorizes a request to the standard keymanager API,
+// or nil if the token is not the token of a tenant.
+func (s *Server) authorizeTenant(ctx context.Context, fullMethod string) *tenants.Tenant {
+	if s.tenants == nil || !strings.HasPrefix(fullMethod, keyManagementMethodPrefix) {
+		return nil
+	}
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil
+	}
+	for t, secret := range s.tenantJWTSecrets {
+		secret := secret
+		if _, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+			return validateJWTWithSecret(token, secret)
+		}); err == nil {
+			return t
+		}
+	}
+	return nil
+}
+
+// requestTenant returns the tenant a keymanager API request is scoped to, or nil if the validator client
+// does not host tenants. The keys of a validator client hosting tenants can only be managed with the auth
+// token of their tenant.
+func (s *Server) requestTenant(ctx context.Context) (*tenants.Tenant, error) {
+	if s.tenants == nil {
+		return nil, nil
+	}
+	t := tenantFromContext(ctx)
+	if t == nil {
+		return nil, status.Error(
+			codes.PermissionDenied, "The keys of a multi-tenant validator client can only be managed with the auth token of their tenant",
+		)
+	}
+	return t, nil
+}
+
+// checkTenantKey checks that a validating key belongs to the tenant whose auth token authorized the request,
+// if any.
+func (s *Server) checkTenantKey(ctx context.Context, pubKey []byte) error {
+	t := tenantFromContext(ctx)
+	if t == nil {
+		return nil
+	}
+	owns, err := t.Owns(ctx, bytesutil.ToBytes48(pubKey))
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not retrieve keys of tenant: %v", err)
+	}
+	if !owns {
+		return status.Error(codes.PermissionDenied, "Validating key does not belong to the tenant")
+	}
+	return nil
+}
+
+// applyTenantProposerSettings gives the keys just imported by a tenant the proposer settings of the tenant.
+func (s *Server) applyTenantProposerSettings(ctx context.Context) {
+	if s.validatorService == nil {
+		return
+	}
+	settings, err := s.tenants.ProposerSettings(ctx, s.validatorService.ProposerSettings())
+	if err != nil {
+		log.WithError(err).Error("Could not apply proposer settings of tenants")
+		return
+	}
+	if settings != nil {
+		s.validatorService.SetProposerSettings(settings)
+	}
+}
+
+// slashingProtectionDB returns the database storing the slashing protection history of the keys of the tenant
+// a request is scoped to, or the validator database if the request is not scoped to a tenant.
+func (s *Server) slashingProtectionDB(t *tenants.Tenant) db.Database {
+	if t != nil && t.DB != nil {
+		return t.DB
+	}
+	return s.valDB
+}
+
+// slashingProtectionOfKeystores keeps the slashing protection history of the imported keystores only, so
+// that a tenant cannot alter the history of the keys of another tenant.
+func slashingProtectionOfKeystores(slashingProtection string, keystores []*keymanager.Keystore) (string, error) {
+	history := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal([]byte(slashingProtection), history); err != nil {
+		return "", errors.Wrap(err, "could not decode slashing protection history")
+	}
+	imported := make(map[string]bool, len(keystores))
+	for _, k := range keystores {
+		imported[normalizeHexPubKey(k.Pubkey)] = true
+	}
+	data := make([]*format.ProtectionData, 0, len(history.Data))
+	for _, d := range history.Data {
+		if d != nil && imported[normalizeHexPubKey(d.Pubkey)] {
+			data = append(data, d)
+		}
+	}
+	history.Data = data
+	enc, err := json.Marshal(history)
+	if err != nil {
+		return "", errors.Wrap(err, "could not encode slashing protection history")
+	}
+	return string(enc), nil
+}
+
+func normalizeHexPubKey(pubKey string) string {
+	return strings.TrimPrefix(strings.ToLower(pubKey), "0x")
+}
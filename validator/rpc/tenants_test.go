@@ -0,0 +1,226 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/db"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/v3/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
+	"github.com/prysmaticlabs/prysm/v3/validator/slashing-protection-history/format"
+	"github.com/prysmaticlabs/prysm/v3/validator/tenants"
+	mocks "github.com/prysmaticlabs/prysm/v3/validator/testing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func setupServerWithTenants(t *testing.T) (*Server, *tenants.Tenant, *tenants.Tenant) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	newTenant := func(name string, offset, numKeys uint64) *tenants.Tenant {
+		km, err := local.NewInteropKeymanager(ctx, offset, numKeys)
+		require.NoError(t, err)
+		return &tenants.Tenant{
+			Name:       name,
+			Wallet:     wallet.New(&wallet.Config{KeymanagerKind: keymanager.Local}),
+			Keymanager: km,
+		}
+	}
+	acme := newTenant("acme", 0, 2)
+	globex := newTenant("globex", 2, 1)
+	ts, err := tenants.New(ctx, []*tenants.Tenant{acme, globex})
+	require.NoError(t, err)
+	s := &Server{
+		jwtSecret: []byte("testKey"),
+		tenants:   ts,
+		tenantJWTSecrets: map[*tenants.Tenant][]byte{
+			acme:   []byte("acmeKey"),
+			globex: []byte("globexKey"),
+		},
+	}
+	return s, acme, globex
+}
+
+func TestServer_JWTInterceptor_Tenant(t *testing.T) {
+	s, acme, _ := setupServerWithTenants(t)
+	interceptor := s.JWTInterceptor()
+
+	var requestTenant *tenants.Tenant
+	unaryHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		requestTenant = tenantFromContext(ctx)
+		return nil, nil
+	}
+	callWithToken := func(secret []byte, method string) error {
+		token, err := createTokenString(secret)
+		require.NoError(t, err)
+		ctx := metadata.NewIncomingContext(context.Background(), map[string][]string{
+			"authorization": {"Bearer " + token},
+		})
+		_, err = interceptor(ctx, "xyz", &grpc.UnaryServerInfo{FullMethod: method}, unaryHandler)
+		return err
+	}
+
+	require.NoError(t, callWithToken(s.tenantJWTSecrets[acme], keyManagementMethodPrefix+"ListKeystores"))
+	assert.Equal(t, acme, requestTenant)
+
+	// The token of the validator client is not scoped to a tenant.
+	require.NoError(t, callWithToken(s.jwtSecret, keyManagementMethodPrefix+"ListKeystores"))
+	assert.Equal(t, true, requestTenant == nil)
+
+	// Tenants can only call the keymanager API.
+	err := callWithToken(s.tenantJWTSecrets[acme], "/ethereum.validator.accounts.v2.Accounts/ListAccounts")
+	require.ErrorContains(t, "signature is invalid", err)
+}
+
+func TestServer_ListKeystores_Tenants(t *testing.T) {
+	s, acme, globex := setupServerWithTenants(t)
+
+	_, err := s.ListKeystores(context.Background(), &empty.Empty{})
+	require.ErrorContains(t, "can only be managed with the auth token of their tenant", err)
+
+	for _, tenant := range []*tenants.Tenant{acme, globex} {
+		ctx := context.WithValue(context.Background(), tenantContextKey{}, tenant)
+		resp, err := s.ListKeystores(ctx, &empty.Empty{})
+		require.NoError(t, err)
+		expectedKeys, err := tenant.Keymanager.FetchValidatingPublicKeys(ctx)
+		require.NoError(t, err)
+		require.Equal(t, len(expectedKeys), len(resp.Data))
+		for i, k := range resp.Data {
+			assert.DeepEqual(t, expectedKeys[i][:], k.ValidatingPubkey)
+		}
+	}
+}
+
+func TestServer_CheckTenantKey(t *testing.T) {
+	s, acme, globex := setupServerWithTenants(t)
+	acmeKeys, err := acme.Keymanager.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	globexKeys, err := globex.Keymanager.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+
+	// Requests which are not scoped to a tenant can use any key.
+	require.NoError(t, s.checkTenantKey(context.Background(), globexKeys[0][:]))
+
+	ctx := context.WithValue(context.Background(), tenantContextKey{}, acme)
+	require.NoError(t, s.checkTenantKey(ctx, acmeKeys[1][:]))
+	require.ErrorContains(t, "Validating key does not belong to the tenant", s.checkTenantKey(ctx, globexKeys[0][:]))
+}
+
+func TestSlashingProtectionOfKeystores(t *testing.T) {
+	history := &format.EIPSlashingProtectionFormat{
+		Data: []*format.ProtectionData{
+			{Pubkey: "0xAAAA"},
+			{Pubkey: "0xbbbb"},
+			{Pubkey: "0xcccc"},
+		},
+	}
+	history.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	enc, err := json.Marshal(history)
+	require.NoError(t, err)
+
+	filtered, err := slashingProtectionOfKeystores(string(enc), []*keymanager.Keystore{
+		{Pubkey: "aaaa"},
+		{Pubkey: "0xCCCC"},
+	})
+	require.NoError(t, err)
+	result := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(filtered), result))
+	assert.Equal(t, format.InterchangeFormatVersion, result.Metadata.InterchangeFormatVersion)
+	require.Equal(t, 2, len(result.Data))
+	assert.Equal(t, "0xAAAA", result.Data[0].Pubkey)
+	assert.Equal(t, "0xcccc", result.Data[1].Pubkey)
+
+	_, err = slashingProtectionOfKeystores("{", nil)
+	require.ErrorContains(t, "could not decode slashing protection history", err)
+}
+
+func TestServer_ImportKeystores_Tenants(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	password := "12345678"
+	newTenant := func(name string) *tenants.Tenant {
+		acc, err := accounts.NewCLIManager(
+			accounts.WithWalletDir(setupWalletDir(t)),
+			accounts.WithKeymanagerType(keymanager.Local),
+			accounts.WithWalletPassword(strongPass),
+		)
+		require.NoError(t, err)
+		w, err := acc.WalletCreate(ctx)
+		require.NoError(t, err)
+		km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
+		require.NoError(t, err)
+		tenantDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{DisableMetrics: true})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, tenantDB.Close())
+		})
+		return &tenants.Tenant{Name: name, Wallet: w, Keymanager: km, DB: tenantDB}
+	}
+	acme, globex := newTenant("acme"), newTenant("globex")
+	globexKeystore := createRandomKeystore(t, password)
+	_, err := globex.Keymanager.(keymanager.Importer).ImportKeystores(
+		ctx, []*keymanager.Keystore{globexKeystore}, []string{password},
+	)
+	require.NoError(t, err)
+	ts, err := tenants.New(ctx, []*tenants.Tenant{acme, globex})
+	require.NoError(t, err)
+	s := &Server{tenants: ts, valDB: dbtest.SetupDB(t, nil)}
+
+	acmeKeystore := createRandomKeystore(t, password)
+	keystores := []*keymanager.Keystore{globexKeystore, acmeKeystore}
+	encodedKeystores := make([]string, len(keystores))
+	publicKeys := make([][fieldparams.BLSPubkeyLength]byte, len(keystores))
+	proposalHistory := make([]kv.ProposalHistoryForPubkey, len(keystores))
+	for i, k := range keystores {
+		enc, err := json.Marshal(k)
+		require.NoError(t, err)
+		encodedKeystores[i] = string(enc)
+		pubKey, err := hex.DecodeString(k.Pubkey)
+		require.NoError(t, err)
+		publicKeys[i] = bytesutil.ToBytes48(pubKey)
+		proposalHistory[i].Proposals = []kv.Proposal{{Slot: 10, SigningRoot: bytesutil.PadTo([]byte{1}, 32)}}
+	}
+	mockJSON, err := mocks.MockSlashingProtectionJSON(publicKeys, nil, proposalHistory)
+	require.NoError(t, err)
+	encodedSlashingProtection, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+
+	resp, err := s.ImportKeystores(context.WithValue(ctx, tenantContextKey{}, acme), &ethpbservice.ImportKeystoresRequest{
+		Keystores:          encodedKeystores,
+		Passwords:          []string{password, password},
+		SlashingProtection: string(encodedSlashingProtection),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_ERROR, resp.Data[0].Status)
+	assert.Equal(t, "Validating key belongs to another tenant", resp.Data[0].Message)
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_IMPORTED, resp.Data[1].Status)
+
+	// The slashing protection history of the key of the tenant is imported into the database of the tenant only.
+	_, exists, err := acme.DB.ProposalHistoryForSlot(ctx, publicKeys[1], 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	_, exists, err = acme.DB.ProposalHistoryForSlot(ctx, publicKeys[0], 10)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+	for _, valDB := range []db.Database{s.valDB, globex.DB} {
+		for _, pubKey := range publicKeys {
+			_, exists, err = valDB.ProposalHistoryForSlot(ctx, pubKey, 10)
+			require.NoError(t, err)
+			assert.Equal(t, false, exists)
+		}
+	}
+}
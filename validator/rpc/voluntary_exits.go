@@ -0,0 +1,89 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/validator/exits"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// BroadcastVoluntaryExits starts broadcasting, in the background and through the beacon node of the
+// validator client, voluntary exits pre-signed with the `validator accounts presign-exits` command.
+// The exits of future epochs are reported as pending, and submitted once their epoch starts.
+func (s *Server) BroadcastVoluntaryExits(ctx context.Context, req *ethpbservice.BroadcastVoluntaryExitsRequest) (*ethpbservice.VoluntaryExitsBroadcastResponse, error) {
+	if s.beaconNodeValidatorClient == nil || s.genesisFetcher == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Beacon node client not ready. Please try again once validator is ready.")
+	}
+	if len(req.Exits) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No voluntary exits provided")
+	}
+	signedExits := make([]*ethpb.SignedVoluntaryExit, len(req.Exits))
+	for i, enc := range req.Exits {
+		exitJSON := &exits.SignedVoluntaryExitJSON{}
+		if err := json.Unmarshal([]byte(enc), exitJSON); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not decode voluntary exit at index %d: %v", i, err)
+		}
+		signedExit, err := exits.FromJSON(exitJSON)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid voluntary exit at index %d: %v", i, err)
+		}
+		signedExits[i] = signedExit
+	}
+	genesis, err := s.genesisFetcher.GenesisInfo(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get genesis time: %v", err)
+	}
+	submit := func(ctx context.Context, signedExit *ethpb.SignedVoluntaryExit) error {
+		_, err := s.beaconNodeValidatorClient.ProposeExit(ctx, signedExit)
+		return err
+	}
+	genesisTime := genesis.GenesisTime.AsTime()
+	if err := exits.BroadcastInBackground(s.ctx, signedExits, submit, genesisTime, s.exitsProgress); err != nil {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	// override the 200 success with 202 as the exits are broadcast in the background
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "202")); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
+	}
+	return exitsReportToProto(s.exitsProgress.Report()), nil
+}
+
+// GetVoluntaryExitsBroadcast reports the progress of the broadcast of pre-signed voluntary exits.
+func (s *Server) GetVoluntaryExitsBroadcast(_ context.Context, _ *empty.Empty) (*ethpbservice.VoluntaryExitsBroadcastResponse, error) {
+	return exitsReportToProto(s.exitsProgress.Report()), nil
+}
+
+func exitsReportToProto(r *exits.Report) *ethpbservice.VoluntaryExitsBroadcastResponse {
+	resp := &ethpbservice.VoluntaryExitsBroadcastResponse{
+		Total:     uint64(r.Total),
+		Submitted: uint64(r.Submitted),
+		Failed:    uint64(r.Failed),
+		Pending:   uint64(r.Pending),
+		Running:   r.Running,
+		Errors:    make([]*ethpbservice.VoluntaryExitError, 0, len(r.Errors)),
+	}
+	for rawIndex, msg := range r.Errors {
+		index, err := strconv.ParseUint(rawIndex, 10, 64)
+		if err != nil {
+			continue
+		}
+		resp.Errors = append(resp.Errors, &ethpbservice.VoluntaryExitError{
+			ValidatorIndex: types.ValidatorIndex(index),
+			Error:          msg,
+		})
+	}
+	sort.Slice(resp.Errors, func(i, j int) bool {
+		return resp.Errors[i].ValidatorIndex < resp.Errors[j].ValidatorIndex
+	})
+	return resp
+}
@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	mock2 "github.com/prysmaticlabs/prysm/v3/testing/mock"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/exits"
+	"google.golang.org/grpc"
+)
+
+func TestServer_BroadcastVoluntaryExits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	beaconClient := mock2.NewMockBeaconNodeValidatorClient(ctrl)
+	s := &Server{
+		ctx:                       context.Background(),
+		beaconNodeValidatorClient: beaconClient,
+		genesisFetcher:            &mockGenesisFetcher{},
+		exitsProgress:             exits.NewProgress(),
+	}
+
+	t.Run("no exits", func(t *testing.T) {
+		_, err := s.BroadcastVoluntaryExits(ctx, &ethpbservice.BroadcastVoluntaryExitsRequest{})
+		assert.ErrorContains(t, "No voluntary exits provided", err)
+	})
+	t.Run("invalid exit", func(t *testing.T) {
+		_, err := s.BroadcastVoluntaryExits(ctx, &ethpbservice.BroadcastVoluntaryExitsRequest{
+			Exits: []string{`{"message":{"epoch":"1","validator_index":"1"},"signature":"0x01"}`},
+		})
+		assert.ErrorContains(t, "Invalid voluntary exit at index 0", err)
+		_, err = s.BroadcastVoluntaryExits(ctx, &ethpbservice.BroadcastVoluntaryExitsRequest{Exits: []string{"{"}})
+		assert.ErrorContains(t, "Could not decode voluntary exit at index 0", err)
+	})
+	t.Run("broadcast", func(t *testing.T) {
+		req := &ethpbservice.BroadcastVoluntaryExitsRequest{}
+		for i := types.ValidatorIndex(1); i <= 3; i++ {
+			enc, err := json.Marshal(exits.ToJSON(&ethpb.SignedVoluntaryExit{
+				Exit:      &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: i},
+				Signature: make([]byte, fieldparams.BLSSignatureLength),
+			}))
+			require.NoError(t, err)
+			req.Exits = append(req.Exits, string(enc))
+		}
+		beaconClient.EXPECT().ProposeExit(gomock.Any(), gomock.Any()).Return(&ethpb.ProposeExitResponse{}, nil).Times(3)
+
+		resp, err := s.BroadcastVoluntaryExits(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), resp.Total)
+
+		for i := 0; i < 100; i++ {
+			resp, err = s.GetVoluntaryExitsBroadcast(ctx, &empty.Empty{})
+			require.NoError(t, err)
+			if !resp.Running {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		assert.Equal(t, false, resp.Running)
+		assert.Equal(t, uint64(3), resp.Submitted)
+		assert.Equal(t, uint64(0), resp.Pending)
+	})
+}
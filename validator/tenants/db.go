@@ -0,0 +1,353 @@
+package tenants
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/v3/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+)
+
+var _ = iface.ValidatorDB(&slashingProtectionDB{})
+
+// slashingProtectionDB keeps the slashing protection history of the keys of each tenant in the database of
+// the tenant, and the history of the keys of no tenant in the database of the validator client, which also
+// stores everything unrelated to slashing protection. Keys are checked against the history of every
+// database, so that a key moved from a tenant to another without its history cannot be slashed.
+type slashingProtectionDB struct {
+	iface.ValidatorDB
+	ts *Tenants
+}
+
+// SlashingProtectionDB returns a validator database storing the slashing protection history of the keys of
+// each tenant in the database of the tenant. The genesis validators root of the validator database is copied
+// to the databases of the tenants which do not have one yet.
+func (ts *Tenants) SlashingProtectionDB(ctx context.Context, db iface.ValidatorDB) (iface.ValidatorDB, error) {
+	root, err := db.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis validators root")
+	}
+	if len(root) != 0 {
+		for _, t := range ts.tenants {
+			if t.DB == nil {
+				continue
+			}
+			if err := saveGenesisValidatorsRoot(ctx, t.DB, root); err != nil {
+				return nil, errors.Wrapf(err, "invalid slashing protection database of tenant %s", t.Name)
+			}
+		}
+	}
+	return &slashingProtectionDB{ValidatorDB: db, ts: ts}, nil
+}
+
+// saveGenesisValidatorsRoot saves the genesis validators root to a database without one, and checks that it
+// matches the root of a database which has one.
+func saveGenesisValidatorsRoot(ctx context.Context, db iface.ValidatorDB, root []byte) error {
+	current, err := db.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis validators root")
+	}
+	if len(current) == 0 {
+		return db.SaveGenesisValidatorsRoot(ctx, root)
+	}
+	if !bytes.Equal(current, root) {
+		return errors.New("genesis validators root does not match the one of the validator database")
+	}
+	return nil
+}
+
+// dbOf returns the database storing the history of a key: the database of its tenant, if any.
+func (d *slashingProtectionDB) dbOf(pubKey [fieldparams.BLSPubkeyLength]byte) iface.ValidatorDB {
+	if t := d.ts.Owner(pubKey); t != nil && t.DB != nil {
+		return t.DB
+	}
+	return d.ValidatorDB
+}
+
+// dbsOf returns every database, starting with the database storing the history of a key.
+func (d *slashingProtectionDB) dbsOf(pubKey [fieldparams.BLSPubkeyLength]byte) []iface.ValidatorDB {
+	first := d.dbOf(pubKey)
+	dbs := []iface.ValidatorDB{first}
+	for _, db := range d.dbs() {
+		if db != first {
+			dbs = append(dbs, db)
+		}
+	}
+	return dbs
+}
+
+// dbs returns the database of the validator client and the databases of the tenants.
+func (d *slashingProtectionDB) dbs() []iface.ValidatorDB {
+	dbs := []iface.ValidatorDB{d.ValidatorDB}
+	for _, t := range d.ts.tenants {
+		if t.DB != nil {
+			dbs = append(dbs, t.DB)
+		}
+	}
+	return dbs
+}
+
+// groupByDB groups keys by the database storing their history.
+func (d *slashingProtectionDB) groupByDB(
+	pubKeys [][fieldparams.BLSPubkeyLength]byte,
+) map[iface.ValidatorDB][][fieldparams.BLSPubkeyLength]byte {
+	groups := make(map[iface.ValidatorDB][][fieldparams.BLSPubkeyLength]byte)
+	for _, pubKey := range pubKeys {
+		db := d.dbOf(pubKey)
+		groups[db] = append(groups[db], pubKey)
+	}
+	return groups
+}
+
+// Close the database of the validator client and the databases of the tenants.
+func (d *slashingProtectionDB) Close() error {
+	for _, t := range d.ts.tenants {
+		if t.DB == nil {
+			continue
+		}
+		if err := t.DB.Close(); err != nil {
+			return errors.Wrapf(err, "could not close slashing protection database of tenant %s", t.Name)
+		}
+	}
+	return d.ValidatorDB.Close()
+}
+
+// UpdatePublicKeysBuckets creates the buckets of the keys in the database storing their history.
+func (d *slashingProtectionDB) UpdatePublicKeysBuckets(publicKeys [][fieldparams.BLSPubkeyLength]byte) error {
+	for db, pubKeys := range d.groupByDB(publicKeys) {
+		if err := db.UpdatePublicKeysBuckets(pubKeys); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveGenesisValidatorsRoot saves the genesis validators root to every database.
+func (d *slashingProtectionDB) SaveGenesisValidatorsRoot(ctx context.Context, genValRoot []byte) error {
+	if err := d.ValidatorDB.SaveGenesisValidatorsRoot(ctx, genValRoot); err != nil {
+		return err
+	}
+	for _, t := range d.ts.tenants {
+		if t.DB == nil {
+			continue
+		}
+		if err := saveGenesisValidatorsRoot(ctx, t.DB, genValRoot); err != nil {
+			return errors.Wrapf(err, "could not save genesis validators root of tenant %s", t.Name)
+		}
+	}
+	return nil
+}
+
+// HighestSignedProposal returns the highest slot signed by a key in any database.
+func (d *slashingProtectionDB) HighestSignedProposal(
+	ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte,
+) (types.Slot, bool, error) {
+	var highest types.Slot
+	var found bool
+	for _, db := range d.dbs() {
+		slot, exists, err := db.HighestSignedProposal(ctx, publicKey)
+		if err != nil {
+			return 0, false, err
+		}
+		if exists && (!found || slot > highest) {
+			highest, found = slot, true
+		}
+	}
+	return highest, found, nil
+}
+
+// LowestSignedProposal returns the lowest slot signed by a key in any database.
+func (d *slashingProtectionDB) LowestSignedProposal(
+	ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte,
+) (types.Slot, bool, error) {
+	var lowest types.Slot
+	var found bool
+	for _, db := range d.dbs() {
+		slot, exists, err := db.LowestSignedProposal(ctx, publicKey)
+		if err != nil {
+			return 0, false, err
+		}
+		if exists && (!found || slot < lowest) {
+			lowest, found = slot, true
+		}
+	}
+	return lowest, found, nil
+}
+
+// ProposalHistoryForPubKey returns the proposals of a key in every database.
+func (d *slashingProtectionDB) ProposalHistoryForPubKey(
+	ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte,
+) ([]*kv.Proposal, error) {
+	var proposals []*kv.Proposal
+	for _, db := range d.dbsOf(publicKey) {
+		history, err := db.ProposalHistoryForPubKey(ctx, publicKey)
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, history...)
+	}
+	return proposals, nil
+}
+
+// ProposalHistoryForSlot returns the signing root of the proposal of a key at a slot in any database.
+func (d *slashingProtectionDB) ProposalHistoryForSlot(
+	ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot,
+) ([32]byte, bool, error) {
+	for _, db := range d.dbsOf(publicKey) {
+		signingRoot, exists, err := db.ProposalHistoryForSlot(ctx, publicKey, slot)
+		if err != nil || exists {
+			return signingRoot, exists, err
+		}
+	}
+	return [32]byte{}, false, nil
+}
+
+// SaveProposalHistoryForSlot saves a proposal to the database storing the history of the key.
+func (d *slashingProtectionDB) SaveProposalHistoryForSlot(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot []byte,
+) error {
+	return d.dbOf(pubKey).SaveProposalHistoryForSlot(ctx, pubKey, slot, signingRoot)
+}
+
+// ProposedPublicKeys returns the keys with proposals in any database.
+func (d *slashingProtectionDB) ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	return d.publicKeys(ctx, iface.ValidatorDB.ProposedPublicKeys)
+}
+
+// EIPImportBlacklistedPublicKeys returns the keys blacklisted in any database.
+func (d *slashingProtectionDB) EIPImportBlacklistedPublicKeys(
+	ctx context.Context,
+) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	return d.publicKeys(ctx, iface.ValidatorDB.EIPImportBlacklistedPublicKeys)
+}
+
+// SaveEIPImportBlacklistedPublicKeys blacklists keys in the database storing their history.
+func (d *slashingProtectionDB) SaveEIPImportBlacklistedPublicKeys(
+	ctx context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte,
+) error {
+	for db, pubKeys := range d.groupByDB(publicKeys) {
+		if err := db.SaveEIPImportBlacklistedPublicKeys(ctx, pubKeys); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SigningRootAtTargetEpoch returns the signing root of the attestation of a key at a target epoch in any
+// database.
+func (d *slashingProtectionDB) SigningRootAtTargetEpoch(
+	ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, target types.Epoch,
+) ([32]byte, error) {
+	for _, db := range d.dbsOf(publicKey) {
+		signingRoot, err := db.SigningRootAtTargetEpoch(ctx, publicKey, target)
+		if err != nil || signingRoot != [32]byte{} {
+			return signingRoot, err
+		}
+	}
+	return [32]byte{}, nil
+}
+
+// LowestSignedTargetEpoch returns the lowest target epoch signed by a key in any database.
+func (d *slashingProtectionDB) LowestSignedTargetEpoch(
+	ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte,
+) (types.Epoch, bool, error) {
+	return d.lowestEpoch(ctx, publicKey, iface.ValidatorDB.LowestSignedTargetEpoch)
+}
+
+// LowestSignedSourceEpoch returns the lowest source epoch signed by a key in any database.
+func (d *slashingProtectionDB) LowestSignedSourceEpoch(
+	ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte,
+) (types.Epoch, bool, error) {
+	return d.lowestEpoch(ctx, publicKey, iface.ValidatorDB.LowestSignedSourceEpoch)
+}
+
+// AttestedPublicKeys returns the keys with attestations in any database.
+func (d *slashingProtectionDB) AttestedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	return d.publicKeys(ctx, iface.ValidatorDB.AttestedPublicKeys)
+}
+
+// CheckSlashableAttestation checks an attestation against the history of the key in every database.
+func (d *slashingProtectionDB) CheckSlashableAttestation(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (kv.SlashingKind, error) {
+	for _, db := range d.dbsOf(pubKey) {
+		kind, err := db.CheckSlashableAttestation(ctx, pubKey, signingRoot, att)
+		if err != nil || kind != kv.NotSlashable {
+			return kind, err
+		}
+	}
+	return kv.NotSlashable, nil
+}
+
+// SaveAttestationForPubKey saves an attestation to the database storing the history of the key.
+func (d *slashingProtectionDB) SaveAttestationForPubKey(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) error {
+	return d.dbOf(pubKey).SaveAttestationForPubKey(ctx, pubKey, signingRoot, att)
+}
+
+// SaveAttestationsForPubKey saves attestations to the database storing the history of the key.
+func (d *slashingProtectionDB) SaveAttestationsForPubKey(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoots [][32]byte, atts []*ethpb.IndexedAttestation,
+) error {
+	return d.dbOf(pubKey).SaveAttestationsForPubKey(ctx, pubKey, signingRoots, atts)
+}
+
+// AttestationHistoryForPubKey returns the attestations of a key in every database.
+func (d *slashingProtectionDB) AttestationHistoryForPubKey(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte,
+) ([]*kv.AttestationRecord, error) {
+	var records []*kv.AttestationRecord
+	for _, db := range d.dbsOf(pubKey) {
+		history, err := db.AttestationHistoryForPubKey(ctx, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, history...)
+	}
+	return records, nil
+}
+
+func (d *slashingProtectionDB) publicKeys(
+	ctx context.Context, keysOf func(iface.ValidatorDB, context.Context) ([][fieldparams.BLSPubkeyLength]byte, error),
+) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	var pubKeys [][fieldparams.BLSPubkeyLength]byte
+	for _, db := range d.dbs() {
+		keys, err := keysOf(db, ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, pubKey := range keys {
+			if seen[pubKey] {
+				continue
+			}
+			seen[pubKey] = true
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	return pubKeys, nil
+}
+
+func (d *slashingProtectionDB) lowestEpoch(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	epochOf func(iface.ValidatorDB, context.Context, [fieldparams.BLSPubkeyLength]byte) (types.Epoch, bool, error),
+) (types.Epoch, bool, error) {
+	var lowest types.Epoch
+	var found bool
+	for _, db := range d.dbs() {
+		epoch, exists, err := epochOf(db, ctx, pubKey)
+		if err != nil {
+			return 0, false, err
+		}
+		if exists && (!found || epoch < lowest) {
+			lowest, found = epoch, true
+		}
+	}
+	return lowest, found, nil
+}
@@ -0,0 +1,118 @@
+package tenants
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/v3/validator/db/testing"
+)
+
+// setupTenantDB opens a slashing protection database of a tenant, whose metrics are not exported like in
+// openTenant.
+func setupTenantDB(t *testing.T) iface.ValidatorDB {
+	db, err := kv.NewKVStore(context.Background(), t.TempDir(), &kv.Config{DisableMetrics: true})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	return db
+}
+
+func TestSlashingProtectionDB(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	acme := interopTenant(t, "acme", 0, 1)
+	acme.DB = setupTenantDB(t)
+	globex := interopTenant(t, "globex", 1, 1)
+	globex.DB = setupTenantDB(t)
+	ts, err := New(ctx, []*Tenant{acme, globex})
+	require.NoError(t, err)
+	acmeKeys, err := acme.Keymanager.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	globexKeys, err := globex.Keymanager.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	valDB := dbtest.SetupDB(t, nil)
+	root := [32]byte{1}
+	require.NoError(t, valDB.SaveGenesisValidatorsRoot(ctx, root[:]))
+	db, err := ts.SlashingProtectionDB(ctx, valDB)
+	require.NoError(t, err)
+
+	// The genesis validators root of the validator database is copied to the databases of the tenants.
+	for _, tenant := range []*Tenant{acme, globex} {
+		tenantRoot, err := tenant.DB.GenesisValidatorsRoot(ctx)
+		require.NoError(t, err)
+		assert.DeepEqual(t, root[:], tenantRoot)
+	}
+
+	// The history of a key is saved to the database of its tenant only.
+	signingRoot := [32]byte{2}
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, acmeKeys[0], 10, signingRoot[:]))
+	_, exists, err := acme.DB.ProposalHistoryForSlot(ctx, acmeKeys[0], 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	for _, other := range []iface.ValidatorDB{globex.DB, valDB} {
+		_, exists, err = other.ProposalHistoryForSlot(ctx, acmeKeys[0], 10)
+		require.NoError(t, err)
+		assert.Equal(t, false, exists)
+	}
+	att := &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 1},
+			Target: &ethpb.Checkpoint{Epoch: 2},
+		},
+	}
+	require.NoError(t, db.SaveAttestationForPubKey(ctx, globexKeys[0], signingRoot, att))
+	attested, err := globex.DB.AttestedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, globexKeys, attested)
+	attested, err = acme.DB.AttestedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(attested))
+
+	// Keys are checked against the history of every database, such as the history of a key recorded while it
+	// belonged to another tenant.
+	require.NoError(t, acme.DB.SaveProposalHistoryForSlot(ctx, globexKeys[0], 5, signingRoot[:]))
+	lowest, exists, err := db.LowestSignedProposal(ctx, globexKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, 5, int(lowest))
+	otherRoot := [32]byte{3}
+	require.NoError(t, acme.DB.SaveAttestationForPubKey(ctx, acmeKeys[0], otherRoot, att))
+	kind, err := db.CheckSlashableAttestation(ctx, acmeKeys[0], signingRoot, att)
+	require.ErrorContains(t, "double vote found", err)
+	assert.Equal(t, kv.DoubleVote, kind)
+	kind, err = db.CheckSlashableAttestation(ctx, globexKeys[0], signingRoot, att)
+	require.NoError(t, err)
+	assert.Equal(t, kv.NotSlashable, kind)
+	source, exists, err := db.LowestSignedSourceEpoch(ctx, globexKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, 1, int(source))
+
+	proposed, err := db.ProposedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(proposed))
+}
+
+func TestSlashingProtectionDB_GenesisValidatorsRootMismatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	acme := interopTenant(t, "acme", 0, 1)
+	acme.DB = setupTenantDB(t)
+	ts, err := New(ctx, []*Tenant{acme})
+	require.NoError(t, err)
+	acmeRoot := [32]byte{2}
+	require.NoError(t, acme.DB.SaveGenesisValidatorsRoot(ctx, acmeRoot[:]))
+
+	valDB := dbtest.SetupDB(t, nil)
+	root := [32]byte{1}
+	require.NoError(t, valDB.SaveGenesisValidatorsRoot(ctx, root[:]))
+	_, err = ts.SlashingProtectionDB(ctx, valDB)
+	require.ErrorContains(t, "invalid slashing protection database of tenant acme", err)
+}
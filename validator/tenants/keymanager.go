@@ -0,0 +1,119 @@
+package tenants
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/v3/proto/eth/service"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+)
+
+var _ = keymanager.IKeymanager(&Tenants{})
+
+// FetchValidatingPublicKeys returns the validating keys of every tenant.
+func (ts *Tenants) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	var pubKeys [][fieldparams.BLSPubkeyLength]byte
+	for _, t := range ts.tenants {
+		tenantKeys, err := t.Keymanager.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch validating keys of tenant %s", t.Name)
+		}
+		for _, pubKey := range tenantKeys {
+			if seen[pubKey] {
+				continue
+			}
+			seen[pubKey] = true
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	return pubKeys, nil
+}
+
+// Sign signs a message with the keymanager of the tenant owning the validating key.
+func (ts *Tenants) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	t, err := ts.ownerOf(ctx, req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return t.Keymanager.Sign(ctx, req)
+}
+
+// SubscribeAccountChanges notifies of the validating keys of every tenant whenever the keys of a tenant change.
+func (ts *Tenants) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return ts.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ExtractKeystores extracts the keystores of validating keys from the keymanagers of their tenants.
+func (ts *Tenants) ExtractKeystores(
+	ctx context.Context, publicKeys []bls.PublicKey, password string,
+) ([]*keymanager.Keystore, error) {
+	keystores := make([]*keymanager.Keystore, 0, len(publicKeys))
+	for _, pubKey := range publicKeys {
+		t, err := ts.ownerOf(ctx, pubKey.Marshal())
+		if err != nil {
+			return nil, err
+		}
+		tenantKeystores, err := t.Keymanager.ExtractKeystores(ctx, []bls.PublicKey{pubKey}, password)
+		if err != nil {
+			return nil, err
+		}
+		keystores = append(keystores, tenantKeystores...)
+	}
+	return keystores, nil
+}
+
+// ListKeymanagerAccounts lists the accounts of every tenant.
+func (ts *Tenants) ListKeymanagerAccounts(ctx context.Context, cfg keymanager.ListKeymanagerAccountConfig) error {
+	for _, t := range ts.tenants {
+		fmt.Printf("Tenant %s\n", t.Name)
+		tenantCfg := cfg
+		tenantCfg.WalletAccountsDir = t.Wallet.AccountsDir()
+		if err := t.Keymanager.ListKeymanagerAccounts(ctx, tenantCfg); err != nil {
+			return errors.Wrapf(err, "could not list accounts of tenant %s", t.Name)
+		}
+	}
+	return nil
+}
+
+// DeleteKeystores deletes validating keys from the keymanagers of their tenants. Keys which do not belong
+// to any tenant are reported as not found.
+func (ts *Tenants) DeleteKeystores(ctx context.Context, publicKeys [][]byte) ([]*ethpbservice.DeletedKeystoreStatus, error) {
+	statuses := make([]*ethpbservice.DeletedKeystoreStatus, len(publicKeys))
+	for i, pubKey := range publicKeys {
+		t := ts.Owner(bytesutil.ToBytes48(pubKey))
+		if t == nil {
+			statuses[i] = &ethpbservice.DeletedKeystoreStatus{
+				Status: ethpbservice.DeletedKeystoreStatus_NOT_FOUND,
+			}
+			continue
+		}
+		tenantStatuses, err := t.Keymanager.DeleteKeystores(ctx, [][]byte{pubKey})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not delete keys of tenant %s", t.Name)
+		}
+		statuses[i] = tenantStatuses[0]
+	}
+	return statuses, nil
+}
+
+// ownerOf returns the tenant owning a validating key, refreshing the owners of the keys once if no
+// tenant is known to own it, in case the key was just added.
+func (ts *Tenants) ownerOf(ctx context.Context, pubKey []byte) (*Tenant, error) {
+	if t := ts.Owner(bytesutil.ToBytes48(pubKey)); t != nil {
+		return t, nil
+	}
+	if err := ts.refreshOwners(ctx); err != nil {
+		log.WithError(err).Error("Could not refresh the keys of the tenants")
+	}
+	if t := ts.Owner(bytesutil.ToBytes48(pubKey)); t != nil {
+		return t, nil
+	}
+	return nil, fmt.Errorf("no tenant has the validating key %#x", bytesutil.Trunc(pubKey))
+}
@@ -0,0 +1,312 @@
+// Package tenants hosts several tenants, such as the customers of a staking service, in a single validator
+// client. Each tenant has its own wallet and keymanager, slashing protection database, proposer settings and
+// keymanager API auth token.
+package tenants
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v3/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v3/config/fieldparams"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	"github.com/prysmaticlabs/prysm/v3/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v3/io/file"
+	accountsiface "github.com/prysmaticlabs/prysm/v3/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v3/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+var log = logrus.WithField("prefix", "tenants")
+
+// Config of the tenants, as read from the tenants file.
+type Config struct {
+	Tenants []*TenantConfig `json:"tenants" yaml:"tenants"`
+}
+
+// TenantConfig defines the wallet, slashing protection database and proposer settings of a tenant.
+type TenantConfig struct {
+	Name                 string `json:"name" yaml:"name"`
+	WalletDir            string `json:"wallet_dir" yaml:"wallet_dir"`
+	WalletPasswordFile   string `json:"wallet_password_file" yaml:"wallet_password_file"`
+	ProposerSettingsFile string `json:"proposer_settings_file" yaml:"proposer_settings_file"`
+	// DataDir is the directory of the slashing protection database of the tenant, the wallet directory if empty.
+	DataDir string `json:"data_dir" yaml:"data_dir"`
+}
+
+// Tenant hosted by the validator client.
+type Tenant struct {
+	Name       string
+	WalletDir  string
+	Wallet     *wallet.Wallet
+	Keymanager keymanager.IKeymanager
+	// DB stores the slashing protection history of the keys of the tenant. The history is stored in the
+	// database of the validator client if nil.
+	DB iface.ValidatorDB
+	// ProposerSettingsFile is the proposer settings file of the tenant, if any.
+	ProposerSettingsFile string
+	// ProposerSettings of the keys of the tenant. The proposer settings of the validator client apply
+	// to the keys of the tenant if nil.
+	ProposerSettings *validatorserviceconfig.ProposerSettings
+}
+
+// Tenants hosted by the validator client. It implements keymanager.IKeymanager by combining the
+// keymanagers of the tenants, so that the validator client validates with the keys of every tenant.
+type Tenants struct {
+	tenants             []*Tenant
+	lock                sync.RWMutex
+	owners              map[[fieldparams.BLSPubkeyLength]byte]*Tenant
+	accountsChangedFeed *event.Feed
+}
+
+// ReadConfig reads the configuration of the tenants from a YAML or JSON file.
+func ReadConfig(path string) (*Config, error) {
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read tenants file %s", path)
+	}
+	cfg := &Config{}
+	if err := yaml.Unmarshal(enc, cfg); err != nil {
+		return nil, errors.Wrapf(err, "could not decode tenants file %s", path)
+	}
+	if len(cfg.Tenants) == 0 {
+		return nil, errors.New("no tenants defined in tenants file")
+	}
+	names := make(map[string]bool, len(cfg.Tenants))
+	for i, t := range cfg.Tenants {
+		if t == nil || t.Name == "" {
+			return nil, fmt.Errorf("tenant %d has no name", i)
+		}
+		if names[t.Name] {
+			return nil, fmt.Errorf("tenant %s is defined more than once", t.Name)
+		}
+		names[t.Name] = true
+		if t.WalletDir == "" {
+			return nil, fmt.Errorf("tenant %s has no wallet directory", t.Name)
+		}
+	}
+	return cfg, nil
+}
+
+// Load opens the wallet and initializes the keymanager of each tenant defined in the tenants file.
+func Load(ctx context.Context, path string) (*Tenants, error) {
+	cfg, err := ReadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	tenants := make([]*Tenant, len(cfg.Tenants))
+	for i, c := range cfg.Tenants {
+		t, err := openTenant(ctx, c)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not open tenant %s", c.Name)
+		}
+		tenants[i] = t
+	}
+	return New(ctx, tenants)
+}
+
+func openTenant(ctx context.Context, cfg *TenantConfig) (*Tenant, error) {
+	var password string
+	if cfg.WalletPasswordFile != "" {
+		enc, err := file.ReadFileAsBytes(cfg.WalletPasswordFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read wallet password file")
+		}
+		password = strings.TrimRight(string(enc), "\r\n")
+	}
+	w, err := wallet.OpenWallet(ctx, &wallet.Config{
+		WalletDir:      cfg.WalletDir,
+		WalletPassword: password,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open wallet")
+	}
+	km, err := w.InitializeKeymanager(ctx, accountsiface.InitKeymanagerConfig{ListenForChanges: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize keymanager")
+	}
+	dataDir := cfg.DataDir
+	if dataDir == "" {
+		dataDir = cfg.WalletDir
+	}
+	// Only the metrics of the database of the validator client are exported.
+	db, err := kv.NewKVStore(ctx, dataDir, &kv.Config{DisableMetrics: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open slashing protection database")
+	}
+	if err := db.RunUpMigrations(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not run slashing protection database migrations")
+	}
+	log.WithFields(logrus.Fields{
+		"tenant":          cfg.Name,
+		"wallet":          w.AccountsDir(),
+		"keymanager-kind": w.KeymanagerKind().String(),
+		"databasePath":    db.DatabasePath(),
+	}).Info("Opened tenant wallet")
+	return &Tenant{
+		Name:                 cfg.Name,
+		WalletDir:            cfg.WalletDir,
+		Wallet:               w,
+		Keymanager:           km,
+		DB:                   db,
+		ProposerSettingsFile: cfg.ProposerSettingsFile,
+	}, nil
+}
+
+// New combines the keymanagers of the tenants. An error is returned if a key belongs to several tenants.
+// The tenants are kept up to date with the keys added to or removed from their keymanager until the
+// context is canceled.
+func New(ctx context.Context, tenants []*Tenant) (*Tenants, error) {
+	ts := &Tenants{
+		tenants:             tenants,
+		accountsChangedFeed: new(event.Feed),
+	}
+	if err := ts.refreshOwners(ctx); err != nil {
+		return nil, err
+	}
+	for _, t := range tenants {
+		go ts.listenForKeyChanges(ctx, t)
+	}
+	return ts, nil
+}
+
+// List the tenants.
+func (ts *Tenants) List() []*Tenant {
+	return ts.tenants
+}
+
+// Owner returns the tenant owning a validating key, or nil if no tenant owns it.
+func (ts *Tenants) Owner(pubKey [fieldparams.BLSPubkeyLength]byte) *Tenant {
+	ts.lock.RLock()
+	defer ts.lock.RUnlock()
+	return ts.owners[pubKey]
+}
+
+// Owns returns true if a validating key belongs to the tenant.
+func (t *Tenant) Owns(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (bool, error) {
+	pubKeys, err := t.Keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, k := range pubKeys {
+		if k == pubKey {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// refreshOwners maps the validating keys to their tenant. A key belonging to several tenants is mapped to
+// the first of them and an error is returned.
+func (ts *Tenants) refreshOwners(ctx context.Context) error {
+	owners := make(map[[fieldparams.BLSPubkeyLength]byte]*Tenant)
+	var sharedKeyErr error
+	for _, t := range ts.tenants {
+		pubKeys, err := t.Keymanager.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "could not fetch validating keys of tenant %s", t.Name)
+		}
+		for _, pubKey := range pubKeys {
+			if owner, ok := owners[pubKey]; ok {
+				sharedKeyErr = fmt.Errorf(
+					"validating key %#x belongs to both tenants %s and %s", bytesutil.Trunc(pubKey[:]), owner.Name, t.Name,
+				)
+				continue
+			}
+			owners[pubKey] = t
+		}
+	}
+	ts.lock.Lock()
+	ts.owners = owners
+	ts.lock.Unlock()
+	return sharedKeyErr
+}
+
+func (ts *Tenants) listenForKeyChanges(ctx context.Context, t *Tenant) {
+	pubKeysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := t.Keymanager.SubscribeAccountChanges(pubKeysChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case <-pubKeysChan:
+			if err := ts.refreshOwners(ctx); err != nil {
+				log.WithError(err).WithField("tenant", t.Name).Error("Could not refresh the keys of the tenants")
+			}
+			pubKeys, err := ts.FetchValidatingPublicKeys(ctx)
+			if err != nil {
+				log.WithError(err).Error("Could not fetch the keys of the tenants")
+				continue
+			}
+			ts.accountsChangedFeed.Send(pubKeys)
+		case err := <-sub.Err():
+			if err != nil {
+				log.WithError(err).WithField("tenant", t.Name).Error("Could not listen for key changes")
+			}
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// ProposerSettings completes the proposer settings of the validator client with the proposer settings of
+// the tenants, so that the keys of a tenant without settings of their own use the default settings of their
+// tenant. Keys already configured in the given settings are left untouched, so that the settings changed
+// through the keymanager API are kept.
+func (ts *Tenants) ProposerSettings(
+	ctx context.Context, settings *validatorserviceconfig.ProposerSettings,
+) (*validatorserviceconfig.ProposerSettings, error) {
+	var merged *validatorserviceconfig.ProposerSettings
+	if settings != nil {
+		merged = &validatorserviceconfig.ProposerSettings{
+			ProposeConfig: make(map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption, len(settings.ProposeConfig)),
+			DefaultConfig: settings.DefaultConfig,
+		}
+		for pubKey, option := range settings.ProposeConfig {
+			merged.ProposeConfig[pubKey] = option
+		}
+	}
+	for _, t := range ts.tenants {
+		if t.ProposerSettings == nil {
+			continue
+		}
+		pubKeys, err := t.Keymanager.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch validating keys of tenant %s", t.Name)
+		}
+		for _, pubKey := range pubKeys {
+			option, ok := t.ProposerSettings.ProposeConfig[pubKey]
+			if !ok {
+				option = t.ProposerSettings.DefaultConfig
+			}
+			if option == nil {
+				continue
+			}
+			if merged == nil {
+				defaultOption := validatorserviceconfig.DefaultProposerOption()
+				merged = &validatorserviceconfig.ProposerSettings{
+					ProposeConfig: make(map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption),
+					DefaultConfig: &defaultOption,
+				}
+			}
+			if _, ok := merged.ProposeConfig[pubKey]; ok {
+				continue
+			}
+			// Each key gets its own copy of the option, which may then be changed through the keymanager API.
+			keyOption := *option
+			if option.BuilderConfig != nil {
+				builderConfig := *option.BuilderConfig
+				keyOption.BuilderConfig = &builderConfig
+			}
+			merged.ProposeConfig[pubKey] = &keyOption
+		}
+	}
+	return merged, nil
+}
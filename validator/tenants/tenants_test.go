@@ -0,0 +1,169 @@
+package tenants
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/v3/config/validator/service"
+	validatorpb "github.com/prysmaticlabs/prysm/v3/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v3/testing/assert"
+	"github.com/prysmaticlabs/prysm/v3/testing/require"
+	"github.com/prysmaticlabs/prysm/v3/validator/keymanager/local"
+)
+
+func interopTenant(t *testing.T, name string, offset, numKeys uint64) *Tenant {
+	km, err := local.NewInteropKeymanager(context.Background(), offset, numKeys)
+	require.NoError(t, err)
+	return &Tenant{Name: name, Keymanager: km}
+}
+
+func TestReadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tenants.yaml")
+	write := func(content string) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+
+	write(`tenants:
+  - name: acme
+    wallet_dir: /wallets/acme
+    wallet_password_file: /wallets/acme/password.txt
+    proposer_settings_file: /wallets/acme/proposer.yaml
+    data_dir: /data/acme
+  - name: globex
+    wallet_dir: /wallets/globex
+`)
+	cfg, err := ReadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(cfg.Tenants))
+	assert.DeepEqual(t, &TenantConfig{
+		Name:                 "acme",
+		WalletDir:            "/wallets/acme",
+		WalletPasswordFile:   "/wallets/acme/password.txt",
+		ProposerSettingsFile: "/wallets/acme/proposer.yaml",
+		DataDir:              "/data/acme",
+	}, cfg.Tenants[0])
+	assert.Equal(t, "globex", cfg.Tenants[1].Name)
+
+	write(`tenants: []`)
+	_, err = ReadConfig(path)
+	require.ErrorContains(t, "no tenants defined", err)
+
+	write(`tenants:
+  - wallet_dir: /wallets/acme
+`)
+	_, err = ReadConfig(path)
+	require.ErrorContains(t, "tenant 0 has no name", err)
+
+	write(`tenants:
+  - name: acme
+    wallet_dir: /wallets/acme
+  - name: acme
+    wallet_dir: /wallets/acme2
+`)
+	_, err = ReadConfig(path)
+	require.ErrorContains(t, "tenant acme is defined more than once", err)
+
+	write(`tenants:
+  - name: acme
+`)
+	_, err = ReadConfig(path)
+	require.ErrorContains(t, "tenant acme has no wallet directory", err)
+}
+
+func TestTenants_Keymanager(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	acme := interopTenant(t, "acme", 0, 2)
+	globex := interopTenant(t, "globex", 2, 1)
+	ts, err := New(ctx, []*Tenant{acme, globex})
+	require.NoError(t, err)
+
+	pubKeys, err := ts.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(pubKeys))
+	assert.Equal(t, acme, ts.Owner(pubKeys[0]))
+	assert.Equal(t, acme, ts.Owner(pubKeys[1]))
+	assert.Equal(t, globex, ts.Owner(pubKeys[2]))
+
+	owns, err := globex.Owns(ctx, pubKeys[2])
+	require.NoError(t, err)
+	assert.Equal(t, true, owns)
+	owns, err = globex.Owns(ctx, pubKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, false, owns)
+
+	// Messages are signed by the keymanager of the tenant owning the key.
+	req := &validatorpb.SignRequest{PublicKey: pubKeys[2][:], SigningRoot: make([]byte, 32)}
+	sig, err := ts.Sign(ctx, req)
+	require.NoError(t, err)
+	expected, err := globex.Keymanager.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, expected.Marshal(), sig.Marshal())
+
+	_, err = ts.Sign(ctx, &validatorpb.SignRequest{PublicKey: make([]byte, 48), SigningRoot: make([]byte, 32)})
+	require.ErrorContains(t, "no tenant has the validating key", err)
+}
+
+func TestNew_SharedKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := New(ctx, []*Tenant{interopTenant(t, "acme", 0, 2), interopTenant(t, "globex", 1, 1)})
+	require.ErrorContains(t, "belongs to both tenants acme and globex", err)
+}
+
+func TestTenants_ProposerSettings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	acme := interopTenant(t, "acme", 0, 2)
+	globex := interopTenant(t, "globex", 2, 1)
+	ts, err := New(ctx, []*Tenant{acme, globex})
+	require.NoError(t, err)
+	pubKeys, err := ts.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	// Without proposer settings, the settings of the validator client apply.
+	settings, err := ts.ProposerSettings(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, true, settings == nil)
+
+	acmeDefault := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	acmeKey1 := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	acme.ProposerSettings = &validatorserviceconfig.ProposerSettings{
+		ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+			pubKeys[1]: {FeeRecipient: acmeKey1},
+		},
+		DefaultConfig: &validatorserviceconfig.ProposerOption{
+			FeeRecipient:  acmeDefault,
+			BuilderConfig: &validatorserviceconfig.BuilderConfig{Enabled: true, GasLimit: 30000000},
+		},
+	}
+	globalDefault := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	apiSet := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	settings, err = ts.ProposerSettings(ctx, &validatorserviceconfig.ProposerSettings{
+		ProposeConfig: map[[48]byte]*validatorserviceconfig.ProposerOption{
+			pubKeys[1]: {FeeRecipient: apiSet},
+		},
+		DefaultConfig: &validatorserviceconfig.ProposerOption{FeeRecipient: globalDefault},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, globalDefault, settings.DefaultConfig.FeeRecipient)
+	require.Equal(t, 2, len(settings.ProposeConfig))
+	assert.Equal(t, acmeDefault, settings.ProposeConfig[pubKeys[0]].FeeRecipient)
+	// Keys already configured keep their settings.
+	assert.Equal(t, apiSet, settings.ProposeConfig[pubKeys[1]].FeeRecipient)
+	// Keys of tenants without proposer settings use the default settings of the validator client.
+	_, ok := settings.ProposeConfig[pubKeys[2]]
+	assert.Equal(t, false, ok)
+
+	// Each key gets its own copy of the settings of its tenant.
+	settings.ProposeConfig[pubKeys[0]].BuilderConfig.GasLimit = 1
+	assert.Equal(t, validatorserviceconfig.Uint64(30000000), acme.ProposerSettings.DefaultConfig.BuilderConfig.GasLimit)
+
+	settings, err = ts.ProposerSettings(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, acmeKey1, settings.ProposeConfig[pubKeys[1]].FeeRecipient)
+	assert.DeepEqual(t, validatorserviceconfig.DefaultProposerOption(), *settings.DefaultConfig)
+}